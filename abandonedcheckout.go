@@ -0,0 +1,266 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gempages/go-helper/errors"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+type AbandonedCheckoutService interface {
+	List(ctx context.Context, opts ListOptions) ([]*AbandonedCheckout, string, error)
+	ListAll(ctx context.Context, query string) ([]*AbandonedCheckout, error)
+
+	GetAbandonmentByCheckout(ctx context.Context, abandonedCheckoutID string) (*model.Abandonment, error)
+	UpdateDeliveryStatus(ctx context.Context, abandonmentID, marketingActivityID string, status model.AbandonmentDeliveryState, deliveredAt *time.Time) (*model.Abandonment, error)
+}
+
+type AbandonedCheckoutServiceOp struct {
+	client *Client
+}
+
+var _ AbandonedCheckoutService = &AbandonedCheckoutServiceOp{}
+
+// AbandonedCheckout is an abandoned checkout. model.AbandonedCheckout has none of its customer,
+// dates, note or discount codes.
+type AbandonedCheckout struct {
+	ID                   string                                     `json:"id"`
+	Name                 string                                     `json:"name"`
+	Note                 string                                     `json:"note"`
+	CreatedAt            time.Time                                  `json:"createdAt"`
+	UpdatedAt            time.Time                                  `json:"updatedAt"`
+	CompletedAt          *time.Time                                 `json:"completedAt,omitempty"`
+	AbandonedCheckoutURL string                                     `json:"abandonedCheckoutUrl"`
+	TaxesIncluded        bool                                       `json:"taxesIncluded"`
+	DiscountCodes        []string                                   `json:"discountCodes,omitempty"`
+	SubtotalPriceSet     *model.MoneyBag                            `json:"subtotalPriceSet,omitempty"`
+	TotalPriceSet        *model.MoneyBag                            `json:"totalPriceSet,omitempty"`
+	Customer             *model.Customer                            `json:"customer,omitempty"`
+	LineItems            *model.AbandonedCheckoutLineItemConnection `json:"lineItems,omitempty"`
+}
+
+var abandonedCheckoutFields = fmt.Sprintf(`
+	id
+	name
+	note
+	createdAt
+	updatedAt
+	completedAt
+	abandonedCheckoutUrl
+	taxesIncluded
+	discountCodes
+	subtotalPriceSet {
+		%[1]s
+	}
+	totalPriceSet {
+		%[1]s
+	}
+	customer {
+		id
+		email
+		firstName
+		lastName
+		phone
+	}
+`, moneyBagFields)
+
+var abandonedCheckoutLineItemFields = fmt.Sprintf(`
+	id
+	title
+	variantTitle
+	sku
+	quantity
+	variant {
+		id
+	}
+	product {
+		id
+	}
+	originalTotalPriceSet {
+		%s
+	}
+`, moneyBagFields)
+
+const abandonmentFields = `
+	id
+	emailState
+	emailSentAt
+	abandonedCheckoutPayload {
+		id
+	}
+`
+
+// List returns a page of abandoned checkouts with their line items, and the cursor of the next page when there is one.
+func (s *AbandonedCheckoutServiceOp) List(ctx context.Context, opts ListOptions) ([]*AbandonedCheckout, string, error) {
+	q := fmt.Sprintf(`
+		query abandonedCheckouts($first: Int!, $after: String, $query: String, $reverse: Boolean) {
+			abandonedCheckouts(first: $first, after: $after, query: $query, reverse: $reverse) {
+				edges {
+					node {
+						%s
+						lineItems(first: 50) {
+							edges {
+								node {
+									%s
+								}
+							}
+						}
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+				}
+			}
+		}
+	`, abandonedCheckoutFields, abandonedCheckoutLineItemFields)
+
+	first := opts.First
+	if first <= 0 {
+		first = 50
+	}
+	vars := map[string]interface{}{
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+	if opts.Query != "" {
+		vars["query"] = opts.Query
+	}
+
+	out := struct {
+		AbandonedCheckouts struct {
+			Edges []struct {
+				Node   *AbandonedCheckout `json:"node"`
+				Cursor string             `json:"cursor"`
+			} `json:"edges"`
+			PageInfo struct {
+				HasNextPage bool `json:"hasNextPage"`
+			} `json:"pageInfo"`
+		} `json:"abandonedCheckouts"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, "", err
+	}
+
+	edges := out.AbandonedCheckouts.Edges
+	res := make([]*AbandonedCheckout, 0, len(edges))
+	for _, edge := range edges {
+		res = append(res, edge.Node)
+	}
+
+	nextCursor := ""
+	if out.AbandonedCheckouts.PageInfo.HasNextPage && len(edges) > 0 {
+		nextCursor = edges[len(edges)-1].Cursor
+	}
+
+	return res, nextCursor, nil
+}
+
+// ListAll exports the abandoned checkouts matching query through a bulk operation, for large recovery campaigns.
+func (s *AbandonedCheckoutServiceOp) ListAll(ctx context.Context, query string) ([]*AbandonedCheckout, error) {
+	q := fmt.Sprintf(`
+		{
+			abandonedCheckouts(query: "$query") {
+				edges {
+					node {
+						%s
+						lineItems {
+							edges {
+								node {
+									%s
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	`, abandonedCheckoutFields, abandonedCheckoutLineItemFields)
+
+	q = strings.ReplaceAll(q, "$query", query)
+
+	res := []*AbandonedCheckout{}
+	err := s.client.BulkOperation.BulkQuery(ctx, q, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *AbandonedCheckoutServiceOp) GetAbandonmentByCheckout(ctx context.Context, abandonedCheckoutID string) (*model.Abandonment, error) {
+	q := fmt.Sprintf(`
+		query abandonmentByAbandonedCheckoutId($abandonedCheckoutId: ID!) {
+			abandonmentByAbandonedCheckoutId(abandonedCheckoutId: $abandonedCheckoutId) {
+				%s
+			}
+		}
+	`, abandonmentFields)
+
+	vars := map[string]interface{}{
+		"abandonedCheckoutId": abandonedCheckoutID,
+	}
+	out := struct {
+		Abandonment *model.Abandonment `json:"abandonmentByAbandonedCheckoutId"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Abandonment == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "abandonment not found", nil)
+	}
+
+	return out.Abandonment, nil
+}
+
+// UpdateDeliveryStatus reports the delivery of a recovery email sent by the marketing activity, e.g. SENT or DELIVERED.
+func (s *AbandonedCheckoutServiceOp) UpdateDeliveryStatus(ctx context.Context, abandonmentID, marketingActivityID string, status model.AbandonmentDeliveryState, deliveredAt *time.Time) (*model.Abandonment, error) {
+	m := fmt.Sprintf(`
+		mutation abandonmentUpdateActivitiesDeliveryStatuses($abandonmentId: ID!, $marketingActivityId: ID!, $deliveryStatus: AbandonmentDeliveryState!, $deliveredAt: DateTime) {
+			abandonmentUpdateActivitiesDeliveryStatuses(abandonmentId: $abandonmentId, marketingActivityId: $marketingActivityId, deliveryStatus: $deliveryStatus, deliveredAt: $deliveredAt) {
+				abandonment {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, abandonmentFields)
+
+	vars := map[string]interface{}{
+		"abandonmentId":       abandonmentID,
+		"marketingActivityId": marketingActivityID,
+		"deliveryStatus":      status,
+	}
+	if deliveredAt != nil {
+		vars["deliveredAt"] = deliveredAt.UTC().Format(time.RFC3339)
+	}
+
+	out := struct {
+		AbandonmentUpdateActivitiesDeliveryStatuses struct {
+			Abandonment *model.Abandonment `json:"abandonment,omitempty"`
+			UserErrors  []UserErrors       `json:"userErrors,omitempty"`
+		} `json:"abandonmentUpdateActivitiesDeliveryStatuses"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.AbandonmentUpdateActivitiesDeliveryStatuses.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.AbandonmentUpdateActivitiesDeliveryStatuses.UserErrors)
+	}
+
+	return out.AbandonmentUpdateActivitiesDeliveryStatuses.Abandonment, nil
+}
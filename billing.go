@@ -3,6 +3,11 @@ package shopify
 import (
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/gempages/go-helper/errors"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+	"github.com/shopspring/decimal"
 
 	"github.com/gempages/go-shopify-graphql/graphql"
 )
@@ -13,12 +18,23 @@ type BillingService interface {
 	AppSubscriptionCancel(ctx context.Context, id graphql.ID, prorate graphql.Boolean) (*AppSubscriptionCancelResult, error)
 	AppSubscriptionCreate(ctx context.Context, input *AppSubscriptionCreateInput) (*AppSubscriptionCreateResult, error)
 	AppSubscriptionTrialExtend(ctx context.Context, input *AppSubscriptionTrailExtendInput) (*AppSubscriptionTrailExtendResult, error)
+	CreateSubscription(ctx context.Context, input AppSubscriptionInput) (*AppSubscriptionCreation, error)
+	CancelSubscription(ctx context.Context, id string, prorate bool) (*AppSubscriptionDetails, error)
+	UpdateCappedAmount(ctx context.Context, lineItemID string, amount model.MoneyInput) (*AppSubscriptionCreation, error)
+	CreateOneTimePurchase(ctx context.Context, name string, price model.MoneyInput, returnURL string, test bool) (*AppPurchaseOneTimeCreation, error)
+	ListOneTimePurchases(ctx context.Context, opts ListOptions) ([]*model.AppPurchaseOneTime, string, error)
+	GetCurrentInstallation(ctx context.Context) (*AppInstallationBilling, error)
+	CreateAppCredit(ctx context.Context, amount model.MoneyInput, description string, test bool) (*model.AppCredit, error)
+	ExtendTrial(ctx context.Context, subscriptionID string, days int) (*AppSubscriptionDetails, error)
+	GetSubscription(ctx context.Context, id string) (*AppSubscriptionDetails, error)
 }
 
 type BillingServiceOp struct {
 	client *Client
 }
 
+var _ BillingService = &BillingServiceOp{}
+
 type MoneyInput struct {
 	Amount       Decimal      `json:"amount,omitempty"`
 	CurrencyCode CurrencyCode `json:"currencyCode,omitempty"`
@@ -265,3 +281,683 @@ func (instance *BillingServiceOp) AppSubscriptionCreate(ctx context.Context, inp
 
 	return &m.AppSubscriptionCreateResult, nil
 }
+
+// AppSubscriptionInput holds the arguments of appSubscriptionCreate. A subscription may combine
+// one recurring and one usage line item; discounts are set on the recurring pricing details.
+type AppSubscriptionInput struct {
+	Name                string
+	ReturnURL           string
+	LineItems           []model.AppSubscriptionLineItemInput
+	TrialDays           *int
+	ReplacementBehavior *model.AppSubscriptionReplacementBehavior
+	Test                bool
+}
+
+// AppSubscriptionCreation is the pending subscription along with the URL the merchant must visit to approve it.
+type AppSubscriptionCreation struct {
+	Subscription    *AppSubscriptionDetails
+	ConfirmationURL string
+}
+
+// AppSubscriptionDetails mirrors model.AppSubscription with its pricing details decoded.
+// The model declares them as interfaces, which cannot be unmarshalled directly.
+type AppSubscriptionDetails struct {
+	ID               string                      `json:"id"`
+	Name             string                      `json:"name"`
+	Status           model.AppSubscriptionStatus `json:"status"`
+	Test             bool                        `json:"test"`
+	TrialDays        int                         `json:"trialDays"`
+	ReturnURL        string                      `json:"returnUrl"`
+	CreatedAt        time.Time                   `json:"createdAt"`
+	CurrentPeriodEnd *time.Time                  `json:"currentPeriodEnd"`
+	LineItems        []AppSubscriptionLineItem   `json:"lineItems"`
+}
+
+// TrialEndsAt returns when the trial ends, or nil when the subscription has no trial.
+func (s *AppSubscriptionDetails) TrialEndsAt() *time.Time {
+	if s.TrialDays <= 0 {
+		return nil
+	}
+	end := s.CreatedAt.AddDate(0, 0, s.TrialDays)
+	return &end
+}
+
+// IsActive reports whether the merchant is currently being billed for the subscription.
+func (s *AppSubscriptionDetails) IsActive() bool {
+	return s.Status == model.AppSubscriptionStatusActive
+}
+
+// IsTerminal reports whether the subscription can no longer become active.
+func (s *AppSubscriptionDetails) IsTerminal() bool {
+	switch s.Status {
+	case model.AppSubscriptionStatusCancelled, model.AppSubscriptionStatusDeclined, model.AppSubscriptionStatusExpired:
+		return true
+	}
+	return false
+}
+
+type AppSubscriptionLineItem struct {
+	ID   string `json:"id"`
+	Plan struct {
+		PricingDetails AppPricingDetails `json:"pricingDetails"`
+	} `json:"plan"`
+}
+
+// AppPricingDetails is either AppRecurringPricing or AppUsagePricing, as told by Typename.
+type AppPricingDetails struct {
+	Typename string                   `json:"__typename"`
+	Interval model.AppPricingInterval `json:"interval"`
+	// Recurring pricing
+	Price    *MoneyV2                        `json:"price,omitempty"`
+	Discount *AppSubscriptionDiscountDetails `json:"discount,omitempty"`
+	// Usage pricing
+	CappedAmount *MoneyV2 `json:"cappedAmount,omitempty"`
+	BalanceUsed  *MoneyV2 `json:"balanceUsed,omitempty"`
+	Terms        string   `json:"terms,omitempty"`
+}
+
+func (d AppPricingDetails) IsRecurring() bool {
+	return d.Typename == "AppRecurringPricing"
+}
+
+func (d AppPricingDetails) IsUsage() bool {
+	return d.Typename == "AppUsagePricing"
+}
+
+type AppSubscriptionDiscountDetails struct {
+	DurationLimitInIntervals     *int     `json:"durationLimitInIntervals"`
+	RemainingDurationInIntervals *int     `json:"remainingDurationInIntervals"`
+	PriceAfterDiscount           *MoneyV2 `json:"priceAfterDiscount"`
+	Value                        struct {
+		Typename   string   `json:"__typename"`
+		Amount     *MoneyV2 `json:"amount,omitempty"`
+		Percentage *float64 `json:"percentage,omitempty"`
+	} `json:"value"`
+}
+
+const appSubscriptionFields = `
+	id
+	name
+	status
+	test
+	trialDays
+	returnUrl
+	createdAt
+	currentPeriodEnd
+	lineItems {
+		id
+		plan {
+			pricingDetails {
+				__typename
+				... on AppRecurringPricing {
+					interval
+					price {
+						amount
+						currencyCode
+					}
+					discount {
+						durationLimitInIntervals
+						remainingDurationInIntervals
+						priceAfterDiscount {
+							amount
+							currencyCode
+						}
+						value {
+							__typename
+							... on AppSubscriptionDiscountAmount {
+								amount {
+									amount
+									currencyCode
+								}
+							}
+							... on AppSubscriptionDiscountPercentage {
+								percentage
+							}
+						}
+					}
+				}
+				... on AppUsagePricing {
+					interval
+					terms
+					cappedAmount {
+						amount
+						currencyCode
+					}
+					balanceUsed {
+						amount
+						currencyCode
+					}
+				}
+			}
+		}
+	}
+`
+
+// CreateSubscription creates a pending app subscription. Creating a subscription while another one is
+// active replaces it according to ReplacementBehavior once the merchant approves the new one.
+func (instance *BillingServiceOp) CreateSubscription(ctx context.Context, input AppSubscriptionInput) (*AppSubscriptionCreation, error) {
+	m := fmt.Sprintf(`
+		mutation appSubscriptionCreate($name: String!, $returnUrl: URL!, $lineItems: [AppSubscriptionLineItemInput!]!, $trialDays: Int, $replacementBehavior: AppSubscriptionReplacementBehavior, $test: Boolean) {
+			appSubscriptionCreate(name: $name, returnUrl: $returnUrl, lineItems: $lineItems, trialDays: $trialDays, replacementBehavior: $replacementBehavior, test: $test) {
+				appSubscription {
+					%s
+				}
+				confirmationUrl
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, appSubscriptionFields)
+
+	if err := validateAppSubscriptionLineItems(input.LineItems); err != nil {
+		return nil, err
+	}
+
+	vars := map[string]interface{}{
+		"name":      input.Name,
+		"returnUrl": input.ReturnURL,
+		"lineItems": input.LineItems,
+		"test":      input.Test,
+	}
+	if input.TrialDays != nil {
+		vars["trialDays"] = *input.TrialDays
+	}
+	if input.ReplacementBehavior != nil {
+		vars["replacementBehavior"] = *input.ReplacementBehavior
+	}
+
+	out := struct {
+		AppSubscriptionCreate struct {
+			AppSubscription *AppSubscriptionDetails `json:"appSubscription"`
+			ConfirmationURL *string                 `json:"confirmationUrl"`
+			UserErrors      []model.UserError       `json:"userErrors"`
+		} `json:"appSubscriptionCreate"`
+	}{}
+	err := instance.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.AppSubscriptionCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.AppSubscriptionCreate.UserErrors)
+	}
+
+	result := &AppSubscriptionCreation{Subscription: out.AppSubscriptionCreate.AppSubscription}
+	if out.AppSubscriptionCreate.ConfirmationURL != nil {
+		result.ConfirmationURL = *out.AppSubscriptionCreate.ConfirmationURL
+	}
+	return result, nil
+}
+
+// CancelSubscription cancels an app subscription. With prorate, the merchant is credited the unused
+// portion of the current billing cycle.
+func (instance *BillingServiceOp) CancelSubscription(ctx context.Context, id string, prorate bool) (*AppSubscriptionDetails, error) {
+	m := fmt.Sprintf(`
+		mutation appSubscriptionCancel($id: ID!, $prorate: Boolean) {
+			appSubscriptionCancel(id: $id, prorate: $prorate) {
+				appSubscription {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, appSubscriptionFields)
+
+	vars := map[string]interface{}{
+		"id":      id,
+		"prorate": prorate,
+	}
+	out := struct {
+		AppSubscriptionCancel struct {
+			AppSubscription *AppSubscriptionDetails `json:"appSubscription"`
+			UserErrors      []model.UserError       `json:"userErrors"`
+		} `json:"appSubscriptionCancel"`
+	}{}
+	err := instance.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.AppSubscriptionCancel.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.AppSubscriptionCancel.UserErrors)
+	}
+
+	return out.AppSubscriptionCancel.AppSubscription, nil
+}
+
+// UpdateCappedAmount changes the capped amount of a usage line item. Shopify returns a confirmation URL
+// the merchant must visit before the new cap takes effect.
+func (instance *BillingServiceOp) UpdateCappedAmount(ctx context.Context, lineItemID string, amount model.MoneyInput) (*AppSubscriptionCreation, error) {
+	m := fmt.Sprintf(`
+		mutation appSubscriptionLineItemUpdate($id: ID!, $cappedAmount: MoneyInput!) {
+			appSubscriptionLineItemUpdate(id: $id, cappedAmount: $cappedAmount) {
+				appSubscription {
+					%s
+				}
+				confirmationUrl
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, appSubscriptionFields)
+
+	vars := map[string]interface{}{
+		"id":           lineItemID,
+		"cappedAmount": amount,
+	}
+	out := struct {
+		AppSubscriptionLineItemUpdate struct {
+			AppSubscription *AppSubscriptionDetails `json:"appSubscription"`
+			ConfirmationURL *string                 `json:"confirmationUrl"`
+			UserErrors      []model.UserError       `json:"userErrors"`
+		} `json:"appSubscriptionLineItemUpdate"`
+	}{}
+	err := instance.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.AppSubscriptionLineItemUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.AppSubscriptionLineItemUpdate.UserErrors)
+	}
+
+	result := &AppSubscriptionCreation{Subscription: out.AppSubscriptionLineItemUpdate.AppSubscription}
+	if out.AppSubscriptionLineItemUpdate.ConfirmationURL != nil {
+		result.ConfirmationURL = *out.AppSubscriptionLineItemUpdate.ConfirmationURL
+	}
+	return result, nil
+}
+
+// AppPurchaseOneTimeCreation is the pending purchase along with the URL the merchant must visit to approve it.
+type AppPurchaseOneTimeCreation struct {
+	Purchase        *model.AppPurchaseOneTime
+	ConfirmationURL string
+}
+
+const appPurchaseOneTimeFields = `
+	id
+	name
+	status
+	test
+	createdAt
+	price {
+		amount
+		currencyCode
+	}
+`
+
+// CreateOneTimePurchase creates a pending one-time charge, e.g. for a lifetime deal or an add-on.
+func (instance *BillingServiceOp) CreateOneTimePurchase(ctx context.Context, name string, price model.MoneyInput, returnURL string, test bool) (*AppPurchaseOneTimeCreation, error) {
+	m := fmt.Sprintf(`
+		mutation appPurchaseOneTimeCreate($name: String!, $price: MoneyInput!, $returnUrl: URL!, $test: Boolean) {
+			appPurchaseOneTimeCreate(name: $name, price: $price, returnUrl: $returnUrl, test: $test) {
+				appPurchaseOneTime {
+					%s
+				}
+				confirmationUrl
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, appPurchaseOneTimeFields)
+
+	vars := map[string]interface{}{
+		"name":      name,
+		"price":     price,
+		"returnUrl": returnURL,
+		"test":      test,
+	}
+	out := struct {
+		AppPurchaseOneTimeCreate struct {
+			AppPurchaseOneTime *model.AppPurchaseOneTime `json:"appPurchaseOneTime"`
+			ConfirmationURL    *string                   `json:"confirmationUrl"`
+			UserErrors         []model.UserError         `json:"userErrors"`
+		} `json:"appPurchaseOneTimeCreate"`
+	}{}
+	err := instance.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.AppPurchaseOneTimeCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.AppPurchaseOneTimeCreate.UserErrors)
+	}
+
+	result := &AppPurchaseOneTimeCreation{Purchase: out.AppPurchaseOneTimeCreate.AppPurchaseOneTime}
+	if out.AppPurchaseOneTimeCreate.ConfirmationURL != nil {
+		result.ConfirmationURL = *out.AppPurchaseOneTimeCreate.ConfirmationURL
+	}
+	return result, nil
+}
+
+// ListOneTimePurchases returns a page of the one-time purchases of the current installation, oldest first
+// unless opts.Reverse is set, along with the cursor of the next page.
+func (instance *BillingServiceOp) ListOneTimePurchases(ctx context.Context, opts ListOptions) ([]*model.AppPurchaseOneTime, string, error) {
+	q := fmt.Sprintf(`
+		query oneTimePurchases($first: Int!, $after: String, $reverse: Boolean) {
+			currentAppInstallation {
+				oneTimePurchases(first: $first, after: $after, reverse: $reverse, sortKey: CREATED_AT) {
+					edges {
+						node {
+							%s
+						}
+						cursor
+					}
+					pageInfo {
+						hasNextPage
+					}
+				}
+			}
+		}
+	`, appPurchaseOneTimeFields)
+
+	first := opts.First
+	if first <= 0 {
+		first = 50
+	}
+	vars := map[string]interface{}{
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+
+	out := struct {
+		CurrentAppInstallation struct {
+			OneTimePurchases struct {
+				Edges []struct {
+					Node   *model.AppPurchaseOneTime `json:"node"`
+					Cursor string                    `json:"cursor"`
+				} `json:"edges"`
+				PageInfo struct {
+					HasNextPage bool `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"oneTimePurchases"`
+		} `json:"currentAppInstallation"`
+	}{}
+	err := instance.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, "", fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	conn := out.CurrentAppInstallation.OneTimePurchases
+	purchases := make([]*model.AppPurchaseOneTime, 0, len(conn.Edges))
+	for _, edge := range conn.Edges {
+		purchases = append(purchases, edge.Node)
+	}
+	var nextCursor string
+	if conn.PageInfo.HasNextPage && len(conn.Edges) > 0 {
+		nextCursor = conn.Edges[len(conn.Edges)-1].Cursor
+	}
+	return purchases, nextCursor, nil
+}
+
+// AppInstallationBilling is the billing state of the current app installation.
+// OneTimePurchases and Credits hold the most recent 50 records, newest first.
+type AppInstallationBilling struct {
+	ID                  string
+	LaunchURL           string
+	ActiveSubscriptions []AppSubscriptionDetails
+	OneTimePurchases    []*model.AppPurchaseOneTime
+	Credits             []*model.AppCredit
+}
+
+const appCreditFields = `
+	id
+	description
+	test
+	createdAt
+	amount {
+		amount
+		currencyCode
+	}
+`
+
+// GetCurrentInstallation returns the live billing state of the installation, to gate features on.
+func (instance *BillingServiceOp) GetCurrentInstallation(ctx context.Context) (*AppInstallationBilling, error) {
+	q := fmt.Sprintf(`
+		query {
+			currentAppInstallation {
+				id
+				launchUrl
+				activeSubscriptions {
+					%s
+				}
+				oneTimePurchases(first: 50, reverse: true) {
+					edges {
+						node {
+							%s
+						}
+					}
+				}
+				credits(first: 50, reverse: true) {
+					edges {
+						node {
+							%s
+						}
+					}
+				}
+			}
+		}
+	`, appSubscriptionFields, appPurchaseOneTimeFields, appCreditFields)
+
+	out := struct {
+		CurrentAppInstallation struct {
+			ID                  string                   `json:"id"`
+			LaunchURL           string                   `json:"launchUrl"`
+			ActiveSubscriptions []AppSubscriptionDetails `json:"activeSubscriptions"`
+			OneTimePurchases    struct {
+				Edges []struct {
+					Node *model.AppPurchaseOneTime `json:"node"`
+				} `json:"edges"`
+			} `json:"oneTimePurchases"`
+			Credits struct {
+				Edges []struct {
+					Node *model.AppCredit `json:"node"`
+				} `json:"edges"`
+			} `json:"credits"`
+		} `json:"currentAppInstallation"`
+	}{}
+	err := instance.client.gql.QueryString(ctx, q, nil, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	installation := out.CurrentAppInstallation
+	result := &AppInstallationBilling{
+		ID:                  installation.ID,
+		LaunchURL:           installation.LaunchURL,
+		ActiveSubscriptions: installation.ActiveSubscriptions,
+		OneTimePurchases:    make([]*model.AppPurchaseOneTime, 0, len(installation.OneTimePurchases.Edges)),
+		Credits:             make([]*model.AppCredit, 0, len(installation.Credits.Edges)),
+	}
+	for _, edge := range installation.OneTimePurchases.Edges {
+		result.OneTimePurchases = append(result.OneTimePurchases, edge.Node)
+	}
+	for _, edge := range installation.Credits.Edges {
+		result.Credits = append(result.Credits, edge.Node)
+	}
+	return result, nil
+}
+
+// CreateAppCredit grants the shop a credit applied to its future app charges, e.g. as a refund.
+func (instance *BillingServiceOp) CreateAppCredit(ctx context.Context, amount model.MoneyInput, description string, test bool) (*model.AppCredit, error) {
+	m := fmt.Sprintf(`
+		mutation appCreditCreate($amount: MoneyInput!, $description: String!, $test: Boolean) {
+			appCreditCreate(amount: $amount, description: $description, test: $test) {
+				appCredit {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, appCreditFields)
+
+	vars := map[string]interface{}{
+		"amount":      amount,
+		"description": description,
+		"test":        test,
+	}
+	out := struct {
+		AppCreditCreate struct {
+			AppCredit  *model.AppCredit  `json:"appCredit"`
+			UserErrors []model.UserError `json:"userErrors"`
+		} `json:"appCreditCreate"`
+	}{}
+	err := instance.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.AppCreditCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.AppCreditCreate.UserErrors)
+	}
+
+	return out.AppCreditCreate.AppCredit, nil
+}
+
+// ExtendTrial adds days to the trial of an active subscription. Shopify accepts 1 to 1000 days.
+func (instance *BillingServiceOp) ExtendTrial(ctx context.Context, subscriptionID string, days int) (*AppSubscriptionDetails, error) {
+	if days < 1 || days > 1000 {
+		return nil, fmt.Errorf("trial extension must be between 1 and 1000 days, got %d", days)
+	}
+
+	m := fmt.Sprintf(`
+		mutation appSubscriptionTrialExtend($id: ID!, $days: Int!) {
+			appSubscriptionTrialExtend(id: $id, days: $days) {
+				appSubscription {
+					%s
+				}
+				userErrors {
+					field
+					message
+					code
+				}
+			}
+		}
+	`, appSubscriptionFields)
+
+	vars := map[string]interface{}{
+		"id":   subscriptionID,
+		"days": days,
+	}
+	out := struct {
+		AppSubscriptionTrialExtend struct {
+			AppSubscription *AppSubscriptionDetails                     `json:"appSubscription"`
+			UserErrors      []model.AppSubscriptionTrialExtendUserError `json:"userErrors"`
+		} `json:"appSubscriptionTrialExtend"`
+	}{}
+	err := instance.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.AppSubscriptionTrialExtend.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.AppSubscriptionTrialExtend.UserErrors)
+	}
+
+	return out.AppSubscriptionTrialExtend.AppSubscription, nil
+}
+
+// GetSubscription returns the subscription with the given ID, for instance to check its status once
+// the merchant returns from the confirmation URL.
+func (instance *BillingServiceOp) GetSubscription(ctx context.Context, id string) (*AppSubscriptionDetails, error) {
+	q := fmt.Sprintf(`
+		query appSubscription($id: ID!) {
+			node(id: $id) {
+				... on AppSubscription {
+					%s
+				}
+			}
+		}
+	`, appSubscriptionFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		Node *AppSubscriptionDetails `json:"node"`
+	}{}
+	err := instance.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.Node == nil || out.Node.ID == "" {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "app subscription not found", nil)
+	}
+
+	return out.Node, nil
+}
+
+// RecurringLineItem returns a recurring plan line item. discount may be nil.
+func RecurringLineItem(price model.MoneyInput, interval model.AppPricingInterval, discount *model.AppSubscriptionDiscountInput) model.AppSubscriptionLineItemInput {
+	return model.AppSubscriptionLineItemInput{
+		Plan: &model.AppPlanInput{
+			AppRecurringPricingDetails: &model.AppRecurringPricingInput{
+				Price:    &price,
+				Interval: &interval,
+				Discount: discount,
+			},
+		},
+	}
+}
+
+// UsageLineItem returns a usage plan line item charging up to cappedAmount per interval.
+func UsageLineItem(cappedAmount model.MoneyInput, terms string) model.AppSubscriptionLineItemInput {
+	return model.AppSubscriptionLineItemInput{
+		Plan: &model.AppPlanInput{
+			AppUsagePricingDetails: &model.AppUsagePricingInput{
+				CappedAmount: &cappedAmount,
+				Terms:        terms,
+			},
+		},
+	}
+}
+
+// PercentageDiscount discounts a recurring price by percentage, a fraction in (0, 1] where 0.25 is 25%,
+// for durationLimitInIntervals billing cycles. A limit <= 0 applies the discount indefinitely.
+func PercentageDiscount(percentage float64, durationLimitInIntervals int) *model.AppSubscriptionDiscountInput {
+	return &model.AppSubscriptionDiscountInput{
+		Value:                    &model.AppSubscriptionDiscountValueInput{Percentage: &percentage},
+		DurationLimitInIntervals: discountDurationLimit(durationLimitInIntervals),
+	}
+}
+
+// AmountDiscount discounts a recurring price by a fixed amount in the plan currency, see PercentageDiscount.
+func AmountDiscount(amount decimal.Decimal, durationLimitInIntervals int) *model.AppSubscriptionDiscountInput {
+	return &model.AppSubscriptionDiscountInput{
+		Value:                    &model.AppSubscriptionDiscountValueInput{Amount: &amount},
+		DurationLimitInIntervals: discountDurationLimit(durationLimitInIntervals),
+	}
+}
+
+func discountDurationLimit(intervals int) *int {
+	if intervals <= 0 {
+		return nil
+	}
+	return &intervals
+}
+
+// validateAppSubscriptionLineItems rejects discounts Shopify would refuse with an opaque user error.
+func validateAppSubscriptionLineItems(lineItems []model.AppSubscriptionLineItemInput) error {
+	for i, item := range lineItems {
+		if item.Plan == nil || item.Plan.AppRecurringPricingDetails == nil || item.Plan.AppRecurringPricingDetails.Discount == nil {
+			continue
+		}
+		value := item.Plan.AppRecurringPricingDetails.Discount.Value
+		switch {
+		case value == nil || (value.Percentage == nil) == (value.Amount == nil):
+			return fmt.Errorf("line item %d: discount must set exactly one of percentage or amount", i)
+		case value.Percentage != nil && (*value.Percentage <= 0 || *value.Percentage > 1):
+			return fmt.Errorf("line item %d: discount percentage must be in (0, 1], got %v", i, *value.Percentage)
+		}
+	}
+	return nil
+}
@@ -10,12 +10,13 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
-	"regexp"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gempages/go-helper/tracing"
 	"github.com/gempages/go-shopify-graphql-model/graph/model"
+	"github.com/gempages/go-shopify-graphql/gid"
 	"github.com/gempages/go-shopify-graphql/graphql"
 	"github.com/gempages/go-shopify-graphql/rand"
 	"github.com/gempages/go-shopify-graphql/utils"
@@ -40,10 +41,19 @@ type BulkOperationService interface {
 	ShouldGetBulkQueryResultURL(ctx context.Context, id *string) (*string, error)
 	CancelRunningBulkQuery(ctx context.Context) error
 	GetBulkQueryResult(ctx context.Context, id graphql.ID) (*model.BulkOperation, error)
+
+	BulkMutation(ctx context.Context, mutation string, variables io.Reader) (*model.BulkOperation, error)
+	GetCurrentBulkMutation(ctx context.Context) (*model.BulkOperation, error)
+	WaitForCurrentBulkMutation(ctx context.Context, interval time.Duration) (*model.BulkOperation, error)
 }
 
 type BulkOperationServiceOp struct {
 	client *Client
+
+	// the last status seen of the current bulk operation, for Hooks.OnBulkStatusChange
+	statusMu   sync.Mutex
+	lastID     string
+	lastStatus model.BulkOperationStatus
 }
 
 var _ BulkOperationService = &BulkOperationServiceOp{}
@@ -56,12 +66,33 @@ type mutationBulkOperationRunQueryCancel struct {
 	BulkOperationCancelResult model.BulkOperationCancelPayload `graphql:"bulkOperationCancel(id: $id)" json:"bulkOperationCancel"`
 }
 
-var gidRegex *regexp.Regexp
-
-func init() {
-	gidRegex = regexp.MustCompile(`^gid://shopify/(\w+)/\d+$`)
+type mutationBulkOperationRunMutation struct {
+	BulkOperationRunMutationResult model.BulkOperationRunMutationPayload `graphql:"bulkOperationRunMutation(mutation: $mutation, stagedUploadPath: $stagedUploadPath)" json:"bulkOperationRunMutation"`
 }
 
+const (
+	bulkMutationVariablesFilename = "bulk_op_vars"
+	bulkMutationVariablesMimetype = "text/jsonl"
+)
+
+const queryCurrentBulkMutation = `
+	query {
+		currentBulkOperation(type: MUTATION) {
+			id
+			type
+			status
+			errorCode
+			createdAt
+			completedAt
+			objectCount
+			rootObjectCount
+			fileSize
+			url
+			partialDataUrl
+		}
+	}
+`
+
 func (s *BulkOperationServiceOp) PostBulkQuery(ctx context.Context, query string) (*string, error) {
 	m := mutationBulkOperationRunQuery{}
 	vars := map[string]interface{}{
@@ -90,6 +121,7 @@ func (s *BulkOperationServiceOp) GetCurrentBulkQuery(ctx context.Context) (*mode
 	if err != nil {
 		return nil, fmt.Errorf("query: %w", err)
 	}
+	s.observeStatus(&q.CurrentBulkOperation.BulkOperation)
 	return &q.CurrentBulkOperation.BulkOperation, nil
 }
 
@@ -244,7 +276,7 @@ func (s *BulkOperationServiceOp) BulkQuery(ctx context.Context, query string, ou
 		return fmt.Errorf("download file: %w", err)
 	}
 
-	err = parseBulkQueryResult(resultFile, out)
+	err = parseBulkQueryResult(resultFile, out, s.client.gql.JSONCodec())
 	if err != nil {
 		return fmt.Errorf("parse bulk query result: %w", err)
 	}
@@ -266,6 +298,129 @@ func (s *BulkOperationServiceOp) GetBulkQueryResult(ctx context.Context, id grap
 	return q, nil
 }
 
+// BulkMutation uploads the JSONL variables, runs the mutation for each line and waits until the operation finishes.
+// The returned operation URL points to the JSONL result file, each line holds the mutation response and its `__lineNumber`.
+func (s *BulkOperationServiceOp) BulkMutation(ctx context.Context, mutation string, variables io.Reader) (*model.BulkOperation, error) {
+	var err error
+
+	// sentry tracing
+	span := sentry.StartSpan(ctx, "shopify_graphql.bulk_mutation")
+	span.Data = map[string]interface{}{
+		"GraphQL Mutation": mutation,
+	}
+	defer func() {
+		tracing.FinishSpan(span, err)
+	}()
+	ctx = span.Context()
+	// end sentry tracing
+
+	_, err = s.WaitForCurrentBulkMutation(ctx, time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("wait for current bulk mutation: %w", err)
+	}
+
+	stagedUploadPath, err := s.stageBulkMutationVariables(ctx, variables)
+	if err != nil {
+		return nil, fmt.Errorf("stage bulk mutation variables: %w", err)
+	}
+
+	m := mutationBulkOperationRunMutation{}
+	vars := map[string]interface{}{
+		"mutation":         null.StringFrom(mutation),
+		"stagedUploadPath": null.StringFrom(stagedUploadPath),
+	}
+	err = s.client.gql.Mutate(ctx, &m, vars)
+	if err != nil {
+		return nil, fmt.Errorf("error posting bulk mutation: %w", err)
+	}
+	if len(m.BulkOperationRunMutationResult.UserErrors) > 0 {
+		userErrors, _ := json.MarshalIndent(m.BulkOperationRunMutationResult.UserErrors, "", "    ")
+		err = fmt.Errorf("error posting bulk mutation: %s", userErrors)
+		return nil, err
+	}
+	if m.BulkOperationRunMutationResult.BulkOperation == nil {
+		err = fmt.Errorf("posted operation is nil")
+		return nil, err
+	}
+	id := m.BulkOperationRunMutationResult.BulkOperation.ID
+
+	q, err := s.WaitForCurrentBulkMutation(ctx, time.Second)
+	if err != nil {
+		return nil, fmt.Errorf("wait for current bulk mutation: %w", err)
+	}
+	if q.ID != id {
+		err = fmt.Errorf("bulk operation ID doesn't match, got=%v, want=%v", q.ID, id)
+		return q, err
+	}
+	if q.Status != model.BulkOperationStatusCompleted {
+		err = fmt.Errorf("bulk operation didn't complete, status=%s, error_code=%s", q.Status, q.ErrorCode)
+		return q, err
+	}
+
+	return q, nil
+}
+
+func (s *BulkOperationServiceOp) GetCurrentBulkMutation(ctx context.Context) (*model.BulkOperation, error) {
+	out := struct {
+		CurrentBulkOperation *model.BulkOperation `json:"currentBulkOperation"`
+	}{}
+	err := s.client.gql.QueryString(ctx, queryCurrentBulkMutation, nil, &out)
+	if err != nil {
+		return nil, fmt.Errorf("query: %w", err)
+	}
+	if out.CurrentBulkOperation == nil {
+		return &model.BulkOperation{}, nil
+	}
+	s.observeStatus(out.CurrentBulkOperation)
+	return out.CurrentBulkOperation, nil
+}
+
+// observeStatus calls Hooks.OnBulkStatusChange when op isn't in the status it was last seen in.
+func (s *BulkOperationServiceOp) observeStatus(op *model.BulkOperation) {
+	hook := s.client.hooks.OnBulkStatusChange
+	if hook == nil || op.ID == "" {
+		return
+	}
+
+	s.statusMu.Lock()
+	changed := op.ID != s.lastID || op.Status != s.lastStatus
+	s.lastID, s.lastStatus = op.ID, op.Status
+	s.statusMu.Unlock()
+
+	if changed {
+		hook(op.ID, op.Status)
+	}
+}
+
+func (s *BulkOperationServiceOp) WaitForCurrentBulkMutation(ctx context.Context, interval time.Duration) (*model.BulkOperation, error) {
+	q, err := s.GetCurrentBulkMutation(ctx)
+	if err != nil {
+		return q, fmt.Errorf("get current bulk mutation: %w", err)
+	}
+
+	for q.Status == model.BulkOperationStatusCreated || q.Status == model.BulkOperationStatusRunning || q.Status == model.BulkOperationStatusCanceling {
+		log.Debugf("Bulk mutation is still %s...", q.Status)
+		span := sentry.StartSpan(ctx, "time.sleep")
+		span.Description = "interval"
+		time.Sleep(interval)
+		tracing.FinishSpan(span, ctx.Err())
+		ctx = span.Context()
+
+		q, err = s.GetCurrentBulkMutation(ctx)
+		if err != nil {
+			return q, fmt.Errorf("get current bulk mutation continously: %w", err)
+		}
+	}
+	log.Debugf("Bulk mutation ready, latest status=%s", q.Status)
+
+	return q, nil
+}
+
+// stageBulkMutationVariables uploads the JSONL variables file and returns the staged upload path for bulkOperationRunMutation.
+func (s *BulkOperationServiceOp) stageBulkMutationVariables(ctx context.Context, variables io.Reader) (string, error) {
+	return s.client.File.StageBulkMutationFile(ctx, variables)
+}
+
 type bulkQueryBuilder struct {
 	operationName string
 	fields        string
@@ -302,7 +457,9 @@ func (b *bulkQueryBuilder) Build() string {
 	return q
 }
 
-func parseBulkQueryResult(resultFilePath string, out interface{}) error {
+// parseBulkQueryResult decodes the JSONL result file of a bulk query into out with codec, or
+// jsoniter.ConfigFastest when codec is nil.
+func parseBulkQueryResult(resultFilePath string, out interface{}, codec graphql.Codec) error {
 	if reflect.TypeOf(out).Kind() != reflect.Ptr {
 		return fmt.Errorf("the out arg is not a pointer")
 	}
@@ -327,9 +484,11 @@ func parseBulkQueryResult(resultFilePath string, out interface{}) error {
 	defer utils.CloseFile(resultPath)
 
 	reader := bufio.NewReader(resultPath)
-	json := jsoniter.ConfigFastest
+	if codec == nil {
+		codec = jsoniter.ConfigFastest
+	}
 
-	connectionSink := make(map[string]interface{})
+	connectionSink := make(map[string]map[string][]bulkChild)
 
 	for {
 		var line []byte
@@ -338,77 +497,27 @@ func parseBulkQueryResult(resultFilePath string, out interface{}) error {
 			break
 		}
 
-		parentIDNode := json.Get(line, "__parentId")
-		if parentIDNode.LastError() == nil {
-			parentID := parentIDNode.ToString()
-
-			gid := json.Get(line, "id")
-			if gid.LastError() != nil {
-				return fmt.Errorf("The connection type must query the `id` field")
-			}
-			edgeType, nodeType, connectionFieldName, err := concludeObjectType(gid.ToString())
+		var id, parentID, typename string
+		id, parentID, typename, err = bulkLineIDs(codec, line)
+		if err != nil {
+			return fmt.Errorf("unmarshalling: %w", err)
+		}
+		if parentID != "" {
+			var child bulkChild
+			child.edgeType, child.nodeType, child.connection, err = concludeObjectType(id, typename)
 			if err != nil {
 				return err
 			}
-			node := reflect.New(nodeType).Interface()
-			err = json.Unmarshal(line, &node)
-			if err != nil {
-				return fmt.Errorf("unmarshalling: %w", err)
-			}
-			nodeVal := reflect.ValueOf(node).Elem()
-
-			var edge interface{}
-			var edgeVal reflect.Value
-			var nodeField reflect.Value
-			if edgeType.Kind() == reflect.Ptr {
-				edge = reflect.New(edgeType.Elem()).Interface()
-				nodeField = reflect.ValueOf(edge).Elem().FieldByName(nodeFieldName)
-				edgeVal = reflect.ValueOf(edge)
-			} else {
-				edge = reflect.New(edgeType).Interface()
-
-				if reflect.ValueOf(edge).Kind() == reflect.Ptr {
-					nodeField = reflect.ValueOf(edge).Elem().FieldByName(nodeFieldName)
-				} else {
-					nodeField = reflect.ValueOf(edge).FieldByName(nodeFieldName)
-				}
-
-				edgeVal = reflect.ValueOf(edge).Elem()
-			}
-
-			if !nodeField.IsValid() {
-				return fmt.Errorf("Edge in the '%s' doesn't have the Node field", connectionFieldName)
+			child.line = line
+			if connectionSink[parentID] == nil {
+				connectionSink[parentID] = make(map[string][]bulkChild)
 			}
-			nodeField.Set(nodeVal)
-
-			var edgesSlice reflect.Value
-			var edges map[string]interface{}
-			if val, ok := connectionSink[parentID]; ok {
-				var ok2 bool
-				if edges, ok2 = val.(map[string]interface{}); !ok2 {
-					return fmt.Errorf("The connection sink for parent ID '%s' is not a map", parentID)
-				}
-			} else {
-				edges = make(map[string]interface{})
-			}
-
-			if val, ok := edges[connectionFieldName]; ok {
-				edgesSlice = reflect.ValueOf(val)
-			} else {
-				edgesSliceCap := 50
-				edgesSlice = reflect.MakeSlice(reflect.SliceOf(edgeType), 0, edgesSliceCap)
-			}
-
-			edgesSlice = reflect.Append(edgesSlice, edgeVal)
-
-			edges[connectionFieldName] = edgesSlice.Interface()
-			connectionSink[parentID] = edges
-
+			connectionSink[parentID][child.connection] = append(connectionSink[parentID][child.connection], child)
 			continue
 		}
 
 		item := reflect.New(itemType).Interface()
-		err = json.Unmarshal(line, &item)
+		err = codec.Unmarshal(line, item)
 		if err != nil {
 			return fmt.Errorf("unmarshalling: %w", err)
 		}
@@ -422,7 +531,7 @@ func parseBulkQueryResult(resultFilePath string, out interface{}) error {
 	}
 
 	if len(connectionSink) > 0 {
-		err := attachNestedConnections(connectionSink, outSlice)
+		err := attachNestedConnections(connectionSink, outSlice, codec, false)
 		if err != nil {
 			return fmt.Errorf("error processing nested connections: %w", err)
 		}
@@ -436,7 +545,45 @@ func parseBulkQueryResult(resultFilePath string, out interface{}) error {
 	return nil
 }
 
-func attachNestedConnections(connectionSink map[string]interface{}, outSlice reflect.Value) error {
+// bulkLineIDs returns the id, __parentId and __typename fields of a line of a bulk query
+// result, parentID being empty for top-level objects. jsoniter reads them without decoding the
+// whole line.
+func bulkLineIDs(codec graphql.Codec, line []byte) (id, parentID, typename string, err error) {
+	if api, ok := codec.(jsoniter.API); ok {
+		if node := api.Get(line, "id"); node.LastError() == nil {
+			id = node.ToString()
+		}
+		if node := api.Get(line, "__parentId"); node.LastError() == nil {
+			parentID = node.ToString()
+		}
+		if node := api.Get(line, "__typename"); node.LastError() == nil {
+			typename = node.ToString()
+		}
+		return id, parentID, typename, nil
+	}
+
+	var ids struct {
+		ID       string `json:"id"`
+		ParentID string `json:"__parentId"`
+		Typename string `json:"__typename"`
+	}
+	err = codec.Unmarshal(line, &ids)
+	return ids.ID, ids.ParentID, ids.Typename, err
+}
+
+// bulkChild is a line of a nested connection of a bulk query result, with the types it is
+// decoded to when its parent has a connection field.
+type bulkChild struct {
+	line       []byte
+	edgeType   reflect.Type
+	nodeType   reflect.Type
+	connection string
+}
+
+// attachNestedConnections sets the nested connections of the objects of outSlice from the lines
+// of connectionSink, by parent ID. Connection fields, e.g. model.Order.LineItems, get the edges of
+// the registered types; slice fields, e.g. Order.LineItems, get the lines decoded as their items.
+func attachNestedConnections(connectionSink map[string]map[string][]bulkChild, outSlice reflect.Value, codec graphql.Codec, nested bool) error {
 	for i := 0; i < outSlice.Len(); i++ {
 		parent := outSlice.Index(i)
 		if parent.Kind() == reflect.Ptr {
@@ -456,6 +603,10 @@ func attachNestedConnections(connectionSink map[string]interface{}, outSlice ref
 
 		parentIDField := parent.FieldByName("ID")
 		if parentIDField == (reflect.Value{}) {
+			// nested objects without ID, e.g. discount applications, have no connections
+			if nested {
+				continue
+			}
 			return fmt.Errorf("No ID field on the first level")
 		}
 		if parentIDField.Kind() == reflect.Ptr {
@@ -468,40 +619,55 @@ func attachNestedConnections(connectionSink map[string]interface{}, outSlice ref
 			return fmt.Errorf("ID field on the first level is not a string")
 		}
 
-		var connection interface{}
-		if connection, ok = connectionSink[parentID]; !ok {
+		connections, ok := connectionSink[parentID]
+		if !ok {
 			continue
 		}
 
-		edgeMap := reflect.ValueOf(connection)
-		iter := edgeMap.MapRange()
-		for iter.Next() {
-			connectionName := iter.Key()
-			connectionField := parent.FieldByName(connectionName.String())
+		for connectionName, children := range connections {
+			connectionField := connectionFieldByName(parent, connectionName)
 			if !connectionField.IsValid() {
-				return fmt.Errorf("Connection '%s' is not defined on the parent type %s", connectionName.String(), parent.Type().String())
+				return fmt.Errorf("Connection '%s' is not defined on the parent type %s", connectionName, parent.Type().String())
 			}
 
-			var connectionValue reflect.Value
-			var edgesField reflect.Value
-			if connectionField.Kind() == reflect.Ptr {
-				connectionValue = reflect.ValueOf(reflect.New(connectionField.Type().Elem()).Interface())
-				edgesField = connectionValue.Elem().FieldByName(edgesFieldName)
+			var items reflect.Value
+			if connectionField.Kind() == reflect.Slice {
+				items = reflect.MakeSlice(connectionField.Type(), 0, len(children))
+				for _, child := range children {
+					item := reflect.New(connectionField.Type().Elem())
+					if err := codec.Unmarshal(child.line, item.Interface()); err != nil {
+						return fmt.Errorf("unmarshalling: %w", err)
+					}
+					items = reflect.Append(items, item.Elem())
+				}
+				connectionField.Set(items)
 			} else {
-				connectionValue = reflect.ValueOf(reflect.New(connectionField.Type()).Interface())
-				edgesField = connectionValue.Elem().FieldByName(edgesFieldName)
-			}
-
-			if !edgesField.IsValid() {
-				return fmt.Errorf("Connection %s in the '%s' doesn't have the Edges field", connectionName.String(), parent.Type().String())
-			}
+				var connectionValue reflect.Value
+				if connectionField.Kind() == reflect.Ptr {
+					connectionValue = reflect.New(connectionField.Type().Elem())
+				} else {
+					connectionValue = reflect.New(connectionField.Type())
+				}
+				edgesField := connectionValue.Elem().FieldByName(edgesFieldName)
+				if !edgesField.IsValid() {
+					return fmt.Errorf("Connection %s in the '%s' doesn't have the Edges field", connectionName, parent.Type().String())
+				}
 
-			edges := reflect.ValueOf(iter.Value().Interface())
-			edgesField.Set(edges)
+				var err error
+				items, err = newEdges(children, codec)
+				if err != nil {
+					return err
+				}
+				edgesField.Set(items)
 
-			connectionField.Set(connectionValue)
+				if connectionField.Kind() == reflect.Ptr {
+					connectionField.Set(connectionValue)
+				} else {
+					connectionField.Set(connectionValue.Elem())
+				}
+			}
 
-			err := attachNestedConnections(connectionSink, iter.Value().Elem())
+			err := attachNestedConnections(connectionSink, items, codec, true)
 			if err != nil {
 				return fmt.Errorf("error attacing a nested connection: %w", err)
 			}
@@ -511,15 +677,71 @@ func attachNestedConnections(connectionSink map[string]interface{}, outSlice ref
 	return nil
 }
 
-func concludeObjectType(gid string) (reflect.Type, reflect.Type, string, error) {
-	submatches := gidRegex.FindStringSubmatch(gid)
-	if len(submatches) != 2 {
-		return reflect.TypeOf(nil), reflect.TypeOf(nil), "", fmt.Errorf("malformed gid=`%s`", gid)
+// connectionFieldByName returns the field of parent named after a connection, e.g. LineItems, or
+// the field with the JSON name of the connection, e.g. lineItems for
+// FulfillmentOrder.FulfillmentOrderLineItems.
+func connectionFieldByName(parent reflect.Value, connection string) reflect.Value {
+	if f := parent.FieldByName(connection); f.IsValid() {
+		return f
+	}
+	jsonName := strings.ToLower(connection[:1]) + connection[1:]
+	for i := 0; i < parent.NumField(); i++ {
+		name, _, _ := strings.Cut(parent.Type().Field(i).Tag.Get("json"), ",")
+		if name == jsonName {
+			return parent.Field(i)
+		}
+	}
+	return reflect.Value{}
+}
+
+// newEdges returns the edges of the nodes decoded from children.
+func newEdges(children []bulkChild, codec graphql.Codec) (reflect.Value, error) {
+	edgeType := children[0].edgeType
+	edges := reflect.MakeSlice(reflect.SliceOf(edgeType), 0, len(children))
+	for _, child := range children {
+		node := reflect.New(child.nodeType)
+		if err := codec.Unmarshal(child.line, node.Interface()); err != nil {
+			return reflect.Value{}, fmt.Errorf("unmarshalling: %w", err)
+		}
+
+		var edge reflect.Value
+		if edgeType.Kind() == reflect.Ptr {
+			edge = reflect.New(edgeType.Elem())
+		} else {
+			edge = reflect.New(edgeType)
+		}
+		nodeField := edge.Elem().FieldByName(nodeFieldName)
+		if !nodeField.IsValid() {
+			return reflect.Value{}, fmt.Errorf("Edge in the '%s' doesn't have the Node field", child.connection)
+		}
+		nodeField.Set(node.Elem())
+
+		if edgeType.Kind() == reflect.Ptr {
+			edges = reflect.Append(edges, edge)
+		} else {
+			edges = reflect.Append(edges, edge.Elem())
+		}
+	}
+	return edges, nil
+}
+
+// concludeObjectType returns the edge and node types, and the connection name, of a line of a
+// nested connection, by the resource of its id or, for types without one, its __typename.
+func concludeObjectType(id, typename string) (reflect.Type, reflect.Type, string, error) {
+	resource := typename
+	if id != "" {
+		resource = gid.Resource(id)
+		if resource == "" {
+			return reflect.TypeOf(nil), reflect.TypeOf(nil), "", fmt.Errorf("malformed gid=`%s`", id)
+		}
+	} else if typename == "" {
+		return reflect.TypeOf(nil), reflect.TypeOf(nil), "", fmt.Errorf("The connection type must query the `id` field, or `__typename` if it has none")
 	}
-	resource := submatches[1]
 	switch resource {
 	case "LineItem":
 		return reflect.TypeOf(model.LineItemEdge{}), reflect.TypeOf(&model.LineItem{}), fmt.Sprintf("%ss", resource), nil
+	case "AbandonedCheckoutLineItem":
+		return reflect.TypeOf(model.AbandonedCheckoutLineItemEdge{}), reflect.TypeOf(&model.AbandonedCheckoutLineItem{}), "LineItems", nil
 	case "FulfillmentOrderLineItem":
 		return reflect.TypeOf(model.FulfillmentOrderLineItemEdge{}), reflect.TypeOf(&model.FulfillmentOrderLineItem{}), "LineItems", nil
 	case "FulfillmentOrder":
@@ -544,6 +766,15 @@ func concludeObjectType(gid string) (reflect.Type, reflect.Type, string, error)
 		return reflect.TypeOf(model.MediaEdge{}), reflect.TypeOf(&model.Model3d{}), "Media", nil
 	case "ExternalVideo":
 		return reflect.TypeOf(model.MediaEdge{}), reflect.TypeOf(&model.ExternalVideo{}), "Media", nil
+	// the model can't decode the value of discount applications, Order.DiscountApplications can
+	case "AutomaticDiscountApplication":
+		return reflect.TypeOf(model.DiscountApplicationEdge{}), reflect.TypeOf(&model.AutomaticDiscountApplication{}), "DiscountApplications", nil
+	case "DiscountCodeApplication":
+		return reflect.TypeOf(model.DiscountApplicationEdge{}), reflect.TypeOf(&model.DiscountCodeApplication{}), "DiscountApplications", nil
+	case "ManualDiscountApplication":
+		return reflect.TypeOf(model.DiscountApplicationEdge{}), reflect.TypeOf(&model.ManualDiscountApplication{}), "DiscountApplications", nil
+	case "ScriptDiscountApplication":
+		return reflect.TypeOf(model.DiscountApplicationEdge{}), reflect.TypeOf(&model.ScriptDiscountApplication{}), "DiscountApplications", nil
 	default:
 		return reflect.TypeOf(nil), reflect.TypeOf(nil), "", fmt.Errorf("`%s` not implemented type", resource)
 	}
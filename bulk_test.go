@@ -0,0 +1,61 @@
+package shopify
+
+import (
+	"testing"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+func TestParseBulkQueryResultOrders(t *testing.T) {
+	var orders []*Order
+	if err := parseBulkQueryResult("testdata/bulk_orders.jsonl", &orders, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(orders) != 2 {
+		t.Fatalf("got %d orders, want 2", len(orders))
+	}
+
+	o := orders[0]
+	if len(o.CustomAttributes) != 1 || o.CustomAttributes[0].Key != "gift" {
+		t.Errorf("got order custom attributes %+v", o.CustomAttributes)
+	}
+	if len(o.LineItems) != 2 || o.LineItems[0].SKU != "SNOW-1" {
+		t.Fatalf("got line items %+v", o.LineItems)
+	}
+	if attrs := o.LineItems[0].CustomAttributes; len(attrs) != 1 || attrs[0].Key != "engraving" {
+		t.Errorf("got line item custom attributes %+v", attrs)
+	}
+	if len(o.Metafields) != 1 || o.Metafields[0].Key != "source" || o.Metafields[0].Type != model.MetafieldValueType("single_line_text_field") {
+		t.Errorf("got metafields %+v", o.Metafields)
+	}
+
+	if len(o.DiscountApplications) != 2 {
+		t.Fatalf("got discount applications %+v", o.DiscountApplications)
+	}
+	code, manual := o.DiscountApplications[0], o.DiscountApplications[1]
+	if code.Typename != "DiscountCodeApplication" || code.Code != "WINTER10" || code.Value.Percentage != 10 {
+		t.Errorf("got code discount application %+v", code)
+	}
+	if manual.Typename != "ManualDiscountApplication" || manual.Description != "Courtesy" || manual.Value.CurrencyCode != "USD" {
+		t.Errorf("got manual discount application %+v", manual)
+	}
+
+	if len(o.FulfillmentOrders) != 1 {
+		t.Fatalf("got fulfillment orders %+v", o.FulfillmentOrders)
+	}
+	fo := o.FulfillmentOrders[0]
+	if fo.Status != "OPEN" || len(fo.FulfillmentOrderLineItems) != 2 {
+		t.Fatalf("got fulfillment order %+v", fo)
+	}
+	if li := fo.FulfillmentOrderLineItems[1]; li.LineItem.SKU != "WAX-1" || li.RemainingQuantity != 0 {
+		t.Errorf("got fulfillment order line item %+v", li)
+	}
+
+	o = orders[1]
+	if len(o.LineItems) != 1 || len(o.Metafields) != 0 || len(o.FulfillmentOrders) != 0 {
+		t.Errorf("got children of the second order mixed with the first: %+v", o)
+	}
+	if len(o.DiscountApplications) != 1 || o.DiscountApplications[0].Title != "Bundle" {
+		t.Errorf("got discount applications %+v", o.DiscountApplications)
+	}
+}
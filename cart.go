@@ -16,6 +16,10 @@ type CartService interface {
 	CartLinesRemove(ctx context.Context, id graphql.ID, lineIds []graphql.ID) error
 	CartNoteUpdate(ctx context.Context, id graphql.ID, note graphql.String) error
 	CartDiscountCodesUpdate(ctx context.Context, id graphql.ID, discountCodes []graphql.String) error
+	UpdateDiscountCodes(ctx context.Context, cartID graphql.ID, codes []string) ([]CartDiscountCode, error)
+	UpdateGiftCards(ctx context.Context, cartID graphql.ID, codes []string) ([]CartAppliedGiftCard, error)
+	UpdateBuyerIdentity(ctx context.Context, cartID graphql.ID, buyerIdentity CartBuyerIdentityInput) (*CartDeliveryEstimate, error)
+	SelectDeliveryOptions(ctx context.Context, cartID graphql.ID, options []CartSelectedDeliveryOptionInput) (*CartDeliveryEstimate, error)
 }
 
 type CartServiceOp struct {
@@ -97,6 +101,12 @@ const cartBaseQuery = `
                     }
                 }
                 sellingPlanAllocation {
+                    sellingPlan {
+                        id
+                        name
+                        description
+                        recurringDeliveries
+                    }
                     priceAdjustments {
                         compareAtPrice {
                             amount
@@ -297,6 +307,274 @@ func (c CartServiceOp) CartDiscountCodesUpdate(ctx context.Context, id graphql.I
 	return nil
 }
 
+// UpdateDiscountCodes replaces the discount codes of the cart and returns every code on the cart
+// with whether it currently applies. Codes that don't exist or aren't eligible are kept on the cart
+// as not applicable rather than rejected, so check Applicable to validate a code.
+func (c CartServiceOp) UpdateDiscountCodes(ctx context.Context, cartID graphql.ID, codes []string) ([]CartDiscountCode, error) {
+	m := `
+		mutation cartDiscountCodesUpdate($cartId: ID!, $discountCodes: [String!]) {
+			cartDiscountCodesUpdate(cartId: $cartId, discountCodes: $discountCodes) {
+				cart {
+					discountCodes {
+						applicable
+						code
+					}
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`
+
+	if codes == nil {
+		codes = []string{}
+	}
+	vars := map[string]interface{}{
+		"cartId":        cartID,
+		"discountCodes": codes,
+	}
+	out := struct {
+		CartDiscountCodesUpdate struct {
+			Cart *struct {
+				DiscountCodes []CartDiscountCode `json:"discountCodes"`
+			} `json:"cart"`
+			UserErrors []UserErrors `json:"userErrors"`
+		} `json:"cartDiscountCodesUpdate"`
+	}{}
+	err := c.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CartDiscountCodesUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.CartDiscountCodesUpdate.UserErrors)
+	}
+	if out.CartDiscountCodesUpdate.Cart == nil {
+		return nil, fmt.Errorf("cart not found")
+	}
+
+	return out.CartDiscountCodesUpdate.Cart.DiscountCodes, nil
+}
+
+// UpdateGiftCards replaces the gift card codes of the cart and returns the gift cards that were
+// applied. Unlike discount codes, an invalid gift card code is reported as a user error.
+func (c CartServiceOp) UpdateGiftCards(ctx context.Context, cartID graphql.ID, codes []string) ([]CartAppliedGiftCard, error) {
+	m := `
+		mutation cartGiftCardCodesUpdate($cartId: ID!, $giftCardCodes: [String!]!) {
+			cartGiftCardCodesUpdate(cartId: $cartId, giftCardCodes: $giftCardCodes) {
+				cart {
+					appliedGiftCards {
+						id
+						lastCharacters
+						amountUsed {
+							amount
+							currencyCode
+						}
+						balance {
+							amount
+							currencyCode
+						}
+					}
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`
+
+	if codes == nil {
+		codes = []string{}
+	}
+	vars := map[string]interface{}{
+		"cartId":        cartID,
+		"giftCardCodes": codes,
+	}
+	out := struct {
+		CartGiftCardCodesUpdate struct {
+			Cart *struct {
+				AppliedGiftCards []CartAppliedGiftCard `json:"appliedGiftCards"`
+			} `json:"cart"`
+			UserErrors []UserErrors `json:"userErrors"`
+		} `json:"cartGiftCardCodesUpdate"`
+	}{}
+	err := c.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CartGiftCardCodesUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.CartGiftCardCodesUpdate.UserErrors)
+	}
+	if out.CartGiftCardCodesUpdate.Cart == nil {
+		return nil, fmt.Errorf("cart not found")
+	}
+
+	return out.CartGiftCardCodesUpdate.Cart.AppliedGiftCards, nil
+}
+
+const cartDeliveryFields = `
+	deliveryGroups(first: 25) {
+		edges {
+			node {
+				id
+				deliveryAddress {
+					address1
+					address2
+					city
+					province
+					zip
+					countryCodeV2
+				}
+				deliveryOptions {
+					handle
+					title
+					description
+					code
+					deliveryMethodType
+					estimatedCost {
+						amount
+						currencyCode
+					}
+				}
+				selectedDeliveryOption {
+					handle
+					title
+					description
+					code
+					deliveryMethodType
+					estimatedCost {
+						amount
+						currencyCode
+					}
+				}
+			}
+		}
+	}
+	cost {
+		subtotalAmount {
+			amount
+			currencyCode
+		}
+		totalAmount {
+			amount
+			currencyCode
+		}
+		totalTaxAmount {
+			amount
+			currencyCode
+		}
+		totalDutyAmount {
+			amount
+			currencyCode
+		}
+	}
+`
+
+// cartDeliveryResult is the cart selection shared by the mutations returning a CartDeliveryEstimate.
+type cartDeliveryResult struct {
+	DeliveryGroups struct {
+		Edges []struct {
+			Node CartDeliveryGroup `json:"node"`
+		} `json:"edges"`
+	} `json:"deliveryGroups"`
+	Cost CartEstimatedCost `json:"cost"`
+}
+
+func (r *cartDeliveryResult) estimate() *CartDeliveryEstimate {
+	estimate := &CartDeliveryEstimate{
+		DeliveryGroups: make([]CartDeliveryGroup, 0, len(r.DeliveryGroups.Edges)),
+		Cost:           r.Cost,
+	}
+	for _, edge := range r.DeliveryGroups.Edges {
+		estimate.DeliveryGroups = append(estimate.DeliveryGroups, edge.Node)
+	}
+	return estimate
+}
+
+// UpdateBuyerIdentity sets who the cart is for and where it ships to. Shopify recomputes delivery
+// options and taxes from the buyer identity, so the returned estimate reflects the new address.
+func (c CartServiceOp) UpdateBuyerIdentity(ctx context.Context, cartID graphql.ID, buyerIdentity CartBuyerIdentityInput) (*CartDeliveryEstimate, error) {
+	m := fmt.Sprintf(`
+		mutation cartBuyerIdentityUpdate($cartId: ID!, $buyerIdentity: CartBuyerIdentityInput!) {
+			cartBuyerIdentityUpdate(cartId: $cartId, buyerIdentity: $buyerIdentity) {
+				cart {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, cartDeliveryFields)
+
+	vars := map[string]interface{}{
+		"cartId":        cartID,
+		"buyerIdentity": buyerIdentity,
+	}
+	out := struct {
+		CartBuyerIdentityUpdate struct {
+			Cart       *cartDeliveryResult `json:"cart"`
+			UserErrors []UserErrors        `json:"userErrors"`
+		} `json:"cartBuyerIdentityUpdate"`
+	}{}
+	err := c.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CartBuyerIdentityUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.CartBuyerIdentityUpdate.UserErrors)
+	}
+	if out.CartBuyerIdentityUpdate.Cart == nil {
+		return nil, fmt.Errorf("cart not found")
+	}
+
+	return out.CartBuyerIdentityUpdate.Cart.estimate(), nil
+}
+
+// SelectDeliveryOptions picks a delivery option for one or more delivery groups, using the group IDs
+// and option handles returned by UpdateBuyerIdentity.
+func (c CartServiceOp) SelectDeliveryOptions(ctx context.Context, cartID graphql.ID, options []CartSelectedDeliveryOptionInput) (*CartDeliveryEstimate, error) {
+	m := fmt.Sprintf(`
+		mutation cartSelectedDeliveryOptionsUpdate($cartId: ID!, $selectedDeliveryOptions: [CartSelectedDeliveryOptionInput!]!) {
+			cartSelectedDeliveryOptionsUpdate(cartId: $cartId, selectedDeliveryOptions: $selectedDeliveryOptions) {
+				cart {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, cartDeliveryFields)
+
+	vars := map[string]interface{}{
+		"cartId":                  cartID,
+		"selectedDeliveryOptions": options,
+	}
+	out := struct {
+		CartSelectedDeliveryOptionsUpdate struct {
+			Cart       *cartDeliveryResult `json:"cart"`
+			UserErrors []UserErrors        `json:"userErrors"`
+		} `json:"cartSelectedDeliveryOptionsUpdate"`
+	}{}
+	err := c.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CartSelectedDeliveryOptionsUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.CartSelectedDeliveryOptionsUpdate.UserErrors)
+	}
+	if out.CartSelectedDeliveryOptionsUpdate.Cart == nil {
+		return nil, fmt.Errorf("cart not found")
+	}
+
+	return out.CartSelectedDeliveryOptionsUpdate.Cart.estimate(), nil
+}
+
 type Cart struct {
 	Attributes    []Attribute        `json:"attributes,omitempty"`
 	BuyerIdentity CartBuyerIdentity  `json:"buyerIdentity,omitempty"`
@@ -362,8 +640,17 @@ type SellingPlanOption struct {
 }
 
 type SellingPlanPriceAdjustment struct {
-	// adjustmentValue
-	OrderCount graphql.Int `json:"orderCount,omitempty"`
+	AdjustmentValue SellingPlanPriceAdjustmentValue `json:"adjustmentValue,omitempty"`
+	OrderCount      graphql.Int                     `json:"orderCount,omitempty"`
+}
+
+// SellingPlanPriceAdjustmentValue flattens the SellingPlanPriceAdjustmentValue union; only the
+// field matching Typename is set.
+type SellingPlanPriceAdjustmentValue struct {
+	Typename             string   `json:"__typename,omitempty"`
+	AdjustmentPercentage *float64 `json:"adjustmentPercentage,omitempty"`
+	AdjustmentAmount     *MoneyV2 `json:"adjustmentAmount,omitempty"`
+	Price                *MoneyV2 `json:"price,omitempty"`
 }
 
 type CartDiscountCode struct {
@@ -403,6 +690,55 @@ type CartBuyerIdentityInput struct {
 	CustomerAccessToken graphql.String `json:"customerAccessToken,omitempty"`
 	Email               graphql.String `json:"email,omitempty"`
 	Phone               graphql.String `json:"phone,omitempty"`
+	// DeliveryAddressPreferences are the addresses delivery options are estimated for, in order of preference.
+	DeliveryAddressPreferences []CartDeliveryAddressInput `json:"deliveryAddressPreferences,omitempty"`
+}
+
+// CartDeliveryAddressInput is either a new address or the ID of one of the customer's saved addresses.
+type CartDeliveryAddressInput struct {
+	DeliveryAddress   *CartMailingAddressInput `json:"deliveryAddress,omitempty"`
+	CustomerAddressID graphql.String           `json:"customerAddressId,omitempty"`
+}
+
+type CartMailingAddressInput struct {
+	Address1  graphql.String `json:"address1,omitempty"`
+	Address2  graphql.String `json:"address2,omitempty"`
+	City      graphql.String `json:"city,omitempty"`
+	Company   graphql.String `json:"company,omitempty"`
+	Country   graphql.String `json:"country,omitempty"`
+	FirstName graphql.String `json:"firstName,omitempty"`
+	LastName  graphql.String `json:"lastName,omitempty"`
+	Phone     graphql.String `json:"phone,omitempty"`
+	Province  graphql.String `json:"province,omitempty"`
+	Zip       graphql.String `json:"zip,omitempty"`
+}
+
+type CartSelectedDeliveryOptionInput struct {
+	DeliveryGroupID      graphql.String `json:"deliveryGroupId"`
+	DeliveryOptionHandle graphql.String `json:"deliveryOptionHandle"`
+}
+
+// CartDeliveryEstimate is the shipping and tax breakdown of a cart for its current buyer identity.
+type CartDeliveryEstimate struct {
+	DeliveryGroups []CartDeliveryGroup
+	Cost           CartEstimatedCost
+}
+
+// CartDeliveryGroup groups the cart lines shipped together to the same address.
+type CartDeliveryGroup struct {
+	ID                     graphql.String       `json:"id,omitempty"`
+	DeliveryAddress        MailingAddress       `json:"deliveryAddress,omitempty"`
+	DeliveryOptions        []CartDeliveryOption `json:"deliveryOptions,omitempty"`
+	SelectedDeliveryOption *CartDeliveryOption  `json:"selectedDeliveryOption,omitempty"`
+}
+
+type CartDeliveryOption struct {
+	Handle             graphql.String `json:"handle,omitempty"`
+	Title              graphql.String `json:"title,omitempty"`
+	Description        graphql.String `json:"description,omitempty"`
+	Code               graphql.String `json:"code,omitempty"`
+	DeliveryMethodType graphql.String `json:"deliveryMethodType,omitempty"`
+	EstimatedCost      MoneyV2        `json:"estimatedCost,omitempty"`
 }
 
 type CartLineInput struct {
@@ -482,6 +818,14 @@ type AppliedGiftCard struct {
 	PresentmentAmountUsed MoneyV2        `json:"presentmentAmountUsed,omitempty"`
 }
 
+// CartAppliedGiftCard is a gift card applied to a cart. Only the last characters of the code are exposed.
+type CartAppliedGiftCard struct {
+	ID             graphql.String `json:"id,omitempty"`
+	LastCharacters graphql.String `json:"lastCharacters,omitempty"`
+	AmountUsed     MoneyV2        `json:"amountUsed,omitempty"`
+	Balance        MoneyV2        `json:"balance,omitempty"`
+}
+
 type AvailableShippingRates struct {
 	Ready         graphql.Boolean `json:"ready,omitempty"`
 	ShippingRates []ShippingRate  `json:"shippingRates,omitempty"`
@@ -0,0 +1,163 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// CartTransformService registers cart transform functions, which expand or merge cart lines
+// (e.g. bundles), against a shop.
+type CartTransformService interface {
+	List(ctx context.Context) ([]*model.CartTransform, error)
+	Create(ctx context.Context, functionID string, blockOnFailure bool) (*model.CartTransform, error)
+	Register(ctx context.Context, functionID string, blockOnFailure bool) (*model.CartTransform, error)
+	Delete(ctx context.Context, id string) (string, error)
+}
+
+type CartTransformServiceOp struct {
+	client *Client
+}
+
+var _ CartTransformService = &CartTransformServiceOp{}
+
+const cartTransformFields = `
+	id
+	functionId
+	blockOnFailure
+`
+
+// List returns the cart transforms of the shop that belong to the calling app.
+func (s *CartTransformServiceOp) List(ctx context.Context) ([]*model.CartTransform, error) {
+	q := fmt.Sprintf(`
+		query cartTransforms($after: String) {
+			cartTransforms(first: 50, after: $after) {
+				edges {
+					node {
+						%s
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+				}
+			}
+		}
+	`, cartTransformFields)
+
+	vars := map[string]interface{}{}
+	var res []*model.CartTransform
+	for {
+		out := struct {
+			CartTransforms struct {
+				Edges []struct {
+					Node   *model.CartTransform `json:"node"`
+					Cursor string               `json:"cursor"`
+				} `json:"edges"`
+				PageInfo struct {
+					HasNextPage bool `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"cartTransforms"`
+		}{}
+		err := s.client.gql.QueryString(ctx, q, vars, &out)
+		if err != nil {
+			return nil, fmt.Errorf("gql.QueryString: %w", err)
+		}
+
+		edges := out.CartTransforms.Edges
+		for _, edge := range edges {
+			res = append(res, edge.Node)
+		}
+		if !out.CartTransforms.PageInfo.HasNextPage || len(edges) == 0 {
+			return res, nil
+		}
+		vars["after"] = edges[len(edges)-1].Cursor
+	}
+}
+
+// Create registers the cart transform function functionID on the shop. When blockOnFailure is
+// true, checkout is blocked if the function fails instead of leaving the cart untouched.
+func (s *CartTransformServiceOp) Create(ctx context.Context, functionID string, blockOnFailure bool) (*model.CartTransform, error) {
+	m := fmt.Sprintf(`
+		mutation cartTransformCreate($functionId: String!, $blockOnFailure: Boolean) {
+			cartTransformCreate(functionId: $functionId, blockOnFailure: $blockOnFailure) {
+				cartTransform {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, cartTransformFields)
+
+	vars := map[string]interface{}{
+		"functionId":     functionID,
+		"blockOnFailure": blockOnFailure,
+	}
+	out := struct {
+		CartTransformCreate model.CartTransformCreatePayload `json:"cartTransformCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CartTransformCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.CartTransformCreate.UserErrors)
+	}
+
+	return out.CartTransformCreate.CartTransform, nil
+}
+
+// Register is like Create but returns the existing cart transform if functionID is already
+// registered, so it can be called on every app install or onboarding run.
+func (s *CartTransformServiceOp) Register(ctx context.Context, functionID string, blockOnFailure bool) (*model.CartTransform, error) {
+	transforms, err := s.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("s.List: %w", err)
+	}
+	for _, transform := range transforms {
+		if transform.FunctionID == functionID {
+			return transform, nil
+		}
+	}
+
+	return s.Create(ctx, functionID, blockOnFailure)
+}
+
+func (s *CartTransformServiceOp) Delete(ctx context.Context, id string) (string, error) {
+	m := `
+		mutation cartTransformDelete($id: ID!) {
+			cartTransformDelete(id: $id) {
+				deletedId
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		CartTransformDelete model.CartTransformDeletePayload `json:"cartTransformDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return "", fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CartTransformDelete.UserErrors) > 0 {
+		return "", fmt.Errorf("%+v", out.CartTransformDelete.UserErrors)
+	}
+	if out.CartTransformDelete.DeletedID == nil {
+		return "", nil
+	}
+
+	return *out.CartTransformDelete.DeletedID, nil
+}
@@ -0,0 +1,435 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// priceListPricesBatchSize is the maximum number of prices or variant IDs accepted by a single
+// fixed prices mutation.
+const priceListPricesBatchSize = 250
+
+// CatalogService manages catalogs, which tie a price list and a publication to a context such as a
+// market or B2B company locations, and the fixed prices and quantity price breaks of price lists.
+type CatalogService interface {
+	Create(ctx context.Context, input model.CatalogCreateInput) (*Catalog, error)
+	Update(ctx context.Context, id string, input model.CatalogUpdateInput) (*Catalog, error)
+	Delete(ctx context.Context, id string, deleteDependentResources bool) (string, error)
+
+	CreatePriceList(ctx context.Context, input model.PriceListCreateInput) (*model.PriceList, error)
+
+	AddFixedPrices(ctx context.Context, priceListID string, prices []model.PriceListPriceInput) ([]model.PriceListPrice, error)
+	AddFixedPricesBulk(ctx context.Context, priceListID string, prices []model.PriceListPriceInput) ([]model.PriceListPrice, error)
+	DeleteFixedPrices(ctx context.Context, priceListID string, variantIDs []string) ([]string, error)
+	DeleteFixedPricesBulk(ctx context.Context, priceListID string, variantIDs []string) ([]string, error)
+	UpdateFixedPricesByProduct(ctx context.Context, priceListID string, pricesToAdd []model.PriceListProductPriceInput, productIDsToDelete []string) error
+
+	UpdateQuantityPricing(ctx context.Context, priceListID string, input model.QuantityPricingByVariantUpdateInput) error
+}
+
+type CatalogServiceOp struct {
+	client *Client
+}
+
+var _ CatalogService = &CatalogServiceOp{}
+
+// Catalog is a catalog of any type. Typename is one of MarketCatalog, CompanyLocationCatalog or
+// AppCatalog.
+type Catalog struct {
+	Typename    string              `json:"__typename"`
+	ID          string              `json:"id"`
+	Title       string              `json:"title"`
+	Status      model.CatalogStatus `json:"status"`
+	PriceList   *model.PriceList    `json:"priceList,omitempty"`
+	Publication *struct {
+		ID string `json:"id"`
+	} `json:"publication,omitempty"`
+}
+
+const priceListFields = `
+	id
+	name
+	currency
+	fixedPricesCount
+	parent {
+		adjustment {
+			type
+			value
+		}
+		settings {
+			compareAtMode
+		}
+	}
+`
+
+var catalogFields = fmt.Sprintf(`
+	__typename
+	id
+	title
+	status
+	priceList {
+		%s
+	}
+	publication {
+		id
+	}
+`, priceListFields)
+
+const priceListPriceFields = `
+	variant {
+		id
+	}
+	price {
+		amount
+		currencyCode
+	}
+	compareAtPrice {
+		amount
+		currencyCode
+	}
+	originType
+`
+
+func (s *CatalogServiceOp) Create(ctx context.Context, input model.CatalogCreateInput) (*Catalog, error) {
+	m := fmt.Sprintf(`
+		mutation catalogCreate($input: CatalogCreateInput!) {
+			catalogCreate(input: $input) {
+				catalog {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, catalogFields)
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+	out := struct {
+		CatalogCreate struct {
+			Catalog    *Catalog                 `json:"catalog"`
+			UserErrors []model.CatalogUserError `json:"userErrors"`
+		} `json:"catalogCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CatalogCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.CatalogCreate.UserErrors)
+	}
+
+	return out.CatalogCreate.Catalog, nil
+}
+
+// Update updates a catalog; fields left nil in input are not changed.
+func (s *CatalogServiceOp) Update(ctx context.Context, id string, input model.CatalogUpdateInput) (*Catalog, error) {
+	m := fmt.Sprintf(`
+		mutation catalogUpdate($id: ID!, $input: CatalogUpdateInput!) {
+			catalogUpdate(id: $id, input: $input) {
+				catalog {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, catalogFields)
+
+	vars := map[string]interface{}{
+		"id":    id,
+		"input": input,
+	}
+	out := struct {
+		CatalogUpdate struct {
+			Catalog    *Catalog                 `json:"catalog"`
+			UserErrors []model.CatalogUserError `json:"userErrors"`
+		} `json:"catalogUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CatalogUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.CatalogUpdate.UserErrors)
+	}
+
+	return out.CatalogUpdate.Catalog, nil
+}
+
+// Delete deletes a catalog. When deleteDependentResources is true, the price list and publication
+// owned by the catalog are deleted as well.
+func (s *CatalogServiceOp) Delete(ctx context.Context, id string, deleteDependentResources bool) (string, error) {
+	m := `
+		mutation catalogDelete($id: ID!, $deleteDependentResources: Boolean) {
+			catalogDelete(id: $id, deleteDependentResources: $deleteDependentResources) {
+				deletedId
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"id":                       id,
+		"deleteDependentResources": deleteDependentResources,
+	}
+	out := struct {
+		CatalogDelete model.CatalogDeletePayload `json:"catalogDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return "", fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CatalogDelete.UserErrors) > 0 {
+		return "", fmt.Errorf("%+v", out.CatalogDelete.UserErrors)
+	}
+	if out.CatalogDelete.DeletedID == nil {
+		return "", nil
+	}
+
+	return *out.CatalogDelete.DeletedID, nil
+}
+
+// CreatePriceList creates a price list. Prices default to the parent adjustment, a percentage
+// increase or decrease of the product prices, until fixed prices are added.
+func (s *CatalogServiceOp) CreatePriceList(ctx context.Context, input model.PriceListCreateInput) (*model.PriceList, error) {
+	m := fmt.Sprintf(`
+		mutation priceListCreate($input: PriceListCreateInput!) {
+			priceListCreate(input: $input) {
+				priceList {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, priceListFields)
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+	out := struct {
+		PriceListCreate model.PriceListCreatePayload `json:"priceListCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.PriceListCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.PriceListCreate.UserErrors)
+	}
+
+	return out.PriceListCreate.PriceList, nil
+}
+
+// AddFixedPrices sets fixed prices for variants on a price list, overwriting their existing fixed
+// prices. At most 250 prices can be sent at once; use AddFixedPricesBulk for more.
+func (s *CatalogServiceOp) AddFixedPrices(ctx context.Context, priceListID string, prices []model.PriceListPriceInput) ([]model.PriceListPrice, error) {
+	m := fmt.Sprintf(`
+		mutation priceListFixedPricesAdd($priceListId: ID!, $prices: [PriceListPriceInput!]!) {
+			priceListFixedPricesAdd(priceListId: $priceListId, prices: $prices) {
+				prices {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, priceListPriceFields)
+
+	vars := map[string]interface{}{
+		"priceListId": priceListID,
+		"prices":      prices,
+	}
+	out := struct {
+		PriceListFixedPricesAdd model.PriceListFixedPricesAddPayload `json:"priceListFixedPricesAdd"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.PriceListFixedPricesAdd.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.PriceListFixedPricesAdd.UserErrors)
+	}
+
+	return out.PriceListFixedPricesAdd.Prices, nil
+}
+
+// AddFixedPricesBulk is like AddFixedPrices but accepts any number of prices, sending them in
+// batches. On error, the prices added by the previous batches are returned along with it.
+func (s *CatalogServiceOp) AddFixedPricesBulk(ctx context.Context, priceListID string, prices []model.PriceListPriceInput) ([]model.PriceListPrice, error) {
+	added := make([]model.PriceListPrice, 0, len(prices))
+	for start := 0; start < len(prices); start += priceListPricesBatchSize {
+		end := min(start+priceListPricesBatchSize, len(prices))
+		batch, err := s.AddFixedPrices(ctx, priceListID, prices[start:end])
+		if err != nil {
+			return added, fmt.Errorf("s.AddFixedPrices: %w", err)
+		}
+		added = append(added, batch...)
+	}
+	return added, nil
+}
+
+// DeleteFixedPrices removes the fixed prices of variants from a price list, which reverts them to
+// the price list adjustment. It returns the IDs of the variants whose fixed price was deleted.
+func (s *CatalogServiceOp) DeleteFixedPrices(ctx context.Context, priceListID string, variantIDs []string) ([]string, error) {
+	m := `
+		mutation priceListFixedPricesDelete($priceListId: ID!, $variantIds: [ID!]!) {
+			priceListFixedPricesDelete(priceListId: $priceListId, variantIds: $variantIds) {
+				deletedFixedPriceVariantIds
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"priceListId": priceListID,
+		"variantIds":  variantIDs,
+	}
+	out := struct {
+		PriceListFixedPricesDelete model.PriceListFixedPricesDeletePayload `json:"priceListFixedPricesDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.PriceListFixedPricesDelete.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.PriceListFixedPricesDelete.UserErrors)
+	}
+
+	return out.PriceListFixedPricesDelete.DeletedFixedPriceVariantIds, nil
+}
+
+// DeleteFixedPricesBulk is like DeleteFixedPrices but accepts any number of variant IDs, sending
+// them in batches.
+func (s *CatalogServiceOp) DeleteFixedPricesBulk(ctx context.Context, priceListID string, variantIDs []string) ([]string, error) {
+	deleted := make([]string, 0, len(variantIDs))
+	for start := 0; start < len(variantIDs); start += priceListPricesBatchSize {
+		end := min(start+priceListPricesBatchSize, len(variantIDs))
+		batch, err := s.DeleteFixedPrices(ctx, priceListID, variantIDs[start:end])
+		if err != nil {
+			return deleted, fmt.Errorf("s.DeleteFixedPrices: %w", err)
+		}
+		deleted = append(deleted, batch...)
+	}
+	return deleted, nil
+}
+
+// UpdateFixedPricesByProduct sets or removes a fixed price for all the variants of products at once.
+func (s *CatalogServiceOp) UpdateFixedPricesByProduct(ctx context.Context, priceListID string, pricesToAdd []model.PriceListProductPriceInput, productIDsToDelete []string) error {
+	m := `
+		mutation priceListFixedPricesByProductUpdate($priceListId: ID!, $pricesToAdd: [PriceListProductPriceInput!], $pricesToDeleteByProductIds: [ID!]) {
+			priceListFixedPricesByProductUpdate(priceListId: $priceListId, pricesToAdd: $pricesToAdd, pricesToDeleteByProductIds: $pricesToDeleteByProductIds) {
+				priceList {
+					id
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"priceListId": priceListID,
+	}
+	if len(pricesToAdd) > 0 {
+		vars["pricesToAdd"] = pricesToAdd
+	}
+	if len(productIDsToDelete) > 0 {
+		vars["pricesToDeleteByProductIds"] = productIDsToDelete
+	}
+	out := struct {
+		PriceListFixedPricesByProductUpdate struct {
+			UserErrors []model.PriceListFixedPricesByProductBulkUpdateUserError `json:"userErrors"`
+		} `json:"priceListFixedPricesByProductUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.PriceListFixedPricesByProductUpdate.UserErrors) > 0 {
+		return fmt.Errorf("%+v", out.PriceListFixedPricesByProductUpdate.UserErrors)
+	}
+
+	return nil
+}
+
+// UpdateQuantityPricing adds and removes quantity price breaks, quantity rules and fixed prices of
+// a price list in a single operation. A price break gives a variant a lower price from a minimum
+// quantity; the variant must have a fixed price on the price list, which can be added together.
+func (s *CatalogServiceOp) UpdateQuantityPricing(ctx context.Context, priceListID string, input model.QuantityPricingByVariantUpdateInput) error {
+	m := `
+		mutation quantityPricingByVariantUpdate($priceListId: ID!, $input: QuantityPricingByVariantUpdateInput!) {
+			quantityPricingByVariantUpdate(priceListId: $priceListId, input: $input) {
+				productVariants {
+					id
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`
+
+	// All the lists of the input are required, so unset ones are sent empty.
+	vars := map[string]interface{}{
+		"priceListId": priceListID,
+		"input": map[string]interface{}{
+			"quantityPriceBreaksToAdd":         emptyIfNil(input.QuantityPriceBreaksToAdd),
+			"quantityPriceBreaksToDelete":      emptyIfNil(input.QuantityPriceBreaksToDelete),
+			"quantityRulesToAdd":               emptyIfNil(input.QuantityRulesToAdd),
+			"quantityRulesToDeleteByVariantId": emptyIfNil(input.QuantityRulesToDeleteByVariantID),
+			"pricesToAdd":                      emptyIfNil(input.PricesToAdd),
+			"pricesToDeleteByVariantId":        emptyIfNil(input.PricesToDeleteByVariantID),
+		},
+	}
+	out := struct {
+		QuantityPricingByVariantUpdate struct {
+			UserErrors []model.QuantityPricingByVariantUserError `json:"userErrors"`
+		} `json:"quantityPricingByVariantUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.QuantityPricingByVariantUpdate.UserErrors) > 0 {
+		return fmt.Errorf("%+v", out.QuantityPricingByVariantUpdate.UserErrors)
+	}
+
+	return nil
+}
+
+func emptyIfNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
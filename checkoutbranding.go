@@ -0,0 +1,557 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gempages/go-helper/errors"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// CheckoutBrandingService reads and updates the branding of checkout profiles, which is only
+// available on Shopify Plus and development stores.
+type CheckoutBrandingService interface {
+	ListProfiles(ctx context.Context, opts ListOptions) ([]*model.CheckoutProfile, string, error)
+	PublishedProfile(ctx context.Context) (*model.CheckoutProfile, error)
+
+	Get(ctx context.Context, profileID string) (*model.CheckoutBranding, error)
+	Upsert(ctx context.Context, profileID string, input model.CheckoutBrandingInput) (*model.CheckoutBranding, error)
+	Reset(ctx context.Context, profileID string) error
+}
+
+type CheckoutBrandingServiceOp struct {
+	client *Client
+}
+
+var _ CheckoutBrandingService = &CheckoutBrandingServiceOp{}
+
+const checkoutProfileFields = `
+	id
+	name
+	isPublished
+	createdAt
+	updatedAt
+	editedAt
+`
+
+// checkoutBrandingFields selects the whole checkout branding; it must be sent along with
+// checkoutBrandingFragments.
+const checkoutBrandingFields = `
+	customizations {
+		buyerJourney {
+			visibility
+		}
+		cartLink {
+			visibility
+		}
+		checkbox {
+			cornerRadius
+		}
+		choiceList {
+			group {
+				spacing
+			}
+		}
+		control {
+			border
+			color
+			cornerRadius
+			labelPosition
+		}
+		expressCheckout {
+			button {
+				cornerRadius
+			}
+		}
+		favicon {
+			image {
+				...BrandingImage
+			}
+		}
+		footer {
+			alignment
+			colorScheme
+			content {
+				visibility
+			}
+			padding
+			position
+		}
+		global {
+			cornerRadius
+			typography {
+				kerning
+				letterCase
+			}
+		}
+		header {
+			alignment
+			banner {
+				image {
+					...BrandingImage
+				}
+			}
+			cartLink {
+				contentType
+				image {
+					...BrandingImage
+				}
+			}
+			colorScheme
+			logo {
+				image {
+					...BrandingImage
+				}
+				maxWidth
+				visibility
+			}
+			padding
+			position
+		}
+		headingLevel1 {
+			typography {
+				...BrandingTypographyStyle
+			}
+		}
+		headingLevel2 {
+			typography {
+				...BrandingTypographyStyle
+			}
+		}
+		headingLevel3 {
+			typography {
+				...BrandingTypographyStyle
+			}
+		}
+		main {
+			backgroundImage {
+				image {
+					...BrandingImage
+				}
+			}
+			colorScheme
+			section {
+				background
+				border
+				borderStyle
+				borderWidth
+				colorScheme
+				cornerRadius
+				padding
+				shadow
+			}
+		}
+		merchandiseThumbnail {
+			border
+			cornerRadius
+		}
+		orderSummary {
+			backgroundImage {
+				image {
+					...BrandingImage
+				}
+			}
+			colorScheme
+			section {
+				background
+				border
+				borderStyle
+				borderWidth
+				colorScheme
+				cornerRadius
+				padding
+				shadow
+			}
+		}
+		primaryButton {
+			...BrandingButton
+		}
+		secondaryButton {
+			...BrandingButton
+		}
+		select {
+			border
+			typography {
+				...BrandingTypographyStyle
+			}
+		}
+		textField {
+			border
+			typography {
+				...BrandingTypographyStyle
+			}
+		}
+	}
+	designSystem {
+		colors {
+			global {
+				accent
+				brand
+				critical
+				decorative
+				info
+				success
+				warning
+			}
+			schemes {
+				scheme1 {
+					...BrandingColorScheme
+				}
+				scheme2 {
+					...BrandingColorScheme
+				}
+				scheme3 {
+					...BrandingColorScheme
+				}
+				scheme4 {
+					...BrandingColorScheme
+				}
+			}
+		}
+		cornerRadius {
+			base
+			large
+			small
+		}
+		typography {
+			primary {
+				...BrandingFontGroup
+			}
+			secondary {
+				...BrandingFontGroup
+			}
+			size {
+				base
+				ratio
+			}
+		}
+	}
+`
+
+const checkoutBrandingFragments = `
+fragment BrandingImage on Image {
+	id
+	url
+	altText
+	width
+	height
+}
+fragment BrandingColorRoles on CheckoutBrandingColorRoles {
+	accent
+	background
+	border
+	decorative
+	icon
+	text
+}
+fragment BrandingButtonColorRoles on CheckoutBrandingButtonColorRoles {
+	accent
+	background
+	border
+	decorative
+	hover {
+		...BrandingColorRoles
+	}
+	icon
+	text
+}
+fragment BrandingControlColorRoles on CheckoutBrandingControlColorRoles {
+	accent
+	background
+	border
+	decorative
+	icon
+	selected {
+		...BrandingColorRoles
+	}
+	text
+}
+fragment BrandingColorScheme on CheckoutBrandingColorScheme {
+	base {
+		...BrandingColorRoles
+	}
+	control {
+		...BrandingControlColorRoles
+	}
+	primaryButton {
+		...BrandingButtonColorRoles
+	}
+	secondaryButton {
+		...BrandingButtonColorRoles
+	}
+}
+fragment BrandingTypographyStyle on CheckoutBrandingTypographyStyle {
+	font
+	kerning
+	letterCase
+	size
+	weight
+}
+fragment BrandingButton on CheckoutBrandingButton {
+	background
+	blockPadding
+	border
+	cornerRadius
+	inlinePadding
+	typography {
+		...BrandingTypographyStyle
+	}
+}
+fragment BrandingFontGroup on CheckoutBrandingFontGroup {
+	base {
+		__typename
+		sources
+		weight
+		... on CheckoutBrandingCustomFont {
+			genericFileId
+		}
+	}
+	bold {
+		__typename
+		sources
+		weight
+		... on CheckoutBrandingCustomFont {
+			genericFileId
+		}
+	}
+	loadingStrategy
+	name
+}
+`
+
+// ListProfiles returns a page of checkout profiles. opts.Query supports filters such as
+// "is_published:true".
+func (s *CheckoutBrandingServiceOp) ListProfiles(ctx context.Context, opts ListOptions) ([]*model.CheckoutProfile, string, error) {
+	q := fmt.Sprintf(`
+		query checkoutProfiles($first: Int!, $after: String, $query: String, $reverse: Boolean) {
+			checkoutProfiles(first: $first, after: $after, query: $query, reverse: $reverse) {
+				edges {
+					node {
+						%s
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+				}
+			}
+		}
+	`, checkoutProfileFields)
+
+	first := opts.First
+	if first <= 0 {
+		first = 50
+	}
+	vars := map[string]interface{}{
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+	if opts.Query != "" {
+		vars["query"] = opts.Query
+	}
+
+	out := struct {
+		CheckoutProfiles struct {
+			Edges []struct {
+				Node   *model.CheckoutProfile `json:"node"`
+				Cursor string                 `json:"cursor"`
+			} `json:"edges"`
+			PageInfo struct {
+				HasNextPage bool `json:"hasNextPage"`
+			} `json:"pageInfo"`
+		} `json:"checkoutProfiles"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, "", fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	edges := out.CheckoutProfiles.Edges
+	res := make([]*model.CheckoutProfile, 0, len(edges))
+	for _, edge := range edges {
+		res = append(res, edge.Node)
+	}
+	nextCursor := ""
+	if out.CheckoutProfiles.PageInfo.HasNextPage && len(edges) > 0 {
+		nextCursor = edges[len(edges)-1].Cursor
+	}
+
+	return res, nextCursor, nil
+}
+
+// PublishedProfile returns the checkout profile that is live on the shop's checkout.
+func (s *CheckoutBrandingServiceOp) PublishedProfile(ctx context.Context) (*model.CheckoutProfile, error) {
+	profiles, _, err := s.ListProfiles(ctx, ListOptions{Query: "is_published:true", First: 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(profiles) == 0 {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "published checkout profile not found", nil)
+	}
+
+	return profiles[0], nil
+}
+
+func (s *CheckoutBrandingServiceOp) Get(ctx context.Context, profileID string) (*model.CheckoutBranding, error) {
+	q := fmt.Sprintf(`
+		query checkoutBranding($checkoutProfileId: ID!) {
+			checkoutBranding(checkoutProfileId: $checkoutProfileId) {
+				%s
+			}
+		}
+		%s
+	`, checkoutBrandingFields, checkoutBrandingFragments)
+
+	vars := map[string]interface{}{
+		"checkoutProfileId": profileID,
+	}
+	out := struct {
+		CheckoutBranding json.RawMessage `json:"checkoutBranding"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	return decodeCheckoutBranding(out.CheckoutBranding)
+}
+
+// Upsert applies input to the branding of a checkout profile. Fields left nil in input keep their
+// current value; use Reset to go back to the default branding.
+func (s *CheckoutBrandingServiceOp) Upsert(ctx context.Context, profileID string, input model.CheckoutBrandingInput) (*model.CheckoutBranding, error) {
+	return s.upsert(ctx, profileID, &input)
+}
+
+// Reset removes all the branding customizations of a checkout profile.
+func (s *CheckoutBrandingServiceOp) Reset(ctx context.Context, profileID string) error {
+	_, err := s.upsert(ctx, profileID, nil)
+	return err
+}
+
+func (s *CheckoutBrandingServiceOp) upsert(ctx context.Context, profileID string, input *model.CheckoutBrandingInput) (*model.CheckoutBranding, error) {
+	m := fmt.Sprintf(`
+		mutation checkoutBrandingUpsert($checkoutProfileId: ID!, $checkoutBrandingInput: CheckoutBrandingInput) {
+			checkoutBrandingUpsert(checkoutProfileId: $checkoutProfileId, checkoutBrandingInput: $checkoutBrandingInput) {
+				checkoutBranding {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+		%s
+	`, checkoutBrandingFields, checkoutBrandingFragments)
+
+	vars := map[string]interface{}{
+		"checkoutProfileId":     profileID,
+		"checkoutBrandingInput": input,
+	}
+	out := struct {
+		CheckoutBrandingUpsert struct {
+			CheckoutBranding json.RawMessage                         `json:"checkoutBranding"`
+			UserErrors       []model.CheckoutBrandingUpsertUserError `json:"userErrors"`
+		} `json:"checkoutBrandingUpsert"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CheckoutBrandingUpsert.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.CheckoutBrandingUpsert.UserErrors)
+	}
+
+	return decodeCheckoutBranding(out.CheckoutBrandingUpsert.CheckoutBranding)
+}
+
+// decodeCheckoutBranding decodes a checkout branding selected with checkoutBrandingFields. The
+// fonts of the typography font groups are interfaces in the model, so they are taken out of the
+// document and decoded according to their __typename.
+func decodeCheckoutBranding(data json.RawMessage) (*model.CheckoutBranding, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("unmarshal checkout branding: %w", err)
+	}
+
+	fonts := map[string]map[string]any{}
+	if designSystem, ok := doc["designSystem"].(map[string]any); ok {
+		if typography, ok := designSystem["typography"].(map[string]any); ok {
+			for _, group := range []string{"primary", "secondary"} {
+				fontGroup, ok := typography[group].(map[string]any)
+				if !ok {
+					continue
+				}
+				for _, font := range []string{"base", "bold"} {
+					if f, ok := fontGroup[font].(map[string]any); ok {
+						fonts[group+"."+font] = f
+					}
+					delete(fontGroup, font)
+				}
+			}
+		}
+	}
+
+	stripped, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("marshal checkout branding: %w", err)
+	}
+	branding := &model.CheckoutBranding{}
+	if err = json.Unmarshal(stripped, branding); err != nil {
+		return nil, fmt.Errorf("unmarshal checkout branding: %w", err)
+	}
+	if branding.DesignSystem == nil || branding.DesignSystem.Typography == nil {
+		return branding, nil
+	}
+
+	groups := map[string]*model.CheckoutBrandingFontGroup{
+		"primary":   branding.DesignSystem.Typography.Primary,
+		"secondary": branding.DesignSystem.Typography.Secondary,
+	}
+	for name, group := range groups {
+		if group == nil {
+			continue
+		}
+		if group.Base, err = decodeCheckoutBrandingFont(fonts[name+".base"]); err != nil {
+			return nil, err
+		}
+		if group.Bold, err = decodeCheckoutBrandingFont(fonts[name+".bold"]); err != nil {
+			return nil, err
+		}
+	}
+	return branding, nil
+}
+
+func decodeCheckoutBrandingFont(font map[string]any) (model.CheckoutBrandingFont, error) {
+	if font == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(font)
+	if err != nil {
+		return nil, fmt.Errorf("marshal font: %w", err)
+	}
+	switch font["__typename"] {
+	case "CheckoutBrandingCustomFont":
+		f := &model.CheckoutBrandingCustomFont{}
+		if err = json.Unmarshal(b, f); err != nil {
+			return nil, fmt.Errorf("unmarshal custom font: %w", err)
+		}
+		return f, nil
+	case "CheckoutBrandingShopifyFont":
+		f := &model.CheckoutBrandingShopifyFont{}
+		if err = json.Unmarshal(b, f); err != nil {
+			return nil, fmt.Errorf("unmarshal Shopify font: %w", err)
+		}
+		return f, nil
+	default:
+		return nil, fmt.Errorf("unexpected font type %v", font["__typename"])
+	}
+}
@@ -1,6 +1,7 @@
 package shopify
 
 import (
+	"context"
 	"os"
 
 	graphqlclient "github.com/gempages/go-shopify-graphql/graph"
@@ -14,24 +15,37 @@ const (
 	shopifyStoreFrontAPIVersion = "default"
 )
 
+// Client is a Shopify GraphQL client. It is safe for concurrent use by multiple goroutines, and
+// cheap to create per request: its common services are allocated with it, and the rarely used
+// ones, returned by methods such as Dispute, on first use. Configure it, with its Set methods,
+// before its first request; its service fields must not be replaced once it is in use.
 type Client struct {
-	gql *graphql.Client
-
-	Product       ProductService
-	Variant       VariantService
-	Inventory     InventoryService
-	Collection    CollectionService
-	Cart          CartService
-	Billing       BillingService
-	Order         OrderService
-	Fulfillment   FulfillmentService
-	Location      LocationService
-	Metafield     MetafieldService
-	BulkOperation BulkOperationService
-	Webhook       WebhookService
-	File          FileService
-	App           AppService
-	Discount      DiscountService
+	gql   *graphql.Client
+	hooks Hooks
+	svc   services
+	lazy  lazyServices
+
+	webhookTopicVersion string
+
+	Product              ProductService
+	Variant              VariantService
+	Inventory            InventoryService
+	Collection           CollectionService
+	StorefrontCollection StorefrontCollectionService
+	Cart                 CartService
+	CustomerAccount      CustomerAccountService
+	Billing              BillingService
+	Order                OrderService
+	Fulfillment          FulfillmentService
+	Location             LocationService
+	Metafield            MetafieldService
+	MetafieldDefinition  MetafieldDefinitionService
+	BulkOperation        BulkOperationService
+	Webhook              WebhookService
+	File                 FileService
+	App                  AppService
+	Discount             DiscountService
+	Customer             CustomerService
 }
 
 type ListOptions struct {
@@ -60,26 +74,75 @@ func NewDefaultClient() (shopClient *Client) {
 // private app authenticated apiKey and password. The storeName parameter is the shop's myshopify domain
 func NewClient(apiKey string, password string, storeName string) *Client {
 	c := &Client{gql: newShopifyGraphQLClient(apiKey, password, storeName)}
-
-	c.Product = &ProductServiceOp{client: c}
-	c.Variant = &VariantServiceOp{client: c}
-	c.Inventory = &InventoryServiceOp{client: c}
-	c.Cart = &CartServiceOp{client: c}
-	c.Billing = &BillingServiceOp{client: c}
-	c.Collection = &CollectionServiceOp{client: c}
-	c.Order = &OrderServiceOp{client: c}
-	c.Fulfillment = &FulfillmentServiceOp{client: c}
-	c.Location = &LocationServiceOp{client: c}
-	c.Metafield = &MetafieldServiceOp{client: c}
-	c.BulkOperation = &BulkOperationServiceOp{client: c}
-	c.Webhook = &WebhookServiceOp{client: c}
-	c.File = &FileServiceOp{client: c}
-	c.App = &AppServiceOp{client: c}
-	c.Discount = &DiscountServiceOp{client: c}
+	c.initServices()
 
 	return c
 }
 
+// services are the implementations of the services of a Client, allocated with it.
+type services struct {
+	product              ProductServiceOp
+	variant              VariantServiceOp
+	inventory            InventoryServiceOp
+	cart                 CartServiceOp
+	customerAccount      CustomerAccountServiceOp
+	billing              BillingServiceOp
+	collection           CollectionServiceOp
+	storefrontCollection StorefrontCollectionServiceOp
+	order                OrderServiceOp
+	fulfillment          FulfillmentServiceOp
+	location             LocationServiceOp
+	metafield            MetafieldServiceOp
+	metafieldDefinition  MetafieldDefinitionServiceOp
+	bulkOperation        BulkOperationServiceOp
+	webhook              WebhookServiceOp
+	file                 FileServiceOp
+	app                  AppServiceOp
+	discount             DiscountServiceOp
+	customer             CustomerServiceOp
+}
+
+// initServices sets the services of the Admin API clients.
+func (c *Client) initServices() {
+	s := &c.svc
+	s.product.client = c
+	c.Product = &s.product
+	s.variant.client = c
+	c.Variant = &s.variant
+	s.inventory.client = c
+	c.Inventory = &s.inventory
+	s.cart.client = c
+	c.Cart = &s.cart
+	s.customerAccount.client = c
+	c.CustomerAccount = &s.customerAccount
+	s.billing.client = c
+	c.Billing = &s.billing
+	s.collection.client = c
+	c.Collection = &s.collection
+	s.order.client = c
+	c.Order = &s.order
+	s.fulfillment.client = c
+	c.Fulfillment = &s.fulfillment
+	s.location.client = c
+	c.Location = &s.location
+	s.metafield.client = c
+	c.Metafield = &s.metafield
+	s.metafieldDefinition.client = c
+	c.MetafieldDefinition = &s.metafieldDefinition
+	s.bulkOperation.client = c
+	c.BulkOperation = &s.bulkOperation
+	s.webhook.client = c
+	c.Webhook = &s.webhook
+	s.file.client = c
+	c.File = &s.file
+	s.app.client = c
+	c.App = &s.app
+	s.discount.client = c
+	c.Discount = &s.discount
+	s.customer.client = c
+	c.Customer = &s.customer
+}
+
 func newShopifyGraphQLClient(apiKey string, password string, storeName string) *graphql.Client {
 	opts := []graphqlclient.Option{
 		graphqlclient.WithVersion(shopifyAPIVersion),
@@ -96,25 +159,38 @@ func (c *Client) SetRetries(retryCount int) {
 	c.gql.SetRetries(retryCount)
 }
 
+// SetJSONCodec sets the JSON implementation used by the GraphQL client and to parse the results of
+// bulk queries. By default, the GraphQL client uses encoding/json and bulk results are parsed with
+// jsoniter.ConfigFastest.
+func (c *Client) SetJSONCodec(codec graphql.Codec) {
+	c.gql.SetJSONCodec(codec)
+}
+
+// SetCache enables the read-through cache of the client for queries that change rarely, such as
+// shop, locations, publications and metafield definitions, or disables it when cache is nil. See
+// graphql.Cache.
+func (c *Client) SetCache(cache *graphql.Cache) {
+	c.gql.SetCache(cache)
+}
+
+// InvalidateCache invalidates the cached queries selecting any of the given root fields, e.g.
+// "locations" after locations were changed by another client or in the admin.
+func (c *Client) InvalidateCache(ctx context.Context, fields ...string) error {
+	return c.gql.InvalidateCache(ctx, fields...)
+}
+
+// SetWebhookTopicValidation makes the webhook service check the topics of new subscriptions
+// against the topics generated for apiVersion, which should be the API version of the client.
+// It is off by default and for versions without a generated list, leaving Shopify to reject
+// unknown topics, as the client may use a newer API version than the generated lists.
+func (c *Client) SetWebhookTopicValidation(apiVersion string) {
+	c.webhookTopicVersion = apiVersion
+}
+
 // NewClientWithOpts returns a new Shopify GRAPHQL client with custom graphql options
 func NewClientWithOpts(storeName string, opts ...graphqlclient.Option) *Client {
 	c := &Client{gql: graphqlclient.NewClient(storeName, opts...)}
-
-	c.Product = &ProductServiceOp{client: c}
-	c.Variant = &VariantServiceOp{client: c}
-	c.Inventory = &InventoryServiceOp{client: c}
-	c.Cart = &CartServiceOp{client: c}
-	c.Billing = &BillingServiceOp{client: c}
-	c.Collection = &CollectionServiceOp{client: c}
-	c.Order = &OrderServiceOp{client: c}
-	c.Fulfillment = &FulfillmentServiceOp{client: c}
-	c.Location = &LocationServiceOp{client: c}
-	c.Metafield = &MetafieldServiceOp{client: c}
-	c.BulkOperation = &BulkOperationServiceOp{client: c}
-	c.Webhook = &WebhookServiceOp{client: c}
-	c.File = &FileServiceOp{client: c}
-	c.App = &AppServiceOp{client: c}
-	c.Discount = &DiscountServiceOp{client: c}
+	c.initServices()
 
 	return c
 }
@@ -124,31 +200,34 @@ func NewClientWithOpts(storeName string, opts ...graphqlclient.Option) *Client {
 //	authenticated domain and token
 func NewClientWithToken(apiKey string, storeName string) *Client {
 	c := &Client{gql: newShopifyGraphQLClientWithToken(apiKey, storeName)}
-
-	c.Product = &ProductServiceOp{client: c}
-	c.Variant = &VariantServiceOp{client: c}
-	// c.Inventory = &InventoryServiceOp{client: c}
-	c.Cart = &CartServiceOp{client: c}
-	c.Billing = &BillingServiceOp{client: c}
-	c.Collection = &CollectionServiceOp{client: c}
-	// c.Order = &OrderServiceOp{client: c}
-	// c.Fulfillment = &FulfillmentServiceOp{client: c}
-	// c.Location = &LocationServiceOp{client: c}
-	c.Metafield = &MetafieldServiceOp{client: c}
-	c.BulkOperation = &BulkOperationServiceOp{client: c}
-	c.Webhook = &WebhookServiceOp{client: c}
-	c.Discount = &DiscountServiceOp{client: c}
+	c.initServices()
+	// not available to token clients
+	c.Inventory = nil
+	c.Order = nil
+	c.Fulfillment = nil
+	c.Location = nil
+	c.File = nil
+	c.App = nil
 
 	return c
 }
 
 // NewClientStoreFrontWithToken returns a new Shopify Storefront GRAPHQL client with
-// authenticated domain and token. The client can only use function for storefront
+// authenticated domain and token. The client can only use function for storefront.
+// Localize a call for a market with graphql.WithInContext.
 func NewClientStoreFrontWithToken(apiKey string, storeName string) *Client {
 	c := &Client{gql: newShopifyStoreFrontGraphQLClientWithToken(apiKey, storeName)}
-	c.Cart = &CartServiceOp{client: c}
-	c.Product = &ProductServiceOp{client: c}
-	c.Collection = &CollectionServiceOp{client: c}
+	s := &c.svc
+	s.cart.client = c
+	c.Cart = &s.cart
+	s.customerAccount.client = c
+	c.CustomerAccount = &s.customerAccount
+	s.product.client = c
+	c.Product = &s.product
+	s.collection.client = c
+	c.Collection = &s.collection
+	s.storefrontCollection.client = c
+	c.StorefrontCollection = &s.storefrontCollection
 
 	return c
 }
@@ -0,0 +1,31 @@
+package shopify
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestClientLazyServices(t *testing.T) {
+	c := NewClientWithToken("token", "example.myshopify.com")
+
+	const goroutines = 8
+	disputes := make([]DisputeService, goroutines)
+	var wg sync.WaitGroup
+	for i := range disputes {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			disputes[i] = c.Dispute()
+		}(i)
+	}
+	wg.Wait()
+
+	for _, d := range disputes {
+		if d == nil || d != disputes[0] {
+			t.Fatalf("got dispute services %v, want a single one", disputes)
+		}
+	}
+	if op := disputes[0].(*DisputeServiceOp); op.client != c {
+		t.Error("the dispute service doesn't use the client")
+	}
+}
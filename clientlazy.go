@@ -0,0 +1,147 @@
+package shopify
+
+import "sync"
+
+// lazyService is a service of a Client built on first use.
+type lazyService[T any] struct {
+	once sync.Once
+	svc  T
+}
+
+func (l *lazyService[T]) get(build func() T) T {
+	l.once.Do(func() {
+		l.svc = build()
+	})
+	return l.svc
+}
+
+// lazyServices are the services of a Client that most clients never use, which are built on first
+// use rather than with the client.
+type lazyServices struct {
+	segment               lazyService[SegmentService]
+	storeCredit           lazyService[StoreCreditService]
+	catalog               lazyService[CatalogService]
+	page                  lazyService[PageService]
+	menu                  lazyService[MenuService]
+	theme                 lazyService[ThemeService]
+	checkoutBranding      lazyService[CheckoutBrandingService]
+	function              lazyService[FunctionService]
+	cartTransform         lazyService[CartTransformService]
+	deliveryCustomization lazyService[DeliveryCustomizationService]
+	paymentCustomization  lazyService[PaymentCustomizationService]
+	validation            lazyService[ValidationService]
+	dispute               lazyService[DisputeService]
+	staffMember           lazyService[StaffMemberService]
+	productFeed           lazyService[ProductFeedService]
+	tenderTransaction     lazyService[TenderTransactionService]
+	returns               lazyService[ReturnService]
+	abandonedCheckout     lazyService[AbandonedCheckoutService]
+}
+
+func (c *Client) Segment() SegmentService {
+	return c.lazy.segment.get(func() SegmentService {
+		return &SegmentServiceOp{client: c}
+	})
+}
+
+func (c *Client) StoreCredit() StoreCreditService {
+	return c.lazy.storeCredit.get(func() StoreCreditService {
+		return &StoreCreditServiceOp{client: c}
+	})
+}
+
+func (c *Client) Catalog() CatalogService {
+	return c.lazy.catalog.get(func() CatalogService {
+		return &CatalogServiceOp{client: c}
+	})
+}
+
+func (c *Client) Page() PageService {
+	return c.lazy.page.get(func() PageService {
+		return &PageServiceOp{client: c}
+	})
+}
+
+func (c *Client) Menu() MenuService {
+	return c.lazy.menu.get(func() MenuService {
+		return &MenuServiceOp{client: c}
+	})
+}
+
+func (c *Client) Theme() ThemeService {
+	return c.lazy.theme.get(func() ThemeService {
+		return &ThemeServiceOp{client: c}
+	})
+}
+
+func (c *Client) CheckoutBranding() CheckoutBrandingService {
+	return c.lazy.checkoutBranding.get(func() CheckoutBrandingService {
+		return &CheckoutBrandingServiceOp{client: c}
+	})
+}
+
+func (c *Client) Function() FunctionService {
+	return c.lazy.function.get(func() FunctionService {
+		return &FunctionServiceOp{client: c}
+	})
+}
+
+func (c *Client) CartTransform() CartTransformService {
+	return c.lazy.cartTransform.get(func() CartTransformService {
+		return &CartTransformServiceOp{client: c}
+	})
+}
+
+func (c *Client) DeliveryCustomization() DeliveryCustomizationService {
+	return c.lazy.deliveryCustomization.get(func() DeliveryCustomizationService {
+		return &DeliveryCustomizationServiceOp{client: c}
+	})
+}
+
+func (c *Client) PaymentCustomization() PaymentCustomizationService {
+	return c.lazy.paymentCustomization.get(func() PaymentCustomizationService {
+		return &PaymentCustomizationServiceOp{client: c}
+	})
+}
+
+func (c *Client) Validation() ValidationService {
+	return c.lazy.validation.get(func() ValidationService {
+		return &ValidationServiceOp{client: c}
+	})
+}
+
+func (c *Client) Dispute() DisputeService {
+	return c.lazy.dispute.get(func() DisputeService {
+		return &DisputeServiceOp{client: c}
+	})
+}
+
+func (c *Client) StaffMember() StaffMemberService {
+	return c.lazy.staffMember.get(func() StaffMemberService {
+		return &StaffMemberServiceOp{client: c}
+	})
+}
+
+func (c *Client) ProductFeed() ProductFeedService {
+	return c.lazy.productFeed.get(func() ProductFeedService {
+		return &ProductFeedServiceOp{client: c}
+	})
+}
+
+func (c *Client) TenderTransaction() TenderTransactionService {
+	return c.lazy.tenderTransaction.get(func() TenderTransactionService {
+		return &TenderTransactionServiceOp{client: c}
+	})
+}
+
+func (c *Client) Return() ReturnService {
+	return c.lazy.returns.get(func() ReturnService {
+		return &ReturnServiceOp{client: c}
+	})
+}
+
+func (c *Client) AbandonedCheckout() AbandonedCheckoutService {
+	return c.lazy.abandonedCheckout.get(func() AbandonedCheckoutService {
+		return &AbandonedCheckoutServiceOp{client: c}
+	})
+}
@@ -3,6 +3,7 @@ package shopify
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/gempages/go-helper/errors"
 	"github.com/gempages/go-shopify-graphql-model/graph/model"
@@ -17,11 +18,18 @@ type CollectionService interface {
 
 	Get(ctx context.Context, id string) (*model.Collection, error)
 	GetSingleCollection(ctx context.Context, id string, cursor string) (*model.Collection, error)
+	GetAllProducts(ctx context.Context, id string) ([]*model.Product, error)
+	PreviewRuleSet(ctx context.Context, ruleSet model.CollectionRuleSetInput) ([]*model.Product, error)
+
+	Count(ctx context.Context, query string) (*model.Count, error)
 
 	Create(ctx context.Context, collection model.CollectionInput) (output *model.Collection, err error)
 	CreateBulk(ctx context.Context, collections []model.CollectionInput) error
 
 	Update(ctx context.Context, collection model.CollectionInput) (output *model.Collection, err error)
+	UpdateSortOrder(ctx context.Context, id string, sortOrder model.CollectionSortOrder) (*model.Collection, error)
+	SetImage(ctx context.Context, id string, image *UploadInput, altText *string) (*model.Collection, error)
+	RemoveImage(ctx context.Context, id string) (*model.Collection, error)
 }
 
 type CollectionServiceOp struct {
@@ -38,6 +46,30 @@ type mutationCollectionUpdate struct {
 	CollectionCreateResult model.CollectionUpdatePayload `graphql:"collectionUpdate(input: $input)" json:"collectionUpdate"`
 }
 
+var collectionUpdateMutation = `
+mutation collectionUpdate($input: CollectionInput!) {
+	collectionUpdate(input: $input) {
+		collection {
+			id
+			handle
+			title
+			sortOrder
+			image {
+				id
+				altText
+				src
+				width
+				height
+			}
+		}
+		userErrors {
+			field
+			message
+		}
+	}
+}
+`
+
 var collectionQuery = `
 	id
 	handle
@@ -56,6 +88,25 @@ var collectionQuery = `
 	}
 `
 
+var collectionProductsQuery = `
+	id
+	products(first: 250, after: $cursor) {
+		edges {
+			node {
+				id
+				legacyResourceId
+				title
+				handle
+				status
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+`
+
 var collectionSingleQuery = `
   id
   title
@@ -247,6 +298,52 @@ func (s *CollectionServiceOp) getPage(ctx context.Context, id graphql.ID, cursor
 	return out.Collection, nil
 }
 
+// GetAllProducts follows the products cursor of the collection until every product is fetched.
+func (s *CollectionServiceOp) GetAllProducts(ctx context.Context, id string) ([]*model.Product, error) {
+	q := fmt.Sprintf(`
+		query collectionProducts($id: ID!, $cursor: String) {
+			collection(id: $id){
+				%s
+			}
+		}
+	`, collectionProductsQuery)
+
+	var (
+		res    []*model.Product
+		cursor *string
+	)
+	for {
+		vars := map[string]interface{}{
+			"id":     id,
+			"cursor": cursor,
+		}
+
+		out := model.QueryRoot{}
+		err := s.client.gql.QueryString(ctx, q, vars, &out)
+		if err != nil {
+			return nil, err
+		}
+
+		if out.Collection == nil {
+			return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "collection not found", nil)
+		}
+
+		products := out.Collection.Products
+		if products == nil {
+			break
+		}
+		for _, e := range products.Edges {
+			res = append(res, e.Node)
+		}
+		if products.PageInfo == nil || !products.PageInfo.HasNextPage || products.PageInfo.EndCursor == nil {
+			break
+		}
+		cursor = products.PageInfo.EndCursor
+	}
+
+	return res, nil
+}
+
 func (s *CollectionServiceOp) GetSingleCollection(ctx context.Context, id string, cursor string) (*model.Collection, error) {
 	q := ""
 	if cursor != "" {
@@ -287,6 +384,10 @@ func (s *CollectionServiceOp) GetSingleCollection(ctx context.Context, id string
 	return out.Collection, nil
 }
 
+func (s *CollectionServiceOp) Count(ctx context.Context, query string) (*model.Count, error) {
+	return queryCount(ctx, s.client.gql, "collectionsCount", query)
+}
+
 func (s *CollectionServiceOp) CreateBulk(ctx context.Context, collections []model.CollectionInput) error {
 	for _, c := range collections {
 		_, err := s.client.Collection.Create(ctx, c)
@@ -335,3 +436,164 @@ func (s *CollectionServiceOp) Update(ctx context.Context, collection model.Colle
 
 	return m.CollectionCreateResult.Collection, nil
 }
+
+// UpdateSortOrder sets the order in which the products of the collection are listed.
+func (s *CollectionServiceOp) UpdateSortOrder(ctx context.Context, id string, sortOrder model.CollectionSortOrder) (*model.Collection, error) {
+	if !sortOrder.IsValid() {
+		return nil, fmt.Errorf("invalid collection sort order %q", sortOrder)
+	}
+	return s.update(ctx, map[string]interface{}{
+		"id":        id,
+		"sortOrder": sortOrder,
+	})
+}
+
+// SetImage sets the image of the collection. When image has no OriginalSource, its file is
+// uploaded to a staged target first, as with FileService.Upload.
+func (s *CollectionServiceOp) SetImage(ctx context.Context, id string, image *UploadInput, altText *string) (*model.Collection, error) {
+	if image == nil {
+		return nil, fmt.Errorf("image is required, use RemoveImage to remove it")
+	}
+
+	var src string
+	if image.OriginalSource != nil {
+		src = *image.OriginalSource
+	} else {
+		resourceURL, err := s.client.svc.file.stageUpload(ctx, image, model.StagedUploadTargetGenerateUploadResourceCollectionImage)
+		if err != nil {
+			return nil, err
+		}
+		src = resourceURL
+	}
+
+	return s.update(ctx, map[string]interface{}{
+		"id": id,
+		"image": model.ImageInput{
+			Src:     &src,
+			AltText: altText,
+		},
+	})
+}
+
+// RemoveImage removes the image of the collection.
+func (s *CollectionServiceOp) RemoveImage(ctx context.Context, id string) (*model.Collection, error) {
+	// model.CollectionInput omits a nil image, which leaves it unchanged
+	return s.update(ctx, map[string]interface{}{
+		"id":    id,
+		"image": nil,
+	})
+}
+
+func (s *CollectionServiceOp) update(ctx context.Context, input map[string]interface{}) (*model.Collection, error) {
+	out := struct {
+		CollectionUpdate struct {
+			Collection *model.Collection `json:"collection"`
+			UserErrors []UserErrors      `json:"userErrors"`
+		} `json:"collectionUpdate"`
+	}{}
+	vars := map[string]interface{}{
+		"input": input,
+	}
+	err := s.client.gql.MutateString(ctx, collectionUpdateMutation, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.CollectionUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.CollectionUpdate.UserErrors)
+	}
+
+	return out.CollectionUpdate.Collection, nil
+}
+
+// PreviewRuleSet returns the products a smart collection with the rule set would contain.
+func (s *CollectionServiceOp) PreviewRuleSet(ctx context.Context, ruleSet model.CollectionRuleSetInput) ([]*model.Product, error) {
+	query, err := CollectionRuleSetQuery(ruleSet)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		res    []*model.Product
+		cursor string
+	)
+	for {
+		products, err := s.client.Product.ListWithFields(ctx, query, `id legacyResourceId title handle status`, 250, cursor)
+		if err != nil {
+			return nil, fmt.Errorf("list products: %w", err)
+		}
+		if products == nil {
+			break
+		}
+		for _, e := range products.Edges {
+			res = append(res, e.Node)
+		}
+		if products.PageInfo == nil || !products.PageInfo.HasNextPage || len(products.Edges) == 0 {
+			break
+		}
+		cursor = products.Edges[len(products.Edges)-1].Cursor
+	}
+
+	return res, nil
+}
+
+// CollectionRuleSetQuery translates smart collection rules to a products search query.
+// Only the columns backed by a product search field are supported, and not the CONTAINS, NOT_CONTAINS
+// and ENDS_WITH relations, which the product search can't express.
+func CollectionRuleSetQuery(ruleSet model.CollectionRuleSetInput) (string, error) {
+	terms := make([]string, 0, len(ruleSet.Rules))
+	for _, rule := range ruleSet.Rules {
+		var field string
+		switch rule.Column {
+		case model.CollectionRuleColumnTag:
+			field = "tag"
+		case model.CollectionRuleColumnTitle:
+			field = "title"
+		case model.CollectionRuleColumnType:
+			field = "product_type"
+		case model.CollectionRuleColumnVendor:
+			field = "vendor"
+		case model.CollectionRuleColumnVariantInventory:
+			field = "inventory_total"
+		default:
+			return "", fmt.Errorf("collection rule column %s is not supported", rule.Column)
+		}
+
+		term, err := collectionRuleTerm(field, rule.Relation, rule.Condition)
+		if err != nil {
+			return "", err
+		}
+		terms = append(terms, term)
+	}
+
+	if ruleSet.AppliedDisjunctively {
+		return strings.Join(terms, " OR "), nil
+	}
+	return strings.Join(terms, " AND "), nil
+}
+
+// collectionRuleTerm builds the search term of a rule. The product search only supports prefix
+// wildcards, so the relations matching inside or at the end of a value are rejected rather than
+// previewing other products than the collection would contain.
+func collectionRuleTerm(field string, relation model.CollectionRuleRelation, condition string) (string, error) {
+	switch relation {
+	case model.CollectionRuleRelationEquals:
+		return fmt.Sprintf(`%s:"%s"`, field, strings.ReplaceAll(condition, `"`, `\"`)), nil
+	case model.CollectionRuleRelationNotEquals:
+		return fmt.Sprintf(`-%s:"%s"`, field, strings.ReplaceAll(condition, `"`, `\"`)), nil
+	case model.CollectionRuleRelationStartsWith:
+		return fmt.Sprintf(`%s:%s*`, field, quoteSearchValue(condition)), nil
+	case model.CollectionRuleRelationGreaterThan:
+		return fmt.Sprintf(`%s:>%s`, field, quoteSearchValue(condition)), nil
+	case model.CollectionRuleRelationLessThan:
+		return fmt.Sprintf(`%s:<%s`, field, quoteSearchValue(condition)), nil
+	case model.CollectionRuleRelationIsSet:
+		return fmt.Sprintf(`%s:*`, field), nil
+	case model.CollectionRuleRelationIsNotSet:
+		return fmt.Sprintf(`-%s:*`, field), nil
+	case model.CollectionRuleRelationContains, model.CollectionRuleRelationNotContains, model.CollectionRuleRelationEndsWith:
+		return "", fmt.Errorf("collection rule relation %s needs a leading wildcard, which the product search doesn't support", relation)
+	default:
+		return "", fmt.Errorf("collection rule relation %s is not supported", relation)
+	}
+}
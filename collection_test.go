@@ -0,0 +1,108 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+
+	"github.com/gempages/go-shopify-graphql/graphql"
+)
+
+const collectionUpdateResponse = `{
+	"data": {
+		"collectionUpdate": {
+			"collection": {
+				"id": "gid://shopify/Collection/453231870266",
+				"handle": "summer",
+				"title": "Summer",
+				"sortOrder": "PRICE_DESC",
+				"image": {"id": "gid://shopify/CollectionImage/1", "altText": "Summer", "src": "https://cdn.shopify.com/collection.png"}
+			},
+			"userErrors": []
+		}
+	}
+}`
+
+// newCollectionUpdateClient returns a client whose collectionUpdate requests store their input in
+// *input.
+func newCollectionUpdateClient(t *testing.T, input *map[string]interface{}) *Client {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables struct {
+				Input map[string]interface{} `json:"input"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		*input = body.Variables.Input
+		_, _ = w.Write([]byte(collectionUpdateResponse))
+	}))
+	t.Cleanup(srv.Close)
+	c := &Client{gql: graphql.NewClient(srv.URL, nil)}
+	c.initServices()
+	return c
+}
+
+func TestCollectionUpdateSortOrder(t *testing.T) {
+	var input map[string]interface{}
+	c := newCollectionUpdateClient(t, &input)
+
+	collection, err := c.Collection.UpdateSortOrder(context.Background(), "gid://shopify/Collection/453231870266", model.CollectionSortOrderPriceDesc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{"id": "gid://shopify/Collection/453231870266", "sortOrder": "PRICE_DESC"}
+	if !reflect.DeepEqual(input, want) {
+		t.Errorf("got input %#v, want %#v", input, want)
+	}
+	if collection.SortOrder != model.CollectionSortOrderPriceDesc {
+		t.Errorf("got sort order %s", collection.SortOrder)
+	}
+}
+
+func TestCollectionSetImage(t *testing.T) {
+	var input map[string]interface{}
+	c := newCollectionUpdateClient(t, &input)
+	ctx := context.Background()
+
+	src := "https://cdn.shopify.com/collection.png"
+	altText := "Summer"
+	collection, err := c.Collection.SetImage(ctx, "gid://shopify/Collection/453231870266", &UploadInput{OriginalSource: &src}, &altText)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{"src": src, "altText": altText}
+	if !reflect.DeepEqual(input["image"], want) {
+		t.Errorf("got image %#v, want %#v", input["image"], want)
+	}
+	if collection.Image == nil || collection.Image.Src != src {
+		t.Errorf("got image %+v", collection.Image)
+	}
+
+	input = nil
+	collection, err = c.Collection.SetImage(ctx, "gid://shopify/Collection/453231870266", nil, nil)
+	if err == nil || collection != nil {
+		t.Errorf("got %+v, %v without an image, want an error", collection, err)
+	}
+	if input != nil {
+		t.Error("a request was sent without an image")
+	}
+}
+
+func TestCollectionRemoveImage(t *testing.T) {
+	var input map[string]interface{}
+	c := newCollectionUpdateClient(t, &input)
+
+	if _, err := c.Collection.RemoveImage(context.Background(), "gid://shopify/Collection/453231870266"); err != nil {
+		t.Fatal(err)
+	}
+	if image, ok := input["image"]; !ok || image != nil {
+		t.Errorf("got input %#v, want a null image", input)
+	}
+}
@@ -0,0 +1,213 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/gempages/go-helper/errors"
+)
+
+// StorefrontCollectionService queries collections through the Storefront API. It is only
+// available on clients created with NewClientStoreFrontWithToken.
+type StorefrontCollectionService interface {
+	ListFilteredProducts(ctx context.Context, handle string, filters []ProductFilter, opts ListOptions) (*CollectionProductsPage, error)
+}
+
+type StorefrontCollectionServiceOp struct {
+	client *Client
+}
+
+var _ StorefrontCollectionService = &StorefrontCollectionServiceOp{}
+
+// ProductFilter is a Storefront API filter on the products of a collection. Set a single field per
+// filter; filters on different fields are combined with AND, filters on the same field with OR.
+// Product filtering must be enabled in the Search & Discovery app for the filters to take effect.
+type ProductFilter struct {
+	Available        *bool                `json:"available,omitempty"`
+	Price            *PriceRangeFilter    `json:"price,omitempty"`
+	VariantOption    *VariantOptionFilter `json:"variantOption,omitempty"`
+	ProductMetafield *MetafieldFilter     `json:"productMetafield,omitempty"`
+	VariantMetafield *MetafieldFilter     `json:"variantMetafield,omitempty"`
+	ProductType      string               `json:"productType,omitempty"`
+	ProductVendor    string               `json:"productVendor,omitempty"`
+	Tag              string               `json:"tag,omitempty"`
+}
+
+type PriceRangeFilter struct {
+	Min *float64 `json:"min,omitempty"`
+	Max *float64 `json:"max,omitempty"`
+}
+
+type VariantOptionFilter struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type MetafieldFilter struct {
+	Namespace string `json:"namespace"`
+	Key       string `json:"key"`
+	Value     string `json:"value"`
+}
+
+// ProductFacet is a filter available on a collection, as configured in the Search & Discovery app.
+type ProductFacet struct {
+	ID     string              `json:"id"`
+	Label  string              `json:"label"`
+	Type   string              `json:"type"`
+	Values []ProductFacetValue `json:"values"`
+}
+
+type ProductFacetValue struct {
+	ID    string `json:"id"`
+	Label string `json:"label"`
+	Count int    `json:"count"`
+	// Input is the JSON encoded ProductFilter selecting this value, see Filter.
+	Input string `json:"input"`
+}
+
+// Filter decodes Input, so that a facet value picked by the shopper can be passed back to
+// ListFilteredProducts.
+func (v ProductFacetValue) Filter() (ProductFilter, error) {
+	var filter ProductFilter
+	if err := json.Unmarshal([]byte(v.Input), &filter); err != nil {
+		return ProductFilter{}, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+	return filter, nil
+}
+
+// StorefrontProduct is the product summary returned by ListFilteredProducts.
+type StorefrontProduct struct {
+	ID               string `json:"id"`
+	Handle           string `json:"handle"`
+	Title            string `json:"title"`
+	Vendor           string `json:"vendor"`
+	ProductType      string `json:"productType"`
+	AvailableForSale bool   `json:"availableForSale"`
+	// RequiresSellingPlan is true when the product can only be bought with a selling plan, see GetSellingPlans.
+	RequiresSellingPlan bool `json:"requiresSellingPlan"`
+	PriceRange          struct {
+		MinVariantPrice MoneyV2 `json:"minVariantPrice"`
+		MaxVariantPrice MoneyV2 `json:"maxVariantPrice"`
+	} `json:"priceRange"`
+	FeaturedImage *struct {
+		URL     string  `json:"url"`
+		AltText *string `json:"altText"`
+	} `json:"featuredImage"`
+}
+
+// CollectionProductsPage is a page of filtered collection products with the facets available on
+// the whole filtered result.
+type CollectionProductsPage struct {
+	Products   []*StorefrontProduct
+	Filters    []ProductFacet
+	NextCursor string
+}
+
+const storefrontProductFields = `
+	id
+	handle
+	title
+	vendor
+	productType
+	availableForSale
+	requiresSellingPlan
+	priceRange {
+		minVariantPrice {
+			amount
+			currencyCode
+		}
+		maxVariantPrice {
+			amount
+			currencyCode
+		}
+	}
+	featuredImage {
+		url
+		altText
+	}
+`
+
+// ListFilteredProducts returns a page of the products of the collection with the given handle that
+// match filters, together with the filter facets of the result.
+func (s *StorefrontCollectionServiceOp) ListFilteredProducts(ctx context.Context, handle string, filters []ProductFilter, opts ListOptions) (*CollectionProductsPage, error) {
+	q := fmt.Sprintf(`
+		query collectionProducts($handle: String!, $filters: [ProductFilter!], $first: Int!, $after: String, $reverse: Boolean) {
+			collection(handle: $handle) {
+				products(first: $first, after: $after, reverse: $reverse, filters: $filters) {
+					edges {
+						node {
+							%s
+						}
+						cursor
+					}
+					pageInfo {
+						hasNextPage
+					}
+					filters {
+						id
+						label
+						type
+						values {
+							id
+							label
+							count
+							input
+						}
+					}
+				}
+			}
+		}
+	`, storefrontProductFields)
+
+	first := opts.First
+	if first <= 0 {
+		first = 50
+	}
+	vars := map[string]interface{}{
+		"handle":  handle,
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	if len(filters) > 0 {
+		vars["filters"] = filters
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+
+	out := struct {
+		Collection *struct {
+			Products struct {
+				Edges []struct {
+					Node   *StorefrontProduct `json:"node"`
+					Cursor string             `json:"cursor"`
+				} `json:"edges"`
+				PageInfo struct {
+					HasNextPage bool `json:"hasNextPage"`
+				} `json:"pageInfo"`
+				Filters []ProductFacet `json:"filters"`
+			} `json:"products"`
+		} `json:"collection"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.Collection == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "collection not found", nil)
+	}
+
+	conn := out.Collection.Products
+	page := &CollectionProductsPage{
+		Products: make([]*StorefrontProduct, 0, len(conn.Edges)),
+		Filters:  conn.Filters,
+	}
+	for _, edge := range conn.Edges {
+		page.Products = append(page.Products, edge.Node)
+	}
+	if conn.PageInfo.HasNextPage && len(conn.Edges) > 0 {
+		page.NextCursor = conn.Edges[len(conn.Edges)-1].Cursor
+	}
+	return page, nil
+}
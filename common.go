@@ -1,6 +1,13 @@
 package shopify
 
-import "github.com/gempages/go-shopify-graphql/graphql"
+import (
+	"context"
+	"fmt"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+
+	"github.com/gempages/go-shopify-graphql/graphql"
+)
 
 type UserErrors struct {
 	Field   []graphql.String
@@ -110,3 +117,32 @@ type PageInfo struct {
 //
 // Example value: "https://johns-apparel.myshopify.com".
 type URL string
+
+// queryCount runs one of the `*Count` root fields (e.g. productsCount) with an optional search query.
+func queryCount(ctx context.Context, gql *graphql.Client, field string, query string) (*model.Count, error) {
+	q := fmt.Sprintf(`
+		query count($query: String) {
+			%s(query: $query) {
+				count
+				precision
+			}
+		}
+	`, field)
+
+	vars := map[string]interface{}{}
+	if query != "" {
+		vars["query"] = query
+	}
+
+	out := map[string]*model.Count{}
+	err := gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	if out[field] == nil {
+		return nil, fmt.Errorf("%s returned no result", field)
+	}
+
+	return out[field], nil
+}
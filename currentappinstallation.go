@@ -2,12 +2,17 @@ package shopify
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/gempages/go-helper/errors"
 	"github.com/gempages/go-shopify-graphql-model/graph/model"
 )
 
 type AppService interface {
 	GetCurrentAppInstallation(ctx context.Context) (*model.App, error)
+	Get(ctx context.Context, id string) (*model.App, error)
+	GetByHandle(ctx context.Context, handle string) (*model.App, error)
+	GetInstallation(ctx context.Context, id string, metafieldNamespace string) (*model.AppInstallation, error)
 }
 
 type AppServiceOp struct {
@@ -16,6 +21,31 @@ type AppServiceOp struct {
 
 var _ AppService = &AppServiceOp{}
 
+const appFields = `
+	id
+	apiKey
+	handle
+	title
+	description
+	developerName
+	developerType
+	embedded
+	published
+	shopifyDeveloped
+	isPostPurchaseAppInUse
+	previouslyInstalled
+	appStoreAppUrl
+	installUrl
+	webhookApiVersion
+	features
+	requestedAccessScopes {
+		handle
+	}
+	availableAccessScopes {
+		handle
+	}
+`
+
 const queryCurrentAppInstallation = `
 	query {
 		currentAppInstallation {
@@ -43,3 +73,109 @@ func (a *AppServiceOp) GetCurrentAppInstallation(ctx context.Context) (*model.Ap
 
 	return out.CurrentAppInstallation.App, nil
 }
+
+// Get returns the app with the given ID, or the calling app when id is empty.
+func (a *AppServiceOp) Get(ctx context.Context, id string) (*model.App, error) {
+	q := fmt.Sprintf(`
+		query app($id: ID) {
+			app(id: $id) {
+				%s
+			}
+		}
+	`, appFields)
+
+	vars := map[string]interface{}{}
+	if id != "" {
+		vars["id"] = id
+	}
+	out := struct {
+		App *model.App `json:"app"`
+	}{}
+	err := a.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.App == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "app not found", nil)
+	}
+
+	return out.App, nil
+}
+
+// GetByHandle returns the app with the given handle, as found in its App Store URL.
+func (a *AppServiceOp) GetByHandle(ctx context.Context, handle string) (*model.App, error) {
+	q := fmt.Sprintf(`
+		query appByHandle($handle: String!) {
+			appByHandle(handle: $handle) {
+				%s
+			}
+		}
+	`, appFields)
+
+	vars := map[string]interface{}{
+		"handle": handle,
+	}
+	out := struct {
+		AppByHandle *model.App `json:"appByHandle"`
+	}{}
+	err := a.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.AppByHandle == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, fmt.Sprintf("app %q not found", handle), nil)
+	}
+
+	return out.AppByHandle, nil
+}
+
+// GetInstallation returns the app installation with the given ID, or the installation of the
+// calling app when id is empty. The first 250 metafields of the installation are included,
+// limited to metafieldNamespace when it isn't empty. Reading the installation of another app
+// requires the read_apps scope.
+func (a *AppServiceOp) GetInstallation(ctx context.Context, id string, metafieldNamespace string) (*model.AppInstallation, error) {
+	q := fmt.Sprintf(`
+		query appInstallation($id: ID, $namespace: String) {
+			appInstallation(id: $id) {
+				id
+				launchUrl
+				uninstallUrl
+				accessScopes {
+					handle
+				}
+				app {
+					%s
+				}
+				metafields(first: 250, namespace: $namespace) {
+					nodes {
+						id
+						namespace
+						key
+						type
+						value
+					}
+				}
+			}
+		}
+	`, appFields)
+
+	vars := map[string]interface{}{}
+	if id != "" {
+		vars["id"] = id
+	}
+	if metafieldNamespace != "" {
+		vars["namespace"] = metafieldNamespace
+	}
+	out := struct {
+		AppInstallation *model.AppInstallation `json:"appInstallation"`
+	}{}
+	err := a.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.AppInstallation == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "app installation not found", nil)
+	}
+
+	return out.AppInstallation, nil
+}
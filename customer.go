@@ -1,6 +1,46 @@
 package shopify
 
-import "github.com/gempages/go-shopify-graphql/graphql"
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gempages/go-helper/errors"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+
+	"github.com/gempages/go-shopify-graphql/graphql"
+)
+
+type CustomerService interface {
+	Count(ctx context.Context, query string) (*model.Count, error)
+
+	Get(ctx context.Context, id string) (*model.Customer, error)
+	List(ctx context.Context, opts ListOptions) ([]*model.Customer, string, error)
+	ListAll(ctx context.Context, query string) ([]*model.Customer, error)
+	FindByEmail(ctx context.Context, email string) ([]*model.Customer, error)
+	FindByPhone(ctx context.Context, phone string) ([]*model.Customer, error)
+
+	Create(ctx context.Context, input model.CustomerInput) (*model.Customer, error)
+	Update(ctx context.Context, input model.CustomerInput) (*model.Customer, error)
+	Delete(ctx context.Context, id string) (string, error)
+
+	SetAddresses(ctx context.Context, customerID string, addresses []model.MailingAddressInput) (*model.Customer, error)
+	SetDefaultAddress(ctx context.Context, customerID, addressID string) (*model.Customer, error)
+	UpdateTaxExemptions(ctx context.Context, customerID string, op TaxExemptionsOperation, exemptions []model.TaxExemption) ([]model.TaxExemption, error)
+	UpdateEmailMarketingConsent(ctx context.Context, customerID string, consent model.CustomerEmailMarketingConsentInput) (*model.CustomerEmailMarketingConsentState, error)
+
+	MergePreview(ctx context.Context, keepID, discardID string) (*model.CustomerMergePreview, error)
+	Merge(ctx context.Context, keepID, discardID string) (*CustomerMergeResult, error)
+	MergeStatus(ctx context.Context, jobID string) (*model.CustomerMergeRequest, error)
+	SendAccountInvite(ctx context.Context, id string) error
+	AccountActivationURL(ctx context.Context, id string) (string, error)
+}
+
+type CustomerServiceOp struct {
+	client *Client
+}
+
+var _ CustomerService = &CustomerServiceOp{}
 
 type Customer struct {
 	ID               graphql.ID     `json:"id,omitempty"`
@@ -9,3 +49,469 @@ type Customer struct {
 	DisplayName      graphql.String `json:"displayName,omitempty"`
 	Email            graphql.String `json:"email,omitempty"`
 }
+
+func (s *CustomerServiceOp) Count(ctx context.Context, query string) (*model.Count, error) {
+	return queryCount(ctx, s.client.gql, "customersCount", query)
+}
+
+const customerAddressFields = `
+	id
+	firstName
+	lastName
+	company
+	address1
+	address2
+	city
+	province
+	provinceCode
+	country
+	countryCodeV2
+	zip
+	phone
+`
+
+var customerFields = fmt.Sprintf(`
+	id
+	legacyResourceId
+	firstName
+	lastName
+	displayName
+	email
+	phone
+	note
+	tags
+	locale
+	state
+	verifiedEmail
+	taxExempt
+	taxExemptions
+	numberOfOrders
+	createdAt
+	updatedAt
+	amountSpent {
+		amount
+		currencyCode
+	}
+	emailMarketingConsent {
+		marketingState
+		marketingOptInLevel
+		consentUpdatedAt
+	}
+	smsMarketingConsent {
+		marketingState
+		marketingOptInLevel
+		consentUpdatedAt
+		consentCollectedFrom
+	}
+	defaultAddress {
+		%s
+	}
+	addresses {
+		%s
+	}
+`, customerAddressFields, customerAddressFields)
+
+func (s *CustomerServiceOp) Get(ctx context.Context, id string) (*model.Customer, error) {
+	q := fmt.Sprintf(`
+		query customer($id: ID!) {
+			customer(id: $id) {
+				%s
+			}
+		}
+	`, customerFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		Customer *model.Customer `json:"customer"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.Customer == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "customer not found", nil)
+	}
+
+	return out.Customer, nil
+}
+
+// List returns a page of customers matching opts.Query, in the customer search syntax.
+func (s *CustomerServiceOp) List(ctx context.Context, opts ListOptions) ([]*model.Customer, string, error) {
+	q := fmt.Sprintf(`
+		query customers($first: Int!, $after: String, $query: String, $reverse: Boolean) {
+			customers(first: $first, after: $after, query: $query, reverse: $reverse) {
+				edges {
+					node {
+						%s
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+				}
+			}
+		}
+	`, customerFields)
+
+	first := opts.First
+	if first <= 0 {
+		first = 50
+	}
+	vars := map[string]interface{}{
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+	if opts.Query != "" {
+		vars["query"] = opts.Query
+	}
+
+	out := struct {
+		Customers struct {
+			Edges []struct {
+				Node   *model.Customer `json:"node"`
+				Cursor string          `json:"cursor"`
+			} `json:"edges"`
+			PageInfo struct {
+				HasNextPage bool `json:"hasNextPage"`
+			} `json:"pageInfo"`
+		} `json:"customers"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, "", fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	edges := out.Customers.Edges
+	res := make([]*model.Customer, 0, len(edges))
+	for _, edge := range edges {
+		res = append(res, edge.Node)
+	}
+	nextCursor := ""
+	if out.Customers.PageInfo.HasNextPage && len(edges) > 0 {
+		nextCursor = edges[len(edges)-1].Cursor
+	}
+
+	return res, nextCursor, nil
+}
+
+// ListAll exports the customers matching query through a bulk operation, for full customer syncs.
+func (s *CustomerServiceOp) ListAll(ctx context.Context, query string) ([]*model.Customer, error) {
+	q := fmt.Sprintf(`
+		{
+			customers(query: "$query") {
+				edges {
+					node {
+						%s
+					}
+				}
+			}
+		}
+	`, customerFields)
+
+	q = strings.ReplaceAll(q, "$query", strings.ReplaceAll(query, `"`, `\"`))
+
+	res := []*model.Customer{}
+	err := s.client.BulkOperation.BulkQuery(ctx, q, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// FindByEmail returns the customers with the given email address. Emails are unique per shop, but
+// the search index may briefly lag behind recent updates.
+func (s *CustomerServiceOp) FindByEmail(ctx context.Context, email string) ([]*model.Customer, error) {
+	customers, _, err := s.List(ctx, ListOptions{Query: "email:" + quoteSearchValue(email), First: 10})
+	return customers, err
+}
+
+// FindByPhone returns the customers with the given phone number, in E.164 format.
+func (s *CustomerServiceOp) FindByPhone(ctx context.Context, phone string) ([]*model.Customer, error) {
+	customers, _, err := s.List(ctx, ListOptions{Query: "phone:" + quoteSearchValue(phone), First: 10})
+	return customers, err
+}
+
+func (s *CustomerServiceOp) Create(ctx context.Context, input model.CustomerInput) (*model.Customer, error) {
+	m := fmt.Sprintf(`
+		mutation customerCreate($input: CustomerInput!) {
+			customerCreate(input: $input) {
+				customer {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, customerFields)
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+	out := struct {
+		CustomerCreate struct {
+			Customer   *model.Customer   `json:"customer"`
+			UserErrors []model.UserError `json:"userErrors"`
+		} `json:"customerCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CustomerCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.CustomerCreate.UserErrors)
+	}
+
+	return out.CustomerCreate.Customer, nil
+}
+
+// Update updates the customer identified by input.ID. Setting Addresses or Tags replaces the existing ones.
+func (s *CustomerServiceOp) Update(ctx context.Context, input model.CustomerInput) (*model.Customer, error) {
+	if input.ID == nil || *input.ID == "" {
+		return nil, fmt.Errorf("customer ID is required")
+	}
+	return s.update(ctx, input)
+}
+
+// update sends input, a model.CustomerInput or a map of its fields, to customerUpdate.
+func (s *CustomerServiceOp) update(ctx context.Context, input interface{}) (*model.Customer, error) {
+	m := fmt.Sprintf(`
+		mutation customerUpdate($input: CustomerInput!) {
+			customerUpdate(input: $input) {
+				customer {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, customerFields)
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+	out := struct {
+		CustomerUpdate struct {
+			Customer   *model.Customer   `json:"customer"`
+			UserErrors []model.UserError `json:"userErrors"`
+		} `json:"customerUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CustomerUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.CustomerUpdate.UserErrors)
+	}
+
+	return out.CustomerUpdate.Customer, nil
+}
+
+// Delete deletes a customer. Customers with orders can't be deleted.
+func (s *CustomerServiceOp) Delete(ctx context.Context, id string) (string, error) {
+	m := `
+		mutation customerDelete($input: CustomerDeleteInput!) {
+			customerDelete(input: $input) {
+				deletedCustomerId
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"input": model.CustomerDeleteInput{ID: id},
+	}
+	out := struct {
+		CustomerDelete struct {
+			DeletedCustomerID *string           `json:"deletedCustomerId"`
+			UserErrors        []model.UserError `json:"userErrors"`
+		} `json:"customerDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return "", fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CustomerDelete.UserErrors) > 0 {
+		return "", fmt.Errorf("%+v", out.CustomerDelete.UserErrors)
+	}
+	if out.CustomerDelete.DeletedCustomerID == nil {
+		return "", nil
+	}
+
+	return *out.CustomerDelete.DeletedCustomerID, nil
+}
+
+// SetAddresses replaces all the addresses of a customer; no addresses removes them all.
+func (s *CustomerServiceOp) SetAddresses(ctx context.Context, customerID string, addresses []model.MailingAddressInput) (*model.Customer, error) {
+	if customerID == "" {
+		return nil, fmt.Errorf("customer ID is required")
+	}
+	if addresses == nil {
+		addresses = []model.MailingAddressInput{}
+	}
+	// model.CustomerInput omits empty addresses, which leaves them unchanged
+	return s.update(ctx, map[string]interface{}{
+		"id":        customerID,
+		"addresses": addresses,
+	})
+}
+
+// SetDefaultAddress makes one of the existing addresses of a customer its default address.
+func (s *CustomerServiceOp) SetDefaultAddress(ctx context.Context, customerID, addressID string) (*model.Customer, error) {
+	m := fmt.Sprintf(`
+		mutation customerUpdateDefaultAddress($customerId: ID!, $addressId: ID!) {
+			customerUpdateDefaultAddress(customerId: $customerId, addressId: $addressId) {
+				customer {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, customerFields)
+
+	vars := map[string]interface{}{
+		"customerId": customerID,
+		"addressId":  addressID,
+	}
+	out := struct {
+		CustomerUpdateDefaultAddress struct {
+			Customer   *model.Customer   `json:"customer"`
+			UserErrors []model.UserError `json:"userErrors"`
+		} `json:"customerUpdateDefaultAddress"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CustomerUpdateDefaultAddress.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.CustomerUpdateDefaultAddress.UserErrors)
+	}
+
+	return out.CustomerUpdateDefaultAddress.Customer, nil
+}
+
+// TaxExemptionsOperation selects how UpdateTaxExemptions changes the exemptions of a customer.
+type TaxExemptionsOperation string
+
+const (
+	TaxExemptionsAdd     TaxExemptionsOperation = "customerAddTaxExemptions"
+	TaxExemptionsRemove  TaxExemptionsOperation = "customerRemoveTaxExemptions"
+	TaxExemptionsReplace TaxExemptionsOperation = "customerReplaceTaxExemptions"
+)
+
+// UpdateTaxExemptions adds, removes or replaces the tax exemptions of a customer and returns the
+// resulting list.
+func (s *CustomerServiceOp) UpdateTaxExemptions(ctx context.Context, customerID string, op TaxExemptionsOperation, exemptions []model.TaxExemption) ([]model.TaxExemption, error) {
+	switch op {
+	case TaxExemptionsAdd, TaxExemptionsRemove, TaxExemptionsReplace:
+	default:
+		return nil, fmt.Errorf("unknown tax exemptions operation %q", op)
+	}
+
+	m := fmt.Sprintf(`
+		mutation %[1]s($customerId: ID!, $taxExemptions: [TaxExemption!]!) {
+			%[1]s(customerId: $customerId, taxExemptions: $taxExemptions) {
+				customer {
+					id
+					taxExemptions
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, op)
+
+	if exemptions == nil {
+		exemptions = []model.TaxExemption{}
+	}
+	vars := map[string]interface{}{
+		"customerId":    customerID,
+		"taxExemptions": exemptions,
+	}
+	var out map[string]struct {
+		Customer *struct {
+			TaxExemptions []model.TaxExemption `json:"taxExemptions"`
+		} `json:"customer"`
+		UserErrors []model.UserError `json:"userErrors"`
+	}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	payload := out[string(op)]
+	if len(payload.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", payload.UserErrors)
+	}
+	if payload.Customer == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "customer not found", nil)
+	}
+
+	return payload.Customer.TaxExemptions, nil
+}
+
+// UpdateEmailMarketingConsent records whether a customer agreed to receive marketing emails.
+// The customer must already have an email address.
+func (s *CustomerServiceOp) UpdateEmailMarketingConsent(ctx context.Context, customerID string, consent model.CustomerEmailMarketingConsentInput) (*model.CustomerEmailMarketingConsentState, error) {
+	m := `
+		mutation customerEmailMarketingConsentUpdate($input: CustomerEmailMarketingConsentUpdateInput!) {
+			customerEmailMarketingConsentUpdate(input: $input) {
+				customer {
+					emailMarketingConsent {
+						marketingState
+						marketingOptInLevel
+						consentUpdatedAt
+					}
+				}
+				userErrors {
+					field
+					message
+					code
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"input": model.CustomerEmailMarketingConsentUpdateInput{
+			CustomerID:            customerID,
+			EmailMarketingConsent: &consent,
+		},
+	}
+	out := struct {
+		CustomerEmailMarketingConsentUpdate struct {
+			Customer *struct {
+				EmailMarketingConsent *model.CustomerEmailMarketingConsentState `json:"emailMarketingConsent"`
+			} `json:"customer"`
+			UserErrors []model.CustomerEmailMarketingConsentUpdateUserError `json:"userErrors"`
+		} `json:"customerEmailMarketingConsentUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CustomerEmailMarketingConsentUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.CustomerEmailMarketingConsentUpdate.UserErrors)
+	}
+	if out.CustomerEmailMarketingConsentUpdate.Customer == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "customer not found", nil)
+	}
+
+	return out.CustomerEmailMarketingConsentUpdate.Customer.EmailMarketingConsent, nil
+}
@@ -0,0 +1,310 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// CustomerAccountService implements the classic customer account flows of the Storefront API:
+// login through customer access tokens, registration, password recovery and profile updates.
+// It is only available on clients created with NewClientStoreFrontWithToken.
+type CustomerAccountService interface {
+	CreateAccessToken(ctx context.Context, email, password string) (*CustomerAccessToken, error)
+	RenewAccessToken(ctx context.Context, accessToken string) (*CustomerAccessToken, error)
+	DeleteAccessToken(ctx context.Context, accessToken string) error
+	Create(ctx context.Context, input CustomerAccountCreateInput) (*CustomerAccount, error)
+	Recover(ctx context.Context, email string) error
+	Update(ctx context.Context, accessToken string, input CustomerAccountUpdateInput) (*CustomerAccount, *CustomerAccessToken, error)
+}
+
+type CustomerAccountServiceOp struct {
+	client *Client
+}
+
+var _ CustomerAccountService = &CustomerAccountServiceOp{}
+
+type CustomerAccessToken struct {
+	AccessToken string    `json:"accessToken"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+// CustomerAccount is a customer as seen by the Storefront API.
+type CustomerAccount struct {
+	ID               string  `json:"id"`
+	Email            *string `json:"email"`
+	FirstName        *string `json:"firstName"`
+	LastName         *string `json:"lastName"`
+	DisplayName      string  `json:"displayName"`
+	Phone            *string `json:"phone"`
+	AcceptsMarketing bool    `json:"acceptsMarketing"`
+}
+
+type CustomerAccountCreateInput struct {
+	Email            string  `json:"email"`
+	Password         string  `json:"password"`
+	FirstName        *string `json:"firstName,omitempty"`
+	LastName         *string `json:"lastName,omitempty"`
+	Phone            *string `json:"phone,omitempty"`
+	AcceptsMarketing *bool   `json:"acceptsMarketing,omitempty"`
+}
+
+// CustomerAccountUpdateInput updates the set fields of a customer. Changing the password
+// invalidates the access token used for the update.
+type CustomerAccountUpdateInput struct {
+	Email            *string `json:"email,omitempty"`
+	Password         *string `json:"password,omitempty"`
+	FirstName        *string `json:"firstName,omitempty"`
+	LastName         *string `json:"lastName,omitempty"`
+	Phone            *string `json:"phone,omitempty"`
+	AcceptsMarketing *bool   `json:"acceptsMarketing,omitempty"`
+}
+
+// CustomerUserError is a user error of the customer mutations, with codes such as
+// UNIDENTIFIED_CUSTOMER for wrong credentials or TAKEN for an email already registered.
+type CustomerUserError struct {
+	Code    *string  `json:"code"`
+	Field   []string `json:"field"`
+	Message string   `json:"message"`
+}
+
+const customerAccountFields = `
+	id
+	email
+	firstName
+	lastName
+	displayName
+	phone
+	acceptsMarketing
+`
+
+// CreateAccessToken logs a customer in and returns the token authenticating their requests.
+func (s *CustomerAccountServiceOp) CreateAccessToken(ctx context.Context, email, password string) (*CustomerAccessToken, error) {
+	m := `
+		mutation customerAccessTokenCreate($input: CustomerAccessTokenCreateInput!) {
+			customerAccessTokenCreate(input: $input) {
+				customerAccessToken {
+					accessToken
+					expiresAt
+				}
+				customerUserErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"input": map[string]interface{}{
+			"email":    email,
+			"password": password,
+		},
+	}
+	out := struct {
+		CustomerAccessTokenCreate struct {
+			CustomerAccessToken *CustomerAccessToken `json:"customerAccessToken"`
+			CustomerUserErrors  []CustomerUserError  `json:"customerUserErrors"`
+		} `json:"customerAccessTokenCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CustomerAccessTokenCreate.CustomerUserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.CustomerAccessTokenCreate.CustomerUserErrors)
+	}
+	if out.CustomerAccessTokenCreate.CustomerAccessToken == nil {
+		return nil, fmt.Errorf("no access token returned")
+	}
+
+	return out.CustomerAccessTokenCreate.CustomerAccessToken, nil
+}
+
+// RenewAccessToken extends the expiry of a token that has not expired yet.
+func (s *CustomerAccountServiceOp) RenewAccessToken(ctx context.Context, accessToken string) (*CustomerAccessToken, error) {
+	m := `
+		mutation customerAccessTokenRenew($customerAccessToken: String!) {
+			customerAccessTokenRenew(customerAccessToken: $customerAccessToken) {
+				customerAccessToken {
+					accessToken
+					expiresAt
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"customerAccessToken": accessToken,
+	}
+	out := struct {
+		CustomerAccessTokenRenew struct {
+			CustomerAccessToken *CustomerAccessToken `json:"customerAccessToken"`
+			UserErrors          []UserErrors         `json:"userErrors"`
+		} `json:"customerAccessTokenRenew"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CustomerAccessTokenRenew.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.CustomerAccessTokenRenew.UserErrors)
+	}
+	if out.CustomerAccessTokenRenew.CustomerAccessToken == nil {
+		return nil, fmt.Errorf("no access token returned")
+	}
+
+	return out.CustomerAccessTokenRenew.CustomerAccessToken, nil
+}
+
+// DeleteAccessToken logs a customer out by invalidating their token.
+func (s *CustomerAccountServiceOp) DeleteAccessToken(ctx context.Context, accessToken string) error {
+	m := `
+		mutation customerAccessTokenDelete($customerAccessToken: String!) {
+			customerAccessTokenDelete(customerAccessToken: $customerAccessToken) {
+				deletedAccessToken
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"customerAccessToken": accessToken,
+	}
+	out := struct {
+		CustomerAccessTokenDelete struct {
+			UserErrors []UserErrors `json:"userErrors"`
+		} `json:"customerAccessTokenDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CustomerAccessTokenDelete.UserErrors) > 0 {
+		return fmt.Errorf("%+v", out.CustomerAccessTokenDelete.UserErrors)
+	}
+
+	return nil
+}
+
+// Create registers a new customer. Call CreateAccessToken afterwards to log them in.
+func (s *CustomerAccountServiceOp) Create(ctx context.Context, input CustomerAccountCreateInput) (*CustomerAccount, error) {
+	m := fmt.Sprintf(`
+		mutation customerCreate($input: CustomerCreateInput!) {
+			customerCreate(input: $input) {
+				customer {
+					%s
+				}
+				customerUserErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, customerAccountFields)
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+	out := struct {
+		CustomerCreate struct {
+			Customer           *CustomerAccount    `json:"customer"`
+			CustomerUserErrors []CustomerUserError `json:"customerUserErrors"`
+		} `json:"customerCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CustomerCreate.CustomerUserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.CustomerCreate.CustomerUserErrors)
+	}
+
+	return out.CustomerCreate.Customer, nil
+}
+
+// Recover sends a password reset email to the customer.
+func (s *CustomerAccountServiceOp) Recover(ctx context.Context, email string) error {
+	m := `
+		mutation customerRecover($email: String!) {
+			customerRecover(email: $email) {
+				customerUserErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"email": email,
+	}
+	out := struct {
+		CustomerRecover struct {
+			CustomerUserErrors []CustomerUserError `json:"customerUserErrors"`
+		} `json:"customerRecover"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CustomerRecover.CustomerUserErrors) > 0 {
+		return fmt.Errorf("%+v", out.CustomerRecover.CustomerUserErrors)
+	}
+
+	return nil
+}
+
+// Update updates the customer owning accessToken. The returned token is only set when the password
+// was changed, in which case it replaces accessToken.
+func (s *CustomerAccountServiceOp) Update(ctx context.Context, accessToken string, input CustomerAccountUpdateInput) (*CustomerAccount, *CustomerAccessToken, error) {
+	m := fmt.Sprintf(`
+		mutation customerUpdate($customerAccessToken: String!, $customer: CustomerUpdateInput!) {
+			customerUpdate(customerAccessToken: $customerAccessToken, customer: $customer) {
+				customer {
+					%s
+				}
+				customerAccessToken {
+					accessToken
+					expiresAt
+				}
+				customerUserErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, customerAccountFields)
+
+	vars := map[string]interface{}{
+		"customerAccessToken": accessToken,
+		"customer":            input,
+	}
+	out := struct {
+		CustomerUpdate struct {
+			Customer            *CustomerAccount     `json:"customer"`
+			CustomerAccessToken *CustomerAccessToken `json:"customerAccessToken"`
+			CustomerUserErrors  []CustomerUserError  `json:"customerUserErrors"`
+		} `json:"customerUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CustomerUpdate.CustomerUserErrors) > 0 {
+		return nil, nil, fmt.Errorf("%+v", out.CustomerUpdate.CustomerUserErrors)
+	}
+
+	return out.CustomerUpdate.Customer, out.CustomerUpdate.CustomerAccessToken, nil
+}
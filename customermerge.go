@@ -0,0 +1,269 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// CustomerMergeResult is the outcome of starting a merge. The merge runs asynchronously; poll
+// MergeStatus with JobID to know when it completed.
+type CustomerMergeResult struct {
+	ResultingCustomerID string
+	JobID               string
+}
+
+const customerMergeAddressFields = `
+	address1
+	address2
+	city
+	provinceCode
+	countryCodeV2
+	zip
+`
+
+var customerMergePreviewFields = fmt.Sprintf(`
+	resultingCustomerId
+	customerMergeErrors {
+		errorFields
+		message
+	}
+	blockingFields {
+		note
+		tags
+	}
+	defaultFields {
+		displayName
+		firstName
+		lastName
+		email {
+			emailAddress
+		}
+		phoneNumber {
+			phoneNumber
+		}
+		note
+		tags
+		orderCount
+		draftOrderCount
+		giftCardCount
+		discountNodeCount
+		metafieldCount
+		defaultAddress {
+			%s
+		}
+	}
+	alternateFields {
+		firstName
+		lastName
+		email {
+			emailAddress
+		}
+		phoneNumber {
+			phoneNumber
+		}
+		defaultAddress {
+			%s
+		}
+	}
+`, customerMergeAddressFields, customerMergeAddressFields)
+
+// customerMergeOverrides keeps the name, email, phone and default address of keepID when the two
+// customers both have a value, instead of Shopify's default rules.
+func customerMergeOverrides(keepID string) *model.CustomerMergeOverrideFields {
+	return &model.CustomerMergeOverrideFields{
+		CustomerIDOfFirstNameToKeep:      &keepID,
+		CustomerIDOfLastNameToKeep:       &keepID,
+		CustomerIDOfEmailToKeep:          &keepID,
+		CustomerIDOfPhoneNumberToKeep:    &keepID,
+		CustomerIDOfDefaultAddressToKeep: &keepID,
+	}
+}
+
+// MergePreview returns what Merge would produce without merging. Check CustomerMergeErrors, such as
+// customers with a pending data erasure, and BlockingFields before calling Merge.
+func (s *CustomerServiceOp) MergePreview(ctx context.Context, keepID, discardID string) (*model.CustomerMergePreview, error) {
+	q := fmt.Sprintf(`
+		query customerMergePreview($customerOneId: ID!, $customerTwoId: ID!, $overrideFields: CustomerMergeOverrideFields) {
+			customerMergePreview(customerOneId: $customerOneId, customerTwoId: $customerTwoId, overrideFields: $overrideFields) {
+				%s
+			}
+		}
+	`, customerMergePreviewFields)
+
+	vars := map[string]interface{}{
+		"customerOneId":  discardID,
+		"customerTwoId":  keepID,
+		"overrideFields": customerMergeOverrides(keepID),
+	}
+	out := struct {
+		CustomerMergePreview *model.CustomerMergePreview `json:"customerMergePreview"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	return out.CustomerMergePreview, nil
+}
+
+// Merge merges discardID into keepID. Orders, addresses and other records of both customers are
+// combined; conflicting profile fields take the value of keepID. discardID is deleted once the
+// merge job completes.
+func (s *CustomerServiceOp) Merge(ctx context.Context, keepID, discardID string) (*CustomerMergeResult, error) {
+	m := `
+		mutation customerMerge($customerOneId: ID!, $customerTwoId: ID!, $overrideFields: CustomerMergeOverrideFields) {
+			customerMerge(customerOneId: $customerOneId, customerTwoId: $customerTwoId, overrideFields: $overrideFields) {
+				resultingCustomerId
+				job {
+					id
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"customerOneId":  discardID,
+		"customerTwoId":  keepID,
+		"overrideFields": customerMergeOverrides(keepID),
+	}
+	out := struct {
+		CustomerMerge struct {
+			ResultingCustomerID *string `json:"resultingCustomerId"`
+			Job                 *struct {
+				ID string `json:"id"`
+			} `json:"job"`
+			UserErrors []model.CustomerMergeUserError `json:"userErrors"`
+		} `json:"customerMerge"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CustomerMerge.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.CustomerMerge.UserErrors)
+	}
+
+	result := &CustomerMergeResult{}
+	if out.CustomerMerge.ResultingCustomerID != nil {
+		result.ResultingCustomerID = *out.CustomerMerge.ResultingCustomerID
+	}
+	if out.CustomerMerge.Job != nil {
+		result.JobID = out.CustomerMerge.Job.ID
+	}
+	return result, nil
+}
+
+// MergeStatus returns the state of the merge job started by Merge.
+func (s *CustomerServiceOp) MergeStatus(ctx context.Context, jobID string) (*model.CustomerMergeRequest, error) {
+	q := `
+		query customerMergeJobStatus($jobId: ID!) {
+			customerMergeJobStatus(jobId: $jobId) {
+				jobId
+				status
+				resultingCustomerId
+				customerMergeErrors {
+					errorFields
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"jobId": jobID,
+	}
+	out := struct {
+		CustomerMergeJobStatus *model.CustomerMergeRequest `json:"customerMergeJobStatus"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.CustomerMergeJobStatus == nil {
+		return nil, fmt.Errorf("customer merge job %s not found", jobID)
+	}
+
+	return out.CustomerMergeJobStatus, nil
+}
+
+// SendAccountInvite emails the customer an invite to activate their account, using the shop's
+// invite template. It requires API version 2024-10 or later; use AccountActivationURL and send the
+// link yourself on older versions.
+func (s *CustomerServiceOp) SendAccountInvite(ctx context.Context, id string) error {
+	m := `
+		mutation customerSendAccountInviteEmail($customerId: ID!) {
+			customerSendAccountInviteEmail(customerId: $customerId) {
+				customer {
+					id
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"customerId": id,
+	}
+	out := struct {
+		CustomerSendAccountInviteEmail struct {
+			UserErrors []model.UserError `json:"userErrors"`
+		} `json:"customerSendAccountInviteEmail"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CustomerSendAccountInviteEmail.UserErrors) > 0 {
+		return fmt.Errorf("%+v", out.CustomerSendAccountInviteEmail.UserErrors)
+	}
+
+	return nil
+}
+
+// AccountActivationURL generates a one-time URL the customer can use to activate their account.
+// The URL expires after 30 days.
+func (s *CustomerServiceOp) AccountActivationURL(ctx context.Context, id string) (string, error) {
+	m := `
+		mutation customerGenerateAccountActivationUrl($customerId: ID!) {
+			customerGenerateAccountActivationUrl(customerId: $customerId) {
+				accountActivationUrl
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"customerId": id,
+	}
+	out := struct {
+		CustomerGenerateAccountActivationURL struct {
+			AccountActivationURL *string           `json:"accountActivationUrl"`
+			UserErrors           []model.UserError `json:"userErrors"`
+		} `json:"customerGenerateAccountActivationUrl"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return "", fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.CustomerGenerateAccountActivationURL.UserErrors) > 0 {
+		return "", fmt.Errorf("%+v", out.CustomerGenerateAccountActivationURL.UserErrors)
+	}
+	if out.CustomerGenerateAccountActivationURL.AccountActivationURL == nil {
+		return "", fmt.Errorf("no activation URL returned")
+	}
+
+	return *out.CustomerGenerateAccountActivationURL.AccountActivationURL, nil
+}
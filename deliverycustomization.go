@@ -0,0 +1,230 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// DeliveryCustomizationService manages delivery customizations, which run a delivery
+// customization function to rename, reorder or hide delivery options at checkout. The function
+// reads its settings from the metafields passed in model.DeliveryCustomizationInput.
+type DeliveryCustomizationService interface {
+	List(ctx context.Context, opts ListOptions) ([]*model.DeliveryCustomization, string, error)
+	Create(ctx context.Context, input model.DeliveryCustomizationInput) (*model.DeliveryCustomization, error)
+	Update(ctx context.Context, id string, input model.DeliveryCustomizationInput) (*model.DeliveryCustomization, error)
+	Delete(ctx context.Context, id string) (string, error)
+	Activate(ctx context.Context, ids []string, enabled bool) ([]string, error)
+}
+
+type DeliveryCustomizationServiceOp struct {
+	client *Client
+}
+
+var _ DeliveryCustomizationService = &DeliveryCustomizationServiceOp{}
+
+const deliveryCustomizationFields = `
+	id
+	title
+	enabled
+	functionId
+`
+
+// List returns a page of the delivery customizations of the shop. opts.Query supports filters
+// such as "enabled:true" or "function_id:<id>".
+func (s *DeliveryCustomizationServiceOp) List(ctx context.Context, opts ListOptions) ([]*model.DeliveryCustomization, string, error) {
+	q := fmt.Sprintf(`
+		query deliveryCustomizations($first: Int!, $after: String, $query: String, $reverse: Boolean) {
+			deliveryCustomizations(first: $first, after: $after, query: $query, reverse: $reverse) {
+				edges {
+					node {
+						%s
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+				}
+			}
+		}
+	`, deliveryCustomizationFields)
+
+	first := opts.First
+	if first <= 0 {
+		first = 50
+	}
+	vars := map[string]interface{}{
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+	if opts.Query != "" {
+		vars["query"] = opts.Query
+	}
+
+	out := struct {
+		DeliveryCustomizations struct {
+			Edges []struct {
+				Node   *model.DeliveryCustomization `json:"node"`
+				Cursor string                       `json:"cursor"`
+			} `json:"edges"`
+			PageInfo struct {
+				HasNextPage bool `json:"hasNextPage"`
+			} `json:"pageInfo"`
+		} `json:"deliveryCustomizations"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, "", fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	edges := out.DeliveryCustomizations.Edges
+	res := make([]*model.DeliveryCustomization, 0, len(edges))
+	for _, edge := range edges {
+		res = append(res, edge.Node)
+	}
+	nextCursor := ""
+	if out.DeliveryCustomizations.PageInfo.HasNextPage && len(edges) > 0 {
+		nextCursor = edges[len(edges)-1].Cursor
+	}
+
+	return res, nextCursor, nil
+}
+
+func (s *DeliveryCustomizationServiceOp) Create(ctx context.Context, input model.DeliveryCustomizationInput) (*model.DeliveryCustomization, error) {
+	m := fmt.Sprintf(`
+		mutation deliveryCustomizationCreate($deliveryCustomization: DeliveryCustomizationInput!) {
+			deliveryCustomizationCreate(deliveryCustomization: $deliveryCustomization) {
+				deliveryCustomization {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, deliveryCustomizationFields)
+
+	vars := map[string]interface{}{
+		"deliveryCustomization": input,
+	}
+	out := struct {
+		DeliveryCustomizationCreate model.DeliveryCustomizationCreatePayload `json:"deliveryCustomizationCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.DeliveryCustomizationCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.DeliveryCustomizationCreate.UserErrors)
+	}
+
+	return out.DeliveryCustomizationCreate.DeliveryCustomization, nil
+}
+
+// Update updates a delivery customization; fields left nil in input are not changed.
+func (s *DeliveryCustomizationServiceOp) Update(ctx context.Context, id string, input model.DeliveryCustomizationInput) (*model.DeliveryCustomization, error) {
+	m := fmt.Sprintf(`
+		mutation deliveryCustomizationUpdate($id: ID!, $deliveryCustomization: DeliveryCustomizationInput!) {
+			deliveryCustomizationUpdate(id: $id, deliveryCustomization: $deliveryCustomization) {
+				deliveryCustomization {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, deliveryCustomizationFields)
+
+	vars := map[string]interface{}{
+		"id":                    id,
+		"deliveryCustomization": input,
+	}
+	out := struct {
+		DeliveryCustomizationUpdate model.DeliveryCustomizationUpdatePayload `json:"deliveryCustomizationUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.DeliveryCustomizationUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.DeliveryCustomizationUpdate.UserErrors)
+	}
+
+	return out.DeliveryCustomizationUpdate.DeliveryCustomization, nil
+}
+
+func (s *DeliveryCustomizationServiceOp) Delete(ctx context.Context, id string) (string, error) {
+	m := `
+		mutation deliveryCustomizationDelete($id: ID!) {
+			deliveryCustomizationDelete(id: $id) {
+				deletedId
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		DeliveryCustomizationDelete model.DeliveryCustomizationDeletePayload `json:"deliveryCustomizationDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return "", fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.DeliveryCustomizationDelete.UserErrors) > 0 {
+		return "", fmt.Errorf("%+v", out.DeliveryCustomizationDelete.UserErrors)
+	}
+	if out.DeliveryCustomizationDelete.DeletedID == nil {
+		return "", nil
+	}
+
+	return *out.DeliveryCustomizationDelete.DeletedID, nil
+}
+
+// Activate enables or disables delivery customizations and returns the IDs of the updated ones.
+func (s *DeliveryCustomizationServiceOp) Activate(ctx context.Context, ids []string, enabled bool) ([]string, error) {
+	m := `
+		mutation deliveryCustomizationActivation($ids: [ID!]!, $enabled: Boolean!) {
+			deliveryCustomizationActivation(ids: $ids, enabled: $enabled) {
+				ids
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"ids":     ids,
+		"enabled": enabled,
+	}
+	out := struct {
+		DeliveryCustomizationActivation model.DeliveryCustomizationActivationPayload `json:"deliveryCustomizationActivation"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.DeliveryCustomizationActivation.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.DeliveryCustomizationActivation.UserErrors)
+	}
+
+	return out.DeliveryCustomizationActivation.Ids, nil
+}
@@ -14,6 +14,23 @@ type DiscountService interface {
 	AutomaticActivate(ctx context.Context, discountBaseID string) (*model.DiscountAutomaticNode, error)
 	AutomaticDeactivate(ctx context.Context, discountBaseID string) (*model.DiscountAutomaticNode, error)
 	AutomaticNode(ctx context.Context, discountBaseID, metafieldKey, metafieldNamespace string) (*model.DiscountAutomaticNode, error)
+	AutomaticBasicCreate(ctx context.Context, input model.DiscountAutomaticBasicInput) (*model.DiscountAutomaticNode, error)
+	AutomaticBasicUpdate(ctx context.Context, id string, input model.DiscountAutomaticBasicInput) (*model.DiscountAutomaticNode, error)
+	AutomaticBxgyCreate(ctx context.Context, input model.DiscountAutomaticBxgyInput) (*model.DiscountAutomaticNode, error)
+	AutomaticBxgyUpdate(ctx context.Context, id string, input model.DiscountAutomaticBxgyInput) (*model.DiscountAutomaticNode, error)
+	AutomaticFreeShippingCreate(ctx context.Context, input model.DiscountAutomaticFreeShippingInput) (*model.DiscountAutomaticNode, error)
+	AutomaticFreeShippingUpdate(ctx context.Context, id string, input model.DiscountAutomaticFreeShippingInput) (*model.DiscountAutomaticNode, error)
+
+	CodeBasicCreate(ctx context.Context, input model.DiscountCodeBasicInput) (*CodeDiscount, error)
+	CodeBasicUpdate(ctx context.Context, id string, input model.DiscountCodeBasicInput) (*CodeDiscount, error)
+	CodeBxgyCreate(ctx context.Context, input model.DiscountCodeBxgyInput) (*CodeDiscount, error)
+	CodeBxgyUpdate(ctx context.Context, id string, input model.DiscountCodeBxgyInput) (*CodeDiscount, error)
+	CodeFreeShippingCreate(ctx context.Context, input model.DiscountCodeFreeShippingInput) (*CodeDiscount, error)
+	CodeFreeShippingUpdate(ctx context.Context, id string, input model.DiscountCodeFreeShippingInput) (*CodeDiscount, error)
+	CodeAppCreate(ctx context.Context, input model.DiscountCodeAppInput) (*model.DiscountCodeApp, error)
+	CodeDelete(ctx context.Context, id string) error
+	CodeNodeByCode(ctx context.Context, code string) (*CodeDiscount, error)
+	CodeNodes(ctx context.Context, opts ListOptions) ([]*CodeDiscount, string, error)
 }
 
 type DiscountServiceOp struct {
@@ -67,6 +84,64 @@ func (i *DiscountAutomaticAppInput) ToMap() map[string]any {
 	return result
 }
 
+// automaticDiscountNodeFields selects an automatic discount node of any type. __typename is
+// required by model.DiscountAutomaticNode to decode the automaticDiscount union.
+const automaticDiscountNodeFields = `
+  id
+  automaticDiscount {
+    __typename
+    ... on DiscountAutomaticApp {
+      discountId
+      title
+      status
+      startsAt
+      endsAt
+    }
+    ... on DiscountAutomaticBasic {
+      title
+      status
+      startsAt
+      endsAt
+      summary
+      asyncUsageCount
+      combinesWith {
+        orderDiscounts
+        productDiscounts
+        shippingDiscounts
+      }
+    }
+    ... on DiscountAutomaticBxgy {
+      title
+      status
+      startsAt
+      endsAt
+      summary
+      asyncUsageCount
+      usesPerOrderLimit
+      combinesWith {
+        orderDiscounts
+        productDiscounts
+        shippingDiscounts
+      }
+    }
+    ... on DiscountAutomaticFreeShipping {
+      title
+      status
+      startsAt
+      endsAt
+      summary
+      asyncUsageCount
+      appliesOnOneTimePurchase
+      appliesOnSubscription
+      combinesWith {
+        orderDiscounts
+        productDiscounts
+        shippingDiscounts
+      }
+    }
+  }
+`
+
 var discountAutomaticAppCreate = `
 mutation discountAutomaticAppCreate($automaticAppDiscount: DiscountAutomaticAppInput!) {
   discountAutomaticAppCreate(automaticAppDiscount: $automaticAppDiscount) {
@@ -139,20 +214,11 @@ mutation discountAutomaticDelete($id: ID!) {
 }
 `
 
-var discountAutomaticActivate = `
+var discountAutomaticActivate = fmt.Sprintf(`
 mutation discountAutomaticActivate($id: ID!) {
   discountAutomaticActivate(id: $id) {
     automaticDiscountNode {
-      automaticDiscount {
-        ... on DiscountAutomaticApp {
-          discountId
-          title
-          status
-          startsAt
-          endsAt
-          __typename
-        }
-      }
+      %s
     }
     userErrors {
       field
@@ -162,22 +228,13 @@ mutation discountAutomaticActivate($id: ID!) {
     }
   }
 }
-`
+`, automaticDiscountNodeFields)
 
-var discountAutomaticDeactivate = `
+var discountAutomaticDeactivate = fmt.Sprintf(`
 mutation discountAutomaticDeactivate($id: ID!) {
   discountAutomaticDeactivate(id: $id) {
     automaticDiscountNode {
-      automaticDiscount {
-        ... on DiscountAutomaticApp {
-          discountId
-          title
-          status
-          startsAt
-          endsAt
-          __typename
-        }
-      }
+      %s
     }
     userErrors {
       field
@@ -187,7 +244,7 @@ mutation discountAutomaticDeactivate($id: ID!) {
     }
   }
 }
-`
+`, automaticDiscountNodeFields)
 
 var automaticDiscountNode = `
 query ($id: ID!, $key: String!, $namespace: String) {
@@ -336,3 +393,135 @@ func parseUserErrors(errors []model.DiscountUserError) error {
 	}
 	return fmt.Errorf("%+v", errors)
 }
+
+type automaticDiscountPayload struct {
+	AutomaticDiscountNode *model.DiscountAutomaticNode `json:"automaticDiscountNode"`
+	UserErrors            []model.DiscountUserError    `json:"userErrors"`
+}
+
+var discountAutomaticBasicCreate = fmt.Sprintf(`
+mutation discountAutomaticBasicCreate($automaticBasicDiscount: DiscountAutomaticBasicInput!) {
+  discountAutomaticBasicCreate(automaticBasicDiscount: $automaticBasicDiscount) {
+    automaticDiscountNode {
+      %s
+    }
+    %s
+  }
+}
+`, automaticDiscountNodeFields, discountUserErrorFields)
+
+var discountAutomaticBasicUpdate = fmt.Sprintf(`
+mutation discountAutomaticBasicUpdate($id: ID!, $automaticBasicDiscount: DiscountAutomaticBasicInput!) {
+  discountAutomaticBasicUpdate(id: $id, automaticBasicDiscount: $automaticBasicDiscount) {
+    automaticDiscountNode {
+      %s
+    }
+    %s
+  }
+}
+`, automaticDiscountNodeFields, discountUserErrorFields)
+
+var discountAutomaticBxgyCreate = fmt.Sprintf(`
+mutation discountAutomaticBxgyCreate($automaticBxgyDiscount: DiscountAutomaticBxgyInput!) {
+  discountAutomaticBxgyCreate(automaticBxgyDiscount: $automaticBxgyDiscount) {
+    automaticDiscountNode {
+      %s
+    }
+    %s
+  }
+}
+`, automaticDiscountNodeFields, discountUserErrorFields)
+
+var discountAutomaticBxgyUpdate = fmt.Sprintf(`
+mutation discountAutomaticBxgyUpdate($id: ID!, $automaticBxgyDiscount: DiscountAutomaticBxgyInput!) {
+  discountAutomaticBxgyUpdate(id: $id, automaticBxgyDiscount: $automaticBxgyDiscount) {
+    automaticDiscountNode {
+      %s
+    }
+    %s
+  }
+}
+`, automaticDiscountNodeFields, discountUserErrorFields)
+
+var discountAutomaticFreeShippingCreate = fmt.Sprintf(`
+mutation discountAutomaticFreeShippingCreate($freeShippingAutomaticDiscount: DiscountAutomaticFreeShippingInput!) {
+  discountAutomaticFreeShippingCreate(freeShippingAutomaticDiscount: $freeShippingAutomaticDiscount) {
+    automaticDiscountNode {
+      %s
+    }
+    %s
+  }
+}
+`, automaticDiscountNodeFields, discountUserErrorFields)
+
+var discountAutomaticFreeShippingUpdate = fmt.Sprintf(`
+mutation discountAutomaticFreeShippingUpdate($id: ID!, $freeShippingAutomaticDiscount: DiscountAutomaticFreeShippingInput!) {
+  discountAutomaticFreeShippingUpdate(id: $id, freeShippingAutomaticDiscount: $freeShippingAutomaticDiscount) {
+    automaticDiscountNode {
+      %s
+    }
+    %s
+  }
+}
+`, automaticDiscountNodeFields, discountUserErrorFields)
+
+func (s *DiscountServiceOp) AutomaticBasicCreate(ctx context.Context, input model.DiscountAutomaticBasicInput) (*model.DiscountAutomaticNode, error) {
+	vars := map[string]any{
+		"automaticBasicDiscount": input,
+	}
+	return s.mutateAutomaticDiscount(ctx, "discountAutomaticBasicCreate", discountAutomaticBasicCreate, vars)
+}
+
+// AutomaticBasicUpdate updates the basic automatic discount with the given DiscountAutomaticNode ID.
+// Fields left nil in input are not changed.
+func (s *DiscountServiceOp) AutomaticBasicUpdate(ctx context.Context, id string, input model.DiscountAutomaticBasicInput) (*model.DiscountAutomaticNode, error) {
+	vars := map[string]any{
+		"id":                     id,
+		"automaticBasicDiscount": input,
+	}
+	return s.mutateAutomaticDiscount(ctx, "discountAutomaticBasicUpdate", discountAutomaticBasicUpdate, vars)
+}
+
+func (s *DiscountServiceOp) AutomaticBxgyCreate(ctx context.Context, input model.DiscountAutomaticBxgyInput) (*model.DiscountAutomaticNode, error) {
+	vars := map[string]any{
+		"automaticBxgyDiscount": input,
+	}
+	return s.mutateAutomaticDiscount(ctx, "discountAutomaticBxgyCreate", discountAutomaticBxgyCreate, vars)
+}
+
+func (s *DiscountServiceOp) AutomaticBxgyUpdate(ctx context.Context, id string, input model.DiscountAutomaticBxgyInput) (*model.DiscountAutomaticNode, error) {
+	vars := map[string]any{
+		"id":                    id,
+		"automaticBxgyDiscount": input,
+	}
+	return s.mutateAutomaticDiscount(ctx, "discountAutomaticBxgyUpdate", discountAutomaticBxgyUpdate, vars)
+}
+
+func (s *DiscountServiceOp) AutomaticFreeShippingCreate(ctx context.Context, input model.DiscountAutomaticFreeShippingInput) (*model.DiscountAutomaticNode, error) {
+	vars := map[string]any{
+		"freeShippingAutomaticDiscount": input,
+	}
+	return s.mutateAutomaticDiscount(ctx, "discountAutomaticFreeShippingCreate", discountAutomaticFreeShippingCreate, vars)
+}
+
+func (s *DiscountServiceOp) AutomaticFreeShippingUpdate(ctx context.Context, id string, input model.DiscountAutomaticFreeShippingInput) (*model.DiscountAutomaticNode, error) {
+	vars := map[string]any{
+		"id":                            id,
+		"freeShippingAutomaticDiscount": input,
+	}
+	return s.mutateAutomaticDiscount(ctx, "discountAutomaticFreeShippingUpdate", discountAutomaticFreeShippingUpdate, vars)
+}
+
+func (s *DiscountServiceOp) mutateAutomaticDiscount(ctx context.Context, name, mutation string, vars map[string]any) (*model.DiscountAutomaticNode, error) {
+	out := map[string]automaticDiscountPayload{}
+	if err := s.client.gql.MutateString(ctx, mutation, vars, &out); err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	payload := out[name]
+	if len(payload.UserErrors) > 0 {
+		return nil, parseUserErrors(payload.UserErrors)
+	}
+
+	return payload.AutomaticDiscountNode, nil
+}
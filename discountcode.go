@@ -0,0 +1,420 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gempages/go-helper/errors"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// CodeDiscount is a code discount of any type. Typename is one of DiscountCodeBasic, DiscountCodeBxgy,
+// DiscountCodeFreeShipping or DiscountCodeApp. ID is the ID of the DiscountCodeNode, which is the ID
+// the update and delete mutations expect.
+type CodeDiscount struct {
+	ID                     string                      `json:"id"`
+	Typename               string                      `json:"__typename"`
+	Title                  string                      `json:"title"`
+	Status                 model.DiscountStatus        `json:"status"`
+	Summary                string                      `json:"summary,omitempty"`
+	StartsAt               time.Time                   `json:"startsAt"`
+	EndsAt                 *time.Time                  `json:"endsAt,omitempty"`
+	UsageLimit             *int                        `json:"usageLimit,omitempty"`
+	AsyncUsageCount        int                         `json:"asyncUsageCount"`
+	AppliesOncePerCustomer bool                        `json:"appliesOncePerCustomer"`
+	CombinesWith           *model.DiscountCombinesWith `json:"combinesWith,omitempty"`
+	CreatedAt              time.Time                   `json:"createdAt"`
+	UpdatedAt              time.Time                   `json:"updatedAt"`
+	// Codes holds up to the first 250 redeem codes; CodesCount is the total.
+	Codes      []string `json:"codes"`
+	CodesCount int      `json:"codesCount"`
+}
+
+// codeDiscountNode decodes a DiscountCodeNode. The model's CodeDiscount field is an interface
+// without a decoder, so the union is read into CodeDiscount instead.
+type codeDiscountNode struct {
+	ID           string `json:"id"`
+	CodeDiscount *struct {
+		CodeDiscount
+		Codes struct {
+			Nodes []struct {
+				Code string `json:"code"`
+			} `json:"nodes"`
+		} `json:"codes"`
+		CodesCount *struct {
+			Count int `json:"count"`
+		} `json:"codesCount"`
+	} `json:"codeDiscount"`
+}
+
+func (n *codeDiscountNode) discount() *CodeDiscount {
+	if n == nil || n.CodeDiscount == nil {
+		return nil
+	}
+	d := n.CodeDiscount.CodeDiscount
+	d.ID = n.ID
+	d.Codes = make([]string, 0, len(n.CodeDiscount.Codes.Nodes))
+	for _, code := range n.CodeDiscount.Codes.Nodes {
+		d.Codes = append(d.Codes, code.Code)
+	}
+	if n.CodeDiscount.CodesCount != nil {
+		d.CodesCount = n.CodeDiscount.CodesCount.Count
+	}
+	return &d
+}
+
+type codeDiscountPayload struct {
+	CodeDiscountNode *codeDiscountNode         `json:"codeDiscountNode"`
+	UserErrors       []model.DiscountUserError `json:"userErrors"`
+}
+
+const codeDiscountCommonFields = `
+      title
+      status
+      startsAt
+      endsAt
+      usageLimit
+      asyncUsageCount
+      appliesOncePerCustomer
+      createdAt
+      updatedAt
+      combinesWith {
+        orderDiscounts
+        productDiscounts
+        shippingDiscounts
+      }
+      codes(first: 250) {
+        nodes {
+          code
+        }
+      }
+      codesCount {
+        count
+      }
+`
+
+var codeDiscountNodeFields = fmt.Sprintf(`
+  id
+  codeDiscount {
+    __typename
+    ... on DiscountCodeBasic {
+      summary
+      %[1]s
+    }
+    ... on DiscountCodeBxgy {
+      summary
+      %[1]s
+    }
+    ... on DiscountCodeFreeShipping {
+      summary
+      %[1]s
+    }
+    ... on DiscountCodeApp {
+      %[1]s
+    }
+  }
+`, codeDiscountCommonFields)
+
+const discountUserErrorFields = `
+    userErrors {
+      field
+      code
+      message
+      extraInfo
+    }
+`
+
+var discountCodeBasicCreate = fmt.Sprintf(`
+mutation discountCodeBasicCreate($basicCodeDiscount: DiscountCodeBasicInput!) {
+  discountCodeBasicCreate(basicCodeDiscount: $basicCodeDiscount) {
+    codeDiscountNode {
+      %s
+    }
+    %s
+  }
+}
+`, codeDiscountNodeFields, discountUserErrorFields)
+
+var discountCodeBasicUpdate = fmt.Sprintf(`
+mutation discountCodeBasicUpdate($id: ID!, $basicCodeDiscount: DiscountCodeBasicInput!) {
+  discountCodeBasicUpdate(id: $id, basicCodeDiscount: $basicCodeDiscount) {
+    codeDiscountNode {
+      %s
+    }
+    %s
+  }
+}
+`, codeDiscountNodeFields, discountUserErrorFields)
+
+var discountCodeBxgyCreate = fmt.Sprintf(`
+mutation discountCodeBxgyCreate($bxgyCodeDiscount: DiscountCodeBxgyInput!) {
+  discountCodeBxgyCreate(bxgyCodeDiscount: $bxgyCodeDiscount) {
+    codeDiscountNode {
+      %s
+    }
+    %s
+  }
+}
+`, codeDiscountNodeFields, discountUserErrorFields)
+
+var discountCodeBxgyUpdate = fmt.Sprintf(`
+mutation discountCodeBxgyUpdate($id: ID!, $bxgyCodeDiscount: DiscountCodeBxgyInput!) {
+  discountCodeBxgyUpdate(id: $id, bxgyCodeDiscount: $bxgyCodeDiscount) {
+    codeDiscountNode {
+      %s
+    }
+    %s
+  }
+}
+`, codeDiscountNodeFields, discountUserErrorFields)
+
+var discountCodeFreeShippingCreate = fmt.Sprintf(`
+mutation discountCodeFreeShippingCreate($freeShippingCodeDiscount: DiscountCodeFreeShippingInput!) {
+  discountCodeFreeShippingCreate(freeShippingCodeDiscount: $freeShippingCodeDiscount) {
+    codeDiscountNode {
+      %s
+    }
+    %s
+  }
+}
+`, codeDiscountNodeFields, discountUserErrorFields)
+
+var discountCodeFreeShippingUpdate = fmt.Sprintf(`
+mutation discountCodeFreeShippingUpdate($id: ID!, $freeShippingCodeDiscount: DiscountCodeFreeShippingInput!) {
+  discountCodeFreeShippingUpdate(id: $id, freeShippingCodeDiscount: $freeShippingCodeDiscount) {
+    codeDiscountNode {
+      %s
+    }
+    %s
+  }
+}
+`, codeDiscountNodeFields, discountUserErrorFields)
+
+var discountCodeAppCreate = fmt.Sprintf(`
+mutation discountCodeAppCreate($codeAppDiscount: DiscountCodeAppInput!) {
+  discountCodeAppCreate(codeAppDiscount: $codeAppDiscount) {
+    codeAppDiscount {
+      discountId
+      title
+      startsAt
+      endsAt
+      status
+      usageLimit
+      appliesOncePerCustomer
+      appDiscountType {
+        appKey
+        functionId
+      }
+      combinesWith {
+        orderDiscounts
+        productDiscounts
+        shippingDiscounts
+      }
+    }
+    %s
+  }
+}
+`, discountUserErrorFields)
+
+var discountCodeDelete = fmt.Sprintf(`
+mutation discountCodeDelete($id: ID!) {
+  discountCodeDelete(id: $id) {
+    deletedCodeDiscountId
+    %s
+  }
+}
+`, discountUserErrorFields)
+
+var codeDiscountNodeByCode = fmt.Sprintf(`
+query codeDiscountNodeByCode($code: String!) {
+  codeDiscountNodeByCode(code: $code) {
+    %s
+  }
+}
+`, codeDiscountNodeFields)
+
+var codeDiscountNodes = fmt.Sprintf(`
+query codeDiscountNodes($first: Int!, $after: String, $query: String, $reverse: Boolean) {
+  codeDiscountNodes(first: $first, after: $after, query: $query, reverse: $reverse) {
+    edges {
+      node {
+        %s
+      }
+      cursor
+    }
+    pageInfo {
+      hasNextPage
+    }
+  }
+}
+`, codeDiscountNodeFields)
+
+func (s *DiscountServiceOp) CodeBasicCreate(ctx context.Context, input model.DiscountCodeBasicInput) (*CodeDiscount, error) {
+	vars := map[string]any{
+		"basicCodeDiscount": input,
+	}
+	return s.mutateCodeDiscount(ctx, "discountCodeBasicCreate", discountCodeBasicCreate, vars)
+}
+
+// CodeBasicUpdate updates the basic code discount with the given DiscountCodeNode ID. Fields left
+// nil in input are not changed.
+func (s *DiscountServiceOp) CodeBasicUpdate(ctx context.Context, id string, input model.DiscountCodeBasicInput) (*CodeDiscount, error) {
+	vars := map[string]any{
+		"id":                id,
+		"basicCodeDiscount": input,
+	}
+	return s.mutateCodeDiscount(ctx, "discountCodeBasicUpdate", discountCodeBasicUpdate, vars)
+}
+
+func (s *DiscountServiceOp) CodeBxgyCreate(ctx context.Context, input model.DiscountCodeBxgyInput) (*CodeDiscount, error) {
+	vars := map[string]any{
+		"bxgyCodeDiscount": input,
+	}
+	return s.mutateCodeDiscount(ctx, "discountCodeBxgyCreate", discountCodeBxgyCreate, vars)
+}
+
+func (s *DiscountServiceOp) CodeBxgyUpdate(ctx context.Context, id string, input model.DiscountCodeBxgyInput) (*CodeDiscount, error) {
+	vars := map[string]any{
+		"id":               id,
+		"bxgyCodeDiscount": input,
+	}
+	return s.mutateCodeDiscount(ctx, "discountCodeBxgyUpdate", discountCodeBxgyUpdate, vars)
+}
+
+func (s *DiscountServiceOp) CodeFreeShippingCreate(ctx context.Context, input model.DiscountCodeFreeShippingInput) (*CodeDiscount, error) {
+	vars := map[string]any{
+		"freeShippingCodeDiscount": input,
+	}
+	return s.mutateCodeDiscount(ctx, "discountCodeFreeShippingCreate", discountCodeFreeShippingCreate, vars)
+}
+
+func (s *DiscountServiceOp) CodeFreeShippingUpdate(ctx context.Context, id string, input model.DiscountCodeFreeShippingInput) (*CodeDiscount, error) {
+	vars := map[string]any{
+		"id":                       id,
+		"freeShippingCodeDiscount": input,
+	}
+	return s.mutateCodeDiscount(ctx, "discountCodeFreeShippingUpdate", discountCodeFreeShippingUpdate, vars)
+}
+
+// mutateCodeDiscount runs one of the code discount create or update mutations, whose payloads
+// only differ by the name of the mutation field.
+func (s *DiscountServiceOp) mutateCodeDiscount(ctx context.Context, name, mutation string, vars map[string]any) (*CodeDiscount, error) {
+	out := map[string]codeDiscountPayload{}
+	if err := s.client.gql.MutateString(ctx, mutation, vars, &out); err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	payload := out[name]
+	if len(payload.UserErrors) > 0 {
+		return nil, parseUserErrors(payload.UserErrors)
+	}
+
+	return payload.CodeDiscountNode.discount(), nil
+}
+
+// CodeAppCreate creates a code discount backed by a discount function; see CodeAppDiscountInput.
+func (s *DiscountServiceOp) CodeAppCreate(ctx context.Context, input model.DiscountCodeAppInput) (*model.DiscountCodeApp, error) {
+	out := struct {
+		DiscountCodeAppCreatePayload model.DiscountCodeAppCreatePayload `json:"discountCodeAppCreate"`
+	}{}
+	vars := map[string]any{
+		"codeAppDiscount": input,
+	}
+	if err := s.client.gql.MutateString(ctx, discountCodeAppCreate, vars, &out); err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.DiscountCodeAppCreatePayload.UserErrors) > 0 {
+		return nil, parseUserErrors(out.DiscountCodeAppCreatePayload.UserErrors)
+	}
+
+	return out.DiscountCodeAppCreatePayload.CodeAppDiscount, nil
+}
+
+func (s *DiscountServiceOp) CodeDelete(ctx context.Context, id string) error {
+	out := struct {
+		DiscountCodeDeletePayload model.DiscountCodeDeletePayload `json:"discountCodeDelete"`
+	}{}
+	vars := map[string]any{
+		"id": id,
+	}
+	if err := s.client.gql.MutateString(ctx, discountCodeDelete, vars, &out); err != nil {
+		return fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.DiscountCodeDeletePayload.UserErrors) > 0 {
+		return parseUserErrors(out.DiscountCodeDeletePayload.UserErrors)
+	}
+
+	return nil
+}
+
+// CodeNodeByCode returns the code discount that the redeem code belongs to. The lookup is case
+// insensitive.
+func (s *DiscountServiceOp) CodeNodeByCode(ctx context.Context, code string) (*CodeDiscount, error) {
+	out := struct {
+		CodeDiscountNodeByCode *codeDiscountNode `json:"codeDiscountNodeByCode"`
+	}{}
+	vars := map[string]any{
+		"code": code,
+	}
+
+	if err := s.client.gql.QueryString(ctx, codeDiscountNodeByCode, vars, &out); err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	discount := out.CodeDiscountNodeByCode.discount()
+	if discount == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "code discount not found", nil)
+	}
+	return discount, nil
+}
+
+// CodeNodes returns a page of code discounts matching opts.Query, which supports filters such as
+// "status:active" or "discount_type:free_shipping".
+func (s *DiscountServiceOp) CodeNodes(ctx context.Context, opts ListOptions) ([]*CodeDiscount, string, error) {
+	first := opts.First
+	if first <= 0 {
+		first = 50
+	}
+	vars := map[string]any{
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+	if opts.Query != "" {
+		vars["query"] = opts.Query
+	}
+
+	out := struct {
+		CodeDiscountNodes struct {
+			Edges []struct {
+				Node   *codeDiscountNode `json:"node"`
+				Cursor string            `json:"cursor"`
+			} `json:"edges"`
+			PageInfo struct {
+				HasNextPage bool `json:"hasNextPage"`
+			} `json:"pageInfo"`
+		} `json:"codeDiscountNodes"`
+	}{}
+	if err := s.client.gql.QueryString(ctx, codeDiscountNodes, vars, &out); err != nil {
+		return nil, "", fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	edges := out.CodeDiscountNodes.Edges
+	res := make([]*CodeDiscount, 0, len(edges))
+	for _, edge := range edges {
+		if discount := edge.Node.discount(); discount != nil {
+			res = append(res, discount)
+		}
+	}
+	nextCursor := ""
+	if out.CodeDiscountNodes.PageInfo.HasNextPage && len(edges) > 0 {
+		nextCursor = edges[len(edges)-1].Cursor
+	}
+
+	return res, nextCursor, nil
+}
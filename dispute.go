@@ -0,0 +1,306 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gempages/go-helper/errors"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// DisputeService reads the chargebacks and inquiries of the shop's Shopify Payments account and
+// submits evidence to contest them. It requires the read_shopify_payments_disputes scope, plus
+// write_shopify_payments_dispute_evidences to update evidence.
+type DisputeService interface {
+	List(ctx context.Context, opts ListOptions) ([]*model.ShopifyPaymentsDispute, string, error)
+	Get(ctx context.Context, id string) (*model.ShopifyPaymentsDispute, error)
+	GetEvidence(ctx context.Context, evidenceID string) (*model.ShopifyPaymentsDisputeEvidence, error)
+	UpdateEvidence(ctx context.Context, evidenceID string, input model.ShopifyPaymentsDisputeEvidenceUpdateInput) (*model.ShopifyPaymentsDisputeEvidence, error)
+	AttachEvidenceFile(ctx context.Context, evidenceID string, fileType model.ShopifyPaymentsDisputeEvidenceFileType, file *UploadInput) (*model.ShopifyPaymentsDisputeEvidence, error)
+	SubmitEvidence(ctx context.Context, evidenceID string) (*model.ShopifyPaymentsDisputeEvidence, error)
+}
+
+type DisputeServiceOp struct {
+	client *Client
+}
+
+var _ DisputeService = &DisputeServiceOp{}
+
+const disputeFields = `
+	id
+	legacyResourceId
+	status
+	type
+	amount {
+		amount
+		currencyCode
+	}
+	initiatedAt
+	evidenceDueBy
+	evidenceSentOn
+	finalizedOn
+	reasonDetails {
+		reason
+		networkReasonCode
+	}
+	order {
+		id
+		name
+	}
+`
+
+const disputeFileUploadFields = `
+	id
+	disputeEvidenceType
+	fileSize
+	fileType
+	originalFileName
+	url
+`
+
+var disputeEvidenceFields = fmt.Sprintf(`
+	id
+	submitted
+	customerEmailAddress
+	customerFirstName
+	customerLastName
+	customerPurchaseIp
+	productDescription
+	accessActivityLog
+	cancellationPolicyDisclosure
+	cancellationRebuttal
+	refundPolicyDisclosure
+	refundRefusalExplanation
+	uncategorizedText
+	shippingAddress {
+		address1
+		address2
+		city
+		province
+		provinceCode
+		country
+		countryCodeV2
+		zip
+	}
+	cancellationPolicyFile {
+		%[2]s
+	}
+	customerCommunicationFile {
+		%[2]s
+	}
+	refundPolicyFile {
+		%[2]s
+	}
+	serviceDocumentationFile {
+		%[2]s
+	}
+	shippingDocumentationFile {
+		%[2]s
+	}
+	uncategorizedFile {
+		%[2]s
+	}
+	disputeFileUploads {
+		%[2]s
+	}
+	dispute {
+		%[1]s
+	}
+`, disputeFields, disputeFileUploadFields)
+
+// List returns a page of the disputes of the Shopify Payments account. opts.Query supports
+// filters such as "status:needs_response" or "initiated_at:>2024-01-01".
+func (s *DisputeServiceOp) List(ctx context.Context, opts ListOptions) ([]*model.ShopifyPaymentsDispute, string, error) {
+	q := fmt.Sprintf(`
+		query disputes($first: Int!, $after: String, $query: String, $reverse: Boolean) {
+			shopifyPaymentsAccount {
+				disputes(first: $first, after: $after, query: $query, reverse: $reverse) {
+					edges {
+						node {
+							%s
+						}
+						cursor
+					}
+					pageInfo {
+						hasNextPage
+					}
+				}
+			}
+		}
+	`, disputeFields)
+
+	first := opts.First
+	if first <= 0 {
+		first = 50
+	}
+	vars := map[string]interface{}{
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+	if opts.Query != "" {
+		vars["query"] = opts.Query
+	}
+
+	out := struct {
+		ShopifyPaymentsAccount *struct {
+			Disputes struct {
+				Edges []struct {
+					Node   *model.ShopifyPaymentsDispute `json:"node"`
+					Cursor string                        `json:"cursor"`
+				} `json:"edges"`
+				PageInfo struct {
+					HasNextPage bool `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"disputes"`
+		} `json:"shopifyPaymentsAccount"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, "", fmt.Errorf("gql.QueryString: %w", err)
+	}
+	// shops that don't use Shopify Payments have no account, hence no disputes
+	if out.ShopifyPaymentsAccount == nil {
+		return nil, "", nil
+	}
+
+	disputes := out.ShopifyPaymentsAccount.Disputes
+	res := make([]*model.ShopifyPaymentsDispute, 0, len(disputes.Edges))
+	for _, edge := range disputes.Edges {
+		res = append(res, edge.Node)
+	}
+	nextCursor := ""
+	if disputes.PageInfo.HasNextPage && len(disputes.Edges) > 0 {
+		nextCursor = disputes.Edges[len(disputes.Edges)-1].Cursor
+	}
+
+	return res, nextCursor, nil
+}
+
+func (s *DisputeServiceOp) Get(ctx context.Context, id string) (*model.ShopifyPaymentsDispute, error) {
+	q := fmt.Sprintf(`
+		query dispute($id: ID!) {
+			dispute(id: $id) {
+				%s
+			}
+		}
+	`, disputeFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		Dispute *model.ShopifyPaymentsDispute `json:"dispute"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.Dispute == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "dispute not found", nil)
+	}
+
+	return out.Dispute, nil
+}
+
+func (s *DisputeServiceOp) GetEvidence(ctx context.Context, evidenceID string) (*model.ShopifyPaymentsDisputeEvidence, error) {
+	q := fmt.Sprintf(`
+		query disputeEvidence($id: ID!) {
+			disputeEvidence(id: $id) {
+				%s
+			}
+		}
+	`, disputeEvidenceFields)
+
+	vars := map[string]interface{}{
+		"id": evidenceID,
+	}
+	out := struct {
+		DisputeEvidence *model.ShopifyPaymentsDisputeEvidence `json:"disputeEvidence"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.DisputeEvidence == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "dispute evidence not found", nil)
+	}
+
+	return out.DisputeEvidence, nil
+}
+
+// UpdateEvidence saves the evidence of a dispute; fields left nil in input are not changed. The
+// evidence is only sent to the card issuer when input.SubmitEvidence is true, after which it can
+// no longer be updated.
+func (s *DisputeServiceOp) UpdateEvidence(ctx context.Context, evidenceID string, input model.ShopifyPaymentsDisputeEvidenceUpdateInput) (*model.ShopifyPaymentsDisputeEvidence, error) {
+	m := fmt.Sprintf(`
+		mutation disputeEvidenceUpdate($id: ID!, $input: ShopifyPaymentsDisputeEvidenceUpdateInput!) {
+			disputeEvidenceUpdate(id: $id, input: $input) {
+				disputeEvidence {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, disputeEvidenceFields)
+
+	vars := map[string]interface{}{
+		"id":    evidenceID,
+		"input": input,
+	}
+	out := struct {
+		DisputeEvidenceUpdate model.DisputeEvidenceUpdatePayload `json:"disputeEvidenceUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.DisputeEvidenceUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.DisputeEvidenceUpdate.UserErrors)
+	}
+
+	return out.DisputeEvidenceUpdate.DisputeEvidence, nil
+}
+
+// AttachEvidenceFile uploads file with FileService.Upload and attaches it to the evidence as
+// fileType, replacing the file previously attached for that type. Issuers accept PDF, JPEG and
+// PNG files.
+func (s *DisputeServiceOp) AttachEvidenceFile(ctx context.Context, evidenceID string, fileType model.ShopifyPaymentsDisputeEvidenceFileType, file *UploadInput) (*model.ShopifyPaymentsDisputeEvidence, error) {
+	uploaded, err := s.client.File.Upload(ctx, file)
+	if err != nil {
+		return nil, fmt.Errorf("s.client.File.Upload: %w", err)
+	}
+
+	fileInput := &model.ShopifyPaymentsDisputeFileUploadUpdateInput{ID: uploaded.GetID()}
+	var input model.ShopifyPaymentsDisputeEvidenceUpdateInput
+	switch fileType {
+	case model.ShopifyPaymentsDisputeEvidenceFileTypeCancellationPolicyFile:
+		input.CancellationPolicyFile = fileInput
+	case model.ShopifyPaymentsDisputeEvidenceFileTypeCustomerCommunicationFile:
+		input.CustomerCommunicationFile = fileInput
+	case model.ShopifyPaymentsDisputeEvidenceFileTypeRefundPolicyFile:
+		input.RefundPolicyFile = fileInput
+	case model.ShopifyPaymentsDisputeEvidenceFileTypeServiceDocumentationFile:
+		input.ServiceDocumentationFile = fileInput
+	case model.ShopifyPaymentsDisputeEvidenceFileTypeShippingDocumentationFile:
+		input.ShippingDocumentationFile = fileInput
+	case model.ShopifyPaymentsDisputeEvidenceFileTypeUncategorizedFile:
+		input.UncategorizedFile = fileInput
+	default:
+		return nil, fmt.Errorf("unsupported dispute evidence file type %q", fileType)
+	}
+
+	return s.UpdateEvidence(ctx, evidenceID, input)
+}
+
+// SubmitEvidence sends the saved evidence to the card issuer.
+func (s *DisputeServiceOp) SubmitEvidence(ctx context.Context, evidenceID string) (*model.ShopifyPaymentsDisputeEvidence, error) {
+	submit := true
+	return s.UpdateEvidence(ctx, evidenceID, model.ShopifyPaymentsDisputeEvidenceUpdateInput{SubmitEvidence: &submit})
+}
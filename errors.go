@@ -27,6 +27,52 @@ func NewDiscountErrorf(code model.DiscountErrorCode, format string, args ...any)
 	return &DiscountError{Code: code, Message: fmt.Sprintf(format, args...)}
 }
 
+// FulfillmentError is a user error returned by a fulfillment mutation, e.g. when cancelling a fulfillment that is already cancelled.
+type FulfillmentError struct {
+	Field   []string `json:"field"`
+	Message string   `json:"message"`
+}
+
+func (m *FulfillmentError) Error() string {
+	if len(m.Field) == 0 {
+		return m.Message
+	}
+	return fmt.Sprintf("%s: %s", strings.Join(m.Field, "."), m.Message)
+}
+
+// FileProcessingError is returned when Shopify fails to process an uploaded file.
+type FileProcessingError struct {
+	FileID      string
+	FileErrors  []model.FileError
+	MediaErrors []model.MediaError
+}
+
+func (m *FileProcessingError) Error() string {
+	messages := make([]string, 0, len(m.FileErrors)+len(m.MediaErrors))
+	for _, e := range m.FileErrors {
+		messages = append(messages, e.Error())
+	}
+	for _, e := range m.MediaErrors {
+		messages = append(messages, e.Error())
+	}
+	if len(messages) == 0 {
+		return fmt.Sprintf("processing file %s failed", m.FileID)
+	}
+	return fmt.Sprintf("processing file %s failed: %s", m.FileID, strings.Join(messages, "; "))
+}
+
+// StagedUploadError is returned when a staged upload target rejects the uploaded content.
+type StagedUploadError struct {
+	URL        string
+	StatusCode int
+	Status     string
+	Body       string
+}
+
+func (m *StagedUploadError) Error() string {
+	return fmt.Sprintf("staged upload to %s failed with status %s: %s", m.URL, m.Status, m.Body)
+}
+
 func IsInvalidTokenError(err error) bool {
 	return err != nil && strings.Contains(err.Error(), "Invalid API key or access token")
 }
@@ -3,12 +3,14 @@ package shopify
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
+	neturl "net/url"
 	"path/filepath"
-	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	"github.com/gempages/go-shopify-graphql-model/graph/model"
 	"github.com/spf13/cast"
 
+	"github.com/gempages/go-shopify-graphql/gid"
 	"github.com/gempages/go-shopify-graphql/graphql"
 )
 
@@ -24,7 +27,22 @@ type FileService interface {
 	QueryFile(ctx context.Context, fileID string) (model.File, error)
 	QueryGenericFile(ctx context.Context, fileID string) (*model.GenericFile, error)
 	QueryMediaImage(ctx context.Context, fileID string) (*model.MediaImage, error)
+	QueryVideo(ctx context.Context, fileID string) (*model.Video, error)
+	QueryModel3d(ctx context.Context, modelID string) (*model.Model3d, error)
+	UploadVideo(ctx context.Context, input *UploadInput) (*model.Video, error)
+	UploadModel3d(ctx context.Context, productID string, input *UploadInput) (*model.Model3d, error)
+	CreateExternalVideo(ctx context.Context, productID string, originURL string, alt *string) (*model.ExternalVideo, error)
 	Delete(ctx context.Context, fileID []graphql.ID) ([]string, error)
+	WaitUntilReady(ctx context.Context, fileID string, timeout time.Duration) (model.File, error)
+	DeleteByURL(ctx context.Context, urls []string) ([]string, error)
+	PurgeUnreferenced(ctx context.Context, prefix string, olderThan time.Duration) ([]string, error)
+	StageBulkMutationFile(ctx context.Context, jsonl io.Reader) (string, error)
+	ImportImages(ctx context.Context, sources []ImageSource, opts ImportImagesOptions) *ImageImportReport
+	UploadLarge(ctx context.Context, input *LargeUploadInput) (model.File, error)
+	UploadFiles(ctx context.Context, files []model.FileCreateInput) ([]model.File, error)
+	List(ctx context.Context, query FileQuery, opts ListOptions) ([]model.File, string, error)
+	ListAll(ctx context.Context, query FileQuery) ([]model.File, error)
+	UploadMediaImages(ctx context.Context, images []UploadMediaImageInput) ([]*model.MediaImage, error)
 }
 
 type FileServiceOp struct {
@@ -59,50 +77,115 @@ type UploadInput struct {
 	OriginalSource *string   // Only for upload Image, use OriginalSource when upload by url
 	File           io.Reader // use File when upload by file
 	FileSize       int64
+	Alt            *string // alternative text of the created file
 }
 
+// UploadMediaImageInput describes an image to create from an external or staged upload URL.
+type UploadMediaImageInput struct {
+	OriginalSource string
+	Alt            *string
+	Filename       *string
+}
+
+// fileCreateLimit is the maximum number of files accepted by a single fileCreate mutation.
+const fileCreateLimit = 250
+
 const fileFieldName = "file"
+
+// fileNodeFields selects the File implementations this service decodes.
+// NOTE: no timestamps are selected since File nodes are decoded with mapstructure.
+const fileNodeFields = `
+	id
+	fileStatus
+	... on GenericFile {
+		id
+		url
+		originalFileSize
+		mimeType
+		fileStatus
+		fileErrors {
+			code
+			details
+			message
+		}
+		__typename
+	}
+	... on Video {
+		id
+		alt
+		filename
+		duration
+		originalSource {
+			url
+			format
+			mimeType
+			height
+			width
+			fileSize
+		}
+		sources {
+			url
+			format
+			mimeType
+			height
+			width
+			fileSize
+		}
+		preview {
+			status
+			image {
+				url
+				width
+				height
+			}
+		}
+		mediaErrors {
+			code
+			details
+			message
+		}
+		__typename
+	}
+	... on MediaImage {
+		id
+		image {
+			id
+			originalSrc: url
+			width
+			height
+		}
+		mediaErrors {
+			code
+			details
+			message
+		}
+		__typename
+	}
+`
+
 const queryGenericFile = `
 		query files($query: String!) {
 			files(first: 1, query: $query) {
 				edges {
 					node {
-						id
-						fileStatus
-						... on GenericFile {
-							id
-							url
-							originalFileSize
-							mimeType
-							fileStatus
-							fileErrors {
-								code
-								details
-								message
-							}
-							__typename
-						}
-						... on MediaImage {
-							id
-							image {
-								id
-								originalSrc: url
-								width
-								height
-							}
-							mediaErrors {
-								code
-								details
-								message
-							}
-							__typename
-						}
+						` + fileNodeFields + `
 					}
 				}
 			}
 		}
 	`
 
+const queryFileNode = `
+		query file($id: ID!) {
+			node(id: $id) {
+				__typename
+				... on File {
+					` + fileNodeFields + `
+				}
+			}
+		}
+	`
+
 func (s *FileServiceOp) QueryFile(ctx context.Context, fileID string) (model.File, error) {
 	return s.queryFile(ctx, fileID)
 }
@@ -125,6 +208,19 @@ func (s *FileServiceOp) QueryMediaImage(ctx context.Context, fileID string) (*mo
 	return file.(*model.MediaImage), nil
 }
 
+func (s *FileServiceOp) QueryVideo(ctx context.Context, fileID string) (*model.Video, error) {
+	file, err := s.queryFile(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	video, ok := file.(*model.Video)
+	if !ok {
+		return nil, fmt.Errorf("file %s is a %T, not a video", fileID, file)
+	}
+	return video, nil
+}
+
 func (s *FileServiceOp) Upload(ctx context.Context, input *UploadInput) (model.File, error) {
 	var (
 		fileCreatePayload *model.FileCreatePayload
@@ -154,18 +250,12 @@ func (s *FileServiceOp) Upload(ctx context.Context, input *UploadInput) (model.F
 }
 
 func (s *FileServiceOp) upload(ctx context.Context, input *UploadInput) (*model.FileCreatePayload, error) {
-	fileSizeStr := cast.ToString(input.FileSize)
-	stageCreated, err := s.stagedUploadsCreate(fileSizeStr, input.Filename, input.Mimetype)
-	if err != nil {
-		return nil, fmt.Errorf("s.stagedUploadsCreate: %w", err)
-	}
-
-	err = s.uploadFileToStage(ctx, input.File, input.Filename, fileSizeStr, stageCreated)
+	resourceURL, err := s.stageUpload(ctx, input, fileTargetResource(input.Mimetype))
 	if err != nil {
-		return nil, fmt.Errorf("s.uploadFileToStage: %w", err)
+		return nil, err
 	}
 
-	input.OriginalSource = stageCreated.ResourceURL
+	input.OriginalSource = &resourceURL
 	result, err := s.fileCreate(ctx, input)
 	if err != nil {
 		return nil, fmt.Errorf("s.fileCreate: %w", err)
@@ -174,21 +264,41 @@ func (s *FileServiceOp) upload(ctx context.Context, input *UploadInput) (*model.
 	return result, nil
 }
 
-func (s *FileServiceOp) stagedUploadsCreate(fileSize, fileName, mimetype string) (*model.StagedMediaUploadTarget, error) {
-	m := mutationStagedUploadsCreate{}
+// stageUpload uploads the file of input to a new staged target of resource and returns the URL
+// of the staged resource, to be passed as the source of the object created from it.
+func (s *FileServiceOp) stageUpload(ctx context.Context, input *UploadInput, resource model.StagedUploadTargetGenerateUploadResource) (string, error) {
+	fileSizeStr := cast.ToString(input.FileSize)
+	stageCreated, err := s.stagedUploadsCreate(ctx, fileSizeStr, input.Filename, input.Mimetype, resource)
+	if err != nil {
+		return "", fmt.Errorf("s.stagedUploadsCreate: %w", err)
+	}
+
+	err = s.uploadFileToStage(ctx, input.File, input.Filename, fileSizeStr, stageCreated)
+	if err != nil {
+		return "", fmt.Errorf("s.uploadFileToStage: %w", err)
+	}
+	if stageCreated.ResourceURL == nil {
+		return "", fmt.Errorf("staged target has no resource URL")
+	}
+
+	return *stageCreated.ResourceURL, nil
+}
+
+func (s *FileServiceOp) stagedUploadsCreate(ctx context.Context, fileSize, fileName, mimetype string, resource model.StagedUploadTargetGenerateUploadResource) (*model.StagedMediaUploadTarget, error) {
 	method := model.StagedUploadHTTPMethodTypePost
+	return s.createStagedUploadTarget(ctx, model.StagedUploadInput{
+		FileSize:   &fileSize,
+		Filename:   fileName,
+		HTTPMethod: &method,
+		MimeType:   mimetype,
+		Resource:   resource,
+	})
+}
 
-	resource := fileTargetResource(mimetype)
-	err := s.client.gql.Mutate(context.Background(), &m, map[string]interface{}{
-		"input": []model.StagedUploadInput{
-			{
-				FileSize:   &fileSize,
-				Filename:   fileName,
-				HTTPMethod: &method,
-				MimeType:   mimetype,
-				Resource:   resource,
-			},
-		},
+func (s *FileServiceOp) createStagedUploadTarget(ctx context.Context, input model.StagedUploadInput) (*model.StagedMediaUploadTarget, error) {
+	m := mutationStagedUploadsCreate{}
+	err := s.client.gql.Mutate(ctx, &m, map[string]interface{}{
+		"input": []model.StagedUploadInput{input},
 	})
 	if err != nil {
 		return nil, fmt.Errorf("gql.Mutate: %w", err)
@@ -197,10 +307,47 @@ func (s *FileServiceOp) stagedUploadsCreate(fileSize, fileName, mimetype string)
 	if len(m.StagedUploadsCreateResult.UserErrors) > 0 {
 		return nil, fmt.Errorf("%+v", m.StagedUploadsCreateResult.UserErrors)
 	}
+	if len(m.StagedUploadsCreateResult.StagedTargets) == 0 {
+		return nil, fmt.Errorf("no staged target created")
+	}
 
 	return &m.StagedUploadsCreateResult.StagedTargets[0], nil
 }
 
+// StageBulkMutationFile uploads a JSONL file of mutation variables to a BULK_MUTATION_VARIABLES
+// staged target and returns the stagedUploadPath expected by bulkOperationRunMutation.
+func (s *FileServiceOp) StageBulkMutationFile(ctx context.Context, jsonl io.Reader) (string, error) {
+	method := model.StagedUploadHTTPMethodTypePost
+	target, err := s.createStagedUploadTarget(ctx, model.StagedUploadInput{
+		Filename:   bulkMutationVariablesFilename,
+		HTTPMethod: &method,
+		MimeType:   bulkMutationVariablesMimetype,
+		Resource:   model.StagedUploadTargetGenerateUploadResourceBulkMutationVariables,
+	})
+	if err != nil {
+		return "", fmt.Errorf("s.createStagedUploadTarget: %w", err)
+	}
+
+	multiForm, err := createMultipartFormWithFile(jsonl, bulkMutationVariablesFilename, target)
+	if err != nil {
+		return "", fmt.Errorf("createMultipartFormWithFile: %w", err)
+	}
+	err = performHTTPPostWithHeaders(ctx, *target.URL, multiForm.data, map[string]string{
+		"Content-Type": multiForm.contentType,
+	})
+	if err != nil {
+		return "", fmt.Errorf("performHTTPPostWithHeaders: %w", err)
+	}
+
+	for _, param := range target.Parameters {
+		if param.Name == "key" {
+			return param.Value, nil
+		}
+	}
+
+	return "", fmt.Errorf("staged target has no key parameter")
+}
+
 func (s *FileServiceOp) uploadFileToStage(
 	ctx context.Context, file io.Reader, fileName, fileSize string, stageCreated *model.StagedMediaUploadTarget,
 ) error {
@@ -226,8 +373,6 @@ func (s *FileServiceOp) uploadFileToStage(
 }
 
 func (s *FileServiceOp) fileCreate(ctx context.Context, input *UploadInput) (*model.FileCreatePayload, error) {
-	out := mutationFileCreate{}
-
 	duplicateResolutionMode := model.FileCreateInputDuplicateResolutionModeReplace
 	fileType := fileCreateContentType(input.Mimetype)
 	if fileType != model.FileContentTypeImage {
@@ -235,15 +380,62 @@ func (s *FileServiceOp) fileCreate(ctx context.Context, input *UploadInput) (*mo
 	}
 
 	newFilename := replaceExtension(input.Filename, filepath.Ext(*input.OriginalSource))
-	vars := map[string]interface{}{
-		"files": []model.FileCreateInput{
-			{
-				Filename:                &newFilename,
-				ContentType:             &fileType,
-				OriginalSource:          *input.OriginalSource,
-				DuplicateResolutionMode: &duplicateResolutionMode,
-			},
+	return s.createFiles(ctx, []model.FileCreateInput{
+		{
+			Filename:                &newFilename,
+			ContentType:             &fileType,
+			OriginalSource:          *input.OriginalSource,
+			DuplicateResolutionMode: &duplicateResolutionMode,
+			Alt:                     input.Alt,
 		},
+	})
+}
+
+// UploadFiles creates files from external or staged upload URLs, batching up to 250 files per request.
+// The returned files are usually still processing; poll them with QueryFile until they are ready.
+// When a batch fails, the files created by earlier batches are returned along with the error.
+func (s *FileServiceOp) UploadFiles(ctx context.Context, files []model.FileCreateInput) ([]model.File, error) {
+	result := make([]model.File, 0, len(files))
+	for start := 0; start < len(files); start += fileCreateLimit {
+		end := min(start+fileCreateLimit, len(files))
+		payload, err := s.createFiles(ctx, files[start:end])
+		if err != nil {
+			return result, fmt.Errorf("s.createFiles: %w", err)
+		}
+		result = append(result, payload.Files...)
+	}
+	return result, nil
+}
+
+// UploadMediaImages creates images in batches, see UploadFiles.
+func (s *FileServiceOp) UploadMediaImages(ctx context.Context, images []UploadMediaImageInput) ([]*model.MediaImage, error) {
+	contentType := model.FileContentTypeImage
+	duplicateResolutionMode := model.FileCreateInputDuplicateResolutionModeReplace
+	files := make([]model.FileCreateInput, 0, len(images))
+	for _, img := range images {
+		files = append(files, model.FileCreateInput{
+			Filename:                img.Filename,
+			OriginalSource:          img.OriginalSource,
+			ContentType:             &contentType,
+			Alt:                     img.Alt,
+			DuplicateResolutionMode: &duplicateResolutionMode,
+		})
+	}
+
+	created, err := s.UploadFiles(ctx, files)
+	result := make([]*model.MediaImage, 0, len(created))
+	for _, f := range created {
+		if img, ok := f.(*model.MediaImage); ok {
+			result = append(result, img)
+		}
+	}
+	return result, err
+}
+
+func (s *FileServiceOp) createFiles(ctx context.Context, files []model.FileCreateInput) (*model.FileCreatePayload, error) {
+	out := mutationFileCreate{}
+	vars := map[string]interface{}{
+		"files": files,
 	}
 
 	m := `
@@ -295,10 +487,13 @@ func (s *FileServiceOp) getUploadResult(ctx context.Context, fileID string, inte
 			if len(fileErrors) > 0 {
 				return nil, &fileErrors[0]
 			}
-			// Handle errors for images
+			// Handle errors for images and videos
 			if mediaImage, ok := file.(*model.MediaImage); ok && len(mediaImage.MediaErrors) > 0 {
 				return nil, &mediaImage.MediaErrors[0]
 			}
+			if video, ok := file.(*model.Video); ok && len(video.MediaErrors) > 0 {
+				return nil, &video.MediaErrors[0]
+			}
 			// Unknown error
 			errData := map[string]any{
 				"fileID": fileID,
@@ -311,12 +506,107 @@ func (s *FileServiceOp) getUploadResult(ctx context.Context, fileID string, inte
 	}
 }
 
+const (
+	fileReadyInitialInterval = 500 * time.Millisecond
+	fileReadyMaxInterval     = 10 * time.Second
+)
+
+// WaitUntilReady polls the file with exponential backoff until it is READY and returns it.
+// A FAILED file returns a *FileProcessingError carrying its fileErrors and mediaErrors.
+// A timeout <= 0 waits until ctx is done.
+func (s *FileServiceOp) WaitUntilReady(ctx context.Context, fileID string, timeout time.Duration) (model.File, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	interval := fileReadyInitialInterval
+	for {
+		file, err := s.queryFileNode(ctx, fileID)
+		if err != nil && !IsRateLimitError(err) {
+			return nil, fmt.Errorf("s.queryFileNode: %w", err)
+		}
+		if err == nil {
+			switch file.GetFileStatus() {
+			case model.FileStatusReady:
+				return file, nil
+			case model.FileStatusFailed:
+				procErr := &FileProcessingError{FileID: fileID, FileErrors: file.GetFileErrors()}
+				switch f := file.(type) {
+				case *model.MediaImage:
+					procErr.MediaErrors = f.MediaErrors
+				case *model.Video:
+					procErr.MediaErrors = f.MediaErrors
+				}
+				return nil, procErr
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("wait for file %s: %w", fileID, ctx.Err())
+		case <-time.After(interval):
+		}
+		interval = min(interval*2, fileReadyMaxInterval)
+	}
+}
+
 func (s *FileServiceOp) queryFile(ctx context.Context, fileID string) (model.File, error) {
+	file, err := s.queryFileNode(ctx, fileID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(file.GetFileErrors()) > 0 {
+		return nil, fmt.Errorf("%+v", file.GetFileErrors())
+	}
+
+	return file, nil
+}
+
+const (
+	fileNodeRetries    = 3
+	fileNodeRetryDelay = 500 * time.Millisecond
+)
+
+// queryFileNode returns the file without treating its fileErrors as a query failure.
+// GIDs are resolved with node(id:), retrying while the node is not visible yet right after an upload.
+// Numeric IDs fall back to searching the files connection.
+func (s *FileServiceOp) queryFileNode(ctx context.Context, fileID string) (model.File, error) {
+	if !strings.HasPrefix(fileID, "gid://") {
+		return s.searchFileNode(ctx, fileID)
+	}
+
+	vars := map[string]interface{}{
+		"id": fileID,
+	}
+	for attempt := 0; ; attempt++ {
+		out := struct {
+			Node map[string]interface{} `json:"node"`
+		}{}
+		err := s.client.gql.QueryString(ctx, queryFileNode, vars, &out)
+		if err != nil {
+			return nil, fmt.Errorf("gql.QueryString: %w", err)
+		}
+		if out.Node != nil {
+			return decodeFileNode(out.Node)
+		}
+		if attempt >= fileNodeRetries {
+			return nil, fmt.Errorf("file is not found")
+		}
+		if err = sleepContext(ctx, fileNodeRetryDelay*time.Duration(attempt+1)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (s *FileServiceOp) searchFileNode(ctx context.Context, fileID string) (model.File, error) {
 	out := struct {
 		Files *model.FileConnection `json:"files"`
 	}{}
 
-	fileID = getShopifyID(fileID)
+	fileID = gid.Legacy(fileID)
 	vars := map[string]interface{}{
 		"query": graphql.String(fmt.Sprintf("id:%s", fileID)),
 	}
@@ -329,11 +619,48 @@ func (s *FileServiceOp) queryFile(ctx context.Context, fileID string) (model.Fil
 		return nil, fmt.Errorf("file is not found")
 	}
 
-	if len(out.Files.Edges[0].Node.GetFileErrors()) > 0 {
-		return nil, fmt.Errorf("%+v", out.Files.Edges[0].Node.GetFileErrors())
+	return out.Files.Edges[0].Node, nil
+}
+
+// decodeFileNode decodes a node(id:) result through model.FileEdge, which resolves the File implementation.
+func decodeFileNode(node map[string]interface{}) (model.File, error) {
+	switch typeName, _ := node["__typename"].(string); typeName {
+	case "GenericFile", "MediaImage", "Video":
+	default:
+		return nil, fmt.Errorf("node is a %s, not a file", typeName)
 	}
 
-	return out.Files.Edges[0].Node, nil
+	b, err := json.Marshal(map[string]interface{}{"node": node})
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+	var edge model.FileEdge
+	if err = json.Unmarshal(b, &edge); err != nil {
+		return nil, fmt.Errorf("decode file: %w", err)
+	}
+	return edge.Node, nil
+}
+
+// QueryModel3d returns a 3D model by GID. 3D models are product media rather than files.
+func (s *FileServiceOp) QueryModel3d(ctx context.Context, modelID string) (*model.Model3d, error) {
+	q := fmt.Sprintf(`query model3d($id: ID!) {
+		node(id: $id) {
+			... on Model3d {
+				%s
+			}
+		}
+	}`, model3dFields)
+	out := struct {
+		Node *model.Model3d `json:"node"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, map[string]interface{}{"id": modelID}, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.Node == nil || out.Node.ID == "" {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "3D model not found", nil)
+	}
+	return out.Node, nil
 }
 
 func (s *FileServiceOp) Delete(ctx context.Context, fileID []graphql.ID) ([]string, error) {
@@ -397,25 +724,49 @@ func performHTTPPostWithHeaders(ctx context.Context, url string, body io.Reader,
 	}
 	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusCreated {
-		bodyContent, _ := io.ReadAll(resp.Body)
-		return errors.NewErrorWithContext(ctx, fmt.Errorf("non-201 Created status code: %v", resp.Status), map[string]any{"body": string(bodyContent)})
+	if !slices.Contains(stagedUploadSuccessStatuses(req.URL.Host), resp.StatusCode) {
+		bodyContent, _ := io.ReadAll(io.LimitReader(resp.Body, 64<<10))
+		return &StagedUploadError{
+			URL:        stagedUploadURLWithoutQuery(req.URL),
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Body:       string(bodyContent),
+		}
 	}
 
 	return nil
 }
 
-func getShopifyID(shopifyBaseID string) string {
-	regexPattern := `^(gid://shopify/MediaImage/|gid://shopify/GenericFile/)`
-	re := regexp.MustCompile(regexPattern)
+// stagedUploadURLWithoutQuery drops the signature carried in the query of signed target URLs.
+func stagedUploadURLWithoutQuery(u *neturl.URL) string {
+	stripped := *u
+	stripped.RawQuery = ""
+	return stripped.String()
+}
 
-	return re.ReplaceAllString(shopifyBaseID, "")
+// stagedUploadSuccessStatuses returns the status codes a staged upload target answers a successful
+// form POST with. Google Cloud Storage replies 204 unless success_action_status asks for 200 or 201,
+// S3 replies 201 or 204, and unknown hosts get the union.
+func stagedUploadSuccessStatuses(host string) []int {
+	switch {
+	case strings.HasSuffix(host, "storage.googleapis.com"):
+		return []int{http.StatusOK, http.StatusCreated, http.StatusNoContent}
+	case strings.HasSuffix(host, "amazonaws.com"):
+		return []int{http.StatusCreated, http.StatusNoContent}
+	}
+	return []int{http.StatusOK, http.StatusCreated, http.StatusNoContent}
 }
 
 func fileTargetResource(mimetype string) model.StagedUploadTargetGenerateUploadResource {
 	if strings.Contains(mimetype, "image") {
 		return model.StagedUploadTargetGenerateUploadResourceImage
 	}
+	if strings.HasPrefix(mimetype, "video/") {
+		return model.StagedUploadTargetGenerateUploadResourceVideo
+	}
+	if isModel3dMimetype(mimetype) {
+		return model.StagedUploadTargetGenerateUploadResourceModel3d
+	}
 
 	return model.StagedUploadTargetGenerateUploadResourceFile
 }
@@ -424,6 +775,9 @@ func fileCreateContentType(mimetype string) model.FileContentType {
 	if strings.Contains(mimetype, "image") {
 		return model.FileContentTypeImage
 	}
+	if strings.HasPrefix(mimetype, "video/") {
+		return model.FileContentTypeVideo
+	}
 
 	return model.FileContentTypeFile
 }
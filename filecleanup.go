@@ -0,0 +1,149 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	neturl "net/url"
+	"path"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+
+	"github.com/gempages/go-shopify-graphql/graphql"
+)
+
+// fileDeleteBatchSize bounds the number of IDs sent in a single fileDelete mutation.
+const fileDeleteBatchSize = 100
+
+// searchSyntaxChars are the characters with a meaning in Shopify search queries, such as wildcards,
+// comparisons and quotes.
+const searchSyntaxChars = `*?:()"'\<>=`
+
+// DeleteByURL deletes the files served at urls and returns the deleted file IDs.
+// Files are looked up by the filename in each URL and matched on the URL without its query string,
+// so CDN version parameters such as ?v=123 are ignored. URLs matching no file are skipped.
+func (s *FileServiceOp) DeleteByURL(ctx context.Context, urls []string) ([]string, error) {
+	var ids []string
+	for _, u := range urls {
+		target := stripURLQuery(u)
+		filename := path.Base(target)
+		if filename == "." || filename == "/" {
+			continue
+		}
+
+		files, err := s.listAllPages(ctx, FileQuery{Filename: filename})
+		if err != nil {
+			return nil, fmt.Errorf("list files named %s: %w", filename, err)
+		}
+		for _, f := range files {
+			if stripURLQuery(fileURL(f)) == target {
+				ids = append(ids, f.GetID())
+			}
+		}
+	}
+
+	return s.deleteInBatches(ctx, ids)
+}
+
+// PurgeUnreferenced deletes files whose filename starts with prefix, that are not used by any
+// resource and were created more than olderThan ago. It is meant for assets generated by the app;
+// empty prefixes and prefixes containing whitespace, wildcards or other search syntax are rejected
+// so that merchant files are never purged by accident.
+func (s *FileServiceOp) PurgeUnreferenced(ctx context.Context, prefix string, olderThan time.Duration) ([]string, error) {
+	if err := validatePurgePrefix(prefix); err != nil {
+		return nil, err
+	}
+
+	createdBefore := time.Now().Add(-olderThan).UTC().Format(time.RFC3339)
+	files, err := s.listAllPages(ctx, FileQuery{
+		UsedIn: FileUsedInNone,
+		Raw:    fmt.Sprintf("filename:%s* AND created_at:<'%s'", prefix, createdBefore),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list unreferenced files: %w", err)
+	}
+
+	ids := make([]string, 0, len(files))
+	for _, f := range files {
+		ids = append(ids, f.GetID())
+	}
+	return s.deleteInBatches(ctx, ids)
+}
+
+// validatePurgePrefix returns an error unless prefix is a literal filename prefix, which matches
+// the same files once followed by the wildcard of the purge query.
+func validatePurgePrefix(prefix string) error {
+	if prefix == "" {
+		return fmt.Errorf("prefix is required")
+	}
+	if strings.ContainsFunc(prefix, unicode.IsSpace) || strings.ContainsAny(prefix, searchSyntaxChars) || strings.HasPrefix(prefix, "-") {
+		return fmt.Errorf("prefix %q must not contain whitespace, wildcards or search operators", prefix)
+	}
+	return nil
+}
+
+func (s *FileServiceOp) listAllPages(ctx context.Context, query FileQuery) ([]model.File, error) {
+	var (
+		result []model.File
+		opts   = ListOptions{First: 250}
+	)
+	for {
+		files, next, err := s.List(ctx, query, opts)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, files...)
+		if next == "" {
+			return result, nil
+		}
+		opts.After = next
+	}
+}
+
+func (s *FileServiceOp) deleteInBatches(ctx context.Context, ids []string) ([]string, error) {
+	deleted := make([]string, 0, len(ids))
+	for start := 0; start < len(ids); start += fileDeleteBatchSize {
+		end := min(start+fileDeleteBatchSize, len(ids))
+		batch := make([]graphql.ID, 0, end-start)
+		for _, id := range ids[start:end] {
+			batch = append(batch, id)
+		}
+		deletedIDs, err := s.Delete(ctx, batch)
+		if err != nil {
+			return deleted, fmt.Errorf("s.Delete: %w", err)
+		}
+		deleted = append(deleted, deletedIDs...)
+	}
+	return deleted, nil
+}
+
+// fileURL returns the URL a file is served at, or an empty string if it is not known yet.
+func fileURL(f model.File) string {
+	switch file := f.(type) {
+	case *model.GenericFile:
+		if file.URL != nil {
+			return *file.URL
+		}
+	case *model.MediaImage:
+		if file.Image != nil {
+			return file.Image.OriginalSrc
+		}
+	case *model.Video:
+		if file.OriginalSource != nil {
+			return file.OriginalSource.URL
+		}
+	}
+	return ""
+}
+
+func stripURLQuery(u string) string {
+	parsed, err := neturl.Parse(u)
+	if err != nil {
+		return strings.SplitN(u, "?", 2)[0]
+	}
+	parsed.RawQuery = ""
+	parsed.Fragment = ""
+	return parsed.String()
+}
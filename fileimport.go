@@ -0,0 +1,246 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"sync"
+	"time"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+const (
+	defaultImportConcurrency = 4
+	defaultImportRetries     = 2
+	importRateLimitPause     = 2 * time.Second
+	// maxImportThrottles is the number of rate limited attempts after which an image fails.
+	maxImportThrottles = 10
+)
+
+// ImageSource is an image to import, either from an external URL or from Reader.
+// A Reader is only retried when it also implements io.Seeker.
+type ImageSource struct {
+	URL      string
+	Reader   io.Reader
+	Size     int64
+	Filename string
+	Mimetype string
+	Alt      *string
+	// Checksum, if set, identifies duplicate images. Otherwise Filename, then URL, is used.
+	Checksum string
+}
+
+func (src ImageSource) dedupKey() string {
+	switch {
+	case src.Checksum != "":
+		return "checksum:" + src.Checksum
+	case src.Filename != "":
+		return "filename:" + src.Filename
+	}
+	return "url:" + src.URL
+}
+
+type ImportImagesOptions struct {
+	// Concurrency is the number of images uploaded at once, 4 by default.
+	Concurrency int
+	// MaxRetries is the number of times a failed image is retried, 2 by default.
+	// Rate limited attempts are not counted, an image fails after 10 of them instead.
+	MaxRetries int
+	// OnProgress, if set, is called from the workers after each image completes.
+	OnProgress func(done, total int)
+}
+
+// ImageImportResult is the outcome of a single ImageSource, at the same index as in the input.
+type ImageImportResult struct {
+	Source   ImageSource
+	File     model.File
+	Err      error
+	Attempts int
+	// DuplicateOf is the index of the source this one duplicates, or -1. Duplicates are not uploaded
+	// and share the File and Err of the original.
+	DuplicateOf int
+}
+
+type ImageImportReport struct {
+	Results   []ImageImportResult
+	Succeeded int
+	Failed    int
+	Skipped   int
+}
+
+// ImportImages uploads sources with a pool of workers and waits until every image is processed.
+// It never fails as a whole; per image errors are reported in the returned report.
+func (s *FileServiceOp) ImportImages(ctx context.Context, sources []ImageSource, opts ImportImagesOptions) *ImageImportReport {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = defaultImportConcurrency
+	}
+	maxRetries := opts.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = defaultImportRetries
+	}
+
+	report := &ImageImportReport{Results: make([]ImageImportResult, len(sources))}
+	firstByKey := make(map[string]int, len(sources))
+	jobs := make(chan int)
+	for i, src := range sources {
+		report.Results[i] = ImageImportResult{Source: src, DuplicateOf: -1}
+		if first, ok := firstByKey[src.dedupKey()]; ok {
+			report.Results[i].DuplicateOf = first
+			continue
+		}
+		firstByKey[src.dedupKey()] = i
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		done     int
+		limiter  importRateLimiter
+		progress = func() {
+			mu.Lock()
+			done++
+			d := done
+			mu.Unlock()
+			if opts.OnProgress != nil {
+				opts.OnProgress(d, len(firstByKey))
+			}
+		}
+	)
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				result := &report.Results[i]
+				result.File, result.Attempts, result.Err = s.importImage(ctx, result.Source, maxRetries, &limiter)
+				progress()
+			}
+		}()
+	}
+
+	for i := range report.Results {
+		if report.Results[i].DuplicateOf >= 0 {
+			continue
+		}
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			report.Results[i].Err = ctx.Err()
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	for i := range report.Results {
+		result := &report.Results[i]
+		if result.DuplicateOf >= 0 {
+			original := report.Results[result.DuplicateOf]
+			result.File, result.Err = original.File, original.Err
+			report.Skipped++
+			continue
+		}
+		if result.Err != nil {
+			report.Failed++
+		} else {
+			report.Succeeded++
+		}
+	}
+	return report
+}
+
+func (s *FileServiceOp) importImage(ctx context.Context, src ImageSource, maxRetries int, limiter *importRateLimiter) (model.File, int, error) {
+	var (
+		attempts  int
+		throttles int
+		err       error
+	)
+	for failures := 0; failures <= maxRetries; {
+		if err = limiter.wait(ctx); err != nil {
+			return nil, attempts, err
+		}
+		if attempts > 0 && src.Reader != nil {
+			seeker, ok := src.Reader.(io.Seeker)
+			if !ok {
+				return nil, attempts, err
+			}
+			if _, seekErr := seeker.Seek(0, io.SeekStart); seekErr != nil {
+				return nil, attempts, fmt.Errorf("rewind %s: %w", src.Filename, seekErr)
+			}
+		}
+
+		attempts++
+		var file model.File
+		file, err = s.Upload(ctx, imageUploadInput(src))
+		if err == nil {
+			return file, attempts, nil
+		}
+		if IsRateLimitError(err) {
+			throttles++
+			if throttles >= maxImportThrottles {
+				return nil, attempts, fmt.Errorf("still rate limited after %d attempts: %w", throttles, err)
+			}
+			limiter.pause(importRateLimitPause)
+			continue
+		}
+		if ctx.Err() != nil {
+			return nil, attempts, err
+		}
+		failures++
+	}
+	return nil, attempts, err
+}
+
+func imageUploadInput(src ImageSource) *UploadInput {
+	filename := src.Filename
+	if filename == "" {
+		filename = path.Base(src.URL)
+	}
+	mimetype := src.Mimetype
+	if mimetype == "" {
+		mimetype = mime.TypeByExtension(path.Ext(filename))
+	}
+	if mimetype == "" {
+		mimetype = "image/jpeg"
+	}
+
+	input := &UploadInput{
+		Filename: filename,
+		Mimetype: mimetype,
+		File:     src.Reader,
+		FileSize: src.Size,
+		Alt:      src.Alt,
+	}
+	if src.Reader == nil {
+		url := src.URL
+		input.OriginalSource = &url
+	}
+	return input
+}
+
+// importRateLimiter makes every worker back off once one of them is throttled.
+type importRateLimiter struct {
+	mu    sync.Mutex
+	until time.Time
+}
+
+func (l *importRateLimiter) pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until := time.Now().Add(d); until.After(l.until) {
+		l.until = until
+	}
+}
+
+func (l *importRateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	d := time.Until(l.until)
+	l.mu.Unlock()
+	if d <= 0 {
+		return ctx.Err()
+	}
+	return sleepContext(ctx, d)
+}
@@ -0,0 +1,186 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// File media types accepted by the media_type filter of the files query.
+const (
+	FileMediaTypeImage         = "IMAGE"
+	FileMediaTypeVideo         = "VIDEO"
+	FileMediaTypeModel3d       = "MODEL_3D"
+	FileMediaTypeExternalVideo = "EXTERNAL_VIDEO"
+	FileMediaTypeGenericFile   = "GENERIC_FILE"
+)
+
+// File usages accepted by the used_in filter of the files query.
+const (
+	FileUsedInProduct = "product"
+	FileUsedInNone    = "none"
+)
+
+// FileQuery builds the search query of the files connection. Multiple values of a filter are ORed,
+// different filters are ANDed. Raw is appended as is.
+type FileQuery struct {
+	MediaTypes []string
+	Statuses   []model.FileStatus
+	Filename   string
+	UsedIn     string
+	Raw        string
+}
+
+func (q FileQuery) String() string {
+	var terms []string
+	terms = appendSearchTermGroup(terms, "media_type", q.MediaTypes)
+	statuses := make([]string, 0, len(q.Statuses))
+	for _, status := range q.Statuses {
+		statuses = append(statuses, strings.ToLower(string(status)))
+	}
+	terms = appendSearchTermGroup(terms, "status", statuses)
+	if q.Filename != "" {
+		terms = append(terms, "filename:"+quoteSearchValue(q.Filename))
+	}
+	if q.UsedIn != "" {
+		terms = append(terms, "used_in:"+quoteSearchValue(q.UsedIn))
+	}
+	if q.Raw != "" {
+		terms = append(terms, q.Raw)
+	}
+	return strings.Join(terms, " AND ")
+}
+
+// NOTE: no timestamps are selected since File nodes are decoded with mapstructure.
+const fileListFields = `
+	__typename
+	id
+	alt
+	fileStatus
+	... on GenericFile {
+		url
+		mimeType
+		originalFileSize
+	}
+	... on MediaImage {
+		mimeType
+		image {
+			id
+			originalSrc: url
+			width
+			height
+		}
+	}
+	... on Video {
+		filename
+		duration
+		originalSource {
+			url
+			mimeType
+			fileSize
+		}
+	}
+`
+
+// List returns a page of files matching query, along with the cursor of the next page,
+// which is empty on the last page. opts.Query, if set, is ANDed with query.
+func (s *FileServiceOp) List(ctx context.Context, query FileQuery, opts ListOptions) ([]model.File, string, error) {
+	q := fmt.Sprintf(`
+		query files($first: Int!, $after: String, $query: String, $reverse: Boolean) {
+			files(first: $first, after: $after, query: $query, reverse: $reverse) {
+				edges {
+					node {
+						%s
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+				}
+			}
+		}
+	`, fileListFields)
+
+	first := opts.First
+	if first <= 0 {
+		first = 50
+	}
+	vars := map[string]interface{}{
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+	search := query.String()
+	if opts.Query != "" {
+		if search != "" {
+			search += " AND "
+		}
+		search += opts.Query
+	}
+	if search != "" {
+		vars["query"] = search
+	}
+
+	out := struct {
+		Files *model.FileConnection `json:"files"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, "", fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.Files == nil {
+		return nil, "", nil
+	}
+
+	files := make([]model.File, 0, len(out.Files.Edges))
+	for _, edge := range out.Files.Edges {
+		files = append(files, edge.Node)
+	}
+	var nextCursor string
+	if out.Files.PageInfo != nil && out.Files.PageInfo.HasNextPage && len(out.Files.Edges) > 0 {
+		nextCursor = out.Files.Edges[len(out.Files.Edges)-1].Cursor
+	}
+	return files, nextCursor, nil
+}
+
+// ListAll exports every file matching query with a bulk operation.
+func (s *FileServiceOp) ListAll(ctx context.Context, query FileQuery) ([]model.File, error) {
+	q := fmt.Sprintf(`
+		{
+			files(query: "$query") {
+				edges {
+					node {
+						%s
+					}
+				}
+			}
+		}
+	`, fileListFields)
+	q = strings.ReplaceAll(q, "$query", strings.ReplaceAll(query.String(), `"`, `\"`))
+
+	// File is an interface, so each line is decoded through model.FileEdge which resolves __typename.
+	var nodes []map[string]interface{}
+	err := s.client.BulkOperation.BulkQuery(ctx, q, &nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]model.File, 0, len(nodes))
+	for _, node := range nodes {
+		b, err := json.Marshal(map[string]interface{}{"node": node})
+		if err != nil {
+			return nil, fmt.Errorf("json.Marshal: %w", err)
+		}
+		var edge model.FileEdge
+		if err = json.Unmarshal(b, &edge); err != nil {
+			return nil, fmt.Errorf("decode file: %w", err)
+		}
+		files = append(files, edge.Node)
+	}
+	return files, nil
+}
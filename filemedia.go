@@ -0,0 +1,239 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gempages/go-helper/errors"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// mediaPollInterval is how long to wait between processing status checks of product media.
+const mediaPollInterval = 2 * time.Second
+
+const model3dFields = `
+	id
+	alt
+	mediaContentType
+	status
+	originalSource {
+		url
+		format
+		mimeType
+		filesize
+	}
+	sources {
+		url
+		format
+		mimeType
+		filesize
+	}
+	preview {
+		status
+		image {
+			url
+			width
+			height
+		}
+	}
+	mediaErrors {
+		code
+		details
+		message
+	}
+`
+
+const externalVideoFields = `
+	id
+	alt
+	mediaContentType
+	status
+	host
+	originUrl
+	embedUrl
+	preview {
+		status
+		image {
+			url
+			width
+			height
+		}
+	}
+	mediaErrors {
+		code
+		details
+		message
+	}
+`
+
+// UploadVideo uploads a video file to the Files area and waits until Shopify has processed it.
+func (s *FileServiceOp) UploadVideo(ctx context.Context, input *UploadInput) (*model.Video, error) {
+	if input.Mimetype == "" || !strings.HasPrefix(input.Mimetype, "video/") {
+		return nil, fmt.Errorf("unsupported video mimetype %q", input.Mimetype)
+	}
+	file, err := s.Upload(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	video, ok := file.(*model.Video)
+	if !ok {
+		return nil, fmt.Errorf("uploaded file is a %T, not a video", file)
+	}
+	return video, nil
+}
+
+// UploadModel3d stages a 3D model (GLB or USDZ), attaches it to the product and waits until it is processed.
+// 3D models can only exist as product media, so productID is required.
+func (s *FileServiceOp) UploadModel3d(ctx context.Context, productID string, input *UploadInput) (*model.Model3d, error) {
+	if !isModel3dMimetype(input.Mimetype) {
+		return nil, fmt.Errorf("unsupported 3D model mimetype %q", input.Mimetype)
+	}
+
+	resourceURL, err := s.stageUpload(ctx, input, fileTargetResource(input.Mimetype))
+	if err != nil {
+		return nil, err
+	}
+
+	mediaID, err := s.createProductMedia(ctx, productID, model.CreateMediaInput{
+		OriginalSource:   resourceURL,
+		MediaContentType: model.MediaContentTypeModel3d,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("s.createProductMedia: %w", err)
+	}
+
+	out := struct {
+		Node *model.Model3d `json:"node"`
+	}{}
+	err = s.waitForMedia(ctx, mediaID, "Model3d", model3dFields, &out, func() (model.MediaStatus, []model.MediaError) {
+		if out.Node == nil {
+			return "", nil
+		}
+		return out.Node.Status, out.Node.MediaErrors
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Node, nil
+}
+
+// CreateExternalVideo attaches a YouTube or Vimeo video to the product and waits until it is processed.
+func (s *FileServiceOp) CreateExternalVideo(ctx context.Context, productID string, originURL string, alt *string) (*model.ExternalVideo, error) {
+	mediaID, err := s.createProductMedia(ctx, productID, model.CreateMediaInput{
+		OriginalSource:   originURL,
+		Alt:              alt,
+		MediaContentType: model.MediaContentTypeExternalVideo,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("s.createProductMedia: %w", err)
+	}
+
+	out := struct {
+		Node *model.ExternalVideo `json:"node"`
+	}{}
+	err = s.waitForMedia(ctx, mediaID, "ExternalVideo", externalVideoFields, &out, func() (model.MediaStatus, []model.MediaError) {
+		if out.Node == nil {
+			return "", nil
+		}
+		return out.Node.Status, out.Node.MediaErrors
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Node, nil
+}
+
+func (s *FileServiceOp) createProductMedia(ctx context.Context, productID string, media model.CreateMediaInput) (string, error) {
+	m := `mutation productCreateMedia($productId: ID!, $media: [CreateMediaInput!]!) {
+		productCreateMedia(productId: $productId, media: $media) {
+			media {
+				... on Node {
+					id
+				}
+			}
+			mediaUserErrors {
+				field
+				message
+				code
+			}
+		}
+	}`
+	vars := map[string]interface{}{
+		"productId": productID,
+		"media":     []model.CreateMediaInput{media},
+	}
+	out := struct {
+		ProductCreateMedia struct {
+			Media []struct {
+				ID string `json:"id"`
+			} `json:"media"`
+			MediaUserErrors []model.MediaUserError `json:"mediaUserErrors"`
+		} `json:"productCreateMedia"`
+	}{}
+
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return "", fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.ProductCreateMedia.MediaUserErrors) > 0 {
+		return "", fmt.Errorf("%+v", out.ProductCreateMedia.MediaUserErrors)
+	}
+	if len(out.ProductCreateMedia.Media) == 0 {
+		return "", fmt.Errorf("productCreateMedia returned no media")
+	}
+	return out.ProductCreateMedia.Media[0].ID, nil
+}
+
+// waitForMedia polls the media node into out until status reports READY or FAILED.
+// An empty status means the node was not found.
+func (s *FileServiceOp) waitForMedia(
+	ctx context.Context, mediaID, typeName, fields string, out any, status func() (model.MediaStatus, []model.MediaError),
+) error {
+	q := fmt.Sprintf(`query media($id: ID!) {
+		node(id: $id) {
+			... on %s {
+				%s
+			}
+		}
+	}`, typeName, fields)
+	vars := map[string]interface{}{
+		"id": mediaID,
+	}
+
+	for {
+		err := s.client.gql.QueryString(ctx, q, vars, out)
+		if err != nil && !IsRateLimitError(err) {
+			return fmt.Errorf("gql.QueryString: %w", err)
+		}
+		if err == nil {
+			mediaStatus, mediaErrors := status()
+			switch mediaStatus {
+			case "":
+				return errors.NewNotExistsError(errors.ErrorResourceNotFound, "media not found", nil)
+			case model.MediaStatusReady:
+				return nil
+			case model.MediaStatusFailed:
+				if len(mediaErrors) > 0 {
+					return &mediaErrors[0]
+				}
+				return errors.NewErrorWithContext(ctx, fmt.Errorf("media processing failed"), map[string]any{"mediaID": mediaID})
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(mediaPollInterval):
+		}
+	}
+}
+
+func isModel3dMimetype(mimetype string) bool {
+	switch mimetype {
+	case "model/gltf-binary", "model/vnd.usdz+zip", "model/vnd.pixar.usd":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,348 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gempages/go-helper/errors"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+	"github.com/spf13/cast"
+)
+
+const (
+	// resumableChunkAlign is the granularity Google Cloud Storage requires for non-final chunks.
+	resumableChunkAlign     = 256 << 10
+	defaultResumableChunk   = 32 * resumableChunkAlign // 8 MiB
+	defaultResumableRetries = 3
+	resumableRetryBaseDelay = time.Second
+	statusResumeIncomplete  = 308
+	headerGoogResumable     = "X-Goog-Resumable"
+)
+
+var errResumableNotSupported = fmt.Errorf("resumable uploads are not supported by the staged target")
+
+// LargeUploadInput describes a file uploaded with UploadLarge.
+// File must support random access so that failed chunks can be sent again.
+type LargeUploadInput struct {
+	Filename string
+	Mimetype string
+	File     io.ReaderAt
+	FileSize int64
+	// ChunkSize is rounded down to a multiple of 256 KiB, 8 MiB by default.
+	ChunkSize int64
+	// MaxRetries is the number of times a failed chunk is retried, 3 by default.
+	MaxRetries int
+	// OnProgress, if set, is called with the number of bytes stored so far.
+	OnProgress func(uploaded, total int64)
+}
+
+// UploadLarge uploads a file through a PUT staged target, which is not subject to the size limit of
+// multipart POST targets. The content is sent in chunks over a resumable session when the target
+// accepts starting one with the method it was signed for, retrying failed chunks from the last
+// offset the storage acknowledged; otherwise it falls back to a single streamed PUT. It waits
+// until Shopify has processed the file.
+func (s *FileServiceOp) UploadLarge(ctx context.Context, input *LargeUploadInput) (model.File, error) {
+	fileSize := cast.ToString(input.FileSize)
+	method := model.StagedUploadHTTPMethodTypePut
+	target, err := s.createStagedUploadTarget(ctx, model.StagedUploadInput{
+		FileSize:   &fileSize,
+		Filename:   input.Filename,
+		HTTPMethod: &method,
+		MimeType:   input.Mimetype,
+		Resource:   fileTargetResource(input.Mimetype),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("s.createStagedUploadTarget: %w", err)
+	}
+	if target.URL == nil || target.ResourceURL == nil {
+		return nil, fmt.Errorf("staged upload target has no URL")
+	}
+
+	upload := newResumableUpload(*target.URL, string(method), target.Parameters, input)
+	err = upload.run(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("upload.run: %w", err)
+	}
+
+	payload, err := s.fileCreate(ctx, &UploadInput{
+		Filename:       input.Filename,
+		Mimetype:       input.Mimetype,
+		OriginalSource: target.ResourceURL,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("s.fileCreate: %w", err)
+	}
+
+	result, err := s.getUploadResult(ctx, payload.Files[0].GetID(), time.Second*2)
+	if err != nil {
+		return nil, fmt.Errorf("s.getUploadResult: %w", err)
+	}
+	return result, nil
+}
+
+type resumableUpload struct {
+	url string
+	// method is the method the staged target was created with, which its URL is signed for.
+	method     string
+	headers    map[string]string
+	file       io.ReaderAt
+	size       int64
+	chunkSize  int64
+	maxRetries int
+	onProgress func(uploaded, total int64)
+	httpClient *http.Client
+}
+
+func newResumableUpload(url, method string, params []model.StagedUploadParameter, input *LargeUploadInput) *resumableUpload {
+	headers := make(map[string]string, len(params))
+	for _, p := range params {
+		headers[p.Name] = p.Value
+	}
+
+	chunkSize := input.ChunkSize / resumableChunkAlign * resumableChunkAlign
+	if chunkSize <= 0 {
+		chunkSize = defaultResumableChunk
+	}
+	maxRetries := input.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = defaultResumableRetries
+	}
+
+	return &resumableUpload{
+		url:        url,
+		method:     method,
+		headers:    headers,
+		file:       input.File,
+		size:       input.FileSize,
+		chunkSize:  chunkSize,
+		maxRetries: maxRetries,
+		onProgress: input.OnProgress,
+		httpClient: http.DefaultClient,
+	}
+}
+
+func (u *resumableUpload) run(ctx context.Context) error {
+	session, err := u.startSession(ctx)
+	if err != nil {
+		if err == errResumableNotSupported {
+			return u.putWhole(ctx)
+		}
+		return err
+	}
+	return u.putChunks(ctx, session)
+}
+
+// startSession opens a resumable session and returns its URI. The request must use the method the
+// URL is signed for, or the storage rejects its signature.
+func (u *resumableUpload) startSession(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, u.method, u.url, http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	u.setHeaders(req)
+	req.Header.Set(headerGoogResumable, "start")
+
+	resp, err := u.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("httpClient.Do: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	location := resp.Header.Get("Location")
+	if (resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK) || location == "" {
+		return "", errResumableNotSupported
+	}
+	return location, nil
+}
+
+func (u *resumableUpload) putChunks(ctx context.Context, session string) error {
+	var (
+		offset int64
+		// stalls counts the consecutive chunks of which the storage persisted nothing
+		stalls int
+	)
+	for offset < u.size {
+		end := min(offset+u.chunkSize, u.size)
+
+		next, err := u.putChunk(ctx, session, offset, end)
+		for attempt := 1; err != nil && attempt <= u.maxRetries; attempt++ {
+			if waitErr := sleepContext(ctx, resumableRetryBaseDelay*time.Duration(1<<(attempt-1))); waitErr != nil {
+				return waitErr
+			}
+			// Resynchronize with what the storage actually persisted before resending.
+			offset, err = u.queryOffset(ctx, session)
+			if err != nil {
+				continue
+			}
+			end = min(offset+u.chunkSize, u.size)
+			next, err = u.putChunk(ctx, session, offset, end)
+		}
+		if err != nil {
+			return fmt.Errorf("upload bytes %d-%d: %w", offset, end-1, err)
+		}
+		if next <= offset {
+			stalls++
+			if stalls > u.maxRetries {
+				return fmt.Errorf("upload bytes %d-%d: no bytes persisted after %d attempts", offset, end-1, stalls)
+			}
+			if err = sleepContext(ctx, resumableRetryBaseDelay*time.Duration(1<<(stalls-1))); err != nil {
+				return err
+			}
+		} else {
+			stalls = 0
+		}
+
+		offset = next
+		if u.onProgress != nil {
+			u.onProgress(offset, u.size)
+		}
+	}
+	return nil
+}
+
+// putChunk sends bytes [start, end) and returns the offset to continue from.
+func (u *resumableUpload) putChunk(ctx context.Context, session string, start, end int64) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, session, io.NewSectionReader(u.file, start, end-start))
+	if err != nil {
+		return 0, err
+	}
+	req.ContentLength = end - start
+	req.Header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", start, end-1, u.size))
+
+	resp, err := u.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("httpClient.Do: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		return u.size, nil
+	case statusResumeIncomplete:
+		return persistedOffset(resp.Header.Get("Range")), nil
+	}
+	body, _ := io.ReadAll(resp.Body)
+	return 0, errors.NewErrorWithContext(ctx, fmt.Errorf("unexpected chunk upload status: %v", resp.Status), map[string]any{"body": string(body)})
+}
+
+// queryOffset asks the storage how many bytes of the session it has persisted.
+func (u *resumableUpload) queryOffset(ctx context.Context, session string) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, session, http.NoBody)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Range", fmt.Sprintf("bytes */%d", u.size))
+
+	resp, err := u.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("httpClient.Do: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		return u.size, nil
+	case statusResumeIncomplete:
+		return persistedOffset(resp.Header.Get("Range")), nil
+	}
+	return 0, fmt.Errorf("unexpected upload status: %v", resp.Status)
+}
+
+// putWhole streams the whole file in one PUT, retrying from the start on failure.
+func (u *resumableUpload) putWhole(ctx context.Context) error {
+	var err error
+	for attempt := 0; attempt <= u.maxRetries; attempt++ {
+		if attempt > 0 {
+			if waitErr := sleepContext(ctx, resumableRetryBaseDelay*time.Duration(1<<(attempt-1))); waitErr != nil {
+				return waitErr
+			}
+		}
+
+		var req *http.Request
+		body := &progressReader{r: io.NewSectionReader(u.file, 0, u.size), total: u.size, onProgress: u.onProgress}
+		req, err = http.NewRequestWithContext(ctx, u.method, u.url, body)
+		if err != nil {
+			return err
+		}
+		req.ContentLength = u.size
+		u.setHeaders(req)
+
+		var resp *http.Response
+		resp, err = u.httpClient.Do(req)
+		if err != nil {
+			err = fmt.Errorf("httpClient.Do: %w", err)
+			continue
+		}
+		respBody, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return nil
+		}
+		err = &StagedUploadError{
+			URL:        stagedUploadURLWithoutQuery(req.URL),
+			StatusCode: resp.StatusCode,
+			Status:     resp.Status,
+			Body:       string(respBody),
+		}
+		if resp.StatusCode < 500 {
+			return err
+		}
+	}
+	return err
+}
+
+// setHeaders adds the staged target parameters, which PUT targets expect as request headers.
+func (u *resumableUpload) setHeaders(req *http.Request) {
+	for name, value := range u.headers {
+		if strings.EqualFold(name, "content_type") {
+			name = "Content-Type"
+		}
+		req.Header.Set(name, value)
+	}
+}
+
+// persistedOffset converts a "bytes=0-N" Range header to the next offset to upload. A resume
+// incomplete response without a Range header means that no byte was persisted yet.
+func persistedOffset(rangeHeader string) int64 {
+	_, last, ok := strings.Cut(strings.TrimPrefix(rangeHeader, "bytes="), "-")
+	if !ok {
+		return 0
+	}
+	n, err := strconv.ParseInt(last, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n + 1
+}
+
+type progressReader struct {
+	r          io.Reader
+	read       int64
+	total      int64
+	onProgress func(uploaded, total int64)
+}
+
+func (p *progressReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	p.read += int64(n)
+	if n > 0 && p.onProgress != nil {
+		p.onProgress(p.read, p.total)
+	}
+	return n, err
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
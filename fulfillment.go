@@ -3,61 +3,917 @@ package shopify
 import (
 	"context"
 	"fmt"
+	"time"
 
-	"github.com/gempages/go-shopify-graphql/graphql"
+	"github.com/gempages/go-helper/errors"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+
+	"github.com/gempages/go-shopify-graphql/gid"
 )
 
 type FulfillmentService interface {
-	Create(ctx context.Context, input FulfillmentV2Input) error
+	Create(ctx context.Context, input model.FulfillmentV2Input) (*model.Fulfillment, error)
+	UpdateTracking(ctx context.Context, fulfillmentID string, trackingInfo model.FulfillmentTrackingInput, notifyCustomer bool) (*model.Fulfillment, error)
+	Cancel(ctx context.Context, fulfillmentID string) (*model.Fulfillment, error)
+
+	GetFulfillmentOrder(ctx context.Context, id string) (*model.FulfillmentOrder, error)
+	MoveFulfillmentOrder(ctx context.Context, id, newLocationID string, lineItems []model.FulfillmentOrderLineItemInput) (*FulfillmentOrderMoveResult, error)
+	HoldFulfillmentOrder(ctx context.Context, id string, hold model.FulfillmentOrderHoldInput) (*model.FulfillmentOrder, error)
+	ReleaseFulfillmentOrderHold(ctx context.Context, id string) (*model.FulfillmentOrder, error)
+	SplitFulfillmentOrders(ctx context.Context, splits []model.FulfillmentOrderSplitInput) ([]FulfillmentOrderSplitResult, error)
+	MergeFulfillmentOrders(ctx context.Context, merges []model.FulfillmentOrderMergeInput) ([]*model.FulfillmentOrder, error)
+	CloseFulfillmentOrder(ctx context.Context, id string, message *string) (*model.FulfillmentOrder, error)
+	RescheduleFulfillmentOrder(ctx context.Context, id string, fulfillAt time.Time) (*model.FulfillmentOrder, error)
+
+	CreateFulfillmentService(ctx context.Context, input FulfillmentServiceInput) (*model.FulfillmentService, error)
+	UpdateFulfillmentService(ctx context.Context, id string, input FulfillmentServiceInput) (*model.FulfillmentService, error)
+	DeleteFulfillmentService(ctx context.Context, id string, destinationLocationID *string) error
+
+	ListAssignedFulfillmentOrders(ctx context.Context, assignmentStatus model.FulfillmentOrderAssignmentStatus, locationIDs []string) ([]*model.FulfillmentOrder, error)
+	AcceptFulfillmentRequest(ctx context.Context, id string, message *string) (*model.FulfillmentOrder, error)
+	RejectFulfillmentRequest(ctx context.Context, id string, reason *model.FulfillmentOrderRejectionReason, message *string) (*model.FulfillmentOrder, error)
+	AcceptCancellationRequest(ctx context.Context, id string, message *string) (*model.FulfillmentOrder, error)
+	RejectCancellationRequest(ctx context.Context, id string, message *string) (*model.FulfillmentOrder, error)
+
+	ListFulfillmentOrdersByDeliveryMethod(ctx context.Context, locationID string, methodType model.DeliveryMethodType) ([]*model.FulfillmentOrder, error)
+	MarkPreparedForPickup(ctx context.Context, fulfillmentOrderIDs []string) error
 }
 
 type FulfillmentServiceOp struct {
 	client *Client
 }
 
-type FulfillmentV2Input struct {
-	LineItemsByFulfillmentOrder []FulfillmentOrderLineItemsInput `json:"lineItemsByFulfillmentOrder,omitempty"`
-	NotifyCustomer              graphql.Boolean                  `json:"notifyCustomer,omitempty"`
-	TrackingInfo                FulfillmentTrackingInput         `json:"trackingInfo,omitempty"`
+var _ FulfillmentService = &FulfillmentServiceOp{}
+
+const fulfillmentOrderFields = `
+	id
+	status
+	requestStatus
+	fulfillAt
+	order {
+		id
+	}
+	deliveryMethod {
+		id
+		methodType
+		minDeliveryDateTime
+		maxDeliveryDateTime
+	}
+	assignedLocation {
+		name
+		location {
+			id
+		}
+	}
+	fulfillmentHolds {
+		reason
+		reasonNotes
+	}
+	lineItems(first: 250) {
+		edges {
+			node {
+				id
+				totalQuantity
+				remainingQuantity
+				lineItem {
+					id
+					sku
+				}
+			}
+		}
+	}
+`
+
+type FulfillmentOrderMoveResult struct {
+	MovedFulfillmentOrder     *model.FulfillmentOrder `json:"movedFulfillmentOrder,omitempty"`
+	OriginalFulfillmentOrder  *model.FulfillmentOrder `json:"originalFulfillmentOrder,omitempty"`
+	RemainingFulfillmentOrder *model.FulfillmentOrder `json:"remainingFulfillmentOrder,omitempty"`
 }
 
-type FulfillmentOrderLineItemsInput struct {
-	FulfillmentOrderID        graphql.ID                      `json:"fulfillmentOrderId,omitempty"`
-	FulfillmentOrderLineItems []FulfillmentOrderLineItemInput `json:"fulfillmentOrderLineItems,omitempty"`
+type FulfillmentOrderSplitResult struct {
+	FulfillmentOrder            *model.FulfillmentOrder `json:"fulfillmentOrder,omitempty"`
+	RemainingFulfillmentOrder   *model.FulfillmentOrder `json:"remainingFulfillmentOrder,omitempty"`
+	ReplacementFulfillmentOrder *model.FulfillmentOrder `json:"replacementFulfillmentOrder,omitempty"`
 }
 
-type FulfillmentOrderLineItemInput struct {
-	ID       graphql.ID  `json:"id,omitempty"`
-	Quantity graphql.Int `json:"quantity,omitempty"`
+const fulfillmentFields = `
+	id
+	name
+	status
+	displayStatus
+	createdAt
+	order {
+		id
+	}
+	location {
+		id
+	}
+	trackingInfo {
+		company
+		number
+		url
+	}
+`
+
+type fulfillmentMutationResult struct {
+	Fulfillment *model.Fulfillment `json:"fulfillment,omitempty"`
+	UserErrors  []UserErrors       `json:"userErrors,omitempty"`
 }
 
-type FulfillmentTrackingInput struct {
-	Company graphql.String `json:"company,omitempty"`
-	Number  graphql.String `json:"number,omitempty"`
-	URL     URL            `json:"url,omitempty"`
+// Create fulfills fulfillment order line items, all of the fulfillment orders must belong to the same order and location.
+// Several tracking numbers can be given with the Numbers and Urls fields of the tracking info.
+func (s *FulfillmentServiceOp) Create(ctx context.Context, fulfillment model.FulfillmentV2Input) (*model.Fulfillment, error) {
+	m := fmt.Sprintf(`
+		mutation fulfillmentCreateV2($fulfillment: FulfillmentV2Input!) {
+			fulfillmentCreateV2(fulfillment: $fulfillment) {
+				fulfillment {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, fulfillmentFields)
+
+	vars := map[string]interface{}{
+		"fulfillment": fulfillment,
+	}
+	out := struct {
+		FulfillmentCreateV2 fulfillmentMutationResult `json:"fulfillmentCreateV2"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.FulfillmentCreateV2.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.FulfillmentCreateV2.UserErrors)
+	}
+
+	return out.FulfillmentCreateV2.Fulfillment, nil
 }
 
-type mutationFulfillmentCreateV2 struct {
-	FulfillmentCreateV2Result FulfillmentCreateV2Result `graphql:"fulfillmentCreateV2(fulfillment: $fulfillment)" json:"fulfillmentCreateV2"`
+// UpdateTracking replaces the tracking info of a fulfillment and optionally sends a shipping update to the customer.
+func (s *FulfillmentServiceOp) UpdateTracking(ctx context.Context, fulfillmentID string, trackingInfo model.FulfillmentTrackingInput, notifyCustomer bool) (*model.Fulfillment, error) {
+	m := fmt.Sprintf(`
+		mutation fulfillmentTrackingInfoUpdateV2($fulfillmentId: ID!, $trackingInfoInput: FulfillmentTrackingInput!, $notifyCustomer: Boolean) {
+			fulfillmentTrackingInfoUpdateV2(fulfillmentId: $fulfillmentId, trackingInfoInput: $trackingInfoInput, notifyCustomer: $notifyCustomer) {
+				fulfillment {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, fulfillmentFields)
+
+	vars := map[string]interface{}{
+		"fulfillmentId":     fulfillmentID,
+		"trackingInfoInput": trackingInfo,
+		"notifyCustomer":    notifyCustomer,
+	}
+	out := struct {
+		FulfillmentTrackingInfoUpdateV2 fulfillmentMutationResult `json:"fulfillmentTrackingInfoUpdateV2"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.FulfillmentTrackingInfoUpdateV2.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.FulfillmentTrackingInfoUpdateV2.UserErrors)
+	}
+
+	return out.FulfillmentTrackingInfoUpdateV2.Fulfillment, nil
 }
 
-type FulfillmentCreateV2Result struct {
-	UserErrors []UserErrors `json:"userErrors,omitempty"`
+// Cancel cancels a fulfillment, its line items go back to the fulfillment order to be fulfilled again.
+// User errors are returned as *FulfillmentError.
+func (s *FulfillmentServiceOp) Cancel(ctx context.Context, fulfillmentID string) (*model.Fulfillment, error) {
+	m := fmt.Sprintf(`
+		mutation fulfillmentCancel($id: ID!) {
+			fulfillmentCancel(id: $id) {
+				fulfillment {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, fulfillmentFields)
+
+	vars := map[string]interface{}{
+		"id": fulfillmentID,
+	}
+	out := struct {
+		FulfillmentCancel struct {
+			Fulfillment *model.Fulfillment `json:"fulfillment,omitempty"`
+			UserErrors  []FulfillmentError `json:"userErrors,omitempty"`
+		} `json:"fulfillmentCancel"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.FulfillmentCancel.UserErrors) > 0 {
+		return nil, &out.FulfillmentCancel.UserErrors[0]
+	}
+
+	return out.FulfillmentCancel.Fulfillment, nil
 }
 
-func (s *FulfillmentServiceOp) Create(ctx context.Context, fulfillment FulfillmentV2Input) error {
-	m := mutationFulfillmentCreateV2{}
+type fulfillmentOrderMutationResult struct {
+	FulfillmentOrder *model.FulfillmentOrder `json:"fulfillmentOrder,omitempty"`
+	UserErrors       []UserErrors            `json:"userErrors,omitempty"`
+}
+
+func (s *FulfillmentServiceOp) GetFulfillmentOrder(ctx context.Context, id string) (*model.FulfillmentOrder, error) {
+	q := fmt.Sprintf(`
+		query fulfillmentOrder($id: ID!) {
+			fulfillmentOrder(id: $id) {
+				%s
+			}
+		}
+	`, fulfillmentOrderFields)
 
 	vars := map[string]interface{}{
-		"fulfillment": fulfillment,
+		"id": id,
+	}
+	out := struct {
+		FulfillmentOrder *model.FulfillmentOrder `json:"fulfillmentOrder"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, err
+	}
+
+	if out.FulfillmentOrder == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "fulfillment order not found", nil)
+	}
+
+	return out.FulfillmentOrder, nil
+}
+
+// MoveFulfillmentOrder reassigns the fulfillment order to another location. When lineItems is empty the whole fulfillment order moves,
+// otherwise only the given quantities do and the rest stays in the remaining fulfillment order.
+func (s *FulfillmentServiceOp) MoveFulfillmentOrder(ctx context.Context, id, newLocationID string, lineItems []model.FulfillmentOrderLineItemInput) (*FulfillmentOrderMoveResult, error) {
+	m := fmt.Sprintf(`
+		mutation fulfillmentOrderMove($id: ID!, $newLocationId: ID!, $fulfillmentOrderLineItems: [FulfillmentOrderLineItemInput!]) {
+			fulfillmentOrderMove(id: $id, newLocationId: $newLocationId, fulfillmentOrderLineItems: $fulfillmentOrderLineItems) {
+				movedFulfillmentOrder {
+					%[1]s
+				}
+				originalFulfillmentOrder {
+					%[1]s
+				}
+				remainingFulfillmentOrder {
+					%[1]s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, fulfillmentOrderFields)
+
+	vars := map[string]interface{}{
+		"id":            id,
+		"newLocationId": newLocationID,
+	}
+	if len(lineItems) > 0 {
+		vars["fulfillmentOrderLineItems"] = lineItems
+	}
+	out := struct {
+		FulfillmentOrderMove struct {
+			FulfillmentOrderMoveResult
+			UserErrors []UserErrors `json:"userErrors,omitempty"`
+		} `json:"fulfillmentOrderMove"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.FulfillmentOrderMove.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.FulfillmentOrderMove.UserErrors)
+	}
+
+	return &out.FulfillmentOrderMove.FulfillmentOrderMoveResult, nil
+}
+
+func (s *FulfillmentServiceOp) HoldFulfillmentOrder(ctx context.Context, id string, hold model.FulfillmentOrderHoldInput) (*model.FulfillmentOrder, error) {
+	m := fmt.Sprintf(`
+		mutation fulfillmentOrderHold($id: ID!, $fulfillmentHold: FulfillmentOrderHoldInput!) {
+			fulfillmentOrderHold(id: $id, fulfillmentHold: $fulfillmentHold) {
+				fulfillmentOrder {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, fulfillmentOrderFields)
+
+	vars := map[string]interface{}{
+		"id":              id,
+		"fulfillmentHold": hold,
+	}
+	out := struct {
+		FulfillmentOrderHold fulfillmentOrderMutationResult `json:"fulfillmentOrderHold"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.FulfillmentOrderHold.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.FulfillmentOrderHold.UserErrors)
+	}
+
+	return out.FulfillmentOrderHold.FulfillmentOrder, nil
+}
+
+func (s *FulfillmentServiceOp) ReleaseFulfillmentOrderHold(ctx context.Context, id string) (*model.FulfillmentOrder, error) {
+	m := fmt.Sprintf(`
+		mutation fulfillmentOrderReleaseHold($id: ID!) {
+			fulfillmentOrderReleaseHold(id: $id) {
+				fulfillmentOrder {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, fulfillmentOrderFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		FulfillmentOrderReleaseHold fulfillmentOrderMutationResult `json:"fulfillmentOrderReleaseHold"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.FulfillmentOrderReleaseHold.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.FulfillmentOrderReleaseHold.UserErrors)
+	}
+
+	return out.FulfillmentOrderReleaseHold.FulfillmentOrder, nil
+}
+
+// SplitFulfillmentOrders splits each fulfillment order, the given line item quantities go to the new fulfillment order.
+func (s *FulfillmentServiceOp) SplitFulfillmentOrders(ctx context.Context, splits []model.FulfillmentOrderSplitInput) ([]FulfillmentOrderSplitResult, error) {
+	m := fmt.Sprintf(`
+		mutation fulfillmentOrderSplit($fulfillmentOrderSplits: [FulfillmentOrderSplitInput!]!) {
+			fulfillmentOrderSplit(fulfillmentOrderSplits: $fulfillmentOrderSplits) {
+				fulfillmentOrderSplits {
+					fulfillmentOrder {
+						%[1]s
+					}
+					remainingFulfillmentOrder {
+						%[1]s
+					}
+					replacementFulfillmentOrder {
+						%[1]s
+					}
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, fulfillmentOrderFields)
+
+	vars := map[string]interface{}{
+		"fulfillmentOrderSplits": splits,
+	}
+	out := struct {
+		FulfillmentOrderSplit struct {
+			FulfillmentOrderSplits []FulfillmentOrderSplitResult `json:"fulfillmentOrderSplits,omitempty"`
+			UserErrors             []UserErrors                  `json:"userErrors,omitempty"`
+		} `json:"fulfillmentOrderSplit"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.FulfillmentOrderSplit.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.FulfillmentOrderSplit.UserErrors)
+	}
+
+	return out.FulfillmentOrderSplit.FulfillmentOrderSplits, nil
+}
+
+// MergeFulfillmentOrders merges fulfillment orders of the same order and location, one merged fulfillment order is returned per input.
+func (s *FulfillmentServiceOp) MergeFulfillmentOrders(ctx context.Context, merges []model.FulfillmentOrderMergeInput) ([]*model.FulfillmentOrder, error) {
+	m := fmt.Sprintf(`
+		mutation fulfillmentOrderMerge($fulfillmentOrderMergeInputs: [FulfillmentOrderMergeInput!]!) {
+			fulfillmentOrderMerge(fulfillmentOrderMergeInputs: $fulfillmentOrderMergeInputs) {
+				fulfillmentOrderMerges {
+					fulfillmentOrder {
+						%s
+					}
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, fulfillmentOrderFields)
+
+	vars := map[string]interface{}{
+		"fulfillmentOrderMergeInputs": merges,
+	}
+	out := struct {
+		FulfillmentOrderMerge struct {
+			FulfillmentOrderMerges []struct {
+				FulfillmentOrder *model.FulfillmentOrder `json:"fulfillmentOrder,omitempty"`
+			} `json:"fulfillmentOrderMerges,omitempty"`
+			UserErrors []UserErrors `json:"userErrors,omitempty"`
+		} `json:"fulfillmentOrderMerge"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.FulfillmentOrderMerge.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.FulfillmentOrderMerge.UserErrors)
+	}
+
+	res := make([]*model.FulfillmentOrder, 0, len(out.FulfillmentOrderMerge.FulfillmentOrderMerges))
+	for _, merge := range out.FulfillmentOrderMerge.FulfillmentOrderMerges {
+		res = append(res, merge.FulfillmentOrder)
+	}
+
+	return res, nil
+}
+
+// CloseFulfillmentOrder marks an in progress fulfillment order as incomplete, the message is shown to the merchant.
+func (s *FulfillmentServiceOp) CloseFulfillmentOrder(ctx context.Context, id string, message *string) (*model.FulfillmentOrder, error) {
+	m := fmt.Sprintf(`
+		mutation fulfillmentOrderClose($id: ID!, $message: String) {
+			fulfillmentOrderClose(id: $id, message: $message) {
+				fulfillmentOrder {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, fulfillmentOrderFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	if message != nil {
+		vars["message"] = *message
+	}
+	out := struct {
+		FulfillmentOrderClose fulfillmentOrderMutationResult `json:"fulfillmentOrderClose"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.FulfillmentOrderClose.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.FulfillmentOrderClose.UserErrors)
+	}
+
+	return out.FulfillmentOrderClose.FulfillmentOrder, nil
+}
+
+// RescheduleFulfillmentOrder changes when a scheduled fulfillment order becomes ready to fulfill.
+func (s *FulfillmentServiceOp) RescheduleFulfillmentOrder(ctx context.Context, id string, fulfillAt time.Time) (*model.FulfillmentOrder, error) {
+	m := fmt.Sprintf(`
+		mutation fulfillmentOrderReschedule($id: ID!, $fulfillAt: DateTime!) {
+			fulfillmentOrderReschedule(id: $id, fulfillAt: $fulfillAt) {
+				fulfillmentOrder {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, fulfillmentOrderFields)
+
+	vars := map[string]interface{}{
+		"id":        id,
+		"fulfillAt": fulfillAt.UTC().Format(time.RFC3339),
+	}
+	out := struct {
+		FulfillmentOrderReschedule fulfillmentOrderMutationResult `json:"fulfillmentOrderReschedule"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.FulfillmentOrderReschedule.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.FulfillmentOrderReschedule.UserErrors)
+	}
+
+	return out.FulfillmentOrderReschedule.FulfillmentOrder, nil
+}
+
+// FulfillmentServiceInput holds the settings of a fulfillment service, nil fields are left unchanged on update.
+type FulfillmentServiceInput struct {
+	Name                *string
+	CallbackURL         *string
+	TrackingSupport     *bool
+	InventoryManagement *bool
+	PermitsSkuSharing   *bool
+}
+
+func (i FulfillmentServiceInput) toVars() map[string]interface{} {
+	vars := map[string]interface{}{}
+	if i.Name != nil {
+		vars["name"] = *i.Name
+	}
+	if i.CallbackURL != nil {
+		vars["callbackUrl"] = *i.CallbackURL
+	}
+	if i.TrackingSupport != nil {
+		vars["trackingSupport"] = *i.TrackingSupport
+	}
+	if i.InventoryManagement != nil {
+		vars["inventoryManagement"] = *i.InventoryManagement
+	}
+	if i.PermitsSkuSharing != nil {
+		vars["permitsSkuSharing"] = *i.PermitsSkuSharing
+	}
+	return vars
+}
+
+const fulfillmentServiceFields = `
+	id
+	handle
+	serviceName
+	callbackUrl
+	trackingSupport
+	inventoryManagement
+	permitsSkuSharing
+	location {
+		id
+	}
+`
+
+type fulfillmentServiceMutationResult struct {
+	FulfillmentService *model.FulfillmentService `json:"fulfillmentService,omitempty"`
+	UserErrors         []UserErrors              `json:"userErrors,omitempty"`
+}
+
+// CreateFulfillmentService registers the app as a fulfillment service, Shopify creates a location for it.
+// Fulfillment and cancellation requests are notified to the callback URL.
+func (s *FulfillmentServiceOp) CreateFulfillmentService(ctx context.Context, input FulfillmentServiceInput) (*model.FulfillmentService, error) {
+	if input.Name == nil {
+		return nil, fmt.Errorf("fulfillment service name is required")
+	}
+
+	m := fmt.Sprintf(`
+		mutation fulfillmentServiceCreate($name: String!, $callbackUrl: URL, $trackingSupport: Boolean, $inventoryManagement: Boolean, $permitsSkuSharing: Boolean) {
+			fulfillmentServiceCreate(name: $name, callbackUrl: $callbackUrl, trackingSupport: $trackingSupport, inventoryManagement: $inventoryManagement, permitsSkuSharing: $permitsSkuSharing) {
+				fulfillmentService {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, fulfillmentServiceFields)
+
+	out := struct {
+		FulfillmentServiceCreate fulfillmentServiceMutationResult `json:"fulfillmentServiceCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, input.toVars(), &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.FulfillmentServiceCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.FulfillmentServiceCreate.UserErrors)
+	}
+
+	return out.FulfillmentServiceCreate.FulfillmentService, nil
+}
+
+func (s *FulfillmentServiceOp) UpdateFulfillmentService(ctx context.Context, id string, input FulfillmentServiceInput) (*model.FulfillmentService, error) {
+	m := fmt.Sprintf(`
+		mutation fulfillmentServiceUpdate($id: ID!, $name: String, $callbackUrl: URL, $trackingSupport: Boolean, $inventoryManagement: Boolean, $permitsSkuSharing: Boolean) {
+			fulfillmentServiceUpdate(id: $id, name: $name, callbackUrl: $callbackUrl, trackingSupport: $trackingSupport, inventoryManagement: $inventoryManagement, permitsSkuSharing: $permitsSkuSharing) {
+				fulfillmentService {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, fulfillmentServiceFields)
+
+	vars := input.toVars()
+	vars["id"] = id
+	out := struct {
+		FulfillmentServiceUpdate fulfillmentServiceMutationResult `json:"fulfillmentServiceUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.FulfillmentServiceUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.FulfillmentServiceUpdate.UserErrors)
+	}
+
+	return out.FulfillmentServiceUpdate.FulfillmentService, nil
+}
+
+// DeleteFulfillmentService removes the fulfillment service, its inventory moves to the destination location when one is given.
+func (s *FulfillmentServiceOp) DeleteFulfillmentService(ctx context.Context, id string, destinationLocationID *string) error {
+	m := `
+		mutation fulfillmentServiceDelete($id: ID!, $destinationLocationId: ID) {
+			fulfillmentServiceDelete(id: $id, destinationLocationId: $destinationLocationId) {
+				deletedId
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	if destinationLocationID != nil {
+		vars["destinationLocationId"] = *destinationLocationID
+	}
+	out := struct {
+		FulfillmentServiceDelete struct {
+			DeletedID  string       `json:"deletedId,omitempty"`
+			UserErrors []UserErrors `json:"userErrors,omitempty"`
+		} `json:"fulfillmentServiceDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.FulfillmentServiceDelete.UserErrors) > 0 {
+		return fmt.Errorf("%+v", out.FulfillmentServiceDelete.UserErrors)
+	}
+
+	return nil
+}
+
+// ListAssignedFulfillmentOrders returns the fulfillment orders assigned to the app's fulfillment service locations,
+// e.g. with FULFILLMENT_REQUESTED to poll pending requests. An empty locationIDs means all of the app's locations.
+func (s *FulfillmentServiceOp) ListAssignedFulfillmentOrders(ctx context.Context, assignmentStatus model.FulfillmentOrderAssignmentStatus, locationIDs []string) ([]*model.FulfillmentOrder, error) {
+	q := fmt.Sprintf(`
+		query assignedFulfillmentOrders($assignmentStatus: FulfillmentOrderAssignmentStatus, $locationIds: [ID!], $after: String) {
+			assignedFulfillmentOrders(first: 100, assignmentStatus: $assignmentStatus, locationIds: $locationIds, after: $after) {
+				edges {
+					node {
+						%s
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`, fulfillmentOrderFields)
+
+	vars := map[string]interface{}{}
+	if assignmentStatus != "" {
+		vars["assignmentStatus"] = assignmentStatus
+	}
+	if len(locationIDs) > 0 {
+		vars["locationIds"] = locationIDs
+	}
+
+	res := []*model.FulfillmentOrder{}
+	for {
+		out := struct {
+			AssignedFulfillmentOrders struct {
+				Edges []struct {
+					Node *model.FulfillmentOrder `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"assignedFulfillmentOrders"`
+		}{}
+		err := s.client.gql.QueryString(ctx, q, vars, &out)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, edge := range out.AssignedFulfillmentOrders.Edges {
+			res = append(res, edge.Node)
+		}
+
+		if !out.AssignedFulfillmentOrders.PageInfo.HasNextPage {
+			break
+		}
+		vars["after"] = out.AssignedFulfillmentOrders.PageInfo.EndCursor
+	}
+
+	return res, nil
+}
+
+func (s *FulfillmentServiceOp) AcceptFulfillmentRequest(ctx context.Context, id string, message *string) (*model.FulfillmentOrder, error) {
+	return s.respondToRequest(ctx, "fulfillmentOrderAcceptFulfillmentRequest", id, message, nil)
+}
+
+func (s *FulfillmentServiceOp) RejectFulfillmentRequest(ctx context.Context, id string, reason *model.FulfillmentOrderRejectionReason, message *string) (*model.FulfillmentOrder, error) {
+	var extra map[string]interface{}
+	if reason != nil {
+		extra = map[string]interface{}{
+			"reason": *reason,
+		}
+	}
+	return s.respondToRequest(ctx, "fulfillmentOrderRejectFulfillmentRequest", id, message, extra)
+}
+
+func (s *FulfillmentServiceOp) AcceptCancellationRequest(ctx context.Context, id string, message *string) (*model.FulfillmentOrder, error) {
+	return s.respondToRequest(ctx, "fulfillmentOrderAcceptCancellationRequest", id, message, nil)
+}
+
+func (s *FulfillmentServiceOp) RejectCancellationRequest(ctx context.Context, id string, message *string) (*model.FulfillmentOrder, error) {
+	return s.respondToRequest(ctx, "fulfillmentOrderRejectCancellationRequest", id, message, nil)
+}
+
+// respondToRequest runs one of the fulfillment and cancellation request responses, they share the same arguments apart from the rejection reason.
+func (s *FulfillmentServiceOp) respondToRequest(ctx context.Context, mutation, id string, message *string, extra map[string]interface{}) (*model.FulfillmentOrder, error) {
+	args := "id: $id, message: $message"
+	params := "$id: ID!, $message: String"
+	if _, ok := extra["reason"]; ok {
+		args += ", reason: $reason"
+		params += ", $reason: FulfillmentOrderRejectionReason"
+	}
+
+	m := fmt.Sprintf(`
+		mutation %[1]s(%[2]s) {
+			%[1]s(%[3]s) {
+				fulfillmentOrder {
+					%[4]s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, mutation, params, args, fulfillmentOrderFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	if message != nil {
+		vars["message"] = *message
+	}
+	for k, v := range extra {
+		vars[k] = v
+	}
+
+	out := map[string]*fulfillmentOrderMutationResult{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	result := out[mutation]
+	if result == nil {
+		return nil, fmt.Errorf("%s: empty response", mutation)
+	}
+	if len(result.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", result.UserErrors)
+	}
+
+	return result.FulfillmentOrder, nil
+}
+
+// ListFulfillmentOrdersByDeliveryMethod returns the open fulfillment orders assigned to the location with the given delivery method,
+// e.g. LOCAL or PICK_UP. The delivery method can't be searched, so the location's fulfillment orders are filtered client side.
+func (s *FulfillmentServiceOp) ListFulfillmentOrdersByDeliveryMethod(ctx context.Context, locationID string, methodType model.DeliveryMethodType) ([]*model.FulfillmentOrder, error) {
+	q := fmt.Sprintf(`
+		query fulfillmentOrders($query: String, $after: String) {
+			fulfillmentOrders(first: 100, query: $query, after: $after) {
+				edges {
+					node {
+						%s
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`, fulfillmentOrderFields)
+
+	vars := map[string]interface{}{
+		"query": fmt.Sprintf("assigned_location_id:%s", gid.Legacy(locationID)),
+	}
+
+	res := []*model.FulfillmentOrder{}
+	for {
+		out := struct {
+			FulfillmentOrders struct {
+				Edges []struct {
+					Node *model.FulfillmentOrder `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"fulfillmentOrders"`
+		}{}
+		err := s.client.gql.QueryString(ctx, q, vars, &out)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, edge := range out.FulfillmentOrders.Edges {
+			if edge.Node != nil && edge.Node.DeliveryMethod != nil && edge.Node.DeliveryMethod.MethodType == methodType {
+				res = append(res, edge.Node)
+			}
+		}
+
+		if !out.FulfillmentOrders.PageInfo.HasNextPage {
+			break
+		}
+		vars["after"] = out.FulfillmentOrders.PageInfo.EndCursor
+	}
+
+	return res, nil
+}
+
+// MarkPreparedForPickup marks every line item of the pickup fulfillment orders as ready, which sends the ready for pickup notification to the customer.
+func (s *FulfillmentServiceOp) MarkPreparedForPickup(ctx context.Context, fulfillmentOrderIDs []string) error {
+	m := `
+		mutation fulfillmentOrderLineItemsPreparedForPickup($input: FulfillmentOrderLineItemsPreparedForPickupInput!) {
+			fulfillmentOrderLineItemsPreparedForPickup(input: $input) {
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`
+
+	lineItemsByFulfillmentOrder := make([]map[string]interface{}, 0, len(fulfillmentOrderIDs))
+	for _, id := range fulfillmentOrderIDs {
+		lineItemsByFulfillmentOrder = append(lineItemsByFulfillmentOrder, map[string]interface{}{
+			"fulfillmentOrderId": id,
+		})
+	}
+	vars := map[string]interface{}{
+		"input": map[string]interface{}{
+			"lineItemsByFulfillmentOrder": lineItemsByFulfillmentOrder,
+		},
 	}
-	err := s.client.gql.Mutate(ctx, &m, vars)
+	out := struct {
+		FulfillmentOrderLineItemsPreparedForPickup struct {
+			UserErrors []UserErrors `json:"userErrors,omitempty"`
+		} `json:"fulfillmentOrderLineItemsPreparedForPickup"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
 	if err != nil {
-		return fmt.Errorf("Mutation error: %s", err)
+		return fmt.Errorf("gql.MutateString: %w", err)
 	}
 
-	if len(m.FulfillmentCreateV2Result.UserErrors) > 0 {
-		return fmt.Errorf("UserErrors: %+v", m.FulfillmentCreateV2Result.UserErrors)
+	if len(out.FulfillmentOrderLineItemsPreparedForPickup.UserErrors) > 0 {
+		return fmt.Errorf("%+v", out.FulfillmentOrderLineItemsPreparedForPickup.UserErrors)
 	}
 
 	return nil
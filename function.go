@@ -0,0 +1,168 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gempages/go-helper/errors"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// FunctionService discovers the Shopify Functions deployed by the calling app. Function IDs
+// differ between shops and app versions, so they must be looked up at runtime before attaching a
+// function to a discount, customization, validation or cart transform.
+type FunctionService interface {
+	List(ctx context.Context, apiType FunctionAPIType) ([]*model.ShopifyFunction, error)
+	Get(ctx context.Context, id string) (*model.ShopifyFunction, error)
+	Find(ctx context.Context, apiType FunctionAPIType, title string) (*model.ShopifyFunction, error)
+}
+
+type FunctionServiceOp struct {
+	client *Client
+}
+
+var _ FunctionService = &FunctionServiceOp{}
+
+// FunctionAPIType is the Function API a function implements, as found in ShopifyFunction.APIType.
+type FunctionAPIType string
+
+const (
+	FunctionAPITypeProductDiscounts         FunctionAPIType = "product_discounts"
+	FunctionAPITypeOrderDiscounts           FunctionAPIType = "order_discounts"
+	FunctionAPITypeShippingDiscounts        FunctionAPIType = "shipping_discounts"
+	FunctionAPITypePaymentCustomization     FunctionAPIType = "payment_customization"
+	FunctionAPITypeDeliveryCustomization    FunctionAPIType = "delivery_customization"
+	FunctionAPITypeCartTransform            FunctionAPIType = "cart_transform"
+	FunctionAPITypeCartCheckoutValidation   FunctionAPIType = "cart_checkout_validation"
+	FunctionAPITypeFulfillmentConstraints   FunctionAPIType = "fulfillment_constraints"
+	FunctionAPITypeOrderRoutingLocationRule FunctionAPIType = "order_routing_location_rule"
+)
+
+const shopifyFunctionFields = `
+	id
+	title
+	description
+	apiType
+	apiVersion
+	appKey
+	useCreationUi
+`
+
+// List returns the functions of the app implementing apiType, or all of them when apiType is empty.
+func (s *FunctionServiceOp) List(ctx context.Context, apiType FunctionAPIType) ([]*model.ShopifyFunction, error) {
+	q := fmt.Sprintf(`
+		query shopifyFunctions($apiType: String, $after: String) {
+			shopifyFunctions(apiType: $apiType, first: 50, after: $after) {
+				edges {
+					node {
+						%s
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+				}
+			}
+		}
+	`, shopifyFunctionFields)
+
+	vars := map[string]interface{}{}
+	if apiType != "" {
+		vars["apiType"] = apiType
+	}
+
+	var res []*model.ShopifyFunction
+	for {
+		out := struct {
+			ShopifyFunctions struct {
+				Edges []struct {
+					Node   *model.ShopifyFunction `json:"node"`
+					Cursor string                 `json:"cursor"`
+				} `json:"edges"`
+				PageInfo struct {
+					HasNextPage bool `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"shopifyFunctions"`
+		}{}
+		err := s.client.gql.QueryString(ctx, q, vars, &out)
+		if err != nil {
+			return nil, fmt.Errorf("gql.QueryString: %w", err)
+		}
+
+		edges := out.ShopifyFunctions.Edges
+		for _, edge := range edges {
+			res = append(res, edge.Node)
+		}
+		if !out.ShopifyFunctions.PageInfo.HasNextPage || len(edges) == 0 {
+			return res, nil
+		}
+		vars["after"] = edges[len(edges)-1].Cursor
+	}
+}
+
+func (s *FunctionServiceOp) Get(ctx context.Context, id string) (*model.ShopifyFunction, error) {
+	q := fmt.Sprintf(`
+		query shopifyFunction($id: String!) {
+			shopifyFunction(id: $id) {
+				%s
+			}
+		}
+	`, shopifyFunctionFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		ShopifyFunction *model.ShopifyFunction `json:"shopifyFunction"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.ShopifyFunction == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "shopify function not found", nil)
+	}
+
+	return out.ShopifyFunction, nil
+}
+
+// Find returns the function of the app implementing apiType with the given title, which is the
+// name set in the function extension's configuration.
+func (s *FunctionServiceOp) Find(ctx context.Context, apiType FunctionAPIType, title string) (*model.ShopifyFunction, error) {
+	functions, err := s.List(ctx, apiType)
+	if err != nil {
+		return nil, err
+	}
+	for _, function := range functions {
+		if function.Title == title {
+			return function, nil
+		}
+	}
+
+	return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, fmt.Sprintf("shopify function %q not found", title), nil)
+}
+
+// AutomaticAppDiscountInput returns the input of DiscountService.AutomaticAppCreate for an automatic
+// discount backed by the discount function functionID.
+func AutomaticAppDiscountInput(functionID, title string, startsAt time.Time) model.DiscountAutomaticAppInput {
+	return model.DiscountAutomaticAppInput{
+		FunctionID: &functionID,
+		Title:      &title,
+		StartsAt:   &startsAt,
+	}
+}
+
+// CodeAppDiscountInput returns the input of DiscountService.CodeAppCreate for a code discount
+// redeemed with code and backed by the discount function functionID. The discount applies to all
+// customers.
+func CodeAppDiscountInput(functionID, title, code string, startsAt time.Time) model.DiscountCodeAppInput {
+	all := true
+	return model.DiscountCodeAppInput{
+		FunctionID:        &functionID,
+		Title:             &title,
+		Code:              &code,
+		StartsAt:          &startsAt,
+		CustomerSelection: &model.DiscountCustomerSelectionInput{All: &all},
+	}
+}
@@ -0,0 +1,125 @@
+// Package gid parses and formats Shopify global IDs, such as gid://shopify/Product/123.
+//
+// A global ID names a resource type and the ID of an object of that type, its legacy ID for
+// resources that exist in the REST Admin API. Some global IDs also carry parameters, e.g.
+// gid://shopify/InventoryLevel/1?inventory_item_id=2.
+package gid
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Prefix starts every Shopify global ID.
+const Prefix = "gid://shopify/"
+
+// ErrMalformed is returned, wrapped, when parsing a string that isn't a Shopify global ID.
+var ErrMalformed = errors.New("malformed gid")
+
+// GID is a parsed global ID.
+type GID struct {
+	// Resource is the type of the object, e.g. Product.
+	Resource string
+	// ID is the ID of the object within its type, usually its legacy numeric ID.
+	ID string
+	// Params are the parameters following the ID, if any.
+	Params url.Values
+}
+
+// Parse parses a global ID.
+func Parse(s string) (GID, error) {
+	rest, ok := strings.CutPrefix(s, Prefix)
+	if !ok {
+		return GID{}, fmt.Errorf("%w `%s`: missing %s prefix", ErrMalformed, s, Prefix)
+	}
+	rest, rawParams, _ := strings.Cut(rest, "?")
+	resource, id, ok := strings.Cut(rest, "/")
+	if !ok || resource == "" || id == "" || strings.Contains(id, "/") {
+		return GID{}, fmt.Errorf("%w `%s`: want %s<resource>/<id>", ErrMalformed, s, Prefix)
+	}
+	for _, c := range resource {
+		if !(c >= 'A' && c <= 'Z' || c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_') {
+			return GID{}, fmt.Errorf("%w `%s`: invalid resource %q", ErrMalformed, s, resource)
+		}
+	}
+
+	g := GID{Resource: resource, ID: id}
+	if rawParams != "" {
+		params, err := url.ParseQuery(rawParams)
+		if err != nil {
+			return GID{}, fmt.Errorf("%w `%s`: %s", ErrMalformed, s, err.Error())
+		}
+		g.Params = params
+	}
+	return g, nil
+}
+
+// MustParse is like Parse but panics if s isn't a global ID.
+func MustParse(s string) GID {
+	g, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return g
+}
+
+// String formats g as a global ID.
+func (g GID) String() string {
+	s := Prefix + g.Resource + "/" + g.ID
+	if len(g.Params) > 0 {
+		s += "?" + g.Params.Encode()
+	}
+	return s
+}
+
+// Int64 returns the ID of g as a number, for the resources whose IDs are numeric.
+func (g GID) Int64() (int64, error) {
+	return strconv.ParseInt(g.ID, 10, 64)
+}
+
+// Format returns the global ID of the object of the given resource type and ID.
+func Format[T ~int | ~int64 | ~uint64 | ~string](resource string, id T) string {
+	return GID{Resource: resource, ID: fmt.Sprint(id)}.String()
+}
+
+// Validate returns an error if s isn't a global ID, or isn't the global ID of one of resources
+// when some are given.
+func Validate(s string, resources ...string) error {
+	g, err := Parse(s)
+	if err != nil {
+		return err
+	}
+	if len(resources) == 0 {
+		return nil
+	}
+	for _, r := range resources {
+		if g.Resource == r {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w `%s`: want the ID of a %s", ErrMalformed, s, strings.Join(resources, " or "))
+}
+
+// Resource returns the resource type of a global ID, e.g. Product for gid://shopify/Product/123,
+// or "" if s isn't a global ID.
+func Resource(s string) string {
+	g, err := Parse(s)
+	if err != nil {
+		return ""
+	}
+	return g.Resource
+}
+
+// Legacy returns the legacy ID of a global ID, e.g. 123 for gid://shopify/Product/123, as search
+// queries and the REST Admin API expect. s is returned as is if it isn't a global ID, so that
+// Legacy can be applied to IDs that may already be legacy ones.
+func Legacy(s string) string {
+	g, err := Parse(s)
+	if err != nil {
+		return s
+	}
+	return g.ID
+}
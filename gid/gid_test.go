@@ -0,0 +1,84 @@
+package gid
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in       string
+		resource string
+		id       string
+		params   string
+		wantErr  bool
+	}{
+		{in: "gid://shopify/Product/123", resource: "Product", id: "123"},
+		{in: "gid://shopify/InventoryLevel/1?inventory_item_id=2", resource: "InventoryLevel", id: "1", params: "inventory_item_id=2"},
+		{in: "gid://shopify/Cart/c1-abc", resource: "Cart", id: "c1-abc"},
+		{in: "123", wantErr: true},
+		{in: "gid://shopify/Product", wantErr: true},
+		{in: "gid://shopify//123", wantErr: true},
+		{in: "gid://shopify/Product/", wantErr: true},
+		{in: "gid://shopify/Product/1/2", wantErr: true},
+		{in: "gid://other/Product/1", wantErr: true},
+	}
+	for _, tc := range tests {
+		g, err := Parse(tc.in)
+		if tc.wantErr {
+			if !errors.Is(err, ErrMalformed) {
+				t.Errorf("Parse(%q): got error %v, want ErrMalformed", tc.in, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Parse(%q): %s", tc.in, err)
+			continue
+		}
+		if g.Resource != tc.resource || g.ID != tc.id || g.Params.Encode() != tc.params {
+			t.Errorf("Parse(%q) = %+v", tc.in, g)
+		}
+		if g.String() != tc.in {
+			t.Errorf("Parse(%q).String() = %q", tc.in, g.String())
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	if got := Product(123); got != "gid://shopify/Product/123" {
+		t.Errorf("Product(123) = %q", got)
+	}
+	if got := Format("Cart", "c1-abc"); got != "gid://shopify/Cart/c1-abc" {
+		t.Errorf(`Format("Cart", "c1-abc") = %q`, got)
+	}
+	if got := Format(ResourceOrder, uint64(18446744073709551615)); got != "gid://shopify/Order/18446744073709551615" {
+		t.Errorf("Format of a uint64 = %q", got)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := Validate("gid://shopify/Product/1"); err != nil {
+		t.Errorf("Validate without resources: %s", err)
+	}
+	if err := Validate("gid://shopify/Product/1", ResourceCollection, ResourceProduct); err != nil {
+		t.Errorf("Validate of an allowed resource: %s", err)
+	}
+	if err := Validate("gid://shopify/Product/1", ResourceCollection); !errors.Is(err, ErrMalformed) {
+		t.Errorf("Validate of another resource: got %v, want ErrMalformed", err)
+	}
+}
+
+func TestResourceAndLegacy(t *testing.T) {
+	if got := Resource("gid://shopify/MediaImage/9"); got != ResourceMediaImage {
+		t.Errorf("Resource = %q", got)
+	}
+	if got := Resource("9"); got != "" {
+		t.Errorf("Resource of a legacy ID = %q", got)
+	}
+	if got := Legacy("gid://shopify/Location/123?foo=bar"); got != "123" {
+		t.Errorf("Legacy = %q", got)
+	}
+	if got := Legacy("123"); got != "123" {
+		t.Errorf("Legacy of a legacy ID = %q", got)
+	}
+}
@@ -0,0 +1,81 @@
+package gid
+
+// Resource types of the global IDs built by the helpers below.
+const (
+	ResourceCollection          = "Collection"
+	ResourceCustomer            = "Customer"
+	ResourceDraftOrder          = "DraftOrder"
+	ResourceFulfillment         = "Fulfillment"
+	ResourceFulfillmentOrder    = "FulfillmentOrder"
+	ResourceGenericFile         = "GenericFile"
+	ResourceInventoryItem       = "InventoryItem"
+	ResourceLineItem            = "LineItem"
+	ResourceLocation            = "Location"
+	ResourceMediaImage          = "MediaImage"
+	ResourceMetafield           = "Metafield"
+	ResourceOrder               = "Order"
+	ResourcePriceList           = "PriceList"
+	ResourceProduct             = "Product"
+	ResourceProductVariant      = "ProductVariant"
+	ResourcePublication         = "Publication"
+	ResourceShop                = "Shop"
+	ResourceVideo               = "Video"
+	ResourceWebhookSubscription = "WebhookSubscription"
+)
+
+// Collection returns the global ID of a collection.
+func Collection(id int64) string { return Format(ResourceCollection, id) }
+
+// Customer returns the global ID of a customer.
+func Customer(id int64) string { return Format(ResourceCustomer, id) }
+
+// DraftOrder returns the global ID of a draft order.
+func DraftOrder(id int64) string { return Format(ResourceDraftOrder, id) }
+
+// Fulfillment returns the global ID of a fulfillment.
+func Fulfillment(id int64) string { return Format(ResourceFulfillment, id) }
+
+// FulfillmentOrder returns the global ID of a fulfillment order.
+func FulfillmentOrder(id int64) string { return Format(ResourceFulfillmentOrder, id) }
+
+// GenericFile returns the global ID of a generic file.
+func GenericFile(id int64) string { return Format(ResourceGenericFile, id) }
+
+// InventoryItem returns the global ID of an inventory item.
+func InventoryItem(id int64) string { return Format(ResourceInventoryItem, id) }
+
+// LineItem returns the global ID of an order line item.
+func LineItem(id int64) string { return Format(ResourceLineItem, id) }
+
+// Location returns the global ID of a location.
+func Location(id int64) string { return Format(ResourceLocation, id) }
+
+// MediaImage returns the global ID of an image file or product image.
+func MediaImage(id int64) string { return Format(ResourceMediaImage, id) }
+
+// Metafield returns the global ID of a metafield.
+func Metafield(id int64) string { return Format(ResourceMetafield, id) }
+
+// Order returns the global ID of an order.
+func Order(id int64) string { return Format(ResourceOrder, id) }
+
+// PriceList returns the global ID of a price list.
+func PriceList(id int64) string { return Format(ResourcePriceList, id) }
+
+// Product returns the global ID of a product.
+func Product(id int64) string { return Format(ResourceProduct, id) }
+
+// ProductVariant returns the global ID of a product variant.
+func ProductVariant(id int64) string { return Format(ResourceProductVariant, id) }
+
+// Publication returns the global ID of a publication.
+func Publication(id int64) string { return Format(ResourcePublication, id) }
+
+// Shop returns the global ID of a shop.
+func Shop(id int64) string { return Format(ResourceShop, id) }
+
+// Video returns the global ID of a video file.
+func Video(id int64) string { return Format(ResourceVideo, id) }
+
+// WebhookSubscription returns the global ID of a webhook subscription.
+func WebhookSubscription(id int64) string { return Format(ResourceWebhookSubscription, id) }
@@ -61,6 +61,41 @@ func WithPrivateAppAuth(apiKey string, password string) Option {
 	}
 }
 
+// WithTransport optionally sets the RoundTripper sending the requests, http.DefaultTransport by
+// default. It is mostly useful to point the client at a test server.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(t *transport) {
+		t.base = rt
+	}
+}
+
+// Middleware wraps the RoundTripper sending the requests of a client, e.g. to log or record them.
+// The requests it sees already carry the authentication headers.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// WithMiddleware optionally wraps the requests of the client with mws. The first middleware is
+// the outermost one.
+func WithMiddleware(mws ...Middleware) Option {
+	return func(t *transport) {
+		t.middlewares = append(t.middlewares, mws...)
+	}
+}
+
+// WithJSONCodec optionally sets the JSON implementation of the client, encoding/json by default.
+// The bulk operation service of shopify.Client parses results with it too.
+func WithJSONCodec(codec graphql.Codec) Option {
+	return func(t *transport) {
+		t.codec = codec
+	}
+}
+
+// WithCache optionally enables the read-through cache of the client, see graphql.Cache.
+func WithCache(cache *graphql.Cache) Option {
+	return func(t *transport) {
+		t.cache = cache
+	}
+}
+
 type transport struct {
 	accessToken           string
 	storeFrontAccessToken string
@@ -68,6 +103,11 @@ type transport struct {
 	password              string
 	apiVersion            string
 	apiPath               string
+	base                  http.RoundTripper
+	middlewares           []Middleware
+	next                  http.RoundTripper
+	codec                 graphql.Codec
+	cache                 *graphql.Cache
 }
 
 func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
@@ -79,6 +119,12 @@ func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
 		req.Header.Set(shopifyStoreFrontAccessTokenHeader, t.storeFrontAccessToken)
 	}
 
+	if t.next != nil {
+		return t.next.RoundTrip(req)
+	}
+	if t.base != nil {
+		return t.base.RoundTrip(req)
+	}
 	return http.DefaultTransport.RoundTrip(req)
 }
 
@@ -92,13 +138,32 @@ func NewClient(shopifyDomain string, opts ...Option) *graphql.Client {
 	for _, opt := range opts {
 		opt(trans)
 	}
+	if len(trans.middlewares) > 0 {
+		trans.next = trans.base
+		if trans.next == nil {
+			trans.next = roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				return http.DefaultTransport.RoundTrip(req)
+			})
+		}
+		for i := len(trans.middlewares) - 1; i >= 0; i-- {
+			trans.next = trans.middlewares[i](trans.next)
+		}
+	}
 
 	httpClient := &http.Client{Transport: trans}
 	url := buildAPIEndpoint(shopifyDomain, trans.apiPath, trans.apiVersion)
 	graphClient := graphql.NewClient(url, httpClient)
+	graphClient.SetJSONCodec(trans.codec)
+	graphClient.SetCache(trans.cache)
 	return graphClient
 }
 
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
 func buildAPIEndpoint(domain string, path string, version string) string {
 	if version == defaultAPIVersion {
 		return fmt.Sprintf("%s://%s/%s/%s", apiProtocol, domain, path, apiEndpoint)
@@ -0,0 +1,287 @@
+package graphql
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// DefaultCacheTTL is how long responses are cached when Cache.TTL is 0.
+const DefaultCacheTTL = 5 * time.Minute
+
+// cacheKeyPrefix starts the keys of the client in a Store, which may be shared with other data.
+const cacheKeyPrefix = "shopify-graphql:"
+
+// DefaultCachedFields are the root fields of the queries cached when Cache.Fields is nil. They
+// change rarely and are read on hot paths.
+var DefaultCachedFields = []string{
+	"shop",
+	"publications",
+	"publication",
+	"locations",
+	"location",
+	"metafieldDefinitions",
+	"metafieldDefinition",
+}
+
+// DefaultCacheInvalidations are the root fields invalidated by mutations when
+// Cache.Invalidations is nil, by mutation.
+var DefaultCacheInvalidations = map[string][]string{
+	"publicationCreate":         {"publications", "publication"},
+	"publicationUpdate":         {"publications", "publication"},
+	"publicationDelete":         {"publications", "publication"},
+	"locationAdd":               {"locations", "location"},
+	"locationEdit":              {"locations", "location"},
+	"locationActivate":          {"locations", "location"},
+	"locationDeactivate":        {"locations", "location"},
+	"locationDelete":            {"locations", "location"},
+	"metafieldDefinitionCreate": {"metafieldDefinitions", "metafieldDefinition"},
+	"metafieldDefinitionUpdate": {"metafieldDefinitions", "metafieldDefinition"},
+	"metafieldDefinitionDelete": {"metafieldDefinitions", "metafieldDefinition"},
+	"metafieldDefinitionPin":    {"metafieldDefinitions", "metafieldDefinition"},
+	"metafieldDefinitionUnpin":  {"metafieldDefinitions", "metafieldDefinition"},
+	"metafieldsSet":             {"shop"},
+	"metafieldsDelete":          {"shop"},
+	"metafieldDelete":           {"shop"},
+}
+
+// Store stores the responses cached by a client. It must be safe for concurrent use.
+// NewMemoryStore returns an in-memory Store; a Redis Store is a thin wrapper of GET and SET with
+// an expiry, reporting redis.Nil as a miss.
+type Store interface {
+	// Get returns the value of key, ok being false if key isn't set or has expired.
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+	// Set sets the value of key, expiring after ttl.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+}
+
+// Cache configures the read-through cache of a client. Only queries whose root fields are all
+// cached fields are cached, per endpoint, query, variables and @inContext directive. The endpoint
+// URL tells the shop, the Admin or Storefront API and its version apart. Errors of the Store are
+// not returned: the requests are sent as if nothing was cached.
+type Cache struct {
+	// Store stores the cached responses.
+	Store Store
+	// TTL is how long responses are cached, DefaultCacheTTL if 0.
+	TTL time.Duration
+	// Fields are the cached root fields, DefaultCachedFields if nil.
+	Fields []string
+	// Invalidations are the cached root fields invalidated by the mutations of the client, by
+	// mutation, DefaultCacheInvalidations if nil. Changes made by other clients are only seen
+	// once the cached responses expire, or after InvalidateCache.
+	Invalidations map[string][]string
+}
+
+// clientCache is a Cache with its defaults resolved.
+type clientCache struct {
+	store         Store
+	ttl           time.Duration
+	fields        map[string]bool
+	invalidations map[string][]string
+}
+
+// SetCache enables the read-through cache of the client, or disables it when cache is nil or has
+// no Store.
+func (c *Client) SetCache(cache *Cache) {
+	c.mustBeUnused("SetCache")
+	if cache == nil || cache.Store == nil {
+		c.cache = nil
+		return
+	}
+	cc := &clientCache{
+		store:         cache.Store,
+		ttl:           cache.TTL,
+		fields:        map[string]bool{},
+		invalidations: cache.Invalidations,
+	}
+	if cc.ttl == 0 {
+		cc.ttl = DefaultCacheTTL
+	}
+	fields := cache.Fields
+	if fields == nil {
+		fields = DefaultCachedFields
+	}
+	for _, f := range fields {
+		cc.fields[f] = true
+	}
+	if cc.invalidations == nil {
+		cc.invalidations = DefaultCacheInvalidations
+	}
+	c.cache = cc
+}
+
+// InvalidateCache invalidates the cached queries of the endpoint of the client selecting any of
+// fields, e.g. after changing locations from another client.
+func (c *Client) InvalidateCache(ctx context.Context, fields ...string) error {
+	if c.cache == nil {
+		return nil
+	}
+	// invalidated responses are left to expire: the generation of their fields is part of their
+	// key, so a new one makes them unreachable
+	gen := []byte(strconv.FormatInt(time.Now().UnixNano(), 36))
+	for _, f := range fields {
+		if err := c.cache.store.Set(ctx, c.generationKey(f), gen, c.cache.ttl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// doCached executes a single GraphQL operation through the cache of the client.
+func (c *Client) doCached(ctx context.Context, query string, variables map[string]interface{}, v interface{}) error {
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return c.fetch(ctx, query, variables, v)
+	}
+	op, err := soleOperation(doc)
+	if err != nil {
+		return c.fetch(ctx, query, variables, v)
+	}
+
+	if op.Operation == ast.Mutation {
+		err = c.fetch(ctx, query, variables, v)
+		// a failed mutation may still have changed some objects
+		var invalidated []string
+		for _, sel := range op.SelectionSet {
+			if f, ok := sel.(*ast.Field); ok {
+				invalidated = append(invalidated, c.cache.invalidations[f.Name]...)
+			}
+		}
+		_ = c.InvalidateCache(ctx, invalidated...)
+		return err
+	}
+
+	fields, ok := c.cachedFields(op)
+	if !ok {
+		return c.fetch(ctx, query, variables, v)
+	}
+	key, err := c.cacheKey(ctx, query, variables, fields)
+	if err != nil {
+		return c.fetch(ctx, query, variables, v)
+	}
+	if data, ok, err := c.cache.store.Get(ctx, key); err == nil && ok {
+		if c.json().Unmarshal(data, v) == nil {
+			return nil
+		}
+	}
+
+	var data json.RawMessage
+	err = c.fetch(ctx, query, variables, &data)
+	if len(data) > 0 {
+		if uerr := c.json().Unmarshal(data, v); uerr != nil && err == nil {
+			return uerr
+		}
+	}
+	if err == nil && len(data) > 0 {
+		_ = c.cache.store.Set(ctx, key, data, c.cache.ttl)
+	}
+	return err
+}
+
+// cachedFields returns the root fields of query op, ok being false unless they are all cached.
+func (c *Client) cachedFields(op *ast.OperationDefinition) (fields []string, ok bool) {
+	if op.Operation != ast.Query {
+		return nil, false
+	}
+	for _, sel := range op.SelectionSet {
+		f, isField := sel.(*ast.Field)
+		if !isField {
+			return nil, false
+		}
+		if f.Name == "__typename" {
+			continue
+		}
+		if !c.cache.fields[f.Name] {
+			return nil, false
+		}
+		fields = append(fields, f.Name)
+	}
+	return fields, len(fields) > 0
+}
+
+// cacheKey returns the key of the response of query in the Store, which changes when any of the
+// fields it selects is invalidated.
+func (c *Client) cacheKey(ctx context.Context, query string, variables map[string]interface{}, fields []string) (string, error) {
+	query, err := addInContext(ctx, query)
+	if err != nil {
+		return "", err
+	}
+	// encoding/json sorts the keys of maps, unlike some codecs
+	vars, err := json.Marshal(variables)
+	if err != nil {
+		return "", err
+	}
+
+	h := sha256.New()
+	h.Write([]byte(query))
+	h.Write([]byte{0})
+	h.Write(vars)
+	for _, f := range fields {
+		gen, _, err := c.cache.store.Get(ctx, c.generationKey(f))
+		if err != nil {
+			return "", err
+		}
+		h.Write([]byte{0})
+		h.Write(gen)
+	}
+	return cacheKeyPrefix + c.url + ":" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// generationKey returns the key of the generation of the cached responses of field. It expires
+// with the responses, so that no response from before an invalidation outlives it.
+func (c *Client) generationKey(field string) string {
+	return cacheKeyPrefix + c.url + ":gen:" + field
+}
+
+// MemoryStore is a Store keeping values in memory, for a single process.
+type MemoryStore struct {
+	mu        sync.Mutex
+	entries   map[string]memoryEntry
+	nextSweep int
+}
+
+type memoryEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{entries: map[string]memoryEntry{}, nextSweep: 64}
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.entries[key]
+	if !ok || time.Now().After(e.expires) {
+		return nil, false, nil
+	}
+	return e.value, true, nil
+}
+
+// Set implements Store.
+func (s *MemoryStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	s.entries[key] = memoryEntry{value: append([]byte(nil), value...), expires: now.Add(ttl)}
+	// expired entries are swept as the store grows, rather than by a goroutine
+	if len(s.entries) >= s.nextSweep {
+		for k, e := range s.entries {
+			if now.After(e.expires) {
+				delete(s.entries, k)
+			}
+		}
+		s.nextSweep = max(64, 2*len(s.entries))
+	}
+	return nil
+}
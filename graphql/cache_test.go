@@ -0,0 +1,133 @@
+package graphql
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCache(t *testing.T) {
+	requests := map[string]int{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		switch {
+		case strings.Contains(string(body), "metafieldDefinitionCreate"):
+			requests["mutation"]++
+			_, _ = w.Write([]byte(`{"data":{"metafieldDefinitionCreate":{"userErrors":[]}}}`))
+		case strings.Contains(string(body), "metafieldDefinitions"):
+			requests["definitions"]++
+			_, _ = w.Write([]byte(`{"data":{"metafieldDefinitions":{"nodes":[{"key":"a"}]}}}`))
+		case strings.Contains(string(body), "products"):
+			requests["products"]++
+			_, _ = w.Write([]byte(`{"data":{"products":{"nodes":[]}}}`))
+		default:
+			requests["shop"]++
+			_, _ = w.Write([]byte(`{"data":{"shop":{"name":"test"}}}`))
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	c := NewClient(server.URL+"/admin/api/graphql.json", server.Client())
+	c.SetCache(&Cache{Store: NewMemoryStore()})
+
+	query := func(q string, vars map[string]interface{}) {
+		t.Helper()
+		var out map[string]interface{}
+		if err := c.QueryString(ctx, q, vars, &out); err != nil {
+			t.Fatalf("QueryString: %s", err)
+		}
+		if len(out) == 0 {
+			t.Fatalf("QueryString %s got no data", q)
+		}
+	}
+
+	for i := 0; i < 3; i++ {
+		query("{ shop { name } }", nil)
+		query("query($owner: MetafieldOwnerType!) { metafieldDefinitions(first: 10, ownerType: $owner) { nodes { key } } }",
+			map[string]interface{}{"owner": "PRODUCT"})
+		query("{ products(first: 1) { nodes { id } } }", nil)
+	}
+	if requests["shop"] != 1 || requests["definitions"] != 1 || requests["products"] != 3 {
+		t.Errorf("got requests %v, want 1 shop, 1 definitions and 3 products", requests)
+	}
+
+	query("query($owner: MetafieldOwnerType!) { metafieldDefinitions(first: 10, ownerType: $owner) { nodes { key } } }",
+		map[string]interface{}{"owner": "COLLECTION"})
+	if requests["definitions"] != 2 {
+		t.Errorf("got %d definitions requests, want 2 as variables differ", requests["definitions"])
+	}
+
+	var out map[string]interface{}
+	if err := c.MutateString(ctx, `mutation { metafieldDefinitionCreate(definition: {}) { userErrors { message } } }`, nil, &out); err != nil {
+		t.Fatalf("MutateString: %s", err)
+	}
+	query("query($owner: MetafieldOwnerType!) { metafieldDefinitions(first: 10, ownerType: $owner) { nodes { key } } }",
+		map[string]interface{}{"owner": "PRODUCT"})
+	query("{ shop { name } }", nil)
+	if requests["definitions"] != 3 || requests["shop"] != 1 {
+		t.Errorf("got requests %v after mutation, want definitions invalidated only", requests)
+	}
+
+	if err := c.InvalidateCache(ctx, "shop"); err != nil {
+		t.Fatalf("InvalidateCache: %s", err)
+	}
+	query("{ shop { name } }", nil)
+	if requests["shop"] != 2 {
+		t.Errorf("got %d shop requests after InvalidateCache, want 2", requests["shop"])
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore()
+	_ = s.Set(ctx, "a", []byte("1"), time.Hour)
+	_ = s.Set(ctx, "b", []byte("2"), -time.Second)
+	if v, ok, _ := s.Get(ctx, "a"); !ok || string(v) != "1" {
+		t.Errorf("got %q, %v for a, want 1", v, ok)
+	}
+	if _, ok, _ := s.Get(ctx, "b"); ok {
+		t.Error("got expired b")
+	}
+	for i := 0; i < 100; i++ {
+		_ = s.Set(ctx, "b", []byte("2"), -time.Second)
+		_ = s.Set(ctx, strings.Repeat("c", i+1), nil, -time.Second)
+	}
+	if n := len(s.entries); n >= 100 {
+		t.Errorf("got %d entries, want expired ones swept", n)
+	}
+}
+
+func TestCacheIsPerEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"shop":{"name":"` + r.URL.Path + `"}}}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	store := NewMemoryStore()
+	query := func(path string) string {
+		t.Helper()
+		c := NewClient(server.URL+path, server.Client())
+		c.SetCache(&Cache{Store: store})
+		var out struct {
+			Shop struct {
+				Name string `json:"name"`
+			} `json:"shop"`
+		}
+		if err := c.QueryString(ctx, "{ shop { name } }", nil, &out); err != nil {
+			t.Fatalf("QueryString: %s", err)
+		}
+		return out.Shop.Name
+	}
+
+	for _, path := range []string{"/admin/api/2024-04/graphql.json", "/api/2024-04/graphql.json", "/admin/api/2024-07/graphql.json"} {
+		if got := query(path); got != path {
+			t.Errorf("got the response of %s for %s", got, path)
+		}
+	}
+}
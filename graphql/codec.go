@@ -0,0 +1,45 @@
+package graphql
+
+import (
+	"encoding/json"
+)
+
+// Codec is a JSON implementation used to encode requests and decode responses. jsoniter.API,
+// e.g. jsoniter.ConfigFastest, and sonic.API, e.g. sonic.ConfigDefault, implement it, so they can
+// be benchmarked against encoding/json without a wrapper.
+type Codec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// StdJSON is the Codec of encoding/json, used by default.
+var StdJSON Codec = stdJSON{}
+
+type stdJSON struct{}
+
+func (stdJSON) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (stdJSON) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// SetJSONCodec sets the JSON implementation of the client, StdJSON when codec is nil. Deferred
+// queries always use encoding/json, as they merge their incremental parts as generic maps.
+func (c *Client) SetJSONCodec(codec Codec) {
+	c.mustBeUnused("SetJSONCodec")
+	c.codec = codec
+}
+
+// JSONCodec returns the Codec set with SetJSONCodec, or nil if the client uses the default one.
+func (c *Client) JSONCodec() Codec {
+	return c.codec
+}
+
+func (c *Client) json() Codec {
+	if c.codec == nil {
+		return StdJSON
+	}
+	return c.codec
+}
@@ -0,0 +1,45 @@
+package graphql
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type countingCodec struct {
+	marshals, unmarshals int
+}
+
+func (c *countingCodec) Marshal(v interface{}) ([]byte, error) {
+	c.marshals++
+	return StdJSON.Marshal(v)
+}
+
+func (c *countingCodec) Unmarshal(data []byte, v interface{}) error {
+	c.unmarshals++
+	return StdJSON.Unmarshal(data, v)
+}
+
+func TestSetJSONCodec(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"shop":{"name":"test"}}}`))
+	}))
+	defer server.Close()
+
+	codec := &countingCodec{}
+	c := NewClient(server.URL, server.Client())
+	c.SetJSONCodec(codec)
+
+	var out struct{ Shop struct{ Name string } }
+	if err := c.QueryString(context.Background(), "{ shop { name } }", nil, &out); err != nil {
+		t.Fatalf("QueryString: %s", err)
+	}
+	if out.Shop.Name != "test" {
+		t.Errorf("got shop name %q, want test", out.Shop.Name)
+	}
+	// the request, the response, then its data
+	if codec.marshals != 1 || codec.unmarshals != 2 {
+		t.Errorf("got %d marshals and %d unmarshals, want 1 and 2", codec.marshals, codec.unmarshals)
+	}
+}
@@ -0,0 +1,157 @@
+package graphql
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// MaxQueryCost is the maximum cost of a single query accepted by Shopify.
+const MaxQueryCost = 1000
+
+// mutationCost is the cost of each mutation field.
+const mutationCost = 10
+
+// EstimateCost estimates the requested cost Shopify calculates for query, resolving the sizes of
+// connections passed as variables. Without the schema, the estimate counts 1 for every field
+// with a selection set, 0 for other fields, 2 plus the cost of first or last nodes for every
+// connection, and 10 for every mutation field. Only the most expensive of the fragments of a
+// selection set is counted, as they usually select different types.
+func EstimateCost(query string, variables map[string]interface{}) (int, error) {
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return 0, fmt.Errorf("parse query: %w", err)
+	}
+	op, err := soleOperation(doc)
+	if err != nil {
+		return 0, err
+	}
+	e := costEstimator{doc: doc, vars: variables}
+	if op.Operation == ast.Mutation {
+		return mutationCost * len(op.SelectionSet), nil
+	}
+	return e.selectionCost(op.SelectionSet), nil
+}
+
+func soleOperation(doc *ast.QueryDocument) (*ast.OperationDefinition, error) {
+	if len(doc.Operations) != 1 {
+		return nil, fmt.Errorf("query has %d operations, want 1", len(doc.Operations))
+	}
+	return doc.Operations[0], nil
+}
+
+type costEstimator struct {
+	doc  *ast.QueryDocument
+	vars map[string]interface{}
+}
+
+func (e costEstimator) selectionCost(set ast.SelectionSet) int {
+	cost, fragmentCost := 0, 0
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			cost += e.fieldCost(sel)
+		case *ast.InlineFragment:
+			fragmentCost = max(fragmentCost, e.selectionCost(sel.SelectionSet))
+		case *ast.FragmentSpread:
+			if def := e.doc.Fragments.ForName(sel.Name); def != nil {
+				fragmentCost = max(fragmentCost, e.selectionCost(def.SelectionSet))
+			}
+		}
+	}
+	return cost + fragmentCost
+}
+
+func (e costEstimator) fieldCost(f *ast.Field) int {
+	if len(f.SelectionSet) == 0 {
+		return 0
+	}
+	if isConnection(f) {
+		return 2 + e.connectionSize(f)*e.nodeCost(f)
+	}
+	return 1 + e.selectionCost(f.SelectionSet)
+}
+
+// nodeCost returns the cost of one node of connection f.
+func (e costEstimator) nodeCost(f *ast.Field) int {
+	return 1 + e.selectionCost(nodeSelection(f))
+}
+
+// connectionSize returns the first or last argument of connection f, 1 when neither is given.
+func (e costEstimator) connectionSize(f *ast.Field) int {
+	for _, name := range []string{"first", "last"} {
+		arg := f.Arguments.ForName(name)
+		if arg == nil {
+			continue
+		}
+		v, err := arg.Value.Value(e.vars)
+		if err != nil {
+			continue
+		}
+		if n, ok := toInt(v); ok {
+			return n
+		}
+	}
+	return 1
+}
+
+// isConnection reports whether f selects the edges, nodes or page info of a connection.
+func isConnection(f *ast.Field) bool {
+	for _, sel := range f.SelectionSet {
+		if sf, ok := sel.(*ast.Field); ok && (sf.Name == "edges" || sf.Name == "nodes" || sf.Name == "pageInfo") {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeSelection returns the selections of the nodes of connection f, under edges.node and nodes.
+func nodeSelection(f *ast.Field) ast.SelectionSet {
+	var set ast.SelectionSet
+	for _, sel := range f.SelectionSet {
+		sf, ok := sel.(*ast.Field)
+		if !ok {
+			continue
+		}
+		switch sf.Name {
+		case "nodes":
+			set = append(set, sf.SelectionSet...)
+		case "edges":
+			for _, esel := range sf.SelectionSet {
+				if ef, ok := esel.(*ast.Field); ok && ef.Name == "node" {
+					set = append(set, ef.SelectionSet...)
+				}
+			}
+		}
+	}
+	return set
+}
+
+// toInt converts the value of an Int argument, as given in the variables, to an int.
+func toInt(v interface{}) (int, bool) {
+	switch v := v.(type) {
+	case json.Number:
+		n, err := strconv.Atoi(v.String())
+		return n, err == nil
+	case string:
+		return 0, false
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(rv.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int(rv.Uint()), true
+	case reflect.Float32, reflect.Float64:
+		return int(rv.Float()), true
+	case reflect.Ptr:
+		if !rv.IsNil() {
+			return toInt(rv.Elem().Interface())
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,75 @@
+package graphql
+
+import (
+	"testing"
+)
+
+func TestEstimateCost(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		vars  map[string]interface{}
+		want  int
+	}{
+		{"scalars", `{ shop { name email } }`, nil, 1},
+		{"connection", `{ products(first: 10) { edges { node { id title } } } }`, nil, 2 + 10*1},
+		{
+			"nested connection",
+			`{ products(first: 10) { nodes { id variants(first: 5) { nodes { id image { url } } } } } }`,
+			nil,
+			2 + 10*(1+2+5*(1+1)),
+		},
+		{"size from variables", `query($n: Int!) { orders(first: $n) { nodes { id } } }`, map[string]interface{}{"n": 50}, 2 + 50},
+		{"most expensive fragment", `{ node(id: "1") { ... on Product { seo { title } } ... on Collection { image { url } seo { title } } } }`, nil, 1 + 2},
+		{
+			"named fragment",
+			`query { collections(first: 2) { nodes { ...C } } } fragment C on Collection { image { url } }`,
+			nil,
+			2 + 2*(1+1),
+		},
+		{"mutation", `mutation { a: tagsAdd(id: "1", tags: ["x"]) { node { id } } b: tagsAdd(id: "2", tags: ["x"]) { node { id } } }`, nil, 20},
+	}
+	for _, tc := range tests {
+		got, err := EstimateCost(tc.query, tc.vars)
+		if err != nil {
+			t.Errorf("%s: %s", tc.name, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("%s: got cost %d, want %d", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestPlanSplit(t *testing.T) {
+	c := NewClient("https://example.myshopify.com/admin/api/graphql.json", nil)
+	query := `query($n: Int!, $handle: String!) {
+		shop { name }
+		product: productByHandle(handle: $handle) { id seo { title } }
+		products(first: $n, query: "status:active") { edges { node { id featuredImage { url } } } }
+	}`
+
+	if plan := c.planSplit(query, map[string]interface{}{"n": 100, "handle": "x"}); plan != nil {
+		t.Errorf("query within budget got split: %+v", plan)
+	}
+
+	plan := c.planSplit(query, map[string]interface{}{"n": 600, "handle": "x"})
+	if plan == nil {
+		t.Fatal("query over budget wasn't split")
+	}
+	if len(plan.groups) != 1 || len(plan.groups[0].variables) != 1 || plan.groups[0].variables[0] != "handle" {
+		t.Errorf("got groups %+v, want one group declaring $handle", plan.groups)
+	}
+	if len(plan.paged) != 1 {
+		t.Fatalf("got %d paged connections, want 1", len(plan.paged))
+	}
+	p := plan.paged[0]
+	// each product costs 2, with its featured image
+	if p.key != "products" || p.size != 600 || p.pageSize != (MaxQueryCost-2)/2 {
+		t.Errorf("got paged connection %s of %d nodes in pages of %d", p.key, p.size, p.pageSize)
+	}
+	if len(p.nodeKeys) != 1 || p.nodeKeys[0] != "edges" {
+		t.Errorf("got node keys %v, want [edges]", p.nodeKeys)
+	}
+
+}
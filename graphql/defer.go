@@ -0,0 +1,217 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+)
+
+// deferAccept asks for an incremental delivery response, falling back to a single JSON document
+// when the server does not support @defer.
+const deferAccept = "multipart/mixed; deferSpec=20220824, application/json"
+
+// incrementalPart is one part of a multipart/mixed incremental delivery response. The first part
+// carries the initial data, the following ones the deferred fragments.
+type incrementalPart struct {
+	Data        json.RawMessage `json:"data"`
+	Errors      graphErrors     `json:"errors"`
+	HasNext     bool            `json:"hasNext"`
+	Incremental []struct {
+		Data   json.RawMessage `json:"data"`
+		Path   []interface{}   `json:"path"`
+		Errors graphErrors     `json:"errors"`
+	} `json:"incremental"`
+}
+
+// QueryDeferred executes a query that uses the @defer directive, as supported by the Storefront API.
+// v is populated with the initial payload first, then updated in place as every deferred fragment
+// arrives. onUpdate, if not nil, is called after each update with whether more fragments are
+// expected; returning an error stops reading the response. Deferred queries are not retried.
+func (c *Client) QueryDeferred(ctx context.Context, q string, variables map[string]interface{}, v interface{}, onUpdate func(hasNext bool) error) error {
+	c.used.Store(true)
+	query, err := addInContext(ctx, q)
+	if err != nil {
+		return err
+	}
+	in := struct {
+		Query     string                 `json:"query"`
+		Variables map[string]interface{} `json:"variables,omitempty"`
+	}{
+		Query:     query,
+		Variables: variables,
+	}
+	var buf bytes.Buffer
+	if err = json.NewEncoder(&buf).Encode(in); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, &buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", deferAccept)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if err = responseStatusError(ctx, resp); err != nil {
+		return err
+	}
+
+	mediaType, params, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil || !strings.HasPrefix(mediaType, "multipart/") {
+		// The server resolved the deferred fragments inline.
+		var part incrementalPart
+		if err = json.NewDecoder(resp.Body).Decode(&part); err != nil {
+			return fmt.Errorf("JSON decode response: %w", err)
+		}
+		return newIncrementalResult(v, onUpdate).apply(part)
+	}
+
+	result := newIncrementalResult(v, onUpdate)
+	reader := multipart.NewReader(resp.Body, params["boundary"])
+	for {
+		p, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read multipart response: %w", err)
+		}
+		var part incrementalPart
+		err = json.NewDecoder(p).Decode(&part)
+		if err == io.EOF {
+			// Heartbeat or empty closing part.
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("JSON decode response part: %w", err)
+		}
+		if err = result.apply(part); err != nil {
+			return err
+		}
+		if !part.HasNext {
+			break
+		}
+	}
+	return result.err()
+}
+
+// incrementalResult accumulates the parts of an incremental response into a single document.
+type incrementalResult struct {
+	v        interface{}
+	onUpdate func(hasNext bool) error
+	data     interface{}
+	errors   graphErrors
+}
+
+func newIncrementalResult(v interface{}, onUpdate func(hasNext bool) error) *incrementalResult {
+	return &incrementalResult{v: v, onUpdate: onUpdate}
+}
+
+func (r *incrementalResult) apply(part incrementalPart) error {
+	r.errors = append(r.errors, part.Errors...)
+	changed := false
+	if len(part.Data) > 0 && string(part.Data) != "null" {
+		var data interface{}
+		if err := json.Unmarshal(part.Data, &data); err != nil {
+			return fmt.Errorf("unmarshal data: %w", err)
+		}
+		r.data = data
+		changed = true
+	}
+	for _, inc := range part.Incremental {
+		r.errors = append(r.errors, inc.Errors...)
+		if len(inc.Data) == 0 || string(inc.Data) == "null" {
+			continue
+		}
+		var data interface{}
+		if err := json.Unmarshal(inc.Data, &data); err != nil {
+			return fmt.Errorf("unmarshal incremental data: %w", err)
+		}
+		target, err := lookupPath(r.data, inc.Path)
+		if err != nil {
+			return err
+		}
+		mergeObjects(target, data)
+		changed = true
+	}
+
+	if changed && r.v != nil {
+		merged, err := json.Marshal(r.data)
+		if err != nil {
+			return err
+		}
+		if err = json.Unmarshal(merged, r.v); err != nil {
+			return fmt.Errorf("unmarshal data: %w", err)
+		}
+	}
+	if r.onUpdate != nil {
+		if err := r.onUpdate(part.HasNext); err != nil {
+			return err
+		}
+	}
+	if !part.HasNext {
+		return r.err()
+	}
+	return nil
+}
+
+func (r *incrementalResult) err() error {
+	if len(r.errors) > 0 {
+		return r.errors
+	}
+	return nil
+}
+
+// lookupPath returns the object at path, made of field names and list indexes, in data.
+func lookupPath(data interface{}, path []interface{}) (map[string]interface{}, error) {
+	current := data
+	for _, segment := range path {
+		switch key := segment.(type) {
+		case string:
+			obj, ok := current.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("incremental path %v: %q is not in an object", path, key)
+			}
+			current = obj[key]
+		case float64:
+			list, ok := current.([]interface{})
+			if !ok || int(key) < 0 || int(key) >= len(list) {
+				return nil, fmt.Errorf("incremental path %v: index %v out of range", path, key)
+			}
+			current = list[int(key)]
+		default:
+			return nil, fmt.Errorf("incremental path %v: unexpected segment %v", path, segment)
+		}
+	}
+	obj, ok := current.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("incremental path %v does not point to an object", path)
+	}
+	return obj, nil
+}
+
+// mergeObjects deep merges the fields of src into dst.
+func mergeObjects(dst map[string]interface{}, src interface{}) {
+	fields, ok := src.(map[string]interface{})
+	if !ok {
+		return
+	}
+	for key, value := range fields {
+		existing, isObj := dst[key].(map[string]interface{})
+		if incoming, ok := value.(map[string]interface{}); ok && isObj {
+			mergeObjects(existing, incoming)
+			continue
+		}
+		dst[key] = value
+	}
+}
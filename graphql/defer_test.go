@@ -0,0 +1,73 @@
+package graphql
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestQueryDeferred(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Accept"); got != deferAccept {
+			t.Errorf("got Accept %q, want %q", got, deferAccept)
+		}
+		w.Header().Set("Content-Type", `multipart/mixed; boundary="graphql"`)
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte("\r\n--graphql\r\nContent-Type: application/json\r\n\r\n" +
+			`{"data":{"cart":{"id":"gid://shopify/Cart/1","lines":[{"id":"a"}]}},"hasNext":true}` +
+			"\r\n--graphql\r\nContent-Type: application/json\r\n\r\n" +
+			`{"incremental":[{"path":["cart"],"data":{"deliveryGroups":[{"id":"g"}]}},{"path":["cart","lines",0],"data":{"quantity":2}}],"hasNext":false}` +
+			"\r\n--graphql--\r\n"))
+	}))
+	defer server.Close()
+
+	var out struct {
+		Cart struct {
+			ID    string `json:"id"`
+			Lines []struct {
+				ID       string `json:"id"`
+				Quantity int    `json:"quantity"`
+			} `json:"lines"`
+			DeliveryGroups []struct {
+				ID string `json:"id"`
+			} `json:"deliveryGroups"`
+		} `json:"cart"`
+	}
+	var updates []bool
+	c := NewClient(server.URL, server.Client())
+	err := c.QueryDeferred(context.Background(), `{ cart(id: "1") { id ... @defer { deliveryGroups { id } } } }`, nil, &out, func(hasNext bool) error {
+		updates = append(updates, hasNext)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(updates) != 2 || !updates[0] || updates[1] {
+		t.Errorf("got updates %v, want [true false]", updates)
+	}
+	if out.Cart.ID != "gid://shopify/Cart/1" || len(out.Cart.DeliveryGroups) != 1 || out.Cart.Lines[0].Quantity != 2 {
+		t.Errorf("unexpected result %+v", out.Cart)
+	}
+}
+
+func TestQueryDeferredInline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"data":{"shop":{"name":"x"}}}`))
+	}))
+	defer server.Close()
+
+	var out struct {
+		Shop struct {
+			Name string `json:"name"`
+		} `json:"shop"`
+	}
+	c := NewClient(server.URL, server.Client())
+	if err := c.QueryDeferred(context.Background(), `{ shop { name } }`, nil, &out, nil); err != nil {
+		t.Fatal(err)
+	}
+	if out.Shop.Name != "x" {
+		t.Errorf("got name %q, want x", out.Shop.Name)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sync/atomic"
 	"time"
 
 	"github.com/gempages/go-helper/errors"
@@ -22,11 +23,18 @@ import (
 
 const MaxCostExceeded = "MAX_COST_EXCEEDED"
 
-// Client is a GraphQL client.
+// Client is a GraphQL client. It is safe for concurrent use by multiple goroutines. Its settings
+// are immutable once it is used: its Set methods must be called before its first request, and
+// panic afterwards.
 type Client struct {
 	url        string // GraphQL server URL.
 	httpClient *http.Client
 	retries    int
+	hooks      Hooks
+	codec      Codec
+	maxCost    int
+	cache      *clientCache
+	used       atomic.Bool
 }
 
 // NewClient creates a GraphQL client targeting the specified GraphQL server URL.
@@ -38,12 +46,22 @@ func NewClient(url string, httpClient *http.Client) *Client {
 	return &Client{
 		url:        url,
 		httpClient: httpClient,
+		maxCost:    MaxQueryCost,
+	}
+}
+
+// mustBeUnused panics if the client already sent a request, as its settings are read without
+// synchronization by concurrent requests.
+func (c *Client) mustBeUnused(setter string) {
+	if c.used.Load() {
+		panic("graphql: Client." + setter + " called after the client was used")
 	}
 }
 
 // SetRetries set a context for graphql client
 // set input ctx for graphql client
 func (c *Client) SetRetries(retries int) {
+	c.mustBeUnused("SetRetries")
 	c.retries = retries
 }
 
@@ -78,15 +96,46 @@ func (c *Client) MutateString(ctx context.Context, m string, variables map[strin
 	return c.do(ctx, m, variables, v)
 }
 
-// do executes a single GraphQL operation.
+// do executes a single GraphQL operation, through the cache of the client if it has one.
 func (c *Client) do(ctx context.Context, query string, variables map[string]interface{}, v interface{}) error {
-	var err error
+	c.used.Store(true)
+	if c.cache != nil {
+		return c.doCached(ctx, query, variables, v)
+	}
+	return c.fetch(ctx, query, variables, v)
+}
+
+// fetch executes a single GraphQL operation, split into several requests when it is a query whose
+// estimated cost is over the maximum cost of the client.
+func (c *Client) fetch(ctx context.Context, query string, variables map[string]interface{}, v interface{}) error {
+	if c.maxCost > 0 {
+		if plan := c.planSplit(query, variables); plan != nil {
+			return c.doSplit(ctx, plan, variables, v)
+		}
+	}
+	return c.send(ctx, query, variables, v)
+}
+
+// send sends a single GraphQL request, retrying it on transient errors.
+func (c *Client) send(ctx context.Context, query string, variables map[string]interface{}, v interface{}) error {
+	query, err := addInContext(ctx, query)
+	if err != nil {
+		return err
+	}
+	// the variables are encoded once, for the body and the tracing span
+	var vars json.RawMessage
+	if len(variables) > 0 {
+		vars, err = c.json().Marshal(variables)
+		if err != nil {
+			return err
+		}
+	}
 	in := struct {
-		Query     string                 `json:"query"`
-		Variables map[string]interface{} `json:"variables,omitempty"`
+		Query     string          `json:"query"`
+		Variables json.RawMessage `json:"variables,omitempty"`
 	}{
 		Query:     query,
-		Variables: variables,
+		Variables: vars,
 	}
 
 	// sentry tracing
@@ -94,7 +143,7 @@ func (c *Client) do(ctx context.Context, query string, variables map[string]inte
 	span.Description = utils.GetDescriptionFromQuery(query)
 	span.Data = map[string]interface{}{
 		"GraphQL Query":     query,
-		"GraphQL Variables": variables,
+		"GraphQL Variables": redactedVariables(vars),
 		"URL":               c.url,
 	}
 	defer func() {
@@ -103,17 +152,16 @@ func (c *Client) do(ctx context.Context, query string, variables map[string]inte
 	ctx = span.Context()
 	// end sentry tracing
 
+	body, err := c.json().Marshal(in)
+	if err != nil {
+		return err
+	}
+
 	retries := c.retries
 	attempts := 0
 	for {
 		attempts++
-		// Create new data buffer for each attempt
-		var buf bytes.Buffer
-		err = json.NewEncoder(&buf).Encode(in)
-		if err != nil {
-			return err
-		}
-		err = c.doRequest(ctx, &buf, v)
+		err = c.doRequest(ctx, bytes.NewReader(body), v)
 		if err == nil {
 			break
 		}
@@ -121,6 +169,7 @@ func (c *Client) do(ctx context.Context, query string, variables map[string]inte
 			return fmt.Errorf("after %v attempts: %w", attempts, err)
 		}
 		if c.shouldRetry(err) {
+			c.onRetry(attempts, err)
 			retries--
 			time.Sleep(time.Duration(attempts) * time.Second)
 			continue
@@ -136,6 +185,48 @@ func (c *Client) doRequest(ctx context.Context, body io.Reader, v interface{}) e
 		return err
 	}
 	defer resp.Body.Close()
+	if err = responseStatusError(ctx, resp); err != nil {
+		return err
+	}
+	var out struct {
+		Data       *json.RawMessage
+		Errors     graphErrors
+		Extensions struct {
+			Cost Cost
+		}
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+	err = c.json().Unmarshal(data, &out)
+	if err != nil {
+		return errors.NewErrorWithContext(ctx, fmt.Errorf("JSON decode response: %w", err), map[string]any{
+			"body": gpstrings.CutLength(string(data), 500)})
+	}
+	if out.Data != nil {
+		err := c.json().Unmarshal(*out.Data, v)
+		if err != nil {
+			return errors.NewErrorWithContext(ctx, fmt.Errorf("unmarshal data: %w", err), map[string]any{
+				"out.Data": gpstrings.CutLength(string(*out.Data), 500)})
+		}
+	}
+	if len(out.Errors) > 0 {
+		for _, e := range out.Errors {
+			if e.Extensions.Code == MaxCostExceeded {
+				return ErrMaxCostExceeded
+			}
+		}
+		if isThrottledError(out.Errors) {
+			c.onThrottled(out.Extensions.Cost)
+		}
+		return out.Errors
+	}
+	return nil
+}
+
+// responseStatusError maps a non-200 response status to an error.
+func responseStatusError(ctx context.Context, resp *http.Response) error {
 	if resp.StatusCode == http.StatusPaymentRequired {
 		return ErrPaymentRequired
 	}
@@ -165,31 +256,6 @@ func (c *Client) doRequest(ctx context.Context, body io.Reader, v interface{}) e
 		return errors.NewErrorWithContext(ctx, fmt.Errorf("non-200 OK status code: %v", resp.Status), map[string]any{
 			"body": gpstrings.CutLength(string(body), 500)})
 	}
-	var out struct {
-		Data   *json.RawMessage
-		Errors graphErrors
-	}
-	err = json.NewDecoder(resp.Body).Decode(&out)
-	if err != nil {
-		body, _ := io.ReadAll(resp.Body)
-		return errors.NewErrorWithContext(ctx, fmt.Errorf("JSON decode response: %w", err), map[string]any{
-			"body": gpstrings.CutLength(string(body), 500)})
-	}
-	if out.Data != nil {
-		err := json.Unmarshal(*out.Data, v)
-		if err != nil {
-			return errors.NewErrorWithContext(ctx, fmt.Errorf("unmarshal data: %w", err), map[string]any{
-				"out.Data": gpstrings.CutLength(string(*out.Data), 500)})
-		}
-	}
-	if len(out.Errors) > 0 {
-		for _, e := range out.Errors {
-			if e.Extensions.Code == MaxCostExceeded {
-				return ErrMaxCostExceeded
-			}
-		}
-		return out.Errors
-	}
 	return nil
 }
 
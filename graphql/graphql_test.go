@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"sync"
 	"testing"
 	"time"
 )
@@ -168,3 +169,32 @@ func TestQuery(t *testing.T) {
 // 	// equals(t, []byte("OK"), body)
 
 // }
+
+func TestClientConcurrentUse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"shop":{"name":"test"}}}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, server.Client())
+	c.SetRetries(2)
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var out struct{ Shop struct{ Name string } }
+			if err := c.QueryString(context.Background(), "{ shop { name } }", nil, &out); err != nil || out.Shop.Name != "test" {
+				t.Errorf("got %+v, %v", out, err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("SetRetries didn't panic after the client was used")
+		}
+	}()
+	c.SetRetries(3)
+}
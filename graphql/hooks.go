@@ -0,0 +1,58 @@
+package graphql
+
+import (
+	"net/url"
+)
+
+// Hooks are optional callbacks notified of the throttling and the retries of the requests of a
+// Client, e.g. to alert on shops that are persistently rate limited. They may be called
+// concurrently and must not block.
+type Hooks struct {
+	// OnThrottled is called when a request to shop is throttled, with the cost Shopify reported
+	// for it.
+	OnThrottled func(shop string, cost Cost)
+	// OnRetry is called before a failed request is retried, attempt being the number of the
+	// attempt that failed with err.
+	OnRetry func(attempt int, err error)
+}
+
+// Cost is the cost of a query, as reported in the extensions of the response.
+type Cost struct {
+	RequestedQueryCost int            `json:"requestedQueryCost"`
+	ActualQueryCost    *int           `json:"actualQueryCost"`
+	ThrottleStatus     ThrottleStatus `json:"throttleStatus"`
+}
+
+// ThrottleStatus is the state of the leaky bucket rate limiting the queries of an app on a shop.
+type ThrottleStatus struct {
+	MaximumAvailable   float64 `json:"maximumAvailable"`
+	CurrentlyAvailable float64 `json:"currentlyAvailable"`
+	RestoreRate        float64 `json:"restoreRate"`
+}
+
+// SetHooks sets the callbacks of the client, replacing the previous ones.
+func (c *Client) SetHooks(hooks Hooks) {
+	c.mustBeUnused("SetHooks")
+	c.hooks = hooks
+}
+
+func (c *Client) onThrottled(cost Cost) {
+	if c.hooks.OnThrottled == nil {
+		return
+	}
+	c.hooks.OnThrottled(c.shop(), cost)
+}
+
+// shop returns the domain of the shop of the client.
+func (c *Client) shop() string {
+	if u, err := url.Parse(c.url); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return c.url
+}
+
+func (c *Client) onRetry(attempt int, err error) {
+	if c.hooks.OnRetry != nil {
+		c.hooks.OnRetry(attempt, err)
+	}
+}
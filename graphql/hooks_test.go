@@ -0,0 +1,60 @@
+package graphql
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHooks(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			_, _ = w.Write([]byte(`{"errors":[{"message":"Throttled","extensions":{"code":"THROTTLED"}}],` +
+				`"extensions":{"cost":{"requestedQueryCost":52,"actualQueryCost":null,` +
+				`"throttleStatus":{"maximumAvailable":2000,"currentlyAvailable":12,"restoreRate":100}}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"shop":{"name":"test"}}}`))
+	}))
+	defer server.Close()
+
+	var (
+		throttledShop string
+		throttledCost Cost
+		retries       []int
+	)
+	c := NewClient(server.URL+"/admin/api/graphql.json", server.Client())
+	c.SetRetries(2)
+	c.SetHooks(Hooks{
+		OnThrottled: func(shop string, cost Cost) {
+			throttledShop, throttledCost = shop, cost
+		},
+		OnRetry: func(attempt int, err error) {
+			if !isThrottledError(err) {
+				t.Errorf("OnRetry got error %v, want Throttled", err)
+			}
+			retries = append(retries, attempt)
+		},
+	})
+
+	var out struct{ Shop struct{ Name string } }
+	if err := c.QueryString(context.Background(), "{ shop { name } }", nil, &out); err != nil {
+		t.Fatalf("QueryString: %s", err)
+	}
+	if out.Shop.Name != "test" {
+		t.Errorf("got shop name %q, want test", out.Shop.Name)
+	}
+	if want := strings.TrimPrefix(server.URL, "http://"); throttledShop != want {
+		t.Errorf("OnThrottled got shop %q, want %q", throttledShop, want)
+	}
+	if throttledCost.RequestedQueryCost != 52 || throttledCost.ThrottleStatus.CurrentlyAvailable != 12 {
+		t.Errorf("OnThrottled got cost %+v", throttledCost)
+	}
+	if len(retries) != 1 || retries[0] != 1 {
+		t.Errorf("OnRetry got attempts %v, want [1]", retries)
+	}
+}
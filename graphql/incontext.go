@@ -0,0 +1,92 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// InContext is the Storefront API @inContext directive, which localizes prices, availability
+// and translations of an operation for a market. Fields are enum values such as "CA" and "FR";
+// empty fields are omitted.
+type InContext struct {
+	Country  string
+	Language string
+}
+
+type inContextKey struct{}
+
+// WithInContext returns a copy of ctx that makes the client add the @inContext directive to every
+// operation sent with it. It is only supported by the Storefront API; the Admin API rejects it.
+func WithInContext(ctx context.Context, in InContext) context.Context {
+	return context.WithValue(ctx, inContextKey{}, in)
+}
+
+// InContextFromContext returns the InContext set with WithInContext, if any.
+func InContextFromContext(ctx context.Context) (InContext, bool) {
+	in, ok := ctx.Value(inContextKey{}).(InContext)
+	return in, ok
+}
+
+func (in InContext) directive() (string, error) {
+	var args []string
+	for _, arg := range []struct{ name, value string }{
+		{"country", in.Country},
+		{"language", in.Language},
+	} {
+		if arg.value == "" {
+			continue
+		}
+		if !isEnumValue(arg.value) {
+			return "", fmt.Errorf("invalid @inContext %s %q", arg.name, arg.value)
+		}
+		args = append(args, arg.name+": "+arg.value)
+	}
+	if len(args) == 0 {
+		return "", nil
+	}
+	return "@inContext(" + strings.Join(args, ", ") + ")", nil
+}
+
+// addInContext inserts the directive of ctx before the selection set of the operation in query.
+// Queries that already declare @inContext are left untouched.
+func addInContext(ctx context.Context, query string) (string, error) {
+	in, ok := InContextFromContext(ctx)
+	if !ok || strings.Contains(query, "@inContext") {
+		return query, nil
+	}
+	directive, err := in.directive()
+	if err != nil || directive == "" {
+		return query, err
+	}
+
+	depth := 0
+	for i, r := range query {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case '{':
+			if depth > 0 {
+				continue
+			}
+			head := strings.TrimSpace(query[:i])
+			if head == "" {
+				// Shorthand queries have no operation type to attach a directive to.
+				head = "query"
+			}
+			return head + " " + directive + " " + query[i:], nil
+		}
+	}
+	return "", fmt.Errorf("no selection set found in operation")
+}
+
+func isEnumValue(s string) bool {
+	for _, r := range s {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '_' {
+			return false
+		}
+	}
+	return true
+}
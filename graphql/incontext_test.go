@@ -0,0 +1,62 @@
+package graphql
+
+import (
+	"context"
+	"testing"
+)
+
+func TestAddInContext(t *testing.T) {
+	ctx := WithInContext(context.Background(), InContext{Country: "CA", Language: "FR"})
+	tests := []struct {
+		name  string
+		ctx   context.Context
+		query string
+		want  string
+	}{
+		{
+			name:  "no context",
+			ctx:   context.Background(),
+			query: `query product($id: ID!) { product(id: $id) { title } }`,
+			want:  `query product($id: ID!) { product(id: $id) { title } }`,
+		},
+		{
+			name:  "named operation",
+			ctx:   ctx,
+			query: `query product($id: ID!) { product(id: $id) { title } }`,
+			want:  `query product($id: ID!) @inContext(country: CA, language: FR) { product(id: $id) { title } }`,
+		},
+		{
+			name:  "shorthand query",
+			ctx:   ctx,
+			query: `{ shop { name } }`,
+			want:  `query @inContext(country: CA, language: FR) { shop { name } }`,
+		},
+		{
+			name:  "country only",
+			ctx:   WithInContext(context.Background(), InContext{Country: "DE"}),
+			query: `mutation cartCreate($input: CartInput) { cartCreate(input: $input) { cart { id } } }`,
+			want:  `mutation cartCreate($input: CartInput) @inContext(country: DE) { cartCreate(input: $input) { cart { id } } }`,
+		},
+		{
+			name:  "already set",
+			ctx:   ctx,
+			query: `query @inContext(country: US) { shop { name } }`,
+			want:  `query @inContext(country: US) { shop { name } }`,
+		},
+	}
+	for _, tc := range tests {
+		got, err := addInContext(tc.ctx, tc.query)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", tc.name, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("%s:\ngot  %s\nwant %s", tc.name, got, tc.want)
+		}
+	}
+
+	invalid := WithInContext(context.Background(), InContext{Country: "CA) { x }"})
+	if _, err := addInContext(invalid, `{ shop { name } }`); err == nil {
+		t.Error("expected an error for an invalid country")
+	}
+}
@@ -0,0 +1,71 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// redacted replaces the values of sensitive variables in tracing spans.
+const redacted = "[REDACTED]"
+
+// sensitiveKeyWords are the words that make a variable, or a field of an input variable,
+// sensitive when its lower-cased name contains one of them, e.g. password or customerAccessToken.
+var sensitiveKeyWords = []string{"password", "token", "secret"}
+
+// redactedVariables is the JSON of the variables of a request as attached to tracing spans. It
+// reuses the bytes encoded for the request body and is only redacted when the span is serialized:
+// as is when no key can be sensitive, or else decoded with the values of sensitive keys redacted
+// at any depth, as inputs are often structs.
+type redactedVariables []byte
+
+func (v redactedVariables) MarshalJSON() ([]byte, error) {
+	if len(v) == 0 {
+		return []byte("null"), nil
+	}
+	lower := bytes.ToLower(v)
+	sensitive := false
+	for _, word := range sensitiveKeyWords {
+		if bytes.Contains(lower, []byte(word)) {
+			sensitive = true
+			break
+		}
+	}
+	if !sensitive {
+		return v, nil
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(v, &decoded); err != nil {
+		return nil, err
+	}
+	redactValue(decoded)
+	return json.Marshal(decoded)
+}
+
+func redactValue(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSensitiveKey(key) {
+				v[key] = redacted
+				continue
+			}
+			redactValue(value)
+		}
+	case []interface{}:
+		for _, value := range v {
+			redactValue(value)
+		}
+	}
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, word := range sensitiveKeyWords {
+		if strings.Contains(key, word) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+package graphql
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestRedactVariables(t *testing.T) {
+	type customerInput struct {
+		Email    string `json:"email"`
+		Password string `json:"password"`
+	}
+	vars, err := json.Marshal(map[string]interface{}{
+		"input":               customerInput{Email: "a@example.com", Password: "hunter2"},
+		"customerAccessToken": "abc",
+		"lines":               []interface{}{map[string]interface{}{"apiSecret": "s", "quantity": 1}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(map[string]interface{}{"GraphQL Variables": redactedVariables(vars)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got map[string]interface{}
+	if err = json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]interface{}{
+		"GraphQL Variables": map[string]interface{}{
+			"input":               map[string]interface{}{"email": "a@example.com", "password": redacted},
+			"customerAccessToken": redacted,
+			"lines":               []interface{}{map[string]interface{}{"apiSecret": redacted, "quantity": float64(1)}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v, want %#v", got, want)
+	}
+
+	plain := []byte(`{"id":"gid://shopify/Product/1"}`)
+	if data, err = redactedVariables(plain).MarshalJSON(); err != nil || string(data) != string(plain) {
+		t.Errorf("got %s, %v, want the variables unchanged", data, err)
+	}
+}
@@ -0,0 +1,371 @@
+package graphql
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/formatter"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// Names of the variables and the alias added to the queries of a paginated field.
+const (
+	splitFirstVariable = "gqlSplitFirst"
+	splitAfterVariable = "gqlSplitAfter"
+	splitPageInfoAlias = "gqlSplitPageInfo"
+)
+
+// SetMaxQueryCost sets the estimated cost over which queries are split into several requests,
+// MaxQueryCost by default. A cost of 0 or less disables splitting.
+func (c *Client) SetMaxQueryCost(cost int) {
+	c.mustBeUnused("SetMaxQueryCost")
+	c.maxCost = cost
+}
+
+// splitPlan is the requests a query over budget is split into.
+type splitPlan struct {
+	groups []subQuery   // groups of root fields, merged by response key
+	paged  []*pagedRoot // root connections too expensive for a single request
+}
+
+// subQuery is a query made of some of the root fields of a query, with the names of the
+// variables it declares.
+type subQuery struct {
+	query     string
+	variables []string
+}
+
+// pagedRoot is a root connection fetched in pages of pageSize nodes until size nodes are fetched.
+type pagedRoot struct {
+	subQuery
+	key       string
+	size      int
+	pageSize  int
+	after     interface{}
+	nodeKeys  []string // response keys of the edges and nodes of the connection
+	pageInfos []string // response keys of the pageInfo of the connection
+}
+
+// planSplit returns how to split query when its estimated cost is over the maximum cost of the
+// client, or nil when the query is sent as is: within budget, a mutation, or not splittable, in
+// which case Shopify decides.
+func (c *Client) planSplit(query string, variables map[string]interface{}) *splitPlan {
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return nil
+	}
+	op, err := soleOperation(doc)
+	if err != nil || op.Operation != ast.Query {
+		return nil
+	}
+	e := costEstimator{doc: doc, vars: variables}
+	if e.selectionCost(op.SelectionSet) <= c.maxCost {
+		return nil
+	}
+
+	plan := &splitPlan{}
+	var group ast.SelectionSet
+	groupCost := 0
+	flush := func() {
+		if len(group) > 0 {
+			plan.groups = append(plan.groups, formatOperation(doc, op, group, nil))
+			group, groupCost = nil, 0
+		}
+	}
+	for _, sel := range op.SelectionSet {
+		f, ok := sel.(*ast.Field)
+		if !ok {
+			// fragments on the query root can't be split from the rest of the query
+			return nil
+		}
+		cost := e.fieldCost(f)
+		if cost > c.maxCost {
+			paged := e.pageRoot(doc, op, f, c.maxCost)
+			if paged == nil {
+				return nil
+			}
+			plan.paged = append(plan.paged, paged)
+			continue
+		}
+		if groupCost+cost > c.maxCost {
+			flush()
+		}
+		group = append(group, f)
+		groupCost += cost
+	}
+	flush()
+	return plan
+}
+
+// pageRoot returns how to fetch root connection f in pages within maxCost, or nil if f isn't a
+// connection sized by first or if a single node is over budget.
+func (e costEstimator) pageRoot(doc *ast.QueryDocument, op *ast.OperationDefinition, f *ast.Field, maxCost int) *pagedRoot {
+	if !isConnection(f) || f.Arguments.ForName("first") == nil || f.Arguments.ForName("last") != nil {
+		return nil
+	}
+	pageSize := (maxCost - 2) / e.nodeCost(f)
+	if pageSize < 1 {
+		return nil
+	}
+
+	p := &pagedRoot{key: responseKey(f), size: e.connectionSize(f), pageSize: pageSize}
+	paged := *f
+	paged.Arguments = nil
+	for _, arg := range f.Arguments {
+		switch arg.Name {
+		case "first":
+		case "after":
+			p.after, _ = arg.Value.Value(e.vars)
+		default:
+			paged.Arguments = append(paged.Arguments, arg)
+		}
+	}
+	paged.Arguments = append(paged.Arguments,
+		&ast.Argument{Name: "first", Value: &ast.Value{Kind: ast.Variable, Raw: splitFirstVariable}},
+		&ast.Argument{Name: "after", Value: &ast.Value{Kind: ast.Variable, Raw: splitAfterVariable}},
+	)
+	paged.SelectionSet = append(append(ast.SelectionSet{}, f.SelectionSet...), &ast.Field{
+		Alias: splitPageInfoAlias,
+		Name:  "pageInfo",
+		SelectionSet: ast.SelectionSet{
+			&ast.Field{Alias: "hasNextPage", Name: "hasNextPage"},
+			&ast.Field{Alias: "endCursor", Name: "endCursor"},
+		},
+	})
+	for _, sel := range f.SelectionSet {
+		if sf, ok := sel.(*ast.Field); ok {
+			switch sf.Name {
+			case "edges", "nodes":
+				p.nodeKeys = append(p.nodeKeys, responseKey(sf))
+			case "pageInfo":
+				p.pageInfos = append(p.pageInfos, responseKey(sf))
+			}
+		}
+	}
+
+	p.subQuery = formatOperation(doc, op, ast.SelectionSet{&paged}, ast.VariableDefinitionList{
+		{Variable: splitFirstVariable, Type: ast.NonNullNamedType("Int", nil)},
+		{Variable: splitAfterVariable, Type: ast.NamedType("String", nil)},
+	})
+	return p
+}
+
+// formatOperation returns the query of op restricted to set, declaring only the variables and
+// fragments it uses, as Shopify rejects unused ones.
+func formatOperation(doc *ast.QueryDocument, op *ast.OperationDefinition, set ast.SelectionSet, extraVars ast.VariableDefinitionList) subQuery {
+	u := usage{doc: doc, variables: map[string]bool{}, fragments: map[string]bool{}}
+	u.selections(set)
+	u.directives(op.Directives)
+
+	sub := *op
+	sub.SelectionSet = set
+	sub.VariableDefinitions = nil
+	for _, def := range op.VariableDefinitions {
+		if u.variables[def.Variable] {
+			sub.VariableDefinitions = append(sub.VariableDefinitions, def)
+		}
+	}
+	sub.VariableDefinitions = append(sub.VariableDefinitions, extraVars...)
+
+	subDoc := &ast.QueryDocument{Operations: ast.OperationList{&sub}}
+	for _, def := range doc.Fragments {
+		if u.fragments[def.Name] {
+			subDoc.Fragments = append(subDoc.Fragments, def)
+		}
+	}
+
+	var buf bytes.Buffer
+	formatter.NewFormatter(&buf).FormatQueryDocument(subDoc)
+	q := subQuery{query: buf.String()}
+	for _, def := range sub.VariableDefinitions {
+		q.variables = append(q.variables, def.Variable)
+	}
+	return q
+}
+
+// pick returns the values of names among variables.
+func pick(variables map[string]interface{}, names []string) map[string]interface{} {
+	vars := make(map[string]interface{}, len(names))
+	for _, name := range names {
+		if v, ok := variables[name]; ok {
+			vars[name] = v
+		}
+	}
+	return vars
+}
+
+// usage collects the variables and fragments used by selections.
+type usage struct {
+	doc       *ast.QueryDocument
+	variables map[string]bool
+	fragments map[string]bool
+}
+
+func (u usage) selections(set ast.SelectionSet) {
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			u.arguments(sel.Arguments)
+			u.directives(sel.Directives)
+			u.selections(sel.SelectionSet)
+		case *ast.InlineFragment:
+			u.directives(sel.Directives)
+			u.selections(sel.SelectionSet)
+		case *ast.FragmentSpread:
+			u.directives(sel.Directives)
+			if u.fragments[sel.Name] {
+				continue
+			}
+			u.fragments[sel.Name] = true
+			if def := u.doc.Fragments.ForName(sel.Name); def != nil {
+				u.selections(def.SelectionSet)
+			}
+		}
+	}
+}
+
+func (u usage) directives(directives ast.DirectiveList) {
+	for _, d := range directives {
+		u.arguments(d.Arguments)
+	}
+}
+
+func (u usage) arguments(args ast.ArgumentList) {
+	for _, arg := range args {
+		u.value(arg.Value)
+	}
+}
+
+func (u usage) value(v *ast.Value) {
+	if v == nil {
+		return
+	}
+	if v.Kind == ast.Variable {
+		u.variables[v.Raw] = true
+	}
+	for _, child := range v.Children {
+		u.value(child.Value)
+	}
+}
+
+func responseKey(f *ast.Field) string {
+	if f.Alias != "" {
+		return f.Alias
+	}
+	return f.Name
+}
+
+// doSplit runs the requests of plan and decodes their merged data into v.
+func (c *Client) doSplit(ctx context.Context, plan *splitPlan, variables map[string]interface{}, v interface{}) error {
+	data := map[string]json.RawMessage{}
+	for _, q := range plan.groups {
+		var part map[string]json.RawMessage
+		if err := c.send(ctx, q.query, pick(variables, q.variables), &part); err != nil {
+			return err
+		}
+		for k, val := range part {
+			data[k] = val
+		}
+	}
+	for _, p := range plan.paged {
+		conn, err := c.fetchPages(ctx, p, variables)
+		if err != nil {
+			return err
+		}
+		data[p.key] = conn
+	}
+
+	merged, err := c.json().Marshal(data)
+	if err != nil {
+		return fmt.Errorf("marshal split query data: %w", err)
+	}
+	if err = c.json().Unmarshal(merged, v); err != nil {
+		return fmt.Errorf("unmarshal split query data: %w", err)
+	}
+	return nil
+}
+
+// fetchPages fetches the pages of the root connection p and returns the connection made of their
+// nodes.
+func (c *Client) fetchPages(ctx context.Context, p *pagedRoot, variables map[string]interface{}) (json.RawMessage, error) {
+	vars := pick(variables, p.variables)
+	vars[splitAfterVariable] = p.after
+
+	var (
+		conn    map[string]json.RawMessage
+		nodes   = map[string][]json.RawMessage{}
+		fetched int
+	)
+	for fetched < p.size {
+		vars[splitFirstVariable] = min(p.pageSize, p.size-fetched)
+		var part map[string]map[string]json.RawMessage
+		if err := c.send(ctx, p.query, vars, &part); err != nil {
+			return nil, err
+		}
+		page := part[p.key]
+		if page == nil {
+			return json.RawMessage("null"), nil
+		}
+
+		count := 0
+		for _, key := range p.nodeKeys {
+			var list []json.RawMessage
+			if err := c.json().Unmarshal(page[key], &list); err != nil {
+				return nil, fmt.Errorf("unmarshal %s of %s: %w", key, p.key, err)
+			}
+			nodes[key] = append(nodes[key], list...)
+			count = max(count, len(list))
+		}
+		if conn == nil {
+			conn = page
+		} else {
+			for _, key := range p.pageInfos {
+				conn[key] = mergePageInfo(c.json(), conn[key], page[key])
+			}
+		}
+		fetched += count
+
+		var pageInfo struct {
+			HasNextPage bool    `json:"hasNextPage"`
+			EndCursor   *string `json:"endCursor"`
+		}
+		if err := c.json().Unmarshal(page[splitPageInfoAlias], &pageInfo); err != nil {
+			return nil, fmt.Errorf("unmarshal page info of %s: %w", p.key, err)
+		}
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == nil || count == 0 {
+			break
+		}
+		vars[splitAfterVariable] = *pageInfo.EndCursor
+	}
+
+	delete(conn, splitPageInfoAlias)
+	for key, list := range nodes {
+		data, err := c.json().Marshal(list)
+		if err != nil {
+			return nil, err
+		}
+		conn[key] = data
+	}
+	return c.json().Marshal(conn)
+}
+
+// mergePageInfo returns the page info of the last page, with the start of the first one.
+func mergePageInfo(codec Codec, first, last json.RawMessage) json.RawMessage {
+	var a, b map[string]json.RawMessage
+	if codec.Unmarshal(first, &a) != nil || codec.Unmarshal(last, &b) != nil || a == nil || b == nil {
+		return last
+	}
+	for _, key := range []string{"startCursor", "hasPreviousPage"} {
+		if v, ok := a[key]; ok {
+			b[key] = v
+		}
+	}
+	merged, err := codec.Marshal(b)
+	if err != nil {
+		return last
+	}
+	return merged
+}
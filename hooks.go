@@ -0,0 +1,32 @@
+package shopify
+
+import (
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+
+	"github.com/gempages/go-shopify-graphql/graphql"
+)
+
+// Hooks are optional callbacks notified of the throttling, retries and bulk operations of a
+// Client, so that operators can alert on shops that are persistently rate limited or whose bulk
+// operations are stuck. They may be called concurrently and must not block.
+type Hooks struct {
+	// OnThrottled is called when a request to shop is throttled, with the cost Shopify reported
+	// for it.
+	OnThrottled func(shop string, cost graphql.Cost)
+	// OnRetry is called before a failed request is retried, attempt being the number of the
+	// attempt that failed with err.
+	OnRetry func(attempt int, err error)
+	// OnBulkStatusChange is called when the bulk operation service sees the current bulk
+	// operation in a new status, e.g. while waiting for it to complete.
+	OnBulkStatusChange func(id string, status model.BulkOperationStatus)
+}
+
+// SetHooks sets the callbacks of the client, replacing the previous ones. It must be called
+// before the client is used.
+func (c *Client) SetHooks(hooks Hooks) {
+	c.gql.SetHooks(graphql.Hooks{
+		OnThrottled: hooks.OnThrottled,
+		OnRetry:     hooks.OnRetry,
+	})
+	c.hooks = hooks
+}
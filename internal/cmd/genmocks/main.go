@@ -0,0 +1,392 @@
+// Command genmocks generates mock implementations of the service interfaces of the shopify
+// package, in the style of github.com/matryer/moq: each mock has a XxxFunc field per method and
+// records the arguments of every call.
+//
+// Usage:
+//
+//	go run ./internal/cmd/genmocks -dir . -out mocks/mocks_gen.go
+//
+// Every exported interface whose name ends with "Service" is mocked.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	shopifyImportPath = "github.com/gempages/go-shopify-graphql"
+	shopifyPkgName    = "shopify"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the shopify package")
+	out := flag.String("out", "mocks/mocks_gen.go", "output file")
+	pkg := flag.String("package", "mocks", "package name of the generated file")
+	flag.Parse()
+
+	ifaces, err := parseInterfaces(*dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := render(*pkg, ifaces)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type iface struct {
+	name    string
+	methods []method
+}
+
+type method struct {
+	name    string
+	params  []param
+	results string // printed result list, including parentheses when needed
+	hasRes  bool
+}
+
+type param struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+// signature returns the parameter list of m, e.g. "ctx context.Context, ids ...string".
+func (m method) signature() string {
+	parts := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		typ := p.typ
+		if p.variadic {
+			typ = "..." + strings.TrimPrefix(typ, "[]")
+		}
+		parts = append(parts, p.name+" "+typ)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// callArgs returns the arguments forwarding the parameters of m to another function.
+func (m method) callArgs() string {
+	parts := make([]string, 0, len(m.params))
+	for _, p := range m.params {
+		arg := p.name
+		if p.variadic {
+			arg += "..."
+		}
+		parts = append(parts, arg)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// callStruct returns the struct type recording the arguments of a call to m.
+func (m method) callStruct() string {
+	var b strings.Builder
+	b.WriteString("struct {\n")
+	for _, p := range m.params {
+		fmt.Fprintf(&b, "// %s is the %s argument value.\n%s %s\n", fieldName(p.name), p.name, fieldName(p.name), p.typ)
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// parseInterfaces returns the service interfaces declared in dir, with every type of their
+// method signatures qualified so that it can be used from another package.
+func parseInterfaces(dir string) ([]iface, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := pkgs[shopifyPkgName]
+	if !ok {
+		return nil, fmt.Errorf("package %s not found in %s", shopifyPkgName, dir)
+	}
+
+	var res []iface
+	for _, f := range p.Files {
+		imports := fileImports(f)
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				it, ok := ts.Type.(*ast.InterfaceType)
+				if !ok || !ts.Name.IsExported() || !strings.HasSuffix(ts.Name.Name, "Service") {
+					continue
+				}
+				i, err := parseInterface(fset, ts.Name.Name, it, imports)
+				if err != nil {
+					return nil, err
+				}
+				res = append(res, i)
+			}
+		}
+	}
+	sort.Slice(res, func(a, b int) bool { return res[a].name < res[b].name })
+	return res, nil
+}
+
+func parseInterface(fset *token.FileSet, name string, it *ast.InterfaceType, imports map[string]string) (iface, error) {
+	i := iface{name: name}
+	for _, field := range it.Methods.List {
+		if len(field.Names) == 0 {
+			return i, fmt.Errorf("%s: embedded interfaces are not supported", name)
+		}
+		ft := field.Type.(*ast.FuncType)
+		m := method{name: field.Names[0].Name}
+
+		n := 0
+		for _, pf := range ft.Params.List {
+			typ, variadic := pf.Type, false
+			if ell, ok := typ.(*ast.Ellipsis); ok {
+				typ, variadic = &ast.ArrayType{Elt: ell.Elt}, true
+			}
+			typStr, err := printType(fset, typ, imports)
+			if err != nil {
+				return i, err
+			}
+			names := pf.Names
+			if len(names) == 0 {
+				names = []*ast.Ident{{Name: "in" + strconv.Itoa(n+1)}}
+			}
+			for _, pn := range names {
+				m.params = append(m.params, param{name: pn.Name, typ: typStr, variadic: variadic})
+				n++
+			}
+		}
+
+		if ft.Results != nil && len(ft.Results.List) > 0 {
+			var results []string
+			for _, rf := range ft.Results.List {
+				typ, err := printType(fset, rf.Type, imports)
+				if err != nil {
+					return i, err
+				}
+				if len(rf.Names) == 0 {
+					results = append(results, typ)
+				}
+				for _, rn := range rf.Names {
+					results = append(results, rn.Name+" "+typ)
+				}
+			}
+			m.results = strings.Join(results, ", ")
+			if len(results) > 1 || len(ft.Results.List[0].Names) > 0 {
+				m.results = "(" + m.results + ")"
+			}
+			m.hasRes = true
+		}
+		i.methods = append(i.methods, m)
+	}
+	return i, nil
+}
+
+// fileImports maps the package names used in f to their import paths.
+func fileImports(f *ast.File) map[string]string {
+	imports := map[string]string{}
+	for _, spec := range f.Imports {
+		p, _ := strconv.Unquote(spec.Path.Value)
+		name := path.Base(p)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		imports[name] = p
+	}
+	return imports
+}
+
+var predeclared = map[string]bool{
+	"any": true, "bool": true, "byte": true, "comparable": true, "complex64": true, "complex128": true,
+	"error": true, "float32": true, "float64": true, "int": true, "int8": true, "int16": true,
+	"int32": true, "int64": true, "rune": true, "string": true, "uint": true, "uint8": true,
+	"uint16": true, "uint32": true, "uint64": true, "uintptr": true,
+}
+
+// usedImports collects the import paths of the packages referenced by the printed types.
+var usedImports = map[string]string{}
+
+// printType prints the type expression node with the types declared in the shopify package
+// qualified by its name.
+func printType(fset *token.FileSet, node ast.Expr, imports map[string]string) (string, error) {
+	var err error
+	qualified := qualify(node, func(sel string) {
+		p, ok := imports[sel]
+		if sel == shopifyPkgName {
+			p, ok = shopifyImportPath, true
+		}
+		if !ok {
+			err = fmt.Errorf("unknown package %s", sel)
+			return
+		}
+		if old, ok := usedImports[sel]; ok && old != p {
+			err = fmt.Errorf("package name %s is used for both %s and %s", sel, old, p)
+			return
+		}
+		usedImports[sel] = p
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err = printer.Fprint(&buf, fset, qualified); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// qualify returns a copy of node where the identifiers of package level types are replaced by
+// selectors on the shopify package. use is called with the package name of every selector.
+func qualify(node ast.Node, use func(pkg string)) ast.Node {
+	switch n := node.(type) {
+	case *ast.Ident:
+		if predeclared[n.Name] || !n.IsExported() {
+			return n
+		}
+		use(shopifyPkgName)
+		return &ast.SelectorExpr{X: ast.NewIdent(shopifyPkgName), Sel: ast.NewIdent(n.Name)}
+	case *ast.SelectorExpr:
+		use(n.X.(*ast.Ident).Name)
+		return n
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualify(n.X, use).(ast.Expr)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: n.Len, Elt: qualify(n.Elt, use).(ast.Expr)}
+	case *ast.MapType:
+		return &ast.MapType{Key: qualify(n.Key, use).(ast.Expr), Value: qualify(n.Value, use).(ast.Expr)}
+	case *ast.ChanType:
+		return &ast.ChanType{Dir: n.Dir, Value: qualify(n.Value, use).(ast.Expr)}
+	case *ast.IndexExpr:
+		return &ast.IndexExpr{X: qualify(n.X, use).(ast.Expr), Index: qualify(n.Index, use).(ast.Expr)}
+	case *ast.FuncType:
+		return &ast.FuncType{Params: qualifyFields(n.Params, use), Results: qualifyFields(n.Results, use)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: qualify(n.Elt, use).(ast.Expr)}
+	}
+	// interface{}, struct{} and other literals are printed as is
+	return node
+}
+
+func qualifyFields(fl *ast.FieldList, use func(pkg string)) *ast.FieldList {
+	if fl == nil {
+		return nil
+	}
+	res := &ast.FieldList{}
+	for _, f := range fl.List {
+		res.List = append(res.List, &ast.Field{Names: f.Names, Type: qualify(f.Type, use).(ast.Expr)})
+	}
+	return res
+}
+
+// fieldName returns the exported struct field name recording parameter name.
+func fieldName(name string) string {
+	switch strings.ToLower(name) {
+	case "id", "url", "ids", "urls":
+		return strings.ToUpper(name[:len(name)-1]) + name[len(name)-1:]
+	}
+	if strings.HasSuffix(name, "Id") {
+		name = strings.TrimSuffix(name, "Id") + "ID"
+	}
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+func render(pkg string, ifaces []iface) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by genmocks. DO NOT EDIT.\n\npackage %s\n\n", pkg)
+
+	names := make([]string, 0, len(usedImports))
+	for name := range usedImports {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	std := []string{`"sync"`}
+	var thirdParty []string
+	for _, name := range names {
+		p := usedImports[name]
+		spec := strconv.Quote(p)
+		if path.Base(p) != name {
+			spec = name + " " + spec
+		}
+		if strings.Contains(strings.Split(p, "/")[0], ".") {
+			thirdParty = append(thirdParty, spec)
+		} else {
+			std = append(std, spec)
+		}
+	}
+	sort.Strings(std)
+	fmt.Fprintf(&buf, "import (\n%s\n\n%s\n)\n", strings.Join(std, "\n"), strings.Join(thirdParty, "\n"))
+
+	for _, i := range ifaces {
+		renderMock(&buf, i)
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("format generated code: %w\n%s", err, buf.Bytes())
+	}
+	return src, nil
+}
+
+func renderMock(buf *bytes.Buffer, i iface) {
+	mock := i.name + "Mock"
+	fmt.Fprintf(buf, "\nvar _ %s.%s = &%s{}\n\n", shopifyPkgName, i.name, mock)
+	fmt.Fprintf(buf, "// %s is a mock implementation of %s.%s.\n", mock, shopifyPkgName, i.name)
+	fmt.Fprintf(buf, "type %s struct {\n", mock)
+	for _, m := range i.methods {
+		fmt.Fprintf(buf, "// %sFunc mocks the %s method.\n", m.name, m.name)
+		fmt.Fprintf(buf, "%sFunc func(%s) %s\n\n", m.name, m.signature(), m.results)
+	}
+	buf.WriteString("// calls tracks calls to the methods.\ncalls struct {\n")
+	for _, m := range i.methods {
+		fmt.Fprintf(buf, "// %s holds details about calls to the %s method.\n", m.name, m.name)
+		fmt.Fprintf(buf, "%s []%s\n", m.name, m.callStruct())
+	}
+	buf.WriteString("}\n")
+	for _, m := range i.methods {
+		fmt.Fprintf(buf, "lock%s sync.RWMutex\n", m.name)
+	}
+	buf.WriteString("}\n")
+
+	for _, m := range i.methods {
+		fmt.Fprintf(buf, "\n// %s calls %sFunc.\n", m.name, m.name)
+		fmt.Fprintf(buf, "func (mock *%s) %s(%s) %s {\n", mock, m.name, m.signature(), m.results)
+		fmt.Fprintf(buf, "if mock.%sFunc == nil {\n", m.name)
+		fmt.Fprintf(buf, "panic(\"%s.%sFunc: method is nil but %s.%s was just called\")\n}\n", mock, m.name, i.name, m.name)
+		fmt.Fprintf(buf, "callInfo := %s{\n", m.callStruct())
+		for _, p := range m.params {
+			fmt.Fprintf(buf, "%s: %s,\n", fieldName(p.name), p.name)
+		}
+		buf.WriteString("}\n")
+		fmt.Fprintf(buf, "mock.lock%s.Lock()\n", m.name)
+		fmt.Fprintf(buf, "mock.calls.%s = append(mock.calls.%s, callInfo)\n", m.name, m.name)
+		fmt.Fprintf(buf, "mock.lock%s.Unlock()\n", m.name)
+		if m.hasRes {
+			buf.WriteString("return ")
+		}
+		fmt.Fprintf(buf, "mock.%sFunc(%s)\n}\n", m.name, m.callArgs())
+
+		fmt.Fprintf(buf, "\n// %sCalls returns the calls made to %s.\n", m.name, m.name)
+		fmt.Fprintf(buf, "func (mock *%s) %sCalls() []%s {\n", mock, m.name, m.callStruct())
+		fmt.Fprintf(buf, "mock.lock%s.RLock()\n", m.name)
+		fmt.Fprintf(buf, "defer mock.lock%s.RUnlock()\n", m.name)
+		fmt.Fprintf(buf, "return mock.calls.%s\n}\n", m.name)
+	}
+}
@@ -0,0 +1,116 @@
+// Command gentopics generates the per-API-version webhook topic lists used by IsValidTopic.
+//
+// Usage:
+//
+//	go run ./internal/cmd/gentopics -out webhooktopic_gen.go 2024-04=internal/cmd/gentopics/schema/2024-04.graphql 2024-07=internal/cmd/gentopics/schema/2024-07.graphql
+//
+// Each schema is an Admin API schema as fetched by go-shopify-graphql-model's fetchSchema.js, or
+// only its WebhookSubscriptionTopic enum, as kept in the schema directory.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+func main() {
+	out := flag.String("out", "webhooktopic_gen.go", "output file")
+	pkg := flag.String("package", "shopify", "package name of the generated file")
+	flag.Parse()
+	if flag.NArg() == 0 {
+		log.Fatal("usage: gentopics [-out file] version=schema.graphql...")
+	}
+
+	topics := map[string][]string{}
+	for _, arg := range flag.Args() {
+		version, path, ok := strings.Cut(arg, "=")
+		if !ok {
+			log.Fatalf("invalid argument %q, want version=schema.graphql", arg)
+		}
+		values, err := readEnum(path, "WebhookSubscriptionTopic")
+		if err != nil {
+			log.Fatalf("read %s: %s", path, err)
+		}
+		topics[version] = values
+	}
+
+	src, err := render(*pkg, topics)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// readEnum returns the values of enum name declared in the SDL file at path.
+func readEnum(path, name string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		values  []string
+		inEnum  bool
+		inDescr bool
+	)
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 1024*1024), 1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !inEnum {
+			inEnum = line == "enum "+name+" {"
+			continue
+		}
+		switch {
+		case line == "}":
+			sort.Strings(values)
+			return values, nil
+		case strings.HasPrefix(line, `"""`):
+			// A description is either a single line """...""" or spans until the next """.
+			if !(len(line) > 3 && strings.HasSuffix(line, `"""`)) {
+				inDescr = !inDescr
+			}
+		case inDescr, line == "", strings.HasPrefix(line, `"`), strings.HasPrefix(line, "#"):
+		default:
+			values = append(values, strings.Fields(line)[0])
+		}
+	}
+	if err = scanner.Err(); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("enum %s not found", name)
+}
+
+func render(pkg string, topics map[string][]string) ([]byte, error) {
+	versions := make([]string, 0, len(topics))
+	for v := range topics {
+		versions = append(versions, v)
+	}
+	sort.Strings(versions)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gentopics. DO NOT EDIT.\n\npackage %s\n\n", pkg)
+	buf.WriteString("import \"github.com/gempages/go-shopify-graphql-model/graph/model\"\n\n")
+	fmt.Fprintf(&buf, "// latestWebhookTopicVersion is the newest API version with a generated topic list.\n")
+	fmt.Fprintf(&buf, "const latestWebhookTopicVersion = %q\n\n", versions[len(versions)-1])
+	buf.WriteString("var webhookTopicsByVersion = map[string][]model.WebhookSubscriptionTopic{\n")
+	for _, v := range versions {
+		fmt.Fprintf(&buf, "%q: {\n", v)
+		for _, t := range topics[v] {
+			fmt.Fprintf(&buf, "%q,\n", t)
+		}
+		buf.WriteString("},\n")
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
@@ -3,14 +3,29 @@ package shopify
 import (
 	"context"
 	"fmt"
+	"strings"
+
+	"github.com/gempages/go-helper/errors"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
 
 	"github.com/gempages/go-shopify-graphql/graphql"
 )
 
 type InventoryService interface {
 	Update(ctx context.Context, id graphql.ID, input InventoryItemUpdateInput) error
+	UpdateItem(ctx context.Context, id string, input InventoryItemInput) (*model.InventoryItem, error)
 	Adjust(ctx context.Context, locationID graphql.ID, input []InventoryAdjustItemInput) error
+	AdjustQuantities(ctx context.Context, input model.InventoryAdjustQuantitiesInput) (*model.InventoryAdjustmentGroup, error)
+	SetOnHand(ctx context.Context, input model.InventorySetOnHandQuantitiesInput) (*model.InventoryAdjustmentGroup, error)
+	SetScheduledChanges(ctx context.Context, input model.InventorySetScheduledChangesInput) ([]*model.InventoryScheduledChange, error)
 	ActivateInventory(ctx context.Context, locationID graphql.ID, id graphql.ID) error
+	Activate(ctx context.Context, itemID, locationID string) (*model.InventoryLevel, error)
+	Deactivate(ctx context.Context, itemID, locationID string) error
+	BulkToggleActivation(ctx context.Context, itemID string, updates []model.InventoryBulkToggleActivationInput) ([]*model.InventoryLevel, error)
+
+	ListLevels(ctx context.Context, itemID string) ([]*InventoryLevelQuantities, error)
+	ListLevelsAtLocation(ctx context.Context, locationID string, opts ListOptions) ([]*InventoryLevelQuantities, string, error)
+	BulkListLevelsAtLocation(ctx context.Context, locationID string) ([]*InventoryLevelQuantities, error)
 }
 
 type InventoryServiceOp struct {
@@ -31,10 +46,94 @@ type InventoryLevel struct {
 	Item      InventoryItem  `json:"item,omitempty"`
 }
 
+// InventoryLevelQuantities is an inventory level with its quantities by name.
+type InventoryLevelQuantities struct {
+	ID         string
+	ItemID     string
+	SKU        string
+	LocationID string
+	Location   string
+	UpdatedAt  DateTime
+	Available  int
+	Committed  int
+	OnHand     int
+	Incoming   int
+}
+
+type inventoryLevelNode struct {
+	ID        string   `json:"id"`
+	UpdatedAt DateTime `json:"updatedAt"`
+	Item      struct {
+		ID  string `json:"id"`
+		SKU string `json:"sku"`
+	} `json:"item"`
+	Location struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"location"`
+	Quantities []struct {
+		Name     string `json:"name"`
+		Quantity int    `json:"quantity"`
+	} `json:"quantities"`
+}
+
+func (n *inventoryLevelNode) toQuantities() *InventoryLevelQuantities {
+	level := &InventoryLevelQuantities{
+		ID:         n.ID,
+		ItemID:     n.Item.ID,
+		SKU:        n.Item.SKU,
+		LocationID: n.Location.ID,
+		Location:   n.Location.Name,
+		UpdatedAt:  n.UpdatedAt,
+	}
+	for _, q := range n.Quantities {
+		switch InventoryQuantityName(q.Name) {
+		case InventoryQuantityAvailable:
+			level.Available = q.Quantity
+		case InventoryQuantityCommitted:
+			level.Committed = q.Quantity
+		case InventoryQuantityOnHand:
+			level.OnHand = q.Quantity
+		case InventoryQuantityIncoming:
+			level.Incoming = q.Quantity
+		}
+	}
+	return level
+}
+
+const inventoryLevelQuantitiesFields = `
+	id
+	updatedAt
+	item {
+		id
+		sku
+	}
+	location {
+		id
+		name
+	}
+	quantities(names: ["available", "committed", "on_hand", "incoming"]) {
+		name
+		quantity
+	}
+`
+
 type InventoryItemUpdateInput struct {
 	Cost graphql.Float `json:"cost,omitempty"`
 }
 
+// InventoryItemInput is the InventoryItemInput of inventoryItemUpdate with the customs fields
+// missing from model.InventoryItemInput.
+type InventoryItemInput struct {
+	model.InventoryItemInput
+	// The ISO 3166-1 alpha-2 country code of where the item originated from.
+	CountryCodeOfOrigin *model.CountryCode `json:"countryCodeOfOrigin,omitempty"`
+	// The ISO 3166-2 alpha-2 province/state code of where the item originated from.
+	ProvinceCodeOfOrigin *string `json:"provinceCodeOfOrigin,omitempty"`
+	// Country specific harmonized system codes.
+	CountryHarmonizedSystemCodes []model.CountryHarmonizedSystemCodeInput `json:"countryHarmonizedSystemCodes,omitempty"`
+}
+
 type mutationInventoryItemUpdate struct {
 	InventoryItemUpdateResult InventoryItemUpdateResult `graphql:"inventoryItemUpdate(id: $id, input: $input)" json:"inventoryItemUpdate"`
 }
@@ -43,6 +142,45 @@ type InventoryItemUpdateResult struct {
 	UserErrors []UserErrors `json:"userErrors,omitempty"`
 }
 
+type mutationInventoryItemUpdateWithItem struct {
+	InventoryItemUpdateResult struct {
+		InventoryItem *model.InventoryItem `json:"inventoryItem,omitempty"`
+		UserErrors    []UserErrors         `json:"userErrors,omitempty"`
+	} `json:"inventoryItemUpdate"`
+}
+
+const inventoryItemUpdate = `
+mutation inventoryItemUpdate($id: ID!, $input: InventoryItemInput!) {
+	inventoryItemUpdate(id: $id, input: $input) {
+		inventoryItem {
+			id
+			sku
+			tracked
+			requiresShipping
+			countryCodeOfOrigin
+			provinceCodeOfOrigin
+			harmonizedSystemCode
+			countryHarmonizedSystemCodes(first: 250) {
+				edges {
+					node {
+						countryCode
+						harmonizedSystemCode
+					}
+				}
+			}
+			unitCost {
+				amount
+				currencyCode
+			}
+		}
+		userErrors {
+			field
+			message
+		}
+	}
+}
+`
+
 type InventoryAdjustItemInput struct {
 	InventoryItemID graphql.ID  `json:"inventoryItemId,omitempty"`
 	AvailableDelta  graphql.Int `json:"availableDelta,omitempty"`
@@ -56,6 +194,106 @@ type InventoryBulkAdjustQuantityAtLocationResult struct {
 	UserErrors []UserErrors `json:"userErrors,omitempty"`
 }
 
+type mutationInventoryAdjustQuantities struct {
+	InventoryAdjustQuantitiesResult struct {
+		InventoryAdjustmentGroup *model.InventoryAdjustmentGroup `json:"inventoryAdjustmentGroup,omitempty"`
+		UserErrors               []UserErrors                    `json:"userErrors,omitempty"`
+	} `json:"inventoryAdjustQuantities"`
+}
+
+const inventoryAdjustmentGroupFields = `
+	id
+	createdAt
+	reason
+	referenceDocumentUri
+	changes {
+		name
+		delta
+		quantityAfterChange
+		ledgerDocumentUri
+		item {
+			id
+			sku
+		}
+		location {
+			id
+			name
+		}
+	}
+`
+
+var inventoryAdjustQuantities = fmt.Sprintf(`
+mutation inventoryAdjustQuantities($input: InventoryAdjustQuantitiesInput!) {
+	inventoryAdjustQuantities(input: $input) {
+		inventoryAdjustmentGroup {
+			%s
+		}
+		userErrors {
+			field
+			message
+			code
+		}
+	}
+}
+`, inventoryAdjustmentGroupFields)
+
+type mutationInventorySetOnHandQuantities struct {
+	InventorySetOnHandQuantitiesResult struct {
+		InventoryAdjustmentGroup *model.InventoryAdjustmentGroup `json:"inventoryAdjustmentGroup,omitempty"`
+		UserErrors               []UserErrors                    `json:"userErrors,omitempty"`
+	} `json:"inventorySetOnHandQuantities"`
+}
+
+var inventorySetOnHandQuantities = fmt.Sprintf(`
+mutation inventorySetOnHandQuantities($input: InventorySetOnHandQuantitiesInput!) {
+	inventorySetOnHandQuantities(input: $input) {
+		inventoryAdjustmentGroup {
+			%s
+		}
+		userErrors {
+			field
+			message
+			code
+		}
+	}
+}
+`, inventoryAdjustmentGroupFields)
+
+type mutationInventorySetScheduledChanges struct {
+	InventorySetScheduledChangesResult struct {
+		ScheduledChanges []*model.InventoryScheduledChange `json:"scheduledChanges,omitempty"`
+		UserErrors       []UserErrors                      `json:"userErrors,omitempty"`
+	} `json:"inventorySetScheduledChanges"`
+}
+
+const inventorySetScheduledChanges = `
+mutation inventorySetScheduledChanges($input: InventorySetScheduledChangesInput!) {
+	inventorySetScheduledChanges(input: $input) {
+		scheduledChanges {
+			expectedAt
+			fromName
+			toName
+			quantity
+			ledgerDocumentUri
+			inventoryLevel {
+				id
+				item {
+					id
+				}
+				location {
+					id
+				}
+			}
+		}
+		userErrors {
+			field
+			message
+			code
+		}
+	}
+}
+`
+
 type mutationInventoryActivate struct {
 	InventoryActivateResult InventoryActivateResult `graphql:"inventoryActivate(inventoryItemId: $itemID, locationId: $locationId)" json:"inventoryActivate"`
 }
@@ -64,6 +302,47 @@ type InventoryActivateResult struct {
 	UserErrors []UserErrors `json:"userErrors,omitempty"`
 }
 
+const inventoryLevelActivationFields = `
+	id
+	isActive
+	location {
+		id
+		name
+	}
+	item {
+		id
+	}
+`
+
+var inventoryActivate = fmt.Sprintf(`
+mutation inventoryActivate($inventoryItemId: ID!, $locationId: ID!) {
+	inventoryActivate(inventoryItemId: $inventoryItemId, locationId: $locationId) {
+		inventoryLevel {
+			%s
+		}
+		userErrors {
+			field
+			message
+		}
+	}
+}
+`, inventoryLevelActivationFields)
+
+var inventoryBulkToggleActivation = fmt.Sprintf(`
+mutation inventoryBulkToggleActivation($inventoryItemId: ID!, $inventoryItemUpdates: [InventoryBulkToggleActivationInput!]!) {
+	inventoryBulkToggleActivation(inventoryItemId: $inventoryItemId, inventoryItemUpdates: $inventoryItemUpdates) {
+		inventoryLevels {
+			%s
+		}
+		userErrors {
+			field
+			message
+			code
+		}
+	}
+}
+`, inventoryLevelActivationFields)
+
 func (s *InventoryServiceOp) Update(ctx context.Context, id graphql.ID, input InventoryItemUpdateInput) error {
 	m := mutationInventoryItemUpdate{}
 	vars := map[string]interface{}{
@@ -82,6 +361,25 @@ func (s *InventoryServiceOp) Update(ctx context.Context, id graphql.ID, input In
 	return nil
 }
 
+// UpdateItem updates the inventory item's cost, tracking and customs data (country of origin, HS codes).
+func (s *InventoryServiceOp) UpdateItem(ctx context.Context, id string, input InventoryItemInput) (*model.InventoryItem, error) {
+	out := mutationInventoryItemUpdateWithItem{}
+	vars := map[string]interface{}{
+		"id":    id,
+		"input": input,
+	}
+	err := s.client.gql.MutateString(ctx, inventoryItemUpdate, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.InventoryItemUpdateResult.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.InventoryItemUpdateResult.UserErrors)
+	}
+
+	return out.InventoryItemUpdateResult.InventoryItem, nil
+}
+
 func (s *InventoryServiceOp) Adjust(ctx context.Context, locationID graphql.ID, input []InventoryAdjustItemInput) error {
 	m := mutationInventoryBulkAdjustQuantityAtLocation{}
 	vars := map[string]interface{}{
@@ -100,6 +398,73 @@ func (s *InventoryServiceOp) Adjust(ctx context.Context, locationID graphql.ID,
 	return nil
 }
 
+// AdjustQuantities applies quantity deltas for one quantity name (e.g. available, damaged) across
+// several items and locations in one call. The reason and reference document are recorded in the inventory ledger.
+func (s *InventoryServiceOp) AdjustQuantities(ctx context.Context, input model.InventoryAdjustQuantitiesInput) (*model.InventoryAdjustmentGroup, error) {
+	if err := ValidateInventoryAdjustQuantities(input); err != nil {
+		return nil, err
+	}
+
+	out := mutationInventoryAdjustQuantities{}
+	vars := map[string]interface{}{
+		"input": input,
+	}
+	err := s.client.gql.MutateString(ctx, inventoryAdjustQuantities, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.InventoryAdjustQuantitiesResult.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.InventoryAdjustQuantitiesResult.UserErrors)
+	}
+
+	return out.InventoryAdjustQuantitiesResult.InventoryAdjustmentGroup, nil
+}
+
+// SetOnHand sets absolute on hand quantities, Shopify computes and records the deltas.
+func (s *InventoryServiceOp) SetOnHand(ctx context.Context, input model.InventorySetOnHandQuantitiesInput) (*model.InventoryAdjustmentGroup, error) {
+	if err := ValidateInventorySetOnHand(input); err != nil {
+		return nil, err
+	}
+
+	out := mutationInventorySetOnHandQuantities{}
+	vars := map[string]interface{}{
+		"input": input,
+	}
+	err := s.client.gql.MutateString(ctx, inventorySetOnHandQuantities, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.InventorySetOnHandQuantitiesResult.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.InventorySetOnHandQuantitiesResult.UserErrors)
+	}
+
+	return out.InventorySetOnHandQuantitiesResult.InventoryAdjustmentGroup, nil
+}
+
+// SetScheduledChanges sets when incoming quantities are expected to move to another state (e.g. incoming to available).
+func (s *InventoryServiceOp) SetScheduledChanges(ctx context.Context, input model.InventorySetScheduledChangesInput) ([]*model.InventoryScheduledChange, error) {
+	if err := ValidateInventorySetScheduledChanges(input); err != nil {
+		return nil, err
+	}
+
+	out := mutationInventorySetScheduledChanges{}
+	vars := map[string]interface{}{
+		"input": input,
+	}
+	err := s.client.gql.MutateString(ctx, inventorySetScheduledChanges, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.InventorySetScheduledChangesResult.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.InventorySetScheduledChangesResult.UserErrors)
+	}
+
+	return out.InventorySetScheduledChangesResult.ScheduledChanges, nil
+}
+
 func (s *InventoryServiceOp) ActivateInventory(ctx context.Context, locationID graphql.ID, id graphql.ID) error {
 	m := mutationInventoryActivate{}
 	vars := map[string]interface{}{
@@ -117,3 +482,228 @@ func (s *InventoryServiceOp) ActivateInventory(ctx context.Context, locationID g
 
 	return nil
 }
+
+// Activate stocks the inventory item at the location.
+func (s *InventoryServiceOp) Activate(ctx context.Context, itemID, locationID string) (*model.InventoryLevel, error) {
+	out := struct {
+		InventoryActivate struct {
+			InventoryLevel *model.InventoryLevel `json:"inventoryLevel,omitempty"`
+			UserErrors     []UserErrors          `json:"userErrors,omitempty"`
+		} `json:"inventoryActivate"`
+	}{}
+	vars := map[string]interface{}{
+		"inventoryItemId": itemID,
+		"locationId":      locationID,
+	}
+	err := s.client.gql.MutateString(ctx, inventoryActivate, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.InventoryActivate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.InventoryActivate.UserErrors)
+	}
+
+	return out.InventoryActivate.InventoryLevel, nil
+}
+
+// Deactivate removes the inventory item from the location. Shopify refuses it when the item has
+// committed quantities there or it is the only location stocking the item.
+func (s *InventoryServiceOp) Deactivate(ctx context.Context, itemID, locationID string) error {
+	_, err := s.BulkToggleActivation(ctx, itemID, []model.InventoryBulkToggleActivationInput{
+		{
+			LocationID: locationID,
+			Activate:   false,
+		},
+	})
+	return err
+}
+
+// BulkToggleActivation activates or deactivates the inventory item at several locations at once.
+func (s *InventoryServiceOp) BulkToggleActivation(ctx context.Context, itemID string, updates []model.InventoryBulkToggleActivationInput) ([]*model.InventoryLevel, error) {
+	out := struct {
+		InventoryBulkToggleActivation struct {
+			InventoryLevels []*model.InventoryLevel `json:"inventoryLevels,omitempty"`
+			UserErrors      []UserErrors            `json:"userErrors,omitempty"`
+		} `json:"inventoryBulkToggleActivation"`
+	}{}
+	vars := map[string]interface{}{
+		"inventoryItemId":      itemID,
+		"inventoryItemUpdates": updates,
+	}
+	err := s.client.gql.MutateString(ctx, inventoryBulkToggleActivation, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.InventoryBulkToggleActivation.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.InventoryBulkToggleActivation.UserErrors)
+	}
+
+	return out.InventoryBulkToggleActivation.InventoryLevels, nil
+}
+
+// ListLevels returns the inventory levels of the item at every location it is stocked at.
+func (s *InventoryServiceOp) ListLevels(ctx context.Context, itemID string) ([]*InventoryLevelQuantities, error) {
+	q := fmt.Sprintf(`
+		query inventoryLevels($id: ID!, $after: String) {
+			inventoryItem(id: $id) {
+				inventoryLevels(first: 250, after: $after) {
+					edges {
+						node {
+							%s
+						}
+						cursor
+					}
+					pageInfo {
+						hasNextPage
+					}
+				}
+			}
+		}
+	`, inventoryLevelQuantitiesFields)
+
+	var (
+		res    []*InventoryLevelQuantities
+		cursor string
+	)
+	for {
+		vars := map[string]interface{}{
+			"id": itemID,
+		}
+		if cursor != "" {
+			vars["after"] = cursor
+		}
+
+		out := struct {
+			InventoryItem *struct {
+				InventoryLevels inventoryLevelConnection `json:"inventoryLevels"`
+			} `json:"inventoryItem"`
+		}{}
+		err := s.client.gql.QueryString(ctx, q, vars, &out)
+		if err != nil {
+			return nil, err
+		}
+
+		if out.InventoryItem == nil {
+			return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "inventory item not found", nil)
+		}
+
+		levels := out.InventoryItem.InventoryLevels
+		for i := range levels.Edges {
+			res = append(res, levels.Edges[i].Node.toQuantities())
+		}
+		if !levels.PageInfo.HasNextPage || len(levels.Edges) == 0 {
+			break
+		}
+		cursor = levels.Edges[len(levels.Edges)-1].Cursor
+	}
+
+	return res, nil
+}
+
+// ListLevelsAtLocation returns one page of inventory levels at the location and the cursor of the next page,
+// which is empty on the last page.
+func (s *InventoryServiceOp) ListLevelsAtLocation(ctx context.Context, locationID string, opts ListOptions) ([]*InventoryLevelQuantities, string, error) {
+	q := fmt.Sprintf(`
+		query inventoryLevels($id: ID!, $first: Int!, $after: String, $query: String, $reverse: Boolean) {
+			location(id: $id) {
+				inventoryLevels(first: $first, after: $after, query: $query, reverse: $reverse) {
+					edges {
+						node {
+							%s
+						}
+						cursor
+					}
+					pageInfo {
+						hasNextPage
+					}
+				}
+			}
+		}
+	`, inventoryLevelQuantitiesFields)
+
+	first := opts.First
+	if first <= 0 {
+		first = 250
+	}
+	vars := map[string]interface{}{
+		"id":      locationID,
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+	if opts.Query != "" {
+		vars["query"] = opts.Query
+	}
+
+	out := struct {
+		Location *struct {
+			InventoryLevels inventoryLevelConnection `json:"inventoryLevels"`
+		} `json:"location"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if out.Location == nil {
+		return nil, "", errors.NewNotExistsError(errors.ErrorResourceNotFound, "location not found", nil)
+	}
+
+	levels := out.Location.InventoryLevels
+	res := make([]*InventoryLevelQuantities, 0, len(levels.Edges))
+	for i := range levels.Edges {
+		res = append(res, levels.Edges[i].Node.toQuantities())
+	}
+
+	nextCursor := ""
+	if levels.PageInfo.HasNextPage && len(levels.Edges) > 0 {
+		nextCursor = levels.Edges[len(levels.Edges)-1].Cursor
+	}
+
+	return res, nextCursor, nil
+}
+
+// BulkListLevelsAtLocation exports every inventory level at the location through a bulk operation.
+func (s *InventoryServiceOp) BulkListLevelsAtLocation(ctx context.Context, locationID string) ([]*InventoryLevelQuantities, error) {
+	q := fmt.Sprintf(`
+		{
+			location(id: "$id") {
+				inventoryLevels {
+					edges {
+						node {
+							%s
+						}
+					}
+				}
+			}
+		}
+	`, inventoryLevelQuantitiesFields)
+	q = strings.ReplaceAll(q, "$id", locationID)
+
+	nodes := make([]*inventoryLevelNode, 0)
+	err := s.client.BulkOperation.BulkQuery(ctx, q, &nodes)
+	if err != nil {
+		return nil, fmt.Errorf("bulk query: %w", err)
+	}
+
+	res := make([]*InventoryLevelQuantities, 0, len(nodes))
+	for _, n := range nodes {
+		res = append(res, n.toQuantities())
+	}
+
+	return res, nil
+}
+
+type inventoryLevelConnection struct {
+	Edges []struct {
+		Node   inventoryLevelNode `json:"node"`
+		Cursor string             `json:"cursor"`
+	} `json:"edges"`
+	PageInfo struct {
+		HasNextPage bool `json:"hasNextPage"`
+	} `json:"pageInfo"`
+}
@@ -0,0 +1,80 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+
+	"github.com/gempages/go-shopify-graphql/graphql"
+)
+
+const inventoryItemUpdateResponse = `{
+	"data": {
+		"inventoryItemUpdate": {
+			"inventoryItem": {
+				"id": "gid://shopify/InventoryItem/30322695",
+				"sku": "SNOW-1",
+				"tracked": true,
+				"requiresShipping": true,
+				"countryCodeOfOrigin": "VN",
+				"provinceCodeOfOrigin": null,
+				"harmonizedSystemCode": "620342",
+				"countryHarmonizedSystemCodes": {
+					"edges": [{"node": {"countryCode": "US", "harmonizedSystemCode": "6203424011"}}]
+				}
+			},
+			"userErrors": []
+		}
+	}
+}`
+
+func TestInventoryUpdateItemSendsCustomsFields(t *testing.T) {
+	var body struct {
+		Variables struct {
+			Input map[string]interface{} `json:"input"`
+		} `json:"variables"`
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		_, _ = w.Write([]byte(inventoryItemUpdateResponse))
+	}))
+	defer srv.Close()
+	c := &Client{gql: graphql.NewClient(srv.URL, nil)}
+	c.initServices()
+
+	tracked := true
+	hsCode := "620342"
+	origin := model.CountryCodeVn
+	item, err := c.Inventory.UpdateItem(context.Background(), "gid://shopify/InventoryItem/30322695", InventoryItemInput{
+		InventoryItemInput:  model.InventoryItemInput{Tracked: &tracked, HarmonizedSystemCode: &hsCode},
+		CountryCodeOfOrigin: &origin,
+		CountryHarmonizedSystemCodes: []model.CountryHarmonizedSystemCodeInput{
+			{CountryCode: model.CountryCodeUs, HarmonizedSystemCode: "6203424011"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]interface{}{
+		"tracked":              true,
+		"harmonizedSystemCode": "620342",
+		"countryCodeOfOrigin":  "VN",
+		"countryHarmonizedSystemCodes": []interface{}{
+			map[string]interface{}{"countryCode": "US", "harmonizedSystemCode": "6203424011"},
+		},
+	}
+	if !reflect.DeepEqual(body.Variables.Input, want) {
+		t.Errorf("got input %#v, want %#v", body.Variables.Input, want)
+	}
+	if item.CountryCodeOfOrigin == nil || *item.CountryCodeOfOrigin != model.CountryCodeVn {
+		t.Errorf("got country of origin %v", item.CountryCodeOfOrigin)
+	}
+}
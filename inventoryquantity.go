@@ -0,0 +1,158 @@
+package shopify
+
+import (
+	"fmt"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// InventoryQuantityName is the name of an inventory state, as in the quantities of an inventory
+// level and the inputs of the inventory mutations, which take it as a string.
+type InventoryQuantityName string
+
+const (
+	InventoryQuantityAvailable      InventoryQuantityName = "available"
+	InventoryQuantityCommitted      InventoryQuantityName = "committed"
+	InventoryQuantityIncoming       InventoryQuantityName = "incoming"
+	InventoryQuantityOnHand         InventoryQuantityName = "on_hand"
+	InventoryQuantityReserved       InventoryQuantityName = "reserved"
+	InventoryQuantityDamaged        InventoryQuantityName = "damaged"
+	InventoryQuantityQualityControl InventoryQuantityName = "quality_control"
+	InventoryQuantitySafetyStock    InventoryQuantityName = "safety_stock"
+)
+
+// InventoryQuantityNames are all the inventory quantity names.
+var InventoryQuantityNames = []InventoryQuantityName{
+	InventoryQuantityAvailable,
+	InventoryQuantityCommitted,
+	InventoryQuantityIncoming,
+	InventoryQuantityOnHand,
+	InventoryQuantityReserved,
+	InventoryQuantityDamaged,
+	InventoryQuantityQualityControl,
+	InventoryQuantitySafetyStock,
+}
+
+// IsValid reports whether n is a known quantity name.
+func (n InventoryQuantityName) IsValid() bool {
+	for _, name := range InventoryQuantityNames {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsAdjustable reports whether n can be adjusted with InventoryService.AdjustQuantities. On hand
+// quantities are set with SetOnHand, and committed ones follow orders.
+func (n InventoryQuantityName) IsAdjustable() bool {
+	return n.IsValid() && n != InventoryQuantityOnHand && n != InventoryQuantityCommitted
+}
+
+// InventoryAdjustmentReason is the reason of an inventory change.
+type InventoryAdjustmentReason string
+
+const (
+	InventoryAdjustmentReasonCorrection          InventoryAdjustmentReason = "correction"
+	InventoryAdjustmentReasonCycleCountAvailable InventoryAdjustmentReason = "cycle_count_available"
+	InventoryAdjustmentReasonDamaged             InventoryAdjustmentReason = "damaged"
+	InventoryAdjustmentReasonMovementCanceled    InventoryAdjustmentReason = "movement_canceled"
+	InventoryAdjustmentReasonMovementCreated     InventoryAdjustmentReason = "movement_created"
+	InventoryAdjustmentReasonMovementReceived    InventoryAdjustmentReason = "movement_received"
+	InventoryAdjustmentReasonMovementUpdated     InventoryAdjustmentReason = "movement_updated"
+	InventoryAdjustmentReasonOther               InventoryAdjustmentReason = "other"
+	InventoryAdjustmentReasonPromotion           InventoryAdjustmentReason = "promotion"
+	InventoryAdjustmentReasonQualityControl      InventoryAdjustmentReason = "quality_control"
+	InventoryAdjustmentReasonReceived            InventoryAdjustmentReason = "received"
+	InventoryAdjustmentReasonReservationCreated  InventoryAdjustmentReason = "reservation_created"
+	InventoryAdjustmentReasonReservationDeleted  InventoryAdjustmentReason = "reservation_deleted"
+	InventoryAdjustmentReasonReservationUpdated  InventoryAdjustmentReason = "reservation_updated"
+	InventoryAdjustmentReasonRestock             InventoryAdjustmentReason = "restock"
+	InventoryAdjustmentReasonSafetyStock         InventoryAdjustmentReason = "safety_stock"
+	InventoryAdjustmentReasonShrinkage           InventoryAdjustmentReason = "shrinkage"
+)
+
+// InventoryAdjustmentReasons are all the inventory adjustment reasons.
+var InventoryAdjustmentReasons = []InventoryAdjustmentReason{
+	InventoryAdjustmentReasonCorrection,
+	InventoryAdjustmentReasonCycleCountAvailable,
+	InventoryAdjustmentReasonDamaged,
+	InventoryAdjustmentReasonMovementCanceled,
+	InventoryAdjustmentReasonMovementCreated,
+	InventoryAdjustmentReasonMovementReceived,
+	InventoryAdjustmentReasonMovementUpdated,
+	InventoryAdjustmentReasonOther,
+	InventoryAdjustmentReasonPromotion,
+	InventoryAdjustmentReasonQualityControl,
+	InventoryAdjustmentReasonReceived,
+	InventoryAdjustmentReasonReservationCreated,
+	InventoryAdjustmentReasonReservationDeleted,
+	InventoryAdjustmentReasonReservationUpdated,
+	InventoryAdjustmentReasonRestock,
+	InventoryAdjustmentReasonSafetyStock,
+	InventoryAdjustmentReasonShrinkage,
+}
+
+// IsValid reports whether r is a known adjustment reason.
+func (r InventoryAdjustmentReason) IsValid() bool {
+	for _, reason := range InventoryAdjustmentReasons {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateInventoryAdjustQuantities returns an error if input would be rejected by
+// inventoryAdjustQuantities: an unknown reason, a quantity name that can't be adjusted, or a
+// change to a quantity other than available without a ledger document URI.
+func ValidateInventoryAdjustQuantities(input model.InventoryAdjustQuantitiesInput) error {
+	if err := validateInventoryReason(input.Reason); err != nil {
+		return err
+	}
+	name := InventoryQuantityName(input.Name)
+	if !name.IsAdjustable() {
+		return fmt.Errorf("inventory quantity %q can't be adjusted", input.Name)
+	}
+	if name != InventoryQuantityAvailable {
+		for _, change := range input.Changes {
+			if change.LedgerDocumentURI == nil || *change.LedgerDocumentURI == "" {
+				return fmt.Errorf("changes of the %s inventory quantity of item %s require a ledger document URI", name, change.InventoryItemID)
+			}
+		}
+	}
+	return nil
+}
+
+// ValidateInventorySetOnHand returns an error if the reason of input is unknown.
+func ValidateInventorySetOnHand(input model.InventorySetOnHandQuantitiesInput) error {
+	return validateInventoryReason(input.Reason)
+}
+
+// ValidateInventorySetScheduledChanges returns an error if the reason of input is unknown, or if
+// a scheduled change doesn't move quantities between two different known names.
+func ValidateInventorySetScheduledChanges(input model.InventorySetScheduledChangesInput) error {
+	if err := validateInventoryReason(input.Reason); err != nil {
+		return err
+	}
+	for _, item := range input.Items {
+		for _, change := range item.ScheduledChanges {
+			for _, name := range []string{change.FromName, change.ToName} {
+				if !InventoryQuantityName(name).IsValid() {
+					return fmt.Errorf("unknown inventory quantity %q in the scheduled changes of item %s", name, item.InventoryItemID)
+				}
+			}
+			if change.FromName == change.ToName {
+				return fmt.Errorf("scheduled change of item %s from and to the %s inventory quantity", item.InventoryItemID, change.FromName)
+			}
+		}
+	}
+	return nil
+}
+
+func validateInventoryReason(reason string) error {
+	if !InventoryAdjustmentReason(reason).IsValid() {
+		return fmt.Errorf("unknown inventory adjustment reason %q", reason)
+	}
+	return nil
+}
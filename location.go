@@ -2,21 +2,90 @@ package shopify
 
 import (
 	"context"
+	"fmt"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
 
 	"github.com/gempages/go-shopify-graphql/graphql"
 )
 
 type LocationService interface {
 	Get(ctx context.Context, id graphql.ID) (*Location, error)
+	List(ctx context.Context, opts LocationListOptions) ([]*Location, error)
+
+	Add(ctx context.Context, input model.LocationAddInput) (*Location, error)
+	Edit(ctx context.Context, id string, input model.LocationEditInput) (*Location, error)
+	Activate(ctx context.Context, id string) (*Location, error)
+	Deactivate(ctx context.Context, id string, destinationLocationID *string) (*Location, error)
 }
 
 type LocationServiceOp struct {
 	client *Client
 }
 
+var _ LocationService = &LocationServiceOp{}
+
 type Location struct {
-	ID   graphql.ID     `json:"id,omitempty"`
-	Name graphql.String `json:"name,omitempty"`
+	ID                   graphql.ID      `json:"id,omitempty"`
+	Name                 graphql.String  `json:"name,omitempty"`
+	LegacyResourceID     graphql.String  `json:"legacyResourceId,omitempty"`
+	IsActive             graphql.Boolean `json:"isActive,omitempty"`
+	Activatable          graphql.Boolean `json:"activatable,omitempty"`
+	Deactivatable        graphql.Boolean `json:"deactivatable,omitempty"`
+	DeactivatedAt        graphql.String  `json:"deactivatedAt,omitempty"`
+	FulfillsOnlineOrders graphql.Boolean `json:"fulfillsOnlineOrders,omitempty"`
+	HasActiveInventory   graphql.Boolean `json:"hasActiveInventory,omitempty"`
+	ShipsInventory       graphql.Boolean `json:"shipsInventory,omitempty"`
+	Address              LocationAddress `json:"address,omitempty"`
+}
+
+type LocationAddress struct {
+	Address1     graphql.String `json:"address1,omitempty"`
+	Address2     graphql.String `json:"address2,omitempty"`
+	City         graphql.String `json:"city,omitempty"`
+	Province     graphql.String `json:"province,omitempty"`
+	ProvinceCode graphql.String `json:"provinceCode,omitempty"`
+	Country      graphql.String `json:"country,omitempty"`
+	CountryCode  CountryCode    `json:"countryCode,omitempty"`
+	Zip          graphql.String `json:"zip,omitempty"`
+	Phone        graphql.String `json:"phone,omitempty"`
+}
+
+// LocationListOptions filters the locations returned by List.
+// By default Shopify only returns active, non legacy (fulfillment service) locations.
+type LocationListOptions struct {
+	Query           string
+	IncludeInactive bool
+	IncludeLegacy   bool
+}
+
+const locationFields = `
+	id
+	name
+	legacyResourceId
+	isActive
+	activatable
+	deactivatable
+	deactivatedAt
+	fulfillsOnlineOrders
+	hasActiveInventory
+	shipsInventory
+	address {
+		address1
+		address2
+		city
+		province
+		provinceCode
+		country
+		countryCode
+		zip
+		phone
+	}
+`
+
+type locationMutationResult struct {
+	Location   *Location    `json:"location,omitempty"`
+	UserErrors []UserErrors `json:"userErrors,omitempty"`
 }
 
 func (s *LocationServiceOp) Get(ctx context.Context, id graphql.ID) (*Location, error) {
@@ -41,3 +110,213 @@ func (s *LocationServiceOp) Get(ctx context.Context, id graphql.ID) (*Location,
 
 	return out.Location, nil
 }
+
+func (s *LocationServiceOp) List(ctx context.Context, opts LocationListOptions) ([]*Location, error) {
+	q := fmt.Sprintf(`
+		query locations($first: Int!, $after: String, $query: String, $includeInactive: Boolean, $includeLegacy: Boolean) {
+			locations(first: $first, after: $after, query: $query, includeInactive: $includeInactive, includeLegacy: $includeLegacy) {
+				edges {
+					node {
+						%s
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+				}
+			}
+		}
+	`, locationFields)
+
+	var (
+		res    []*Location
+		cursor string
+	)
+	for {
+		vars := map[string]interface{}{
+			"first":           250,
+			"includeInactive": opts.IncludeInactive,
+			"includeLegacy":   opts.IncludeLegacy,
+		}
+		if opts.Query != "" {
+			vars["query"] = opts.Query
+		}
+		if cursor != "" {
+			vars["after"] = cursor
+		}
+
+		out := struct {
+			Locations struct {
+				Edges []struct {
+					Node   *Location `json:"node"`
+					Cursor string    `json:"cursor"`
+				} `json:"edges"`
+				PageInfo struct {
+					HasNextPage bool `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"locations"`
+		}{}
+		err := s.client.gql.QueryString(ctx, q, vars, &out)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range out.Locations.Edges {
+			res = append(res, e.Node)
+		}
+		if !out.Locations.PageInfo.HasNextPage || len(out.Locations.Edges) == 0 {
+			break
+		}
+		cursor = out.Locations.Edges[len(out.Locations.Edges)-1].Cursor
+	}
+
+	return res, nil
+}
+
+func (s *LocationServiceOp) Add(ctx context.Context, input model.LocationAddInput) (*Location, error) {
+	m := fmt.Sprintf(`
+	mutation locationAdd($input: LocationAddInput!) {
+		locationAdd(input: $input) {
+			location {
+				%s
+			}
+			userErrors {
+				field
+				message
+				code
+			}
+		}
+	}
+	`, locationFields)
+
+	out := struct {
+		LocationAdd locationMutationResult `json:"locationAdd"`
+	}{}
+	vars := map[string]interface{}{
+		"input": input,
+	}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.LocationAdd.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.LocationAdd.UserErrors)
+	}
+
+	return out.LocationAdd.Location, nil
+}
+
+func (s *LocationServiceOp) Edit(ctx context.Context, id string, input model.LocationEditInput) (*Location, error) {
+	m := fmt.Sprintf(`
+	mutation locationEdit($id: ID!, $input: LocationEditInput!) {
+		locationEdit(id: $id, input: $input) {
+			location {
+				%s
+			}
+			userErrors {
+				field
+				message
+				code
+			}
+		}
+	}
+	`, locationFields)
+
+	out := struct {
+		LocationEdit locationMutationResult `json:"locationEdit"`
+	}{}
+	vars := map[string]interface{}{
+		"id":    id,
+		"input": input,
+	}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.LocationEdit.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.LocationEdit.UserErrors)
+	}
+
+	return out.LocationEdit.Location, nil
+}
+
+func (s *LocationServiceOp) Activate(ctx context.Context, id string) (*Location, error) {
+	m := fmt.Sprintf(`
+	mutation locationActivate($locationId: ID!) {
+		locationActivate(locationId: $locationId) {
+			location {
+				%s
+			}
+			locationActivateUserErrors {
+				field
+				message
+				code
+			}
+		}
+	}
+	`, locationFields)
+
+	out := struct {
+		LocationActivate struct {
+			Location   *Location    `json:"location,omitempty"`
+			UserErrors []UserErrors `json:"locationActivateUserErrors,omitempty"`
+		} `json:"locationActivate"`
+	}{}
+	vars := map[string]interface{}{
+		"locationId": id,
+	}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.LocationActivate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.LocationActivate.UserErrors)
+	}
+
+	return out.LocationActivate.Location, nil
+}
+
+// Deactivate deactivates the location, destinationLocationID is where its inventory and pending orders are
+// moved to and is required when the location still has active inventory.
+func (s *LocationServiceOp) Deactivate(ctx context.Context, id string, destinationLocationID *string) (*Location, error) {
+	m := fmt.Sprintf(`
+	mutation locationDeactivate($locationId: ID!, $destinationLocationId: ID) {
+		locationDeactivate(locationId: $locationId, destinationLocationId: $destinationLocationId) {
+			location {
+				%s
+			}
+			locationDeactivateUserErrors {
+				field
+				message
+				code
+			}
+		}
+	}
+	`, locationFields)
+
+	out := struct {
+		LocationDeactivate struct {
+			Location   *Location    `json:"location,omitempty"`
+			UserErrors []UserErrors `json:"locationDeactivateUserErrors,omitempty"`
+		} `json:"locationDeactivate"`
+	}{}
+	vars := map[string]interface{}{
+		"locationId": id,
+	}
+	if destinationLocationID != nil {
+		vars["destinationLocationId"] = *destinationLocationID
+	}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.LocationDeactivate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.LocationDeactivate.UserErrors)
+	}
+
+	return out.LocationDeactivate.Location, nil
+}
@@ -0,0 +1,347 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gempages/go-helper/errors"
+)
+
+// MenuService manages the navigation menus of the Online Store. Like pages, menus are only in the
+// GraphQL Admin API from version 2024-10.
+type MenuService interface {
+	Get(ctx context.Context, id string) (*Menu, error)
+	List(ctx context.Context, opts ListOptions) ([]*Menu, string, error)
+
+	Create(ctx context.Context, title, handle string, items []MenuItemInput) (*Menu, error)
+	Update(ctx context.Context, id, title string, handle *string, items []MenuItemInput) (*Menu, error)
+	Delete(ctx context.Context, id string) (string, error)
+}
+
+type MenuServiceOp struct {
+	client *Client
+}
+
+var _ MenuService = &MenuServiceOp{}
+
+type MenuItemType string
+
+const (
+	MenuItemTypeFrontpage   MenuItemType = "FRONTPAGE"
+	MenuItemTypeCatalog     MenuItemType = "CATALOG"
+	MenuItemTypeCollection  MenuItemType = "COLLECTION"
+	MenuItemTypeCollections MenuItemType = "COLLECTIONS"
+	MenuItemTypeProduct     MenuItemType = "PRODUCT"
+	MenuItemTypePage        MenuItemType = "PAGE"
+	MenuItemTypeBlog        MenuItemType = "BLOG"
+	MenuItemTypeArticle     MenuItemType = "ARTICLE"
+	MenuItemTypeSearch      MenuItemType = "SEARCH"
+	MenuItemTypeShopPolicy  MenuItemType = "SHOP_POLICY"
+	MenuItemTypeMetaobject  MenuItemType = "METAOBJECT"
+	MenuItemTypeHTTP        MenuItemType = "HTTP"
+)
+
+type Menu struct {
+	ID     string `json:"id"`
+	Title  string `json:"title"`
+	Handle string `json:"handle"`
+	// IsDefault is set on the main menu and footer menu, which can't be deleted and whose handle
+	// can't be changed.
+	IsDefault bool       `json:"isDefault"`
+	Items     []MenuItem `json:"items"`
+}
+
+// MenuItem is a link of a menu. Items nest up to three levels deep.
+type MenuItem struct {
+	ID         string       `json:"id"`
+	Title      string       `json:"title"`
+	Type       MenuItemType `json:"type"`
+	ResourceID *string      `json:"resourceId,omitempty"`
+	URL        *string      `json:"url,omitempty"`
+	Tags       []string     `json:"tags"`
+	Items      []MenuItem   `json:"items"`
+}
+
+// MenuItemInput describes a menu item to create or update. ID is only set to keep an existing
+// item when updating a menu. ResourceID links to a resource such as a page or collection; URL is
+// used for HTTP items.
+type MenuItemInput struct {
+	ID         *string         `json:"id,omitempty"`
+	Title      string          `json:"title"`
+	Type       MenuItemType    `json:"type"`
+	ResourceID *string         `json:"resourceId,omitempty"`
+	URL        *string         `json:"url,omitempty"`
+	Tags       []string        `json:"tags,omitempty"`
+	Items      []MenuItemInput `json:"items,omitempty"`
+}
+
+// ItemInputs returns the items of the menu as inputs that keep them when passed to Update, so
+// links can be added to or removed from a menu without recreating the others.
+func (m *Menu) ItemInputs() []MenuItemInput {
+	return menuItemInputs(m.Items)
+}
+
+func menuItemInputs(items []MenuItem) []MenuItemInput {
+	if len(items) == 0 {
+		return nil
+	}
+	inputs := make([]MenuItemInput, 0, len(items))
+	for _, item := range items {
+		id := item.ID
+		inputs = append(inputs, MenuItemInput{
+			ID:         &id,
+			Title:      item.Title,
+			Type:       item.Type,
+			ResourceID: item.ResourceID,
+			URL:        item.URL,
+			Tags:       item.Tags,
+			Items:      menuItemInputs(item.Items),
+		})
+	}
+	return inputs
+}
+
+const menuItemFields = `
+	id
+	title
+	type
+	resourceId
+	url
+	tags
+`
+
+var menuFields = fmt.Sprintf(`
+	id
+	title
+	handle
+	isDefault
+	items {
+		%[1]s
+		items {
+			%[1]s
+			items {
+				%[1]s
+			}
+		}
+	}
+`, menuItemFields)
+
+// MenuUserError is a user error of the menu mutations.
+type MenuUserError struct {
+	Code    *string  `json:"code"`
+	Field   []string `json:"field"`
+	Message string   `json:"message"`
+}
+
+func (s *MenuServiceOp) Get(ctx context.Context, id string) (*Menu, error) {
+	q := fmt.Sprintf(`
+		query menu($id: ID!) {
+			menu(id: $id) {
+				%s
+			}
+		}
+	`, menuFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		Menu *Menu `json:"menu"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.Menu == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "menu not found", nil)
+	}
+
+	return out.Menu, nil
+}
+
+// List returns a page of menus with their item trees. opts.Query supports filters such as
+// "title:Footer*".
+func (s *MenuServiceOp) List(ctx context.Context, opts ListOptions) ([]*Menu, string, error) {
+	q := fmt.Sprintf(`
+		query menus($first: Int!, $after: String, $query: String, $reverse: Boolean) {
+			menus(first: $first, after: $after, query: $query, reverse: $reverse) {
+				edges {
+					node {
+						%s
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+				}
+			}
+		}
+	`, menuFields)
+
+	first := opts.First
+	if first <= 0 {
+		first = 50
+	}
+	vars := map[string]interface{}{
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+	if opts.Query != "" {
+		vars["query"] = opts.Query
+	}
+
+	out := struct {
+		Menus struct {
+			Edges []struct {
+				Node   *Menu  `json:"node"`
+				Cursor string `json:"cursor"`
+			} `json:"edges"`
+			PageInfo struct {
+				HasNextPage bool `json:"hasNextPage"`
+			} `json:"pageInfo"`
+		} `json:"menus"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, "", fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	edges := out.Menus.Edges
+	res := make([]*Menu, 0, len(edges))
+	for _, edge := range edges {
+		res = append(res, edge.Node)
+	}
+	nextCursor := ""
+	if out.Menus.PageInfo.HasNextPage && len(edges) > 0 {
+		nextCursor = edges[len(edges)-1].Cursor
+	}
+
+	return res, nextCursor, nil
+}
+
+func (s *MenuServiceOp) Create(ctx context.Context, title, handle string, items []MenuItemInput) (*Menu, error) {
+	m := fmt.Sprintf(`
+		mutation menuCreate($title: String!, $handle: String!, $items: [MenuItemCreateInput!]!) {
+			menuCreate(title: $title, handle: $handle, items: $items) {
+				menu {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, menuFields)
+
+	if items == nil {
+		items = []MenuItemInput{}
+	}
+	vars := map[string]interface{}{
+		"title":  title,
+		"handle": handle,
+		"items":  items,
+	}
+	out := struct {
+		MenuCreate struct {
+			Menu       *Menu           `json:"menu"`
+			UserErrors []MenuUserError `json:"userErrors"`
+		} `json:"menuCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.MenuCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.MenuCreate.UserErrors)
+	}
+
+	return out.MenuCreate.Menu, nil
+}
+
+// Update replaces the title and items of a menu; items that are not in items are removed. Use
+// Menu.ItemInputs to start from the current items. A nil handle is left unchanged.
+func (s *MenuServiceOp) Update(ctx context.Context, id, title string, handle *string, items []MenuItemInput) (*Menu, error) {
+	m := fmt.Sprintf(`
+		mutation menuUpdate($id: ID!, $title: String!, $handle: String, $items: [MenuItemUpdateInput!]!) {
+			menuUpdate(id: $id, title: $title, handle: $handle, items: $items) {
+				menu {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, menuFields)
+
+	if items == nil {
+		items = []MenuItemInput{}
+	}
+	vars := map[string]interface{}{
+		"id":    id,
+		"title": title,
+		"items": items,
+	}
+	if handle != nil {
+		vars["handle"] = *handle
+	}
+	out := struct {
+		MenuUpdate struct {
+			Menu       *Menu           `json:"menu"`
+			UserErrors []MenuUserError `json:"userErrors"`
+		} `json:"menuUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.MenuUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.MenuUpdate.UserErrors)
+	}
+
+	return out.MenuUpdate.Menu, nil
+}
+
+func (s *MenuServiceOp) Delete(ctx context.Context, id string) (string, error) {
+	m := `
+		mutation menuDelete($id: ID!) {
+			menuDelete(id: $id) {
+				deletedMenuId
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		MenuDelete struct {
+			DeletedMenuID *string         `json:"deletedMenuId"`
+			UserErrors    []MenuUserError `json:"userErrors"`
+		} `json:"menuDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return "", fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.MenuDelete.UserErrors) > 0 {
+		return "", fmt.Errorf("%+v", out.MenuDelete.UserErrors)
+	}
+	if out.MenuDelete.DeletedMenuID == nil {
+		return "", nil
+	}
+
+	return *out.MenuDelete.DeletedMenuID, nil
+}
@@ -2,9 +2,12 @@ package shopify
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"strings"
+	"strconv"
+	"time"
 
+	"github.com/gempages/go-helper/errors"
 	"github.com/gempages/go-shopify-graphql-model/graph/model"
 
 	"github.com/gempages/go-shopify-graphql/graphql"
@@ -17,6 +20,25 @@ type MetafieldService interface {
 	Delete(ctx context.Context, input model.MetafieldDeleteInput) error
 	DeleteBulk(ctx context.Context, metafields []model.MetafieldIdentifierInput) error
 	CreateBulk(ctx context.Context, metafields []model.MetafieldsSetInput) ([]model.Metafield, error)
+	Set(ctx context.Context, metafields []model.MetafieldsSetInput) ([]MetafieldSetResult, error)
+
+	ListByOwner(ctx context.Context, ownerID, namespace string, opts ListOptions) ([]*Metafield, string, error)
+	ListAllByOwner(ctx context.Context, ownerID, namespace string) ([]*Metafield, error)
+
+	ResolveReferences(ctx context.Context, metafields []*Metafield) ([]ResolvedMetafield, error)
+
+	GetShopMetafield(ctx context.Context, owner ShopMetafieldOwner, namespace, key string, v any) (*Metafield, error)
+	SetShopMetafield(ctx context.Context, owner ShopMetafieldOwner, namespace, key string, value any) (*Metafield, error)
+
+	ListAppMetafields(ctx context.Context, ownerID, subNamespace string) ([]*Metafield, error)
+	SetAppMetafield(ctx context.Context, ownerID, subNamespace, key, valueType, value string) (*Metafield, error)
+	MigratePrivateMetafields(ctx context.Context, ownerID, namespace string, deleteMigrated bool) ([]*Metafield, error)
+
+	ListStorefrontVisibilities(ctx context.Context, namespace string) ([]*model.MetafieldStorefrontVisibility, error)
+	CreateStorefrontVisibility(ctx context.Context, input model.MetafieldStorefrontVisibilityInput) (*model.MetafieldStorefrontVisibility, error)
+	DeleteStorefrontVisibility(ctx context.Context, id string) error
+
+	BulkExport(ctx context.Context, ownerType model.MetafieldOwnerType, namespace string) ([]OwnedMetafield, error)
 }
 
 type MetafieldServiceOp struct {
@@ -46,6 +68,28 @@ type Metafield struct {
 	Type model.MetafieldValueType `json:"type,omitempty"`
 }
 
+// MetafieldSetResult is the outcome of one MetafieldsSetInput passed to Set, at the same index.
+type MetafieldSetResult struct {
+	Metafield *model.Metafield
+	Err       error
+}
+
+// metafieldsSetLimit is the maximum number of metafields accepted by one metafieldsSet call.
+const metafieldsSetLimit = 25
+
+const metafieldFields = `
+	createdAt
+	description
+	id
+	key
+	legacyResourceId
+	namespace
+	ownerType
+	updatedAt
+	value
+	type
+`
+
 type mutationMetafieldDelete struct {
 	MetafieldDeletePayload model.MetafieldDeletePayload `graphql:"metafieldDelete(input: $input)" json:"metafieldDeletePayload"`
 }
@@ -82,70 +126,11 @@ mutation MetafieldsSet($metafields: [MetafieldsSetInput!]!) {
 `
 
 func (s *MetafieldServiceOp) ListAllShopMetafields(ctx context.Context) ([]*Metafield, error) {
-	q := `
-		{
-			shop{
-				metafields{
-					edges{
-						node{
-							createdAt
-							description
-							id
-							key
-							legacyResourceId
-							namespace
-							ownerType
-							updatedAt
-							value
-							type
-						}
-					}
-				}
-			}
-		}
-`
-
-	res := make([]*Metafield, 0)
-	err := s.client.BulkOperation.BulkQuery(ctx, q, &res)
-	if err != nil {
-		return nil, err
-	}
-
-	return res, nil
+	return s.ListAllByOwner(ctx, "", "")
 }
 
 func (s *MetafieldServiceOp) ListShopMetafieldsByNamespace(ctx context.Context, namespace string) ([]*Metafield, error) {
-	q := `
-		{
-			shop{
-				metafields(namespace: "$namespace"){
-					edges{
-						node{
-							createdAt
-							description
-							id
-							key
-							legacyResourceId
-							namespace
-							ownerType
-							updatedAt
-							value
-							type
-						}
-					}
-				}
-			}
-		}
-`
-	q = strings.ReplaceAll(q, "$namespace", namespace)
-
-	res := make([]*Metafield, 0)
-	err := s.client.BulkOperation.BulkQuery(ctx, q, &res)
-	if err != nil {
-		return nil, err
-	}
-
-	return res, nil
+	return s.ListAllByOwner(ctx, "", namespace)
 }
 
 func (s *MetafieldServiceOp) GetShopMetafieldByKey(ctx context.Context, namespace, key string) (*Metafield, error) {
@@ -218,3 +203,764 @@ func (s *MetafieldServiceOp) CreateBulk(ctx context.Context, inputs []model.Meta
 
 	return out.MetafieldCreateBulkPayload.Metafields, nil
 }
+
+// Set creates or updates any number of metafields, calling metafieldsSet in batches of 25.
+// A batch is saved atomically: when one of its metafields fails, the others in that batch are not saved and get an error too.
+// The returned error is only set when a request fails, per metafield errors are in the results.
+func (s *MetafieldServiceOp) Set(ctx context.Context, inputs []model.MetafieldsSetInput) ([]MetafieldSetResult, error) {
+	results := make([]MetafieldSetResult, len(inputs))
+
+	for start := 0; start < len(inputs); start += metafieldsSetLimit {
+		end := start + metafieldsSetLimit
+		if end > len(inputs) {
+			end = len(inputs)
+		}
+
+		out := mutationMetafieldCreateBulk{}
+		vars := map[string]any{
+			"metafields": inputs[start:end],
+		}
+		if err := s.client.gql.MutateString(ctx, metafieldsSet, vars, &out); err != nil {
+			return results, fmt.Errorf("gql.MutateString: %w", err)
+		}
+
+		payload := out.MetafieldCreateBulkPayload
+		if len(payload.UserErrors) > 0 {
+			mapMetafieldsSetUserErrors(payload.UserErrors, results[start:end])
+			continue
+		}
+
+		for i := range payload.Metafields {
+			if start+i < end {
+				results[start+i].Metafield = &payload.Metafields[i]
+			}
+		}
+	}
+
+	return results, nil
+}
+
+// mapMetafieldsSetUserErrors assigns user errors to the metafields of the batch through their ["metafields", "<index>", ...] field path.
+func mapMetafieldsSetUserErrors(userErrors []model.MetafieldsSetUserError, batch []MetafieldSetResult) {
+	for _, userErr := range userErrors {
+		if len(userErr.Field) >= 2 {
+			if i, err := strconv.Atoi(userErr.Field[1]); err == nil && i >= 0 && i < len(batch) {
+				batch[i].Err = fmt.Errorf("%+v", userErr)
+				continue
+			}
+		}
+		for i := range batch {
+			if batch[i].Err == nil {
+				batch[i].Err = fmt.Errorf("%+v", userErr)
+			}
+		}
+	}
+
+	for i := range batch {
+		if batch[i].Err == nil {
+			batch[i].Err = fmt.Errorf("not saved, another metafield of the batch failed")
+		}
+	}
+}
+
+// ListByOwner returns a page of the metafields of any resource that has metafields (product, variant, collection, order, customer...),
+// and the cursor of the next page when there is one. An empty ownerID reads the shop metafields, an empty namespace reads all namespaces.
+func (s *MetafieldServiceOp) ListByOwner(ctx context.Context, ownerID, namespace string, opts ListOptions) ([]*Metafield, string, error) {
+	owner := "node(id: $ownerId) { ... on HasMetafields { %s } }"
+	params := "$ownerId: ID!, "
+	if ownerID == "" {
+		owner = "shop { %s }"
+		params = ""
+	}
+	connection := fmt.Sprintf(`
+		metafields(first: $first, after: $after, namespace: $namespace, reverse: $reverse) {
+			edges {
+				node {
+					%s
+				}
+				cursor
+			}
+			pageInfo {
+				hasNextPage
+			}
+		}
+	`, metafieldFields)
+	q := fmt.Sprintf(`
+		query metafields(%s$first: Int!, $after: String, $namespace: String, $reverse: Boolean) {
+			owner: %s
+		}
+	`, params, fmt.Sprintf(owner, connection))
+
+	first := opts.First
+	if first <= 0 {
+		first = 250
+	}
+	vars := map[string]interface{}{
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	if ownerID != "" {
+		vars["ownerId"] = ownerID
+	}
+	if namespace != "" {
+		vars["namespace"] = namespace
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+
+	out := struct {
+		Owner *struct {
+			Metafields *struct {
+				Edges []struct {
+					Node   *Metafield `json:"node"`
+					Cursor string     `json:"cursor"`
+				} `json:"edges"`
+				PageInfo struct {
+					HasNextPage bool `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"metafields"`
+		} `json:"owner"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if out.Owner == nil {
+		return nil, "", errors.NewNotExistsError(errors.ErrorResourceNotFound, "metafield owner not found", nil)
+	}
+	if out.Owner.Metafields == nil {
+		return nil, "", fmt.Errorf("%s does not have metafields", ownerID)
+	}
+
+	edges := out.Owner.Metafields.Edges
+	res := make([]*Metafield, 0, len(edges))
+	for _, edge := range edges {
+		res = append(res, edge.Node)
+	}
+
+	nextCursor := ""
+	if out.Owner.Metafields.PageInfo.HasNextPage && len(edges) > 0 {
+		nextCursor = edges[len(edges)-1].Cursor
+	}
+
+	return res, nextCursor, nil
+}
+
+// ListAllByOwner is the bulk operation counterpart of ListByOwner, for owners with too many metafields to page through.
+func (s *MetafieldServiceOp) ListAllByOwner(ctx context.Context, ownerID, namespace string) ([]*Metafield, error) {
+	args := ""
+	if namespace != "" {
+		args = fmt.Sprintf(`(namespace: "%s")`, namespace)
+	}
+	connection := fmt.Sprintf(`
+		metafields%s {
+			edges {
+				node {
+					%s
+				}
+			}
+		}
+	`, args, metafieldFields)
+
+	q := fmt.Sprintf(`{ shop { %s } }`, connection)
+	if ownerID != "" {
+		q = fmt.Sprintf(`{ node(id: "%s") { ... on HasMetafields { %s } } }`, ownerID, connection)
+	}
+
+	res := make([]*Metafield, 0)
+	err := s.client.BulkOperation.BulkQuery(ctx, q, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ShopMetafieldOwner selects where GetShopMetafield and SetShopMetafield store app settings:
+// on the shop, visible to other apps with access, or on the current app installation, private to the app.
+type ShopMetafieldOwner string
+
+const (
+	ShopMetafieldOwnerShop            ShopMetafieldOwner = "shop"
+	ShopMetafieldOwnerAppInstallation ShopMetafieldOwner = "currentAppInstallation"
+)
+
+// GetShopMetafield reads a metafield of the shop or of the app installation and decodes its JSON value into v when v isn't nil.
+func (s *MetafieldServiceOp) GetShopMetafield(ctx context.Context, owner ShopMetafieldOwner, namespace, key string, v any) (*Metafield, error) {
+	q := fmt.Sprintf(`
+		query shopMetafield($namespace: String!, $key: String!) {
+			owner: %s {
+				metafield(namespace: $namespace, key: $key) {
+					%s
+				}
+			}
+		}
+	`, owner, metafieldFields)
+
+	vars := map[string]any{
+		"namespace": namespace,
+		"key":       key,
+	}
+	out := struct {
+		Owner struct {
+			Metafield *Metafield `json:"metafield"`
+		} `json:"owner"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Owner.Metafield == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "metafield not found", nil)
+	}
+
+	if v != nil {
+		err = json.Unmarshal([]byte(out.Owner.Metafield.Value), v)
+		if err != nil {
+			return nil, fmt.Errorf("decode metafield %s.%s: %w", namespace, key, err)
+		}
+	}
+
+	return out.Owner.Metafield, nil
+}
+
+// SetShopMetafield stores value encoded as JSON in a json metafield of the shop or of the app installation.
+func (s *MetafieldServiceOp) SetShopMetafield(ctx context.Context, owner ShopMetafieldOwner, namespace, key string, value any) (*Metafield, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("encode metafield %s.%s: %w", namespace, key, err)
+	}
+
+	q := fmt.Sprintf(`
+		query metafieldOwner {
+			owner: %s {
+				id
+			}
+		}
+	`, owner)
+	ownerOut := struct {
+		Owner struct {
+			ID string `json:"id"`
+		} `json:"owner"`
+	}{}
+	err = s.client.gql.QueryString(ctx, q, nil, &ownerOut)
+	if err != nil {
+		return nil, err
+	}
+
+	m := fmt.Sprintf(`
+		mutation metafieldsSet($metafields: [MetafieldsSetInput!]!) {
+			metafieldsSet(metafields: $metafields) {
+				metafields {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, metafieldFields)
+	vars := map[string]any{
+		"metafields": []map[string]any{
+			{
+				"ownerId":   ownerOut.Owner.ID,
+				"namespace": namespace,
+				"key":       key,
+				"type":      "json",
+				"value":     string(encoded),
+			},
+		},
+	}
+	out := struct {
+		MetafieldsSet struct {
+			Metafields []*Metafield `json:"metafields"`
+			UserErrors []UserErrors `json:"userErrors"`
+		} `json:"metafieldsSet"`
+	}{}
+	err = s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.MetafieldsSet.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.MetafieldsSet.UserErrors)
+	}
+	if len(out.MetafieldsSet.Metafields) == 0 {
+		return nil, fmt.Errorf("metafieldsSet returned no metafield")
+	}
+
+	return out.MetafieldsSet.Metafields[0], nil
+}
+
+// AppReservedNamespace is the namespace reserved to the calling app. Metafields in it, or in one of its
+// `$app:<name>` sub namespaces, can only be read and written by the app unless a definition grants access.
+const AppReservedNamespace = "$app"
+
+// AppNamespace returns the reserved sub namespace `$app:<name>`, or `$app` when name is empty.
+func AppNamespace(name string) string {
+	if name == "" {
+		return AppReservedNamespace
+	}
+	return AppReservedNamespace + ":" + name
+}
+
+// ListAppMetafields returns every metafield of the owner in the app reserved sub namespace, see AppNamespace.
+func (s *MetafieldServiceOp) ListAppMetafields(ctx context.Context, ownerID, subNamespace string) ([]*Metafield, error) {
+	res := []*Metafield{}
+	opts := ListOptions{}
+	for {
+		metafields, nextCursor, err := s.ListByOwner(ctx, ownerID, AppNamespace(subNamespace), opts)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, metafields...)
+		if nextCursor == "" {
+			break
+		}
+		opts.After = nextCursor
+	}
+
+	return res, nil
+}
+
+// SetAppMetafield writes a metafield of the given type (e.g. json, single_line_text_field) in the app reserved sub namespace.
+func (s *MetafieldServiceOp) SetAppMetafield(ctx context.Context, ownerID, subNamespace, key, valueType, value string) (*Metafield, error) {
+	namespace := AppNamespace(subNamespace)
+	results, err := s.Set(ctx, []model.MetafieldsSetInput{
+		{
+			OwnerID:   ownerID,
+			Namespace: &namespace,
+			Key:       key,
+			Type:      &valueType,
+			Value:     value,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if results[0].Err != nil {
+		return nil, results[0].Err
+	}
+
+	return toMetafield(results[0].Metafield), nil
+}
+
+// privateMetafieldTypes maps the value types of private metafields to the metafield types they are migrated to.
+var privateMetafieldTypes = map[model.PrivateMetafieldValueType]string{
+	model.PrivateMetafieldValueTypeString:     "multi_line_text_field",
+	model.PrivateMetafieldValueTypeInteger:    "number_integer",
+	model.PrivateMetafieldValueTypeJSONString: "json",
+}
+
+// MigratePrivateMetafields copies the owner's private metafields into app owned metafields, keeping their keys and moving
+// each namespace to the `$app:<namespace>` sub namespace. An empty namespace migrates all of them.
+// When deleteMigrated is set, the private metafields are deleted once every copy is saved.
+func (s *MetafieldServiceOp) MigratePrivateMetafields(ctx context.Context, ownerID, namespace string, deleteMigrated bool) ([]*Metafield, error) {
+	q := `
+		query privateMetafields($owner: ID!, $namespace: String, $after: String) {
+			privateMetafields(first: 250, owner: $owner, namespace: $namespace, after: $after) {
+				edges {
+					node {
+						id
+						namespace
+						key
+						value
+						valueType
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	vars := map[string]any{
+		"owner": ownerID,
+	}
+	if namespace != "" {
+		vars["namespace"] = namespace
+	}
+
+	private := []*model.PrivateMetafield{}
+	for {
+		out := struct {
+			PrivateMetafields struct {
+				Edges []struct {
+					Node *model.PrivateMetafield `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"privateMetafields"`
+		}{}
+		err := s.client.gql.QueryString(ctx, q, vars, &out)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, edge := range out.PrivateMetafields.Edges {
+			private = append(private, edge.Node)
+		}
+
+		if !out.PrivateMetafields.PageInfo.HasNextPage {
+			break
+		}
+		vars["after"] = out.PrivateMetafields.PageInfo.EndCursor
+	}
+
+	inputs := make([]model.MetafieldsSetInput, 0, len(private))
+	for _, pm := range private {
+		valueType, ok := privateMetafieldTypes[pm.ValueType]
+		if !ok {
+			return nil, fmt.Errorf("private metafield %s.%s: value type %s is not supported", pm.Namespace, pm.Key, pm.ValueType)
+		}
+		appNamespace := AppNamespace(pm.Namespace)
+		inputs = append(inputs, model.MetafieldsSetInput{
+			OwnerID:   ownerID,
+			Namespace: &appNamespace,
+			Key:       pm.Key,
+			Type:      &valueType,
+			Value:     pm.Value,
+		})
+	}
+
+	results, err := s.Set(ctx, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	migrated := make([]*Metafield, 0, len(results))
+	for i, result := range results {
+		if result.Err != nil {
+			return nil, fmt.Errorf("migrate private metafield %s.%s: %w", private[i].Namespace, private[i].Key, result.Err)
+		}
+		migrated = append(migrated, toMetafield(result.Metafield))
+	}
+
+	if deleteMigrated {
+		for _, pm := range private {
+			err = s.deletePrivateMetafield(ctx, ownerID, pm.Namespace, pm.Key)
+			if err != nil {
+				return migrated, fmt.Errorf("delete private metafield %s.%s: %w", pm.Namespace, pm.Key, err)
+			}
+		}
+	}
+
+	return migrated, nil
+}
+
+func (s *MetafieldServiceOp) deletePrivateMetafield(ctx context.Context, ownerID, namespace, key string) error {
+	m := `
+		mutation privateMetafieldDelete($input: PrivateMetafieldDeleteInput!) {
+			privateMetafieldDelete(input: $input) {
+				deletedPrivateMetafieldId
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]any{
+		"input": model.PrivateMetafieldDeleteInput{
+			Owner:     &ownerID,
+			Namespace: namespace,
+			Key:       key,
+		},
+	}
+	out := struct {
+		PrivateMetafieldDelete struct {
+			UserErrors []UserErrors `json:"userErrors"`
+		} `json:"privateMetafieldDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.PrivateMetafieldDelete.UserErrors) > 0 {
+		return fmt.Errorf("%+v", out.PrivateMetafieldDelete.UserErrors)
+	}
+
+	return nil
+}
+
+// toMetafield converts a metafield returned by metafieldsSet to the Metafield type of the readers.
+func toMetafield(m *model.Metafield) *Metafield {
+	if m == nil {
+		return nil
+	}
+	description := ""
+	if m.Description != nil {
+		description = *m.Description
+	}
+	return &Metafield{
+		CreatedAt:   DateTime(m.CreatedAt.Format(time.RFC3339)),
+		Description: graphql.String(description),
+		ID:          graphql.ID(m.ID),
+		Key:         graphql.String(m.Key),
+		Namespace:   graphql.String(m.Namespace),
+		OwnerType:   graphql.String(m.OwnerType),
+		UpdatedAt:   DateTime(m.UpdatedAt.Format(time.RFC3339)),
+		Value:       graphql.String(m.Value),
+		Type:        model.MetafieldValueType(m.Type),
+	}
+}
+
+const metafieldStorefrontVisibilityFields = `
+	id
+	namespace
+	key
+	ownerType
+	createdAt
+	updatedAt
+`
+
+// ListStorefrontVisibilities returns the metafields exposed to the Storefront API, in the namespace when it isn't empty.
+func (s *MetafieldServiceOp) ListStorefrontVisibilities(ctx context.Context, namespace string) ([]*model.MetafieldStorefrontVisibility, error) {
+	q := fmt.Sprintf(`
+		query metafieldStorefrontVisibilities($namespace: String, $after: String) {
+			metafieldStorefrontVisibilities(first: 250, namespace: $namespace, after: $after) {
+				edges {
+					node {
+						%s
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`, metafieldStorefrontVisibilityFields)
+
+	vars := map[string]any{}
+	if namespace != "" {
+		vars["namespace"] = namespace
+	}
+
+	res := []*model.MetafieldStorefrontVisibility{}
+	for {
+		out := struct {
+			MetafieldStorefrontVisibilities struct {
+				Edges []struct {
+					Node *model.MetafieldStorefrontVisibility `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"metafieldStorefrontVisibilities"`
+		}{}
+		err := s.client.gql.QueryString(ctx, q, vars, &out)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, edge := range out.MetafieldStorefrontVisibilities.Edges {
+			res = append(res, edge.Node)
+		}
+
+		if !out.MetafieldStorefrontVisibilities.PageInfo.HasNextPage {
+			break
+		}
+		vars["after"] = out.MetafieldStorefrontVisibilities.PageInfo.EndCursor
+	}
+
+	return res, nil
+}
+
+// CreateStorefrontVisibility exposes the metafields with the namespace and key of an owner type to the Storefront API and Liquid.
+// On API versions with metafield definition access settings, MetafieldDefinition.SetStorefrontAccess is the replacement.
+func (s *MetafieldServiceOp) CreateStorefrontVisibility(ctx context.Context, input model.MetafieldStorefrontVisibilityInput) (*model.MetafieldStorefrontVisibility, error) {
+	m := fmt.Sprintf(`
+		mutation metafieldStorefrontVisibilityCreate($input: MetafieldStorefrontVisibilityInput!) {
+			metafieldStorefrontVisibilityCreate(input: $input) {
+				metafieldStorefrontVisibility {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, metafieldStorefrontVisibilityFields)
+
+	vars := map[string]any{
+		"input": input,
+	}
+	out := struct {
+		MetafieldStorefrontVisibilityCreate struct {
+			MetafieldStorefrontVisibility *model.MetafieldStorefrontVisibility `json:"metafieldStorefrontVisibility"`
+			UserErrors                    []UserErrors                         `json:"userErrors"`
+		} `json:"metafieldStorefrontVisibilityCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.MetafieldStorefrontVisibilityCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.MetafieldStorefrontVisibilityCreate.UserErrors)
+	}
+
+	return out.MetafieldStorefrontVisibilityCreate.MetafieldStorefrontVisibility, nil
+}
+
+func (s *MetafieldServiceOp) DeleteStorefrontVisibility(ctx context.Context, id string) error {
+	m := `
+		mutation metafieldStorefrontVisibilityDelete($id: ID!) {
+			metafieldStorefrontVisibilityDelete(id: $id) {
+				deletedMetafieldStorefrontVisibilityId
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]any{
+		"id": id,
+	}
+	out := struct {
+		MetafieldStorefrontVisibilityDelete struct {
+			UserErrors []UserErrors `json:"userErrors"`
+		} `json:"metafieldStorefrontVisibilityDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.MetafieldStorefrontVisibilityDelete.UserErrors) > 0 {
+		return fmt.Errorf("%+v", out.MetafieldStorefrontVisibilityDelete.UserErrors)
+	}
+
+	return nil
+}
+
+// OwnedMetafield is a metafield exported by BulkExport with the ID of the resource it belongs to.
+type OwnedMetafield struct {
+	OwnerID   string
+	Metafield *Metafield
+}
+
+// metafieldOwnerConnections maps the owner types supported by BulkExport to the root connection listing the owners.
+var metafieldOwnerConnections = map[model.MetafieldOwnerType]string{
+	model.MetafieldOwnerTypeProduct:        "products",
+	model.MetafieldOwnerTypeProductvariant: "productVariants",
+	model.MetafieldOwnerTypeCollection:     "collections",
+	model.MetafieldOwnerTypeCustomer:       "customers",
+	model.MetafieldOwnerTypeOrder:          "orders",
+	model.MetafieldOwnerTypeDraftorder:     "draftOrders",
+	model.MetafieldOwnerTypeLocation:       "locations",
+	model.MetafieldOwnerTypeCompany:        "companies",
+}
+
+// metafieldOwnerNode receives an owner of the bulk export, its metafields are attached by the bulk result parser.
+type metafieldOwnerNode struct {
+	ID         string                     `json:"id"`
+	Metafields *model.MetafieldConnection `json:"metafields,omitempty"`
+}
+
+// BulkExport exports the metafields of every resource of the owner type through a bulk operation,
+// in the namespace when it isn't empty.
+func (s *MetafieldServiceOp) BulkExport(ctx context.Context, ownerType model.MetafieldOwnerType, namespace string) ([]OwnedMetafield, error) {
+	if ownerType == model.MetafieldOwnerTypeShop {
+		return s.exportShopMetafields(ctx, namespace)
+	}
+
+	connection, ok := metafieldOwnerConnections[ownerType]
+	if !ok {
+		return nil, fmt.Errorf("bulk export of %s metafields is not supported", ownerType)
+	}
+
+	args := ""
+	if namespace != "" {
+		args = fmt.Sprintf(`(namespace: "%s")`, namespace)
+	}
+	q := fmt.Sprintf(`
+		{
+			%s {
+				edges {
+					node {
+						id
+						metafields%s {
+							edges {
+								node {
+									id
+									namespace
+									key
+									value
+									type
+									description
+									ownerType
+									createdAt
+									updatedAt
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	`, connection, args)
+
+	owners := []*metafieldOwnerNode{}
+	err := s.client.BulkOperation.BulkQuery(ctx, q, &owners)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []OwnedMetafield{}
+	for _, owner := range owners {
+		if owner.Metafields == nil {
+			continue
+		}
+		for _, edge := range owner.Metafields.Edges {
+			res = append(res, OwnedMetafield{
+				OwnerID:   owner.ID,
+				Metafield: toMetafield(edge.Node),
+			})
+		}
+	}
+
+	return res, nil
+}
+
+func (s *MetafieldServiceOp) exportShopMetafields(ctx context.Context, namespace string) ([]OwnedMetafield, error) {
+	out := struct {
+		Shop struct {
+			ID string `json:"id"`
+		} `json:"shop"`
+	}{}
+	err := s.client.gql.QueryString(ctx, `query { shop { id } }`, nil, &out)
+	if err != nil {
+		return nil, err
+	}
+
+	metafields, err := s.ListAllByOwner(ctx, "", namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]OwnedMetafield, 0, len(metafields))
+	for _, m := range metafields {
+		res = append(res, OwnedMetafield{
+			OwnerID:   out.Shop.ID,
+			Metafield: m,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,348 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+type MetafieldDefinitionService interface {
+	List(ctx context.Context, ownerType model.MetafieldOwnerType, namespace string) ([]*model.MetafieldDefinition, error)
+	Create(ctx context.Context, definition model.MetafieldDefinitionInput) (*model.MetafieldDefinition, error)
+	Update(ctx context.Context, definition model.MetafieldDefinitionUpdateInput) (*model.MetafieldDefinition, error)
+	Delete(ctx context.Context, id string, deleteAllAssociatedMetafields bool) error
+	Pin(ctx context.Context, id string) (*model.MetafieldDefinition, error)
+	Unpin(ctx context.Context, id string) (*model.MetafieldDefinition, error)
+	EnableStandard(ctx context.Context, input StandardMetafieldDefinitionEnableInput) (*model.MetafieldDefinition, error)
+	SetStorefrontAccess(ctx context.Context, ownerType model.MetafieldOwnerType, namespace, key string, admin model.MetafieldAdminAccess, storefront model.MetafieldStorefrontAccess) (*model.MetafieldDefinition, error)
+}
+
+type MetafieldDefinitionServiceOp struct {
+	client *Client
+}
+
+var _ MetafieldDefinitionService = &MetafieldDefinitionServiceOp{}
+
+// StandardMetafieldDefinitionEnableInput selects a standard definition template either by ID or by namespace and key.
+type StandardMetafieldDefinitionEnableInput struct {
+	OwnerType model.MetafieldOwnerType
+	ID        *string
+	Namespace *string
+	Key       *string
+	Pin       bool
+}
+
+const metafieldDefinitionFields = `
+	id
+	name
+	namespace
+	key
+	description
+	ownerType
+	pinnedPosition
+	type {
+		name
+		category
+	}
+	validations {
+		name
+		type
+		value
+	}
+	access {
+		admin
+		storefront
+	}
+`
+
+type metafieldDefinitionMutationResult struct {
+	CreatedDefinition  *model.MetafieldDefinition `json:"createdDefinition,omitempty"`
+	UpdatedDefinition  *model.MetafieldDefinition `json:"updatedDefinition,omitempty"`
+	PinnedDefinition   *model.MetafieldDefinition `json:"pinnedDefinition,omitempty"`
+	UnpinnedDefinition *model.MetafieldDefinition `json:"unpinnedDefinition,omitempty"`
+	UserErrors         []UserErrors               `json:"userErrors,omitempty"`
+}
+
+// List returns the metafield definitions of the owner type, in the namespace when it isn't empty.
+func (s *MetafieldDefinitionServiceOp) List(ctx context.Context, ownerType model.MetafieldOwnerType, namespace string) ([]*model.MetafieldDefinition, error) {
+	q := fmt.Sprintf(`
+		query metafieldDefinitions($ownerType: MetafieldOwnerType!, $namespace: String, $after: String) {
+			metafieldDefinitions(first: 250, ownerType: $ownerType, namespace: $namespace, after: $after) {
+				edges {
+					node {
+						%s
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`, metafieldDefinitionFields)
+
+	vars := map[string]interface{}{
+		"ownerType": ownerType,
+	}
+	if namespace != "" {
+		vars["namespace"] = namespace
+	}
+
+	res := []*model.MetafieldDefinition{}
+	for {
+		out := struct {
+			MetafieldDefinitions struct {
+				Edges []struct {
+					Node *model.MetafieldDefinition `json:"node"`
+				} `json:"edges"`
+				PageInfo struct {
+					HasNextPage bool   `json:"hasNextPage"`
+					EndCursor   string `json:"endCursor"`
+				} `json:"pageInfo"`
+			} `json:"metafieldDefinitions"`
+		}{}
+		err := s.client.gql.QueryString(ctx, q, vars, &out)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, edge := range out.MetafieldDefinitions.Edges {
+			res = append(res, edge.Node)
+		}
+
+		if !out.MetafieldDefinitions.PageInfo.HasNextPage {
+			break
+		}
+		vars["after"] = out.MetafieldDefinitions.PageInfo.EndCursor
+	}
+
+	return res, nil
+}
+
+func (s *MetafieldDefinitionServiceOp) Create(ctx context.Context, definition model.MetafieldDefinitionInput) (*model.MetafieldDefinition, error) {
+	m := fmt.Sprintf(`
+		mutation metafieldDefinitionCreate($definition: MetafieldDefinitionInput!) {
+			metafieldDefinitionCreate(definition: $definition) {
+				createdDefinition {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, metafieldDefinitionFields)
+
+	vars := map[string]interface{}{
+		"definition": definition,
+	}
+	out := struct {
+		MetafieldDefinitionCreate metafieldDefinitionMutationResult `json:"metafieldDefinitionCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.MetafieldDefinitionCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.MetafieldDefinitionCreate.UserErrors)
+	}
+
+	return out.MetafieldDefinitionCreate.CreatedDefinition, nil
+}
+
+// Update changes the definition identified by the namespace, key and owner type of the input.
+func (s *MetafieldDefinitionServiceOp) Update(ctx context.Context, definition model.MetafieldDefinitionUpdateInput) (*model.MetafieldDefinition, error) {
+	m := fmt.Sprintf(`
+		mutation metafieldDefinitionUpdate($definition: MetafieldDefinitionUpdateInput!) {
+			metafieldDefinitionUpdate(definition: $definition) {
+				updatedDefinition {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, metafieldDefinitionFields)
+
+	vars := map[string]interface{}{
+		"definition": definition,
+	}
+	out := struct {
+		MetafieldDefinitionUpdate metafieldDefinitionMutationResult `json:"metafieldDefinitionUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.MetafieldDefinitionUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.MetafieldDefinitionUpdate.UserErrors)
+	}
+
+	return out.MetafieldDefinitionUpdate.UpdatedDefinition, nil
+}
+
+// Delete removes the definition, the metafields using it are kept unless deleteAllAssociatedMetafields is set.
+func (s *MetafieldDefinitionServiceOp) Delete(ctx context.Context, id string, deleteAllAssociatedMetafields bool) error {
+	m := `
+		mutation metafieldDefinitionDelete($id: ID!, $deleteAllAssociatedMetafields: Boolean) {
+			metafieldDefinitionDelete(id: $id, deleteAllAssociatedMetafields: $deleteAllAssociatedMetafields) {
+				deletedDefinitionId
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"id":                            id,
+		"deleteAllAssociatedMetafields": deleteAllAssociatedMetafields,
+	}
+	out := struct {
+		MetafieldDefinitionDelete struct {
+			DeletedDefinitionID string       `json:"deletedDefinitionId,omitempty"`
+			UserErrors          []UserErrors `json:"userErrors,omitempty"`
+		} `json:"metafieldDefinitionDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.MetafieldDefinitionDelete.UserErrors) > 0 {
+		return fmt.Errorf("%+v", out.MetafieldDefinitionDelete.UserErrors)
+	}
+
+	return nil
+}
+
+func (s *MetafieldDefinitionServiceOp) Pin(ctx context.Context, id string) (*model.MetafieldDefinition, error) {
+	m := fmt.Sprintf(`
+		mutation metafieldDefinitionPin($definitionId: ID!) {
+			metafieldDefinitionPin(definitionId: $definitionId) {
+				pinnedDefinition {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, metafieldDefinitionFields)
+
+	vars := map[string]interface{}{
+		"definitionId": id,
+	}
+	out := struct {
+		MetafieldDefinitionPin metafieldDefinitionMutationResult `json:"metafieldDefinitionPin"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.MetafieldDefinitionPin.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.MetafieldDefinitionPin.UserErrors)
+	}
+
+	return out.MetafieldDefinitionPin.PinnedDefinition, nil
+}
+
+func (s *MetafieldDefinitionServiceOp) Unpin(ctx context.Context, id string) (*model.MetafieldDefinition, error) {
+	m := fmt.Sprintf(`
+		mutation metafieldDefinitionUnpin($definitionId: ID!) {
+			metafieldDefinitionUnpin(definitionId: $definitionId) {
+				unpinnedDefinition {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, metafieldDefinitionFields)
+
+	vars := map[string]interface{}{
+		"definitionId": id,
+	}
+	out := struct {
+		MetafieldDefinitionUnpin metafieldDefinitionMutationResult `json:"metafieldDefinitionUnpin"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.MetafieldDefinitionUnpin.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.MetafieldDefinitionUnpin.UserErrors)
+	}
+
+	return out.MetafieldDefinitionUnpin.UnpinnedDefinition, nil
+}
+
+// EnableStandard activates one of Shopify's standard definition templates, e.g. descriptors.subtitle on products.
+func (s *MetafieldDefinitionServiceOp) EnableStandard(ctx context.Context, input StandardMetafieldDefinitionEnableInput) (*model.MetafieldDefinition, error) {
+	m := fmt.Sprintf(`
+		mutation standardMetafieldDefinitionEnable($ownerType: MetafieldOwnerType!, $id: ID, $namespace: String, $key: String, $pin: Boolean!) {
+			standardMetafieldDefinitionEnable(ownerType: $ownerType, id: $id, namespace: $namespace, key: $key, pin: $pin) {
+				createdDefinition {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, metafieldDefinitionFields)
+
+	vars := map[string]interface{}{
+		"ownerType": input.OwnerType,
+		"pin":       input.Pin,
+	}
+	if input.ID != nil {
+		vars["id"] = *input.ID
+	}
+	if input.Namespace != nil {
+		vars["namespace"] = *input.Namespace
+	}
+	if input.Key != nil {
+		vars["key"] = *input.Key
+	}
+	out := struct {
+		StandardMetafieldDefinitionEnable metafieldDefinitionMutationResult `json:"standardMetafieldDefinitionEnable"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.StandardMetafieldDefinitionEnable.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.StandardMetafieldDefinitionEnable.UserErrors)
+	}
+
+	return out.StandardMetafieldDefinitionEnable.CreatedDefinition, nil
+}
+
+// SetStorefrontAccess changes the access settings of the definition, PUBLIC_READ storefront access exposes its metafields
+// to the Storefront API and Liquid. The admin access is required by the API, pass the current one to keep it.
+func (s *MetafieldDefinitionServiceOp) SetStorefrontAccess(ctx context.Context, ownerType model.MetafieldOwnerType, namespace, key string, admin model.MetafieldAdminAccess, storefront model.MetafieldStorefrontAccess) (*model.MetafieldDefinition, error) {
+	return s.Update(ctx, model.MetafieldDefinitionUpdateInput{
+		Namespace: &namespace,
+		Key:       key,
+		OwnerType: ownerType,
+		Access: &model.MetafieldAccessUpdateInput{
+			Admin:      admin,
+			Storefront: &storefront,
+		},
+	})
+}
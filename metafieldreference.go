@@ -0,0 +1,243 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// resolveReferencesBatchSize is the maximum number of IDs accepted by the nodes query.
+const resolveReferencesBatchSize = 250
+
+// MetafieldReference is the resource a reference metafield points to, only the field matching Typename is set.
+type MetafieldReference struct {
+	ID       string
+	Typename string
+
+	Product        *model.Product
+	ProductVariant *model.ProductVariant
+	Collection     *model.Collection
+	MediaImage     *model.MediaImage
+	GenericFile    *model.GenericFile
+	Video          *model.Video
+	Metaobject     *model.Metaobject
+}
+
+// ResolvedMetafield pairs a reference metafield with its targets, in the order of the metafield value.
+// References of a non list reference have at most one item, deleted targets are left out.
+type ResolvedMetafield struct {
+	Metafield  *Metafield
+	References []*MetafieldReference
+}
+
+const metafieldReferenceFragments = `
+	__typename
+	... on Product {
+		id
+		title
+		handle
+		featuredImage {
+			url
+			altText
+		}
+	}
+	... on ProductVariant {
+		id
+		title
+		sku
+		product {
+			id
+		}
+	}
+	... on Collection {
+		id
+		title
+		handle
+	}
+	... on MediaImage {
+		id
+		alt
+		image {
+			url
+			width
+			height
+		}
+	}
+	... on GenericFile {
+		id
+		url
+		mimeType
+	}
+	... on Video {
+		id
+		sources {
+			url
+			mimeType
+		}
+	}
+	... on Metaobject {
+		id
+		handle
+		type
+		fields {
+			key
+			type
+			value
+		}
+	}
+`
+
+// ResolveReferences loads the targets of the reference metafields (file_reference, product_reference, metaobject_reference,
+// their list variants...) with batched nodes queries. Metafields of other types are returned with no references.
+func (s *MetafieldServiceOp) ResolveReferences(ctx context.Context, metafields []*Metafield) ([]ResolvedMetafield, error) {
+	res := make([]ResolvedMetafield, len(metafields))
+	ids := []string{}
+	seen := map[string]bool{}
+	refIDs := make([][]string, len(metafields))
+	for i, m := range metafields {
+		res[i].Metafield = m
+		mIDs, err := metafieldReferenceIDs(m)
+		if err != nil {
+			return nil, err
+		}
+		refIDs[i] = mIDs
+		for _, id := range mIDs {
+			if !seen[id] {
+				seen[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	nodes := make(map[string]*MetafieldReference, len(ids))
+	for start := 0; start < len(ids); start += resolveReferencesBatchSize {
+		end := start + resolveReferencesBatchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+		err := s.fetchReferences(ctx, ids[start:end], nodes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i := range res {
+		for _, id := range refIDs[i] {
+			if ref, ok := nodes[id]; ok {
+				res[i].References = append(res[i].References, ref)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func (s *MetafieldServiceOp) fetchReferences(ctx context.Context, ids []string, nodes map[string]*MetafieldReference) error {
+	q := fmt.Sprintf(`
+		query nodes($ids: [ID!]!) {
+			nodes(ids: $ids) {
+				%s
+			}
+		}
+	`, metafieldReferenceFragments)
+
+	vars := map[string]interface{}{
+		"ids": ids,
+	}
+	out := struct {
+		Nodes []json.RawMessage `json:"nodes"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	for _, raw := range out.Nodes {
+		ref, err := decodeMetafieldReference(raw)
+		if err != nil {
+			return err
+		}
+		if ref != nil {
+			nodes[ref.ID] = ref
+		}
+	}
+
+	return nil
+}
+
+func decodeMetafieldReference(raw json.RawMessage) (*MetafieldReference, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	head := struct {
+		ID       string `json:"id"`
+		Typename string `json:"__typename"`
+	}{}
+	err := json.Unmarshal(raw, &head)
+	if err != nil {
+		return nil, fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	ref := &MetafieldReference{ID: head.ID, Typename: head.Typename}
+	var target interface{}
+	switch head.Typename {
+	case "Product":
+		ref.Product = &model.Product{}
+		target = ref.Product
+	case "ProductVariant":
+		ref.ProductVariant = &model.ProductVariant{}
+		target = ref.ProductVariant
+	case "Collection":
+		ref.Collection = &model.Collection{}
+		target = ref.Collection
+	case "MediaImage":
+		ref.MediaImage = &model.MediaImage{}
+		target = ref.MediaImage
+	case "GenericFile":
+		ref.GenericFile = &model.GenericFile{}
+		target = ref.GenericFile
+	case "Video":
+		ref.Video = &model.Video{}
+		target = ref.Video
+	case "Metaobject":
+		ref.Metaobject = &model.Metaobject{}
+		target = ref.Metaobject
+	default:
+		return ref, nil
+	}
+
+	err = json.Unmarshal(raw, target)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal %s: %w", head.Typename, err)
+	}
+
+	return ref, nil
+}
+
+// metafieldReferenceIDs returns the IDs referenced by the metafield value: a single gid for reference types and
+// a JSON array of gids for their list variants.
+func metafieldReferenceIDs(m *Metafield) ([]string, error) {
+	if m == nil || m.Value == "" {
+		return nil, nil
+	}
+	valueType := string(m.Type)
+	if !strings.HasSuffix(valueType, "_reference") {
+		return nil, nil
+	}
+
+	if !strings.HasPrefix(valueType, "list.") {
+		return []string{string(m.Value)}, nil
+	}
+
+	ids := []string{}
+	err := json.Unmarshal([]byte(m.Value), &ids)
+	if err != nil {
+		return nil, fmt.Errorf("metafield %s.%s: parse %s value: %w", m.Namespace, m.Key, valueType, err)
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,23 @@
+// Package mocks provides mock implementations of the service interfaces of the shopify package,
+// so that code using a *shopify.Client can be unit tested without a shop.
+//
+// Each mock has a XxxFunc field per method, which is called by the method, and a XxxCalls method
+// returning the arguments of the calls made so far. Calling a method whose XxxFunc is nil panics.
+// Assign the mocks to the fields of a client:
+//
+//	products := &mocks.ProductServiceMock{
+//		GetFunc: func(ctx context.Context, id string) (*model.Product, error) {
+//			return &model.Product{ID: id, Title: "Snowboard"}, nil
+//		},
+//	}
+//	client := &shopify.Client{Product: products}
+//	// ... run the code under test with client ...
+//	if len(products.GetCalls()) != 1 {
+//		t.Fatal("expected the product to be fetched once")
+//	}
+//
+// The rarely used services, returned by methods of the client such as Dispute, are built on first
+// use and can't be replaced: have the code under test take their interface instead.
+package mocks
+
+//go:generate go run ../internal/cmd/genmocks -dir .. -out mocks_gen.go
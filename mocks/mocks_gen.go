@@ -0,0 +1,16220 @@
+// Code generated by genmocks. DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	shopify "github.com/gempages/go-shopify-graphql"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+	"github.com/gempages/go-shopify-graphql/graphql"
+)
+
+var _ shopify.AbandonedCheckoutService = &AbandonedCheckoutServiceMock{}
+
+// AbandonedCheckoutServiceMock is a mock implementation of shopify.AbandonedCheckoutService.
+type AbandonedCheckoutServiceMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, opts shopify.ListOptions) ([]*shopify.AbandonedCheckout, string, error)
+
+	// ListAllFunc mocks the ListAll method.
+	ListAllFunc func(ctx context.Context, query string) ([]*shopify.AbandonedCheckout, error)
+
+	// GetAbandonmentByCheckoutFunc mocks the GetAbandonmentByCheckout method.
+	GetAbandonmentByCheckoutFunc func(ctx context.Context, abandonedCheckoutID string) (*model.Abandonment, error)
+
+	// UpdateDeliveryStatusFunc mocks the UpdateDeliveryStatus method.
+	UpdateDeliveryStatusFunc func(ctx context.Context, abandonmentID string, marketingActivityID string, status model.AbandonmentDeliveryState, deliveredAt *time.Time) (*model.Abandonment, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+		// ListAll holds details about calls to the ListAll method.
+		ListAll []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
+		}
+		// GetAbandonmentByCheckout holds details about calls to the GetAbandonmentByCheckout method.
+		GetAbandonmentByCheckout []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AbandonedCheckoutID is the abandonedCheckoutID argument value.
+			AbandonedCheckoutID string
+		}
+		// UpdateDeliveryStatus holds details about calls to the UpdateDeliveryStatus method.
+		UpdateDeliveryStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AbandonmentID is the abandonmentID argument value.
+			AbandonmentID string
+			// MarketingActivityID is the marketingActivityID argument value.
+			MarketingActivityID string
+			// Status is the status argument value.
+			Status model.AbandonmentDeliveryState
+			// DeliveredAt is the deliveredAt argument value.
+			DeliveredAt *time.Time
+		}
+	}
+	lockList                     sync.RWMutex
+	lockListAll                  sync.RWMutex
+	lockGetAbandonmentByCheckout sync.RWMutex
+	lockUpdateDeliveryStatus     sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *AbandonedCheckoutServiceMock) List(ctx context.Context, opts shopify.ListOptions) ([]*shopify.AbandonedCheckout, string, error) {
+	if mock.ListFunc == nil {
+		panic("AbandonedCheckoutServiceMock.ListFunc: method is nil but AbandonedCheckoutService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, opts)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *AbandonedCheckoutServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// ListAll calls ListAllFunc.
+func (mock *AbandonedCheckoutServiceMock) ListAll(ctx context.Context, query string) ([]*shopify.AbandonedCheckout, error) {
+	if mock.ListAllFunc == nil {
+		panic("AbandonedCheckoutServiceMock.ListAllFunc: method is nil but AbandonedCheckoutService.ListAll was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Query is the query argument value.
+		Query string
+	}{
+		Ctx:   ctx,
+		Query: query,
+	}
+	mock.lockListAll.Lock()
+	mock.calls.ListAll = append(mock.calls.ListAll, callInfo)
+	mock.lockListAll.Unlock()
+	return mock.ListAllFunc(ctx, query)
+}
+
+// ListAllCalls returns the calls made to ListAll.
+func (mock *AbandonedCheckoutServiceMock) ListAllCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Query is the query argument value.
+	Query string
+} {
+	mock.lockListAll.RLock()
+	defer mock.lockListAll.RUnlock()
+	return mock.calls.ListAll
+}
+
+// GetAbandonmentByCheckout calls GetAbandonmentByCheckoutFunc.
+func (mock *AbandonedCheckoutServiceMock) GetAbandonmentByCheckout(ctx context.Context, abandonedCheckoutID string) (*model.Abandonment, error) {
+	if mock.GetAbandonmentByCheckoutFunc == nil {
+		panic("AbandonedCheckoutServiceMock.GetAbandonmentByCheckoutFunc: method is nil but AbandonedCheckoutService.GetAbandonmentByCheckout was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// AbandonedCheckoutID is the abandonedCheckoutID argument value.
+		AbandonedCheckoutID string
+	}{
+		Ctx:                 ctx,
+		AbandonedCheckoutID: abandonedCheckoutID,
+	}
+	mock.lockGetAbandonmentByCheckout.Lock()
+	mock.calls.GetAbandonmentByCheckout = append(mock.calls.GetAbandonmentByCheckout, callInfo)
+	mock.lockGetAbandonmentByCheckout.Unlock()
+	return mock.GetAbandonmentByCheckoutFunc(ctx, abandonedCheckoutID)
+}
+
+// GetAbandonmentByCheckoutCalls returns the calls made to GetAbandonmentByCheckout.
+func (mock *AbandonedCheckoutServiceMock) GetAbandonmentByCheckoutCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// AbandonedCheckoutID is the abandonedCheckoutID argument value.
+	AbandonedCheckoutID string
+} {
+	mock.lockGetAbandonmentByCheckout.RLock()
+	defer mock.lockGetAbandonmentByCheckout.RUnlock()
+	return mock.calls.GetAbandonmentByCheckout
+}
+
+// UpdateDeliveryStatus calls UpdateDeliveryStatusFunc.
+func (mock *AbandonedCheckoutServiceMock) UpdateDeliveryStatus(ctx context.Context, abandonmentID string, marketingActivityID string, status model.AbandonmentDeliveryState, deliveredAt *time.Time) (*model.Abandonment, error) {
+	if mock.UpdateDeliveryStatusFunc == nil {
+		panic("AbandonedCheckoutServiceMock.UpdateDeliveryStatusFunc: method is nil but AbandonedCheckoutService.UpdateDeliveryStatus was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// AbandonmentID is the abandonmentID argument value.
+		AbandonmentID string
+		// MarketingActivityID is the marketingActivityID argument value.
+		MarketingActivityID string
+		// Status is the status argument value.
+		Status model.AbandonmentDeliveryState
+		// DeliveredAt is the deliveredAt argument value.
+		DeliveredAt *time.Time
+	}{
+		Ctx:                 ctx,
+		AbandonmentID:       abandonmentID,
+		MarketingActivityID: marketingActivityID,
+		Status:              status,
+		DeliveredAt:         deliveredAt,
+	}
+	mock.lockUpdateDeliveryStatus.Lock()
+	mock.calls.UpdateDeliveryStatus = append(mock.calls.UpdateDeliveryStatus, callInfo)
+	mock.lockUpdateDeliveryStatus.Unlock()
+	return mock.UpdateDeliveryStatusFunc(ctx, abandonmentID, marketingActivityID, status, deliveredAt)
+}
+
+// UpdateDeliveryStatusCalls returns the calls made to UpdateDeliveryStatus.
+func (mock *AbandonedCheckoutServiceMock) UpdateDeliveryStatusCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// AbandonmentID is the abandonmentID argument value.
+	AbandonmentID string
+	// MarketingActivityID is the marketingActivityID argument value.
+	MarketingActivityID string
+	// Status is the status argument value.
+	Status model.AbandonmentDeliveryState
+	// DeliveredAt is the deliveredAt argument value.
+	DeliveredAt *time.Time
+} {
+	mock.lockUpdateDeliveryStatus.RLock()
+	defer mock.lockUpdateDeliveryStatus.RUnlock()
+	return mock.calls.UpdateDeliveryStatus
+}
+
+var _ shopify.AppService = &AppServiceMock{}
+
+// AppServiceMock is a mock implementation of shopify.AppService.
+type AppServiceMock struct {
+	// GetCurrentAppInstallationFunc mocks the GetCurrentAppInstallation method.
+	GetCurrentAppInstallationFunc func(ctx context.Context) (*model.App, error)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id string) (*model.App, error)
+
+	// GetByHandleFunc mocks the GetByHandle method.
+	GetByHandleFunc func(ctx context.Context, handle string) (*model.App, error)
+
+	// GetInstallationFunc mocks the GetInstallation method.
+	GetInstallationFunc func(ctx context.Context, id string, metafieldNamespace string) (*model.AppInstallation, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// GetCurrentAppInstallation holds details about calls to the GetCurrentAppInstallation method.
+		GetCurrentAppInstallation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// GetByHandle holds details about calls to the GetByHandle method.
+		GetByHandle []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Handle is the handle argument value.
+			Handle string
+		}
+		// GetInstallation holds details about calls to the GetInstallation method.
+		GetInstallation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// MetafieldNamespace is the metafieldNamespace argument value.
+			MetafieldNamespace string
+		}
+	}
+	lockGetCurrentAppInstallation sync.RWMutex
+	lockGet                       sync.RWMutex
+	lockGetByHandle               sync.RWMutex
+	lockGetInstallation           sync.RWMutex
+}
+
+// GetCurrentAppInstallation calls GetCurrentAppInstallationFunc.
+func (mock *AppServiceMock) GetCurrentAppInstallation(ctx context.Context) (*model.App, error) {
+	if mock.GetCurrentAppInstallationFunc == nil {
+		panic("AppServiceMock.GetCurrentAppInstallationFunc: method is nil but AppService.GetCurrentAppInstallation was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetCurrentAppInstallation.Lock()
+	mock.calls.GetCurrentAppInstallation = append(mock.calls.GetCurrentAppInstallation, callInfo)
+	mock.lockGetCurrentAppInstallation.Unlock()
+	return mock.GetCurrentAppInstallationFunc(ctx)
+}
+
+// GetCurrentAppInstallationCalls returns the calls made to GetCurrentAppInstallation.
+func (mock *AppServiceMock) GetCurrentAppInstallationCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+} {
+	mock.lockGetCurrentAppInstallation.RLock()
+	defer mock.lockGetCurrentAppInstallation.RUnlock()
+	return mock.calls.GetCurrentAppInstallation
+}
+
+// Get calls GetFunc.
+func (mock *AppServiceMock) Get(ctx context.Context, id string) (*model.App, error) {
+	if mock.GetFunc == nil {
+		panic("AppServiceMock.GetFunc: method is nil but AppService.Get was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls returns the calls made to Get.
+func (mock *AppServiceMock) GetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGet.RLock()
+	defer mock.lockGet.RUnlock()
+	return mock.calls.Get
+}
+
+// GetByHandle calls GetByHandleFunc.
+func (mock *AppServiceMock) GetByHandle(ctx context.Context, handle string) (*model.App, error) {
+	if mock.GetByHandleFunc == nil {
+		panic("AppServiceMock.GetByHandleFunc: method is nil but AppService.GetByHandle was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Handle is the handle argument value.
+		Handle string
+	}{
+		Ctx:    ctx,
+		Handle: handle,
+	}
+	mock.lockGetByHandle.Lock()
+	mock.calls.GetByHandle = append(mock.calls.GetByHandle, callInfo)
+	mock.lockGetByHandle.Unlock()
+	return mock.GetByHandleFunc(ctx, handle)
+}
+
+// GetByHandleCalls returns the calls made to GetByHandle.
+func (mock *AppServiceMock) GetByHandleCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Handle is the handle argument value.
+	Handle string
+} {
+	mock.lockGetByHandle.RLock()
+	defer mock.lockGetByHandle.RUnlock()
+	return mock.calls.GetByHandle
+}
+
+// GetInstallation calls GetInstallationFunc.
+func (mock *AppServiceMock) GetInstallation(ctx context.Context, id string, metafieldNamespace string) (*model.AppInstallation, error) {
+	if mock.GetInstallationFunc == nil {
+		panic("AppServiceMock.GetInstallationFunc: method is nil but AppService.GetInstallation was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// MetafieldNamespace is the metafieldNamespace argument value.
+		MetafieldNamespace string
+	}{
+		Ctx:                ctx,
+		Id:                 id,
+		MetafieldNamespace: metafieldNamespace,
+	}
+	mock.lockGetInstallation.Lock()
+	mock.calls.GetInstallation = append(mock.calls.GetInstallation, callInfo)
+	mock.lockGetInstallation.Unlock()
+	return mock.GetInstallationFunc(ctx, id, metafieldNamespace)
+}
+
+// GetInstallationCalls returns the calls made to GetInstallation.
+func (mock *AppServiceMock) GetInstallationCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// MetafieldNamespace is the metafieldNamespace argument value.
+	MetafieldNamespace string
+} {
+	mock.lockGetInstallation.RLock()
+	defer mock.lockGetInstallation.RUnlock()
+	return mock.calls.GetInstallation
+}
+
+var _ shopify.BillingService = &BillingServiceMock{}
+
+// BillingServiceMock is a mock implementation of shopify.BillingService.
+type BillingServiceMock struct {
+	// AppCreditCreateFunc mocks the AppCreditCreate method.
+	AppCreditCreateFunc func(ctx context.Context, input *shopify.AppCreditCreateInput) (*shopify.AppCreditCreateResult, error)
+
+	// AppPurchaseOneTimeCreateFunc mocks the AppPurchaseOneTimeCreate method.
+	AppPurchaseOneTimeCreateFunc func(ctx context.Context, input *shopify.AppPurchaseOneTimeCreateInput) (*shopify.AppPurchaseOneTimeCreateResult, error)
+
+	// AppSubscriptionCancelFunc mocks the AppSubscriptionCancel method.
+	AppSubscriptionCancelFunc func(ctx context.Context, id graphql.ID, prorate graphql.Boolean) (*shopify.AppSubscriptionCancelResult, error)
+
+	// AppSubscriptionCreateFunc mocks the AppSubscriptionCreate method.
+	AppSubscriptionCreateFunc func(ctx context.Context, input *shopify.AppSubscriptionCreateInput) (*shopify.AppSubscriptionCreateResult, error)
+
+	// AppSubscriptionTrialExtendFunc mocks the AppSubscriptionTrialExtend method.
+	AppSubscriptionTrialExtendFunc func(ctx context.Context, input *shopify.AppSubscriptionTrailExtendInput) (*shopify.AppSubscriptionTrailExtendResult, error)
+
+	// CreateSubscriptionFunc mocks the CreateSubscription method.
+	CreateSubscriptionFunc func(ctx context.Context, input shopify.AppSubscriptionInput) (*shopify.AppSubscriptionCreation, error)
+
+	// CancelSubscriptionFunc mocks the CancelSubscription method.
+	CancelSubscriptionFunc func(ctx context.Context, id string, prorate bool) (*shopify.AppSubscriptionDetails, error)
+
+	// UpdateCappedAmountFunc mocks the UpdateCappedAmount method.
+	UpdateCappedAmountFunc func(ctx context.Context, lineItemID string, amount model.MoneyInput) (*shopify.AppSubscriptionCreation, error)
+
+	// CreateOneTimePurchaseFunc mocks the CreateOneTimePurchase method.
+	CreateOneTimePurchaseFunc func(ctx context.Context, name string, price model.MoneyInput, returnURL string, test bool) (*shopify.AppPurchaseOneTimeCreation, error)
+
+	// ListOneTimePurchasesFunc mocks the ListOneTimePurchases method.
+	ListOneTimePurchasesFunc func(ctx context.Context, opts shopify.ListOptions) ([]*model.AppPurchaseOneTime, string, error)
+
+	// GetCurrentInstallationFunc mocks the GetCurrentInstallation method.
+	GetCurrentInstallationFunc func(ctx context.Context) (*shopify.AppInstallationBilling, error)
+
+	// CreateAppCreditFunc mocks the CreateAppCredit method.
+	CreateAppCreditFunc func(ctx context.Context, amount model.MoneyInput, description string, test bool) (*model.AppCredit, error)
+
+	// ExtendTrialFunc mocks the ExtendTrial method.
+	ExtendTrialFunc func(ctx context.Context, subscriptionID string, days int) (*shopify.AppSubscriptionDetails, error)
+
+	// GetSubscriptionFunc mocks the GetSubscription method.
+	GetSubscriptionFunc func(ctx context.Context, id string) (*shopify.AppSubscriptionDetails, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AppCreditCreate holds details about calls to the AppCreditCreate method.
+		AppCreditCreate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input *shopify.AppCreditCreateInput
+		}
+		// AppPurchaseOneTimeCreate holds details about calls to the AppPurchaseOneTimeCreate method.
+		AppPurchaseOneTimeCreate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input *shopify.AppPurchaseOneTimeCreateInput
+		}
+		// AppSubscriptionCancel holds details about calls to the AppSubscriptionCancel method.
+		AppSubscriptionCancel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id graphql.ID
+			// Prorate is the prorate argument value.
+			Prorate graphql.Boolean
+		}
+		// AppSubscriptionCreate holds details about calls to the AppSubscriptionCreate method.
+		AppSubscriptionCreate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input *shopify.AppSubscriptionCreateInput
+		}
+		// AppSubscriptionTrialExtend holds details about calls to the AppSubscriptionTrialExtend method.
+		AppSubscriptionTrialExtend []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input *shopify.AppSubscriptionTrailExtendInput
+		}
+		// CreateSubscription holds details about calls to the CreateSubscription method.
+		CreateSubscription []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input shopify.AppSubscriptionInput
+		}
+		// CancelSubscription holds details about calls to the CancelSubscription method.
+		CancelSubscription []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Prorate is the prorate argument value.
+			Prorate bool
+		}
+		// UpdateCappedAmount holds details about calls to the UpdateCappedAmount method.
+		UpdateCappedAmount []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// LineItemID is the lineItemID argument value.
+			LineItemID string
+			// Amount is the amount argument value.
+			Amount model.MoneyInput
+		}
+		// CreateOneTimePurchase holds details about calls to the CreateOneTimePurchase method.
+		CreateOneTimePurchase []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+			// Price is the price argument value.
+			Price model.MoneyInput
+			// ReturnURL is the returnURL argument value.
+			ReturnURL string
+			// Test is the test argument value.
+			Test bool
+		}
+		// ListOneTimePurchases holds details about calls to the ListOneTimePurchases method.
+		ListOneTimePurchases []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+		// GetCurrentInstallation holds details about calls to the GetCurrentInstallation method.
+		GetCurrentInstallation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// CreateAppCredit holds details about calls to the CreateAppCredit method.
+		CreateAppCredit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Amount is the amount argument value.
+			Amount model.MoneyInput
+			// Description is the description argument value.
+			Description string
+			// Test is the test argument value.
+			Test bool
+		}
+		// ExtendTrial holds details about calls to the ExtendTrial method.
+		ExtendTrial []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SubscriptionID is the subscriptionID argument value.
+			SubscriptionID string
+			// Days is the days argument value.
+			Days int
+		}
+		// GetSubscription holds details about calls to the GetSubscription method.
+		GetSubscription []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+	}
+	lockAppCreditCreate            sync.RWMutex
+	lockAppPurchaseOneTimeCreate   sync.RWMutex
+	lockAppSubscriptionCancel      sync.RWMutex
+	lockAppSubscriptionCreate      sync.RWMutex
+	lockAppSubscriptionTrialExtend sync.RWMutex
+	lockCreateSubscription         sync.RWMutex
+	lockCancelSubscription         sync.RWMutex
+	lockUpdateCappedAmount         sync.RWMutex
+	lockCreateOneTimePurchase      sync.RWMutex
+	lockListOneTimePurchases       sync.RWMutex
+	lockGetCurrentInstallation     sync.RWMutex
+	lockCreateAppCredit            sync.RWMutex
+	lockExtendTrial                sync.RWMutex
+	lockGetSubscription            sync.RWMutex
+}
+
+// AppCreditCreate calls AppCreditCreateFunc.
+func (mock *BillingServiceMock) AppCreditCreate(ctx context.Context, input *shopify.AppCreditCreateInput) (*shopify.AppCreditCreateResult, error) {
+	if mock.AppCreditCreateFunc == nil {
+		panic("BillingServiceMock.AppCreditCreateFunc: method is nil but BillingService.AppCreditCreate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input *shopify.AppCreditCreateInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockAppCreditCreate.Lock()
+	mock.calls.AppCreditCreate = append(mock.calls.AppCreditCreate, callInfo)
+	mock.lockAppCreditCreate.Unlock()
+	return mock.AppCreditCreateFunc(ctx, input)
+}
+
+// AppCreditCreateCalls returns the calls made to AppCreditCreate.
+func (mock *BillingServiceMock) AppCreditCreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input *shopify.AppCreditCreateInput
+} {
+	mock.lockAppCreditCreate.RLock()
+	defer mock.lockAppCreditCreate.RUnlock()
+	return mock.calls.AppCreditCreate
+}
+
+// AppPurchaseOneTimeCreate calls AppPurchaseOneTimeCreateFunc.
+func (mock *BillingServiceMock) AppPurchaseOneTimeCreate(ctx context.Context, input *shopify.AppPurchaseOneTimeCreateInput) (*shopify.AppPurchaseOneTimeCreateResult, error) {
+	if mock.AppPurchaseOneTimeCreateFunc == nil {
+		panic("BillingServiceMock.AppPurchaseOneTimeCreateFunc: method is nil but BillingService.AppPurchaseOneTimeCreate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input *shopify.AppPurchaseOneTimeCreateInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockAppPurchaseOneTimeCreate.Lock()
+	mock.calls.AppPurchaseOneTimeCreate = append(mock.calls.AppPurchaseOneTimeCreate, callInfo)
+	mock.lockAppPurchaseOneTimeCreate.Unlock()
+	return mock.AppPurchaseOneTimeCreateFunc(ctx, input)
+}
+
+// AppPurchaseOneTimeCreateCalls returns the calls made to AppPurchaseOneTimeCreate.
+func (mock *BillingServiceMock) AppPurchaseOneTimeCreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input *shopify.AppPurchaseOneTimeCreateInput
+} {
+	mock.lockAppPurchaseOneTimeCreate.RLock()
+	defer mock.lockAppPurchaseOneTimeCreate.RUnlock()
+	return mock.calls.AppPurchaseOneTimeCreate
+}
+
+// AppSubscriptionCancel calls AppSubscriptionCancelFunc.
+func (mock *BillingServiceMock) AppSubscriptionCancel(ctx context.Context, id graphql.ID, prorate graphql.Boolean) (*shopify.AppSubscriptionCancelResult, error) {
+	if mock.AppSubscriptionCancelFunc == nil {
+		panic("BillingServiceMock.AppSubscriptionCancelFunc: method is nil but BillingService.AppSubscriptionCancel was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id graphql.ID
+		// Prorate is the prorate argument value.
+		Prorate graphql.Boolean
+	}{
+		Ctx:     ctx,
+		Id:      id,
+		Prorate: prorate,
+	}
+	mock.lockAppSubscriptionCancel.Lock()
+	mock.calls.AppSubscriptionCancel = append(mock.calls.AppSubscriptionCancel, callInfo)
+	mock.lockAppSubscriptionCancel.Unlock()
+	return mock.AppSubscriptionCancelFunc(ctx, id, prorate)
+}
+
+// AppSubscriptionCancelCalls returns the calls made to AppSubscriptionCancel.
+func (mock *BillingServiceMock) AppSubscriptionCancelCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id graphql.ID
+	// Prorate is the prorate argument value.
+	Prorate graphql.Boolean
+} {
+	mock.lockAppSubscriptionCancel.RLock()
+	defer mock.lockAppSubscriptionCancel.RUnlock()
+	return mock.calls.AppSubscriptionCancel
+}
+
+// AppSubscriptionCreate calls AppSubscriptionCreateFunc.
+func (mock *BillingServiceMock) AppSubscriptionCreate(ctx context.Context, input *shopify.AppSubscriptionCreateInput) (*shopify.AppSubscriptionCreateResult, error) {
+	if mock.AppSubscriptionCreateFunc == nil {
+		panic("BillingServiceMock.AppSubscriptionCreateFunc: method is nil but BillingService.AppSubscriptionCreate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input *shopify.AppSubscriptionCreateInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockAppSubscriptionCreate.Lock()
+	mock.calls.AppSubscriptionCreate = append(mock.calls.AppSubscriptionCreate, callInfo)
+	mock.lockAppSubscriptionCreate.Unlock()
+	return mock.AppSubscriptionCreateFunc(ctx, input)
+}
+
+// AppSubscriptionCreateCalls returns the calls made to AppSubscriptionCreate.
+func (mock *BillingServiceMock) AppSubscriptionCreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input *shopify.AppSubscriptionCreateInput
+} {
+	mock.lockAppSubscriptionCreate.RLock()
+	defer mock.lockAppSubscriptionCreate.RUnlock()
+	return mock.calls.AppSubscriptionCreate
+}
+
+// AppSubscriptionTrialExtend calls AppSubscriptionTrialExtendFunc.
+func (mock *BillingServiceMock) AppSubscriptionTrialExtend(ctx context.Context, input *shopify.AppSubscriptionTrailExtendInput) (*shopify.AppSubscriptionTrailExtendResult, error) {
+	if mock.AppSubscriptionTrialExtendFunc == nil {
+		panic("BillingServiceMock.AppSubscriptionTrialExtendFunc: method is nil but BillingService.AppSubscriptionTrialExtend was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input *shopify.AppSubscriptionTrailExtendInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockAppSubscriptionTrialExtend.Lock()
+	mock.calls.AppSubscriptionTrialExtend = append(mock.calls.AppSubscriptionTrialExtend, callInfo)
+	mock.lockAppSubscriptionTrialExtend.Unlock()
+	return mock.AppSubscriptionTrialExtendFunc(ctx, input)
+}
+
+// AppSubscriptionTrialExtendCalls returns the calls made to AppSubscriptionTrialExtend.
+func (mock *BillingServiceMock) AppSubscriptionTrialExtendCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input *shopify.AppSubscriptionTrailExtendInput
+} {
+	mock.lockAppSubscriptionTrialExtend.RLock()
+	defer mock.lockAppSubscriptionTrialExtend.RUnlock()
+	return mock.calls.AppSubscriptionTrialExtend
+}
+
+// CreateSubscription calls CreateSubscriptionFunc.
+func (mock *BillingServiceMock) CreateSubscription(ctx context.Context, input shopify.AppSubscriptionInput) (*shopify.AppSubscriptionCreation, error) {
+	if mock.CreateSubscriptionFunc == nil {
+		panic("BillingServiceMock.CreateSubscriptionFunc: method is nil but BillingService.CreateSubscription was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input shopify.AppSubscriptionInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCreateSubscription.Lock()
+	mock.calls.CreateSubscription = append(mock.calls.CreateSubscription, callInfo)
+	mock.lockCreateSubscription.Unlock()
+	return mock.CreateSubscriptionFunc(ctx, input)
+}
+
+// CreateSubscriptionCalls returns the calls made to CreateSubscription.
+func (mock *BillingServiceMock) CreateSubscriptionCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input shopify.AppSubscriptionInput
+} {
+	mock.lockCreateSubscription.RLock()
+	defer mock.lockCreateSubscription.RUnlock()
+	return mock.calls.CreateSubscription
+}
+
+// CancelSubscription calls CancelSubscriptionFunc.
+func (mock *BillingServiceMock) CancelSubscription(ctx context.Context, id string, prorate bool) (*shopify.AppSubscriptionDetails, error) {
+	if mock.CancelSubscriptionFunc == nil {
+		panic("BillingServiceMock.CancelSubscriptionFunc: method is nil but BillingService.CancelSubscription was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Prorate is the prorate argument value.
+		Prorate bool
+	}{
+		Ctx:     ctx,
+		Id:      id,
+		Prorate: prorate,
+	}
+	mock.lockCancelSubscription.Lock()
+	mock.calls.CancelSubscription = append(mock.calls.CancelSubscription, callInfo)
+	mock.lockCancelSubscription.Unlock()
+	return mock.CancelSubscriptionFunc(ctx, id, prorate)
+}
+
+// CancelSubscriptionCalls returns the calls made to CancelSubscription.
+func (mock *BillingServiceMock) CancelSubscriptionCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Prorate is the prorate argument value.
+	Prorate bool
+} {
+	mock.lockCancelSubscription.RLock()
+	defer mock.lockCancelSubscription.RUnlock()
+	return mock.calls.CancelSubscription
+}
+
+// UpdateCappedAmount calls UpdateCappedAmountFunc.
+func (mock *BillingServiceMock) UpdateCappedAmount(ctx context.Context, lineItemID string, amount model.MoneyInput) (*shopify.AppSubscriptionCreation, error) {
+	if mock.UpdateCappedAmountFunc == nil {
+		panic("BillingServiceMock.UpdateCappedAmountFunc: method is nil but BillingService.UpdateCappedAmount was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// LineItemID is the lineItemID argument value.
+		LineItemID string
+		// Amount is the amount argument value.
+		Amount model.MoneyInput
+	}{
+		Ctx:        ctx,
+		LineItemID: lineItemID,
+		Amount:     amount,
+	}
+	mock.lockUpdateCappedAmount.Lock()
+	mock.calls.UpdateCappedAmount = append(mock.calls.UpdateCappedAmount, callInfo)
+	mock.lockUpdateCappedAmount.Unlock()
+	return mock.UpdateCappedAmountFunc(ctx, lineItemID, amount)
+}
+
+// UpdateCappedAmountCalls returns the calls made to UpdateCappedAmount.
+func (mock *BillingServiceMock) UpdateCappedAmountCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// LineItemID is the lineItemID argument value.
+	LineItemID string
+	// Amount is the amount argument value.
+	Amount model.MoneyInput
+} {
+	mock.lockUpdateCappedAmount.RLock()
+	defer mock.lockUpdateCappedAmount.RUnlock()
+	return mock.calls.UpdateCappedAmount
+}
+
+// CreateOneTimePurchase calls CreateOneTimePurchaseFunc.
+func (mock *BillingServiceMock) CreateOneTimePurchase(ctx context.Context, name string, price model.MoneyInput, returnURL string, test bool) (*shopify.AppPurchaseOneTimeCreation, error) {
+	if mock.CreateOneTimePurchaseFunc == nil {
+		panic("BillingServiceMock.CreateOneTimePurchaseFunc: method is nil but BillingService.CreateOneTimePurchase was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Name is the name argument value.
+		Name string
+		// Price is the price argument value.
+		Price model.MoneyInput
+		// ReturnURL is the returnURL argument value.
+		ReturnURL string
+		// Test is the test argument value.
+		Test bool
+	}{
+		Ctx:       ctx,
+		Name:      name,
+		Price:     price,
+		ReturnURL: returnURL,
+		Test:      test,
+	}
+	mock.lockCreateOneTimePurchase.Lock()
+	mock.calls.CreateOneTimePurchase = append(mock.calls.CreateOneTimePurchase, callInfo)
+	mock.lockCreateOneTimePurchase.Unlock()
+	return mock.CreateOneTimePurchaseFunc(ctx, name, price, returnURL, test)
+}
+
+// CreateOneTimePurchaseCalls returns the calls made to CreateOneTimePurchase.
+func (mock *BillingServiceMock) CreateOneTimePurchaseCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Name is the name argument value.
+	Name string
+	// Price is the price argument value.
+	Price model.MoneyInput
+	// ReturnURL is the returnURL argument value.
+	ReturnURL string
+	// Test is the test argument value.
+	Test bool
+} {
+	mock.lockCreateOneTimePurchase.RLock()
+	defer mock.lockCreateOneTimePurchase.RUnlock()
+	return mock.calls.CreateOneTimePurchase
+}
+
+// ListOneTimePurchases calls ListOneTimePurchasesFunc.
+func (mock *BillingServiceMock) ListOneTimePurchases(ctx context.Context, opts shopify.ListOptions) ([]*model.AppPurchaseOneTime, string, error) {
+	if mock.ListOneTimePurchasesFunc == nil {
+		panic("BillingServiceMock.ListOneTimePurchasesFunc: method is nil but BillingService.ListOneTimePurchases was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockListOneTimePurchases.Lock()
+	mock.calls.ListOneTimePurchases = append(mock.calls.ListOneTimePurchases, callInfo)
+	mock.lockListOneTimePurchases.Unlock()
+	return mock.ListOneTimePurchasesFunc(ctx, opts)
+}
+
+// ListOneTimePurchasesCalls returns the calls made to ListOneTimePurchases.
+func (mock *BillingServiceMock) ListOneTimePurchasesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockListOneTimePurchases.RLock()
+	defer mock.lockListOneTimePurchases.RUnlock()
+	return mock.calls.ListOneTimePurchases
+}
+
+// GetCurrentInstallation calls GetCurrentInstallationFunc.
+func (mock *BillingServiceMock) GetCurrentInstallation(ctx context.Context) (*shopify.AppInstallationBilling, error) {
+	if mock.GetCurrentInstallationFunc == nil {
+		panic("BillingServiceMock.GetCurrentInstallationFunc: method is nil but BillingService.GetCurrentInstallation was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetCurrentInstallation.Lock()
+	mock.calls.GetCurrentInstallation = append(mock.calls.GetCurrentInstallation, callInfo)
+	mock.lockGetCurrentInstallation.Unlock()
+	return mock.GetCurrentInstallationFunc(ctx)
+}
+
+// GetCurrentInstallationCalls returns the calls made to GetCurrentInstallation.
+func (mock *BillingServiceMock) GetCurrentInstallationCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+} {
+	mock.lockGetCurrentInstallation.RLock()
+	defer mock.lockGetCurrentInstallation.RUnlock()
+	return mock.calls.GetCurrentInstallation
+}
+
+// CreateAppCredit calls CreateAppCreditFunc.
+func (mock *BillingServiceMock) CreateAppCredit(ctx context.Context, amount model.MoneyInput, description string, test bool) (*model.AppCredit, error) {
+	if mock.CreateAppCreditFunc == nil {
+		panic("BillingServiceMock.CreateAppCreditFunc: method is nil but BillingService.CreateAppCredit was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Amount is the amount argument value.
+		Amount model.MoneyInput
+		// Description is the description argument value.
+		Description string
+		// Test is the test argument value.
+		Test bool
+	}{
+		Ctx:         ctx,
+		Amount:      amount,
+		Description: description,
+		Test:        test,
+	}
+	mock.lockCreateAppCredit.Lock()
+	mock.calls.CreateAppCredit = append(mock.calls.CreateAppCredit, callInfo)
+	mock.lockCreateAppCredit.Unlock()
+	return mock.CreateAppCreditFunc(ctx, amount, description, test)
+}
+
+// CreateAppCreditCalls returns the calls made to CreateAppCredit.
+func (mock *BillingServiceMock) CreateAppCreditCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Amount is the amount argument value.
+	Amount model.MoneyInput
+	// Description is the description argument value.
+	Description string
+	// Test is the test argument value.
+	Test bool
+} {
+	mock.lockCreateAppCredit.RLock()
+	defer mock.lockCreateAppCredit.RUnlock()
+	return mock.calls.CreateAppCredit
+}
+
+// ExtendTrial calls ExtendTrialFunc.
+func (mock *BillingServiceMock) ExtendTrial(ctx context.Context, subscriptionID string, days int) (*shopify.AppSubscriptionDetails, error) {
+	if mock.ExtendTrialFunc == nil {
+		panic("BillingServiceMock.ExtendTrialFunc: method is nil but BillingService.ExtendTrial was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// SubscriptionID is the subscriptionID argument value.
+		SubscriptionID string
+		// Days is the days argument value.
+		Days int
+	}{
+		Ctx:            ctx,
+		SubscriptionID: subscriptionID,
+		Days:           days,
+	}
+	mock.lockExtendTrial.Lock()
+	mock.calls.ExtendTrial = append(mock.calls.ExtendTrial, callInfo)
+	mock.lockExtendTrial.Unlock()
+	return mock.ExtendTrialFunc(ctx, subscriptionID, days)
+}
+
+// ExtendTrialCalls returns the calls made to ExtendTrial.
+func (mock *BillingServiceMock) ExtendTrialCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// SubscriptionID is the subscriptionID argument value.
+	SubscriptionID string
+	// Days is the days argument value.
+	Days int
+} {
+	mock.lockExtendTrial.RLock()
+	defer mock.lockExtendTrial.RUnlock()
+	return mock.calls.ExtendTrial
+}
+
+// GetSubscription calls GetSubscriptionFunc.
+func (mock *BillingServiceMock) GetSubscription(ctx context.Context, id string) (*shopify.AppSubscriptionDetails, error) {
+	if mock.GetSubscriptionFunc == nil {
+		panic("BillingServiceMock.GetSubscriptionFunc: method is nil but BillingService.GetSubscription was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGetSubscription.Lock()
+	mock.calls.GetSubscription = append(mock.calls.GetSubscription, callInfo)
+	mock.lockGetSubscription.Unlock()
+	return mock.GetSubscriptionFunc(ctx, id)
+}
+
+// GetSubscriptionCalls returns the calls made to GetSubscription.
+func (mock *BillingServiceMock) GetSubscriptionCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGetSubscription.RLock()
+	defer mock.lockGetSubscription.RUnlock()
+	return mock.calls.GetSubscription
+}
+
+var _ shopify.BulkOperationService = &BulkOperationServiceMock{}
+
+// BulkOperationServiceMock is a mock implementation of shopify.BulkOperationService.
+type BulkOperationServiceMock struct {
+	// BulkQueryFunc mocks the BulkQuery method.
+	BulkQueryFunc func(ctx context.Context, query string, v interface{}) error
+
+	// PostBulkQueryFunc mocks the PostBulkQuery method.
+	PostBulkQueryFunc func(ctx context.Context, query string) (*string, error)
+
+	// GetCurrentBulkQueryFunc mocks the GetCurrentBulkQuery method.
+	GetCurrentBulkQueryFunc func(ctx context.Context) (*model.BulkOperation, error)
+
+	// GetCurrentBulkQueryResultURLFunc mocks the GetCurrentBulkQueryResultURL method.
+	GetCurrentBulkQueryResultURLFunc func(ctx context.Context) (*string, error)
+
+	// WaitForCurrentBulkQueryFunc mocks the WaitForCurrentBulkQuery method.
+	WaitForCurrentBulkQueryFunc func(ctx context.Context, interval time.Duration) (*model.BulkOperation, error)
+
+	// ShouldGetBulkQueryResultURLFunc mocks the ShouldGetBulkQueryResultURL method.
+	ShouldGetBulkQueryResultURLFunc func(ctx context.Context, id *string) (*string, error)
+
+	// CancelRunningBulkQueryFunc mocks the CancelRunningBulkQuery method.
+	CancelRunningBulkQueryFunc func(ctx context.Context) error
+
+	// GetBulkQueryResultFunc mocks the GetBulkQueryResult method.
+	GetBulkQueryResultFunc func(ctx context.Context, id graphql.ID) (*model.BulkOperation, error)
+
+	// BulkMutationFunc mocks the BulkMutation method.
+	BulkMutationFunc func(ctx context.Context, mutation string, variables io.Reader) (*model.BulkOperation, error)
+
+	// GetCurrentBulkMutationFunc mocks the GetCurrentBulkMutation method.
+	GetCurrentBulkMutationFunc func(ctx context.Context) (*model.BulkOperation, error)
+
+	// WaitForCurrentBulkMutationFunc mocks the WaitForCurrentBulkMutation method.
+	WaitForCurrentBulkMutationFunc func(ctx context.Context, interval time.Duration) (*model.BulkOperation, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// BulkQuery holds details about calls to the BulkQuery method.
+		BulkQuery []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
+			// V is the v argument value.
+			V interface{}
+		}
+		// PostBulkQuery holds details about calls to the PostBulkQuery method.
+		PostBulkQuery []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
+		}
+		// GetCurrentBulkQuery holds details about calls to the GetCurrentBulkQuery method.
+		GetCurrentBulkQuery []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetCurrentBulkQueryResultURL holds details about calls to the GetCurrentBulkQueryResultURL method.
+		GetCurrentBulkQueryResultURL []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// WaitForCurrentBulkQuery holds details about calls to the WaitForCurrentBulkQuery method.
+		WaitForCurrentBulkQuery []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Interval is the interval argument value.
+			Interval time.Duration
+		}
+		// ShouldGetBulkQueryResultURL holds details about calls to the ShouldGetBulkQueryResultURL method.
+		ShouldGetBulkQueryResultURL []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id *string
+		}
+		// CancelRunningBulkQuery holds details about calls to the CancelRunningBulkQuery method.
+		CancelRunningBulkQuery []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// GetBulkQueryResult holds details about calls to the GetBulkQueryResult method.
+		GetBulkQueryResult []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id graphql.ID
+		}
+		// BulkMutation holds details about calls to the BulkMutation method.
+		BulkMutation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Mutation is the mutation argument value.
+			Mutation string
+			// Variables is the variables argument value.
+			Variables io.Reader
+		}
+		// GetCurrentBulkMutation holds details about calls to the GetCurrentBulkMutation method.
+		GetCurrentBulkMutation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// WaitForCurrentBulkMutation holds details about calls to the WaitForCurrentBulkMutation method.
+		WaitForCurrentBulkMutation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Interval is the interval argument value.
+			Interval time.Duration
+		}
+	}
+	lockBulkQuery                    sync.RWMutex
+	lockPostBulkQuery                sync.RWMutex
+	lockGetCurrentBulkQuery          sync.RWMutex
+	lockGetCurrentBulkQueryResultURL sync.RWMutex
+	lockWaitForCurrentBulkQuery      sync.RWMutex
+	lockShouldGetBulkQueryResultURL  sync.RWMutex
+	lockCancelRunningBulkQuery       sync.RWMutex
+	lockGetBulkQueryResult           sync.RWMutex
+	lockBulkMutation                 sync.RWMutex
+	lockGetCurrentBulkMutation       sync.RWMutex
+	lockWaitForCurrentBulkMutation   sync.RWMutex
+}
+
+// BulkQuery calls BulkQueryFunc.
+func (mock *BulkOperationServiceMock) BulkQuery(ctx context.Context, query string, v interface{}) error {
+	if mock.BulkQueryFunc == nil {
+		panic("BulkOperationServiceMock.BulkQueryFunc: method is nil but BulkOperationService.BulkQuery was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Query is the query argument value.
+		Query string
+		// V is the v argument value.
+		V interface{}
+	}{
+		Ctx:   ctx,
+		Query: query,
+		V:     v,
+	}
+	mock.lockBulkQuery.Lock()
+	mock.calls.BulkQuery = append(mock.calls.BulkQuery, callInfo)
+	mock.lockBulkQuery.Unlock()
+	return mock.BulkQueryFunc(ctx, query, v)
+}
+
+// BulkQueryCalls returns the calls made to BulkQuery.
+func (mock *BulkOperationServiceMock) BulkQueryCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Query is the query argument value.
+	Query string
+	// V is the v argument value.
+	V interface{}
+} {
+	mock.lockBulkQuery.RLock()
+	defer mock.lockBulkQuery.RUnlock()
+	return mock.calls.BulkQuery
+}
+
+// PostBulkQuery calls PostBulkQueryFunc.
+func (mock *BulkOperationServiceMock) PostBulkQuery(ctx context.Context, query string) (*string, error) {
+	if mock.PostBulkQueryFunc == nil {
+		panic("BulkOperationServiceMock.PostBulkQueryFunc: method is nil but BulkOperationService.PostBulkQuery was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Query is the query argument value.
+		Query string
+	}{
+		Ctx:   ctx,
+		Query: query,
+	}
+	mock.lockPostBulkQuery.Lock()
+	mock.calls.PostBulkQuery = append(mock.calls.PostBulkQuery, callInfo)
+	mock.lockPostBulkQuery.Unlock()
+	return mock.PostBulkQueryFunc(ctx, query)
+}
+
+// PostBulkQueryCalls returns the calls made to PostBulkQuery.
+func (mock *BulkOperationServiceMock) PostBulkQueryCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Query is the query argument value.
+	Query string
+} {
+	mock.lockPostBulkQuery.RLock()
+	defer mock.lockPostBulkQuery.RUnlock()
+	return mock.calls.PostBulkQuery
+}
+
+// GetCurrentBulkQuery calls GetCurrentBulkQueryFunc.
+func (mock *BulkOperationServiceMock) GetCurrentBulkQuery(ctx context.Context) (*model.BulkOperation, error) {
+	if mock.GetCurrentBulkQueryFunc == nil {
+		panic("BulkOperationServiceMock.GetCurrentBulkQueryFunc: method is nil but BulkOperationService.GetCurrentBulkQuery was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetCurrentBulkQuery.Lock()
+	mock.calls.GetCurrentBulkQuery = append(mock.calls.GetCurrentBulkQuery, callInfo)
+	mock.lockGetCurrentBulkQuery.Unlock()
+	return mock.GetCurrentBulkQueryFunc(ctx)
+}
+
+// GetCurrentBulkQueryCalls returns the calls made to GetCurrentBulkQuery.
+func (mock *BulkOperationServiceMock) GetCurrentBulkQueryCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+} {
+	mock.lockGetCurrentBulkQuery.RLock()
+	defer mock.lockGetCurrentBulkQuery.RUnlock()
+	return mock.calls.GetCurrentBulkQuery
+}
+
+// GetCurrentBulkQueryResultURL calls GetCurrentBulkQueryResultURLFunc.
+func (mock *BulkOperationServiceMock) GetCurrentBulkQueryResultURL(ctx context.Context) (*string, error) {
+	if mock.GetCurrentBulkQueryResultURLFunc == nil {
+		panic("BulkOperationServiceMock.GetCurrentBulkQueryResultURLFunc: method is nil but BulkOperationService.GetCurrentBulkQueryResultURL was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetCurrentBulkQueryResultURL.Lock()
+	mock.calls.GetCurrentBulkQueryResultURL = append(mock.calls.GetCurrentBulkQueryResultURL, callInfo)
+	mock.lockGetCurrentBulkQueryResultURL.Unlock()
+	return mock.GetCurrentBulkQueryResultURLFunc(ctx)
+}
+
+// GetCurrentBulkQueryResultURLCalls returns the calls made to GetCurrentBulkQueryResultURL.
+func (mock *BulkOperationServiceMock) GetCurrentBulkQueryResultURLCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+} {
+	mock.lockGetCurrentBulkQueryResultURL.RLock()
+	defer mock.lockGetCurrentBulkQueryResultURL.RUnlock()
+	return mock.calls.GetCurrentBulkQueryResultURL
+}
+
+// WaitForCurrentBulkQuery calls WaitForCurrentBulkQueryFunc.
+func (mock *BulkOperationServiceMock) WaitForCurrentBulkQuery(ctx context.Context, interval time.Duration) (*model.BulkOperation, error) {
+	if mock.WaitForCurrentBulkQueryFunc == nil {
+		panic("BulkOperationServiceMock.WaitForCurrentBulkQueryFunc: method is nil but BulkOperationService.WaitForCurrentBulkQuery was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Interval is the interval argument value.
+		Interval time.Duration
+	}{
+		Ctx:      ctx,
+		Interval: interval,
+	}
+	mock.lockWaitForCurrentBulkQuery.Lock()
+	mock.calls.WaitForCurrentBulkQuery = append(mock.calls.WaitForCurrentBulkQuery, callInfo)
+	mock.lockWaitForCurrentBulkQuery.Unlock()
+	return mock.WaitForCurrentBulkQueryFunc(ctx, interval)
+}
+
+// WaitForCurrentBulkQueryCalls returns the calls made to WaitForCurrentBulkQuery.
+func (mock *BulkOperationServiceMock) WaitForCurrentBulkQueryCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Interval is the interval argument value.
+	Interval time.Duration
+} {
+	mock.lockWaitForCurrentBulkQuery.RLock()
+	defer mock.lockWaitForCurrentBulkQuery.RUnlock()
+	return mock.calls.WaitForCurrentBulkQuery
+}
+
+// ShouldGetBulkQueryResultURL calls ShouldGetBulkQueryResultURLFunc.
+func (mock *BulkOperationServiceMock) ShouldGetBulkQueryResultURL(ctx context.Context, id *string) (*string, error) {
+	if mock.ShouldGetBulkQueryResultURLFunc == nil {
+		panic("BulkOperationServiceMock.ShouldGetBulkQueryResultURLFunc: method is nil but BulkOperationService.ShouldGetBulkQueryResultURL was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id *string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockShouldGetBulkQueryResultURL.Lock()
+	mock.calls.ShouldGetBulkQueryResultURL = append(mock.calls.ShouldGetBulkQueryResultURL, callInfo)
+	mock.lockShouldGetBulkQueryResultURL.Unlock()
+	return mock.ShouldGetBulkQueryResultURLFunc(ctx, id)
+}
+
+// ShouldGetBulkQueryResultURLCalls returns the calls made to ShouldGetBulkQueryResultURL.
+func (mock *BulkOperationServiceMock) ShouldGetBulkQueryResultURLCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id *string
+} {
+	mock.lockShouldGetBulkQueryResultURL.RLock()
+	defer mock.lockShouldGetBulkQueryResultURL.RUnlock()
+	return mock.calls.ShouldGetBulkQueryResultURL
+}
+
+// CancelRunningBulkQuery calls CancelRunningBulkQueryFunc.
+func (mock *BulkOperationServiceMock) CancelRunningBulkQuery(ctx context.Context) error {
+	if mock.CancelRunningBulkQueryFunc == nil {
+		panic("BulkOperationServiceMock.CancelRunningBulkQueryFunc: method is nil but BulkOperationService.CancelRunningBulkQuery was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockCancelRunningBulkQuery.Lock()
+	mock.calls.CancelRunningBulkQuery = append(mock.calls.CancelRunningBulkQuery, callInfo)
+	mock.lockCancelRunningBulkQuery.Unlock()
+	return mock.CancelRunningBulkQueryFunc(ctx)
+}
+
+// CancelRunningBulkQueryCalls returns the calls made to CancelRunningBulkQuery.
+func (mock *BulkOperationServiceMock) CancelRunningBulkQueryCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+} {
+	mock.lockCancelRunningBulkQuery.RLock()
+	defer mock.lockCancelRunningBulkQuery.RUnlock()
+	return mock.calls.CancelRunningBulkQuery
+}
+
+// GetBulkQueryResult calls GetBulkQueryResultFunc.
+func (mock *BulkOperationServiceMock) GetBulkQueryResult(ctx context.Context, id graphql.ID) (*model.BulkOperation, error) {
+	if mock.GetBulkQueryResultFunc == nil {
+		panic("BulkOperationServiceMock.GetBulkQueryResultFunc: method is nil but BulkOperationService.GetBulkQueryResult was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id graphql.ID
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGetBulkQueryResult.Lock()
+	mock.calls.GetBulkQueryResult = append(mock.calls.GetBulkQueryResult, callInfo)
+	mock.lockGetBulkQueryResult.Unlock()
+	return mock.GetBulkQueryResultFunc(ctx, id)
+}
+
+// GetBulkQueryResultCalls returns the calls made to GetBulkQueryResult.
+func (mock *BulkOperationServiceMock) GetBulkQueryResultCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id graphql.ID
+} {
+	mock.lockGetBulkQueryResult.RLock()
+	defer mock.lockGetBulkQueryResult.RUnlock()
+	return mock.calls.GetBulkQueryResult
+}
+
+// BulkMutation calls BulkMutationFunc.
+func (mock *BulkOperationServiceMock) BulkMutation(ctx context.Context, mutation string, variables io.Reader) (*model.BulkOperation, error) {
+	if mock.BulkMutationFunc == nil {
+		panic("BulkOperationServiceMock.BulkMutationFunc: method is nil but BulkOperationService.BulkMutation was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Mutation is the mutation argument value.
+		Mutation string
+		// Variables is the variables argument value.
+		Variables io.Reader
+	}{
+		Ctx:       ctx,
+		Mutation:  mutation,
+		Variables: variables,
+	}
+	mock.lockBulkMutation.Lock()
+	mock.calls.BulkMutation = append(mock.calls.BulkMutation, callInfo)
+	mock.lockBulkMutation.Unlock()
+	return mock.BulkMutationFunc(ctx, mutation, variables)
+}
+
+// BulkMutationCalls returns the calls made to BulkMutation.
+func (mock *BulkOperationServiceMock) BulkMutationCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Mutation is the mutation argument value.
+	Mutation string
+	// Variables is the variables argument value.
+	Variables io.Reader
+} {
+	mock.lockBulkMutation.RLock()
+	defer mock.lockBulkMutation.RUnlock()
+	return mock.calls.BulkMutation
+}
+
+// GetCurrentBulkMutation calls GetCurrentBulkMutationFunc.
+func (mock *BulkOperationServiceMock) GetCurrentBulkMutation(ctx context.Context) (*model.BulkOperation, error) {
+	if mock.GetCurrentBulkMutationFunc == nil {
+		panic("BulkOperationServiceMock.GetCurrentBulkMutationFunc: method is nil but BulkOperationService.GetCurrentBulkMutation was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockGetCurrentBulkMutation.Lock()
+	mock.calls.GetCurrentBulkMutation = append(mock.calls.GetCurrentBulkMutation, callInfo)
+	mock.lockGetCurrentBulkMutation.Unlock()
+	return mock.GetCurrentBulkMutationFunc(ctx)
+}
+
+// GetCurrentBulkMutationCalls returns the calls made to GetCurrentBulkMutation.
+func (mock *BulkOperationServiceMock) GetCurrentBulkMutationCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+} {
+	mock.lockGetCurrentBulkMutation.RLock()
+	defer mock.lockGetCurrentBulkMutation.RUnlock()
+	return mock.calls.GetCurrentBulkMutation
+}
+
+// WaitForCurrentBulkMutation calls WaitForCurrentBulkMutationFunc.
+func (mock *BulkOperationServiceMock) WaitForCurrentBulkMutation(ctx context.Context, interval time.Duration) (*model.BulkOperation, error) {
+	if mock.WaitForCurrentBulkMutationFunc == nil {
+		panic("BulkOperationServiceMock.WaitForCurrentBulkMutationFunc: method is nil but BulkOperationService.WaitForCurrentBulkMutation was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Interval is the interval argument value.
+		Interval time.Duration
+	}{
+		Ctx:      ctx,
+		Interval: interval,
+	}
+	mock.lockWaitForCurrentBulkMutation.Lock()
+	mock.calls.WaitForCurrentBulkMutation = append(mock.calls.WaitForCurrentBulkMutation, callInfo)
+	mock.lockWaitForCurrentBulkMutation.Unlock()
+	return mock.WaitForCurrentBulkMutationFunc(ctx, interval)
+}
+
+// WaitForCurrentBulkMutationCalls returns the calls made to WaitForCurrentBulkMutation.
+func (mock *BulkOperationServiceMock) WaitForCurrentBulkMutationCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Interval is the interval argument value.
+	Interval time.Duration
+} {
+	mock.lockWaitForCurrentBulkMutation.RLock()
+	defer mock.lockWaitForCurrentBulkMutation.RUnlock()
+	return mock.calls.WaitForCurrentBulkMutation
+}
+
+var _ shopify.CartService = &CartServiceMock{}
+
+// CartServiceMock is a mock implementation of shopify.CartService.
+type CartServiceMock struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id graphql.String) (*shopify.Cart, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, cartInput *shopify.CartInput) (graphql.String, error)
+
+	// CartLinesUpdateFunc mocks the CartLinesUpdate method.
+	CartLinesUpdateFunc func(ctx context.Context, id graphql.ID, cartLinesUpdateInput []shopify.CartLineUpdateInput) error
+
+	// CartLinesAddFunc mocks the CartLinesAdd method.
+	CartLinesAddFunc func(ctx context.Context, id graphql.ID, lines []shopify.CartLineInput) error
+
+	// CartLinesRemoveFunc mocks the CartLinesRemove method.
+	CartLinesRemoveFunc func(ctx context.Context, id graphql.ID, lineIds []graphql.ID) error
+
+	// CartNoteUpdateFunc mocks the CartNoteUpdate method.
+	CartNoteUpdateFunc func(ctx context.Context, id graphql.ID, note graphql.String) error
+
+	// CartDiscountCodesUpdateFunc mocks the CartDiscountCodesUpdate method.
+	CartDiscountCodesUpdateFunc func(ctx context.Context, id graphql.ID, discountCodes []graphql.String) error
+
+	// UpdateDiscountCodesFunc mocks the UpdateDiscountCodes method.
+	UpdateDiscountCodesFunc func(ctx context.Context, cartID graphql.ID, codes []string) ([]shopify.CartDiscountCode, error)
+
+	// UpdateGiftCardsFunc mocks the UpdateGiftCards method.
+	UpdateGiftCardsFunc func(ctx context.Context, cartID graphql.ID, codes []string) ([]shopify.CartAppliedGiftCard, error)
+
+	// UpdateBuyerIdentityFunc mocks the UpdateBuyerIdentity method.
+	UpdateBuyerIdentityFunc func(ctx context.Context, cartID graphql.ID, buyerIdentity shopify.CartBuyerIdentityInput) (*shopify.CartDeliveryEstimate, error)
+
+	// SelectDeliveryOptionsFunc mocks the SelectDeliveryOptions method.
+	SelectDeliveryOptionsFunc func(ctx context.Context, cartID graphql.ID, options []shopify.CartSelectedDeliveryOptionInput) (*shopify.CartDeliveryEstimate, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id graphql.String
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CartInput is the cartInput argument value.
+			CartInput *shopify.CartInput
+		}
+		// CartLinesUpdate holds details about calls to the CartLinesUpdate method.
+		CartLinesUpdate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id graphql.ID
+			// CartLinesUpdateInput is the cartLinesUpdateInput argument value.
+			CartLinesUpdateInput []shopify.CartLineUpdateInput
+		}
+		// CartLinesAdd holds details about calls to the CartLinesAdd method.
+		CartLinesAdd []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id graphql.ID
+			// Lines is the lines argument value.
+			Lines []shopify.CartLineInput
+		}
+		// CartLinesRemove holds details about calls to the CartLinesRemove method.
+		CartLinesRemove []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id graphql.ID
+			// LineIds is the lineIds argument value.
+			LineIds []graphql.ID
+		}
+		// CartNoteUpdate holds details about calls to the CartNoteUpdate method.
+		CartNoteUpdate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id graphql.ID
+			// Note is the note argument value.
+			Note graphql.String
+		}
+		// CartDiscountCodesUpdate holds details about calls to the CartDiscountCodesUpdate method.
+		CartDiscountCodesUpdate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id graphql.ID
+			// DiscountCodes is the discountCodes argument value.
+			DiscountCodes []graphql.String
+		}
+		// UpdateDiscountCodes holds details about calls to the UpdateDiscountCodes method.
+		UpdateDiscountCodes []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CartID is the cartID argument value.
+			CartID graphql.ID
+			// Codes is the codes argument value.
+			Codes []string
+		}
+		// UpdateGiftCards holds details about calls to the UpdateGiftCards method.
+		UpdateGiftCards []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CartID is the cartID argument value.
+			CartID graphql.ID
+			// Codes is the codes argument value.
+			Codes []string
+		}
+		// UpdateBuyerIdentity holds details about calls to the UpdateBuyerIdentity method.
+		UpdateBuyerIdentity []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CartID is the cartID argument value.
+			CartID graphql.ID
+			// BuyerIdentity is the buyerIdentity argument value.
+			BuyerIdentity shopify.CartBuyerIdentityInput
+		}
+		// SelectDeliveryOptions holds details about calls to the SelectDeliveryOptions method.
+		SelectDeliveryOptions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CartID is the cartID argument value.
+			CartID graphql.ID
+			// Options is the options argument value.
+			Options []shopify.CartSelectedDeliveryOptionInput
+		}
+	}
+	lockGet                     sync.RWMutex
+	lockCreate                  sync.RWMutex
+	lockCartLinesUpdate         sync.RWMutex
+	lockCartLinesAdd            sync.RWMutex
+	lockCartLinesRemove         sync.RWMutex
+	lockCartNoteUpdate          sync.RWMutex
+	lockCartDiscountCodesUpdate sync.RWMutex
+	lockUpdateDiscountCodes     sync.RWMutex
+	lockUpdateGiftCards         sync.RWMutex
+	lockUpdateBuyerIdentity     sync.RWMutex
+	lockSelectDeliveryOptions   sync.RWMutex
+}
+
+// Get calls GetFunc.
+func (mock *CartServiceMock) Get(ctx context.Context, id graphql.String) (*shopify.Cart, error) {
+	if mock.GetFunc == nil {
+		panic("CartServiceMock.GetFunc: method is nil but CartService.Get was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id graphql.String
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls returns the calls made to Get.
+func (mock *CartServiceMock) GetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id graphql.String
+} {
+	mock.lockGet.RLock()
+	defer mock.lockGet.RUnlock()
+	return mock.calls.Get
+}
+
+// Create calls CreateFunc.
+func (mock *CartServiceMock) Create(ctx context.Context, cartInput *shopify.CartInput) (graphql.String, error) {
+	if mock.CreateFunc == nil {
+		panic("CartServiceMock.CreateFunc: method is nil but CartService.Create was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// CartInput is the cartInput argument value.
+		CartInput *shopify.CartInput
+	}{
+		Ctx:       ctx,
+		CartInput: cartInput,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, cartInput)
+}
+
+// CreateCalls returns the calls made to Create.
+func (mock *CartServiceMock) CreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// CartInput is the cartInput argument value.
+	CartInput *shopify.CartInput
+} {
+	mock.lockCreate.RLock()
+	defer mock.lockCreate.RUnlock()
+	return mock.calls.Create
+}
+
+// CartLinesUpdate calls CartLinesUpdateFunc.
+func (mock *CartServiceMock) CartLinesUpdate(ctx context.Context, id graphql.ID, cartLinesUpdateInput []shopify.CartLineUpdateInput) error {
+	if mock.CartLinesUpdateFunc == nil {
+		panic("CartServiceMock.CartLinesUpdateFunc: method is nil but CartService.CartLinesUpdate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id graphql.ID
+		// CartLinesUpdateInput is the cartLinesUpdateInput argument value.
+		CartLinesUpdateInput []shopify.CartLineUpdateInput
+	}{
+		Ctx:                  ctx,
+		Id:                   id,
+		CartLinesUpdateInput: cartLinesUpdateInput,
+	}
+	mock.lockCartLinesUpdate.Lock()
+	mock.calls.CartLinesUpdate = append(mock.calls.CartLinesUpdate, callInfo)
+	mock.lockCartLinesUpdate.Unlock()
+	return mock.CartLinesUpdateFunc(ctx, id, cartLinesUpdateInput)
+}
+
+// CartLinesUpdateCalls returns the calls made to CartLinesUpdate.
+func (mock *CartServiceMock) CartLinesUpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id graphql.ID
+	// CartLinesUpdateInput is the cartLinesUpdateInput argument value.
+	CartLinesUpdateInput []shopify.CartLineUpdateInput
+} {
+	mock.lockCartLinesUpdate.RLock()
+	defer mock.lockCartLinesUpdate.RUnlock()
+	return mock.calls.CartLinesUpdate
+}
+
+// CartLinesAdd calls CartLinesAddFunc.
+func (mock *CartServiceMock) CartLinesAdd(ctx context.Context, id graphql.ID, lines []shopify.CartLineInput) error {
+	if mock.CartLinesAddFunc == nil {
+		panic("CartServiceMock.CartLinesAddFunc: method is nil but CartService.CartLinesAdd was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id graphql.ID
+		// Lines is the lines argument value.
+		Lines []shopify.CartLineInput
+	}{
+		Ctx:   ctx,
+		Id:    id,
+		Lines: lines,
+	}
+	mock.lockCartLinesAdd.Lock()
+	mock.calls.CartLinesAdd = append(mock.calls.CartLinesAdd, callInfo)
+	mock.lockCartLinesAdd.Unlock()
+	return mock.CartLinesAddFunc(ctx, id, lines)
+}
+
+// CartLinesAddCalls returns the calls made to CartLinesAdd.
+func (mock *CartServiceMock) CartLinesAddCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id graphql.ID
+	// Lines is the lines argument value.
+	Lines []shopify.CartLineInput
+} {
+	mock.lockCartLinesAdd.RLock()
+	defer mock.lockCartLinesAdd.RUnlock()
+	return mock.calls.CartLinesAdd
+}
+
+// CartLinesRemove calls CartLinesRemoveFunc.
+func (mock *CartServiceMock) CartLinesRemove(ctx context.Context, id graphql.ID, lineIds []graphql.ID) error {
+	if mock.CartLinesRemoveFunc == nil {
+		panic("CartServiceMock.CartLinesRemoveFunc: method is nil but CartService.CartLinesRemove was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id graphql.ID
+		// LineIds is the lineIds argument value.
+		LineIds []graphql.ID
+	}{
+		Ctx:     ctx,
+		Id:      id,
+		LineIds: lineIds,
+	}
+	mock.lockCartLinesRemove.Lock()
+	mock.calls.CartLinesRemove = append(mock.calls.CartLinesRemove, callInfo)
+	mock.lockCartLinesRemove.Unlock()
+	return mock.CartLinesRemoveFunc(ctx, id, lineIds)
+}
+
+// CartLinesRemoveCalls returns the calls made to CartLinesRemove.
+func (mock *CartServiceMock) CartLinesRemoveCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id graphql.ID
+	// LineIds is the lineIds argument value.
+	LineIds []graphql.ID
+} {
+	mock.lockCartLinesRemove.RLock()
+	defer mock.lockCartLinesRemove.RUnlock()
+	return mock.calls.CartLinesRemove
+}
+
+// CartNoteUpdate calls CartNoteUpdateFunc.
+func (mock *CartServiceMock) CartNoteUpdate(ctx context.Context, id graphql.ID, note graphql.String) error {
+	if mock.CartNoteUpdateFunc == nil {
+		panic("CartServiceMock.CartNoteUpdateFunc: method is nil but CartService.CartNoteUpdate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id graphql.ID
+		// Note is the note argument value.
+		Note graphql.String
+	}{
+		Ctx:  ctx,
+		Id:   id,
+		Note: note,
+	}
+	mock.lockCartNoteUpdate.Lock()
+	mock.calls.CartNoteUpdate = append(mock.calls.CartNoteUpdate, callInfo)
+	mock.lockCartNoteUpdate.Unlock()
+	return mock.CartNoteUpdateFunc(ctx, id, note)
+}
+
+// CartNoteUpdateCalls returns the calls made to CartNoteUpdate.
+func (mock *CartServiceMock) CartNoteUpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id graphql.ID
+	// Note is the note argument value.
+	Note graphql.String
+} {
+	mock.lockCartNoteUpdate.RLock()
+	defer mock.lockCartNoteUpdate.RUnlock()
+	return mock.calls.CartNoteUpdate
+}
+
+// CartDiscountCodesUpdate calls CartDiscountCodesUpdateFunc.
+func (mock *CartServiceMock) CartDiscountCodesUpdate(ctx context.Context, id graphql.ID, discountCodes []graphql.String) error {
+	if mock.CartDiscountCodesUpdateFunc == nil {
+		panic("CartServiceMock.CartDiscountCodesUpdateFunc: method is nil but CartService.CartDiscountCodesUpdate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id graphql.ID
+		// DiscountCodes is the discountCodes argument value.
+		DiscountCodes []graphql.String
+	}{
+		Ctx:           ctx,
+		Id:            id,
+		DiscountCodes: discountCodes,
+	}
+	mock.lockCartDiscountCodesUpdate.Lock()
+	mock.calls.CartDiscountCodesUpdate = append(mock.calls.CartDiscountCodesUpdate, callInfo)
+	mock.lockCartDiscountCodesUpdate.Unlock()
+	return mock.CartDiscountCodesUpdateFunc(ctx, id, discountCodes)
+}
+
+// CartDiscountCodesUpdateCalls returns the calls made to CartDiscountCodesUpdate.
+func (mock *CartServiceMock) CartDiscountCodesUpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id graphql.ID
+	// DiscountCodes is the discountCodes argument value.
+	DiscountCodes []graphql.String
+} {
+	mock.lockCartDiscountCodesUpdate.RLock()
+	defer mock.lockCartDiscountCodesUpdate.RUnlock()
+	return mock.calls.CartDiscountCodesUpdate
+}
+
+// UpdateDiscountCodes calls UpdateDiscountCodesFunc.
+func (mock *CartServiceMock) UpdateDiscountCodes(ctx context.Context, cartID graphql.ID, codes []string) ([]shopify.CartDiscountCode, error) {
+	if mock.UpdateDiscountCodesFunc == nil {
+		panic("CartServiceMock.UpdateDiscountCodesFunc: method is nil but CartService.UpdateDiscountCodes was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// CartID is the cartID argument value.
+		CartID graphql.ID
+		// Codes is the codes argument value.
+		Codes []string
+	}{
+		Ctx:    ctx,
+		CartID: cartID,
+		Codes:  codes,
+	}
+	mock.lockUpdateDiscountCodes.Lock()
+	mock.calls.UpdateDiscountCodes = append(mock.calls.UpdateDiscountCodes, callInfo)
+	mock.lockUpdateDiscountCodes.Unlock()
+	return mock.UpdateDiscountCodesFunc(ctx, cartID, codes)
+}
+
+// UpdateDiscountCodesCalls returns the calls made to UpdateDiscountCodes.
+func (mock *CartServiceMock) UpdateDiscountCodesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// CartID is the cartID argument value.
+	CartID graphql.ID
+	// Codes is the codes argument value.
+	Codes []string
+} {
+	mock.lockUpdateDiscountCodes.RLock()
+	defer mock.lockUpdateDiscountCodes.RUnlock()
+	return mock.calls.UpdateDiscountCodes
+}
+
+// UpdateGiftCards calls UpdateGiftCardsFunc.
+func (mock *CartServiceMock) UpdateGiftCards(ctx context.Context, cartID graphql.ID, codes []string) ([]shopify.CartAppliedGiftCard, error) {
+	if mock.UpdateGiftCardsFunc == nil {
+		panic("CartServiceMock.UpdateGiftCardsFunc: method is nil but CartService.UpdateGiftCards was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// CartID is the cartID argument value.
+		CartID graphql.ID
+		// Codes is the codes argument value.
+		Codes []string
+	}{
+		Ctx:    ctx,
+		CartID: cartID,
+		Codes:  codes,
+	}
+	mock.lockUpdateGiftCards.Lock()
+	mock.calls.UpdateGiftCards = append(mock.calls.UpdateGiftCards, callInfo)
+	mock.lockUpdateGiftCards.Unlock()
+	return mock.UpdateGiftCardsFunc(ctx, cartID, codes)
+}
+
+// UpdateGiftCardsCalls returns the calls made to UpdateGiftCards.
+func (mock *CartServiceMock) UpdateGiftCardsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// CartID is the cartID argument value.
+	CartID graphql.ID
+	// Codes is the codes argument value.
+	Codes []string
+} {
+	mock.lockUpdateGiftCards.RLock()
+	defer mock.lockUpdateGiftCards.RUnlock()
+	return mock.calls.UpdateGiftCards
+}
+
+// UpdateBuyerIdentity calls UpdateBuyerIdentityFunc.
+func (mock *CartServiceMock) UpdateBuyerIdentity(ctx context.Context, cartID graphql.ID, buyerIdentity shopify.CartBuyerIdentityInput) (*shopify.CartDeliveryEstimate, error) {
+	if mock.UpdateBuyerIdentityFunc == nil {
+		panic("CartServiceMock.UpdateBuyerIdentityFunc: method is nil but CartService.UpdateBuyerIdentity was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// CartID is the cartID argument value.
+		CartID graphql.ID
+		// BuyerIdentity is the buyerIdentity argument value.
+		BuyerIdentity shopify.CartBuyerIdentityInput
+	}{
+		Ctx:           ctx,
+		CartID:        cartID,
+		BuyerIdentity: buyerIdentity,
+	}
+	mock.lockUpdateBuyerIdentity.Lock()
+	mock.calls.UpdateBuyerIdentity = append(mock.calls.UpdateBuyerIdentity, callInfo)
+	mock.lockUpdateBuyerIdentity.Unlock()
+	return mock.UpdateBuyerIdentityFunc(ctx, cartID, buyerIdentity)
+}
+
+// UpdateBuyerIdentityCalls returns the calls made to UpdateBuyerIdentity.
+func (mock *CartServiceMock) UpdateBuyerIdentityCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// CartID is the cartID argument value.
+	CartID graphql.ID
+	// BuyerIdentity is the buyerIdentity argument value.
+	BuyerIdentity shopify.CartBuyerIdentityInput
+} {
+	mock.lockUpdateBuyerIdentity.RLock()
+	defer mock.lockUpdateBuyerIdentity.RUnlock()
+	return mock.calls.UpdateBuyerIdentity
+}
+
+// SelectDeliveryOptions calls SelectDeliveryOptionsFunc.
+func (mock *CartServiceMock) SelectDeliveryOptions(ctx context.Context, cartID graphql.ID, options []shopify.CartSelectedDeliveryOptionInput) (*shopify.CartDeliveryEstimate, error) {
+	if mock.SelectDeliveryOptionsFunc == nil {
+		panic("CartServiceMock.SelectDeliveryOptionsFunc: method is nil but CartService.SelectDeliveryOptions was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// CartID is the cartID argument value.
+		CartID graphql.ID
+		// Options is the options argument value.
+		Options []shopify.CartSelectedDeliveryOptionInput
+	}{
+		Ctx:     ctx,
+		CartID:  cartID,
+		Options: options,
+	}
+	mock.lockSelectDeliveryOptions.Lock()
+	mock.calls.SelectDeliveryOptions = append(mock.calls.SelectDeliveryOptions, callInfo)
+	mock.lockSelectDeliveryOptions.Unlock()
+	return mock.SelectDeliveryOptionsFunc(ctx, cartID, options)
+}
+
+// SelectDeliveryOptionsCalls returns the calls made to SelectDeliveryOptions.
+func (mock *CartServiceMock) SelectDeliveryOptionsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// CartID is the cartID argument value.
+	CartID graphql.ID
+	// Options is the options argument value.
+	Options []shopify.CartSelectedDeliveryOptionInput
+} {
+	mock.lockSelectDeliveryOptions.RLock()
+	defer mock.lockSelectDeliveryOptions.RUnlock()
+	return mock.calls.SelectDeliveryOptions
+}
+
+var _ shopify.CartTransformService = &CartTransformServiceMock{}
+
+// CartTransformServiceMock is a mock implementation of shopify.CartTransformService.
+type CartTransformServiceMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context) ([]*model.CartTransform, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, functionID string, blockOnFailure bool) (*model.CartTransform, error)
+
+	// RegisterFunc mocks the Register method.
+	RegisterFunc func(ctx context.Context, functionID string, blockOnFailure bool) (*model.CartTransform, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id string) (string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FunctionID is the functionID argument value.
+			FunctionID string
+			// BlockOnFailure is the blockOnFailure argument value.
+			BlockOnFailure bool
+		}
+		// Register holds details about calls to the Register method.
+		Register []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FunctionID is the functionID argument value.
+			FunctionID string
+			// BlockOnFailure is the blockOnFailure argument value.
+			BlockOnFailure bool
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+	}
+	lockList     sync.RWMutex
+	lockCreate   sync.RWMutex
+	lockRegister sync.RWMutex
+	lockDelete   sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *CartTransformServiceMock) List(ctx context.Context) ([]*model.CartTransform, error) {
+	if mock.ListFunc == nil {
+		panic("CartTransformServiceMock.ListFunc: method is nil but CartTransformService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *CartTransformServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// Create calls CreateFunc.
+func (mock *CartTransformServiceMock) Create(ctx context.Context, functionID string, blockOnFailure bool) (*model.CartTransform, error) {
+	if mock.CreateFunc == nil {
+		panic("CartTransformServiceMock.CreateFunc: method is nil but CartTransformService.Create was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// FunctionID is the functionID argument value.
+		FunctionID string
+		// BlockOnFailure is the blockOnFailure argument value.
+		BlockOnFailure bool
+	}{
+		Ctx:            ctx,
+		FunctionID:     functionID,
+		BlockOnFailure: blockOnFailure,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, functionID, blockOnFailure)
+}
+
+// CreateCalls returns the calls made to Create.
+func (mock *CartTransformServiceMock) CreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// FunctionID is the functionID argument value.
+	FunctionID string
+	// BlockOnFailure is the blockOnFailure argument value.
+	BlockOnFailure bool
+} {
+	mock.lockCreate.RLock()
+	defer mock.lockCreate.RUnlock()
+	return mock.calls.Create
+}
+
+// Register calls RegisterFunc.
+func (mock *CartTransformServiceMock) Register(ctx context.Context, functionID string, blockOnFailure bool) (*model.CartTransform, error) {
+	if mock.RegisterFunc == nil {
+		panic("CartTransformServiceMock.RegisterFunc: method is nil but CartTransformService.Register was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// FunctionID is the functionID argument value.
+		FunctionID string
+		// BlockOnFailure is the blockOnFailure argument value.
+		BlockOnFailure bool
+	}{
+		Ctx:            ctx,
+		FunctionID:     functionID,
+		BlockOnFailure: blockOnFailure,
+	}
+	mock.lockRegister.Lock()
+	mock.calls.Register = append(mock.calls.Register, callInfo)
+	mock.lockRegister.Unlock()
+	return mock.RegisterFunc(ctx, functionID, blockOnFailure)
+}
+
+// RegisterCalls returns the calls made to Register.
+func (mock *CartTransformServiceMock) RegisterCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// FunctionID is the functionID argument value.
+	FunctionID string
+	// BlockOnFailure is the blockOnFailure argument value.
+	BlockOnFailure bool
+} {
+	mock.lockRegister.RLock()
+	defer mock.lockRegister.RUnlock()
+	return mock.calls.Register
+}
+
+// Delete calls DeleteFunc.
+func (mock *CartTransformServiceMock) Delete(ctx context.Context, id string) (string, error) {
+	if mock.DeleteFunc == nil {
+		panic("CartTransformServiceMock.DeleteFunc: method is nil but CartTransformService.Delete was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id)
+}
+
+// DeleteCalls returns the calls made to Delete.
+func (mock *CartTransformServiceMock) DeleteCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockDelete.RLock()
+	defer mock.lockDelete.RUnlock()
+	return mock.calls.Delete
+}
+
+var _ shopify.CatalogService = &CatalogServiceMock{}
+
+// CatalogServiceMock is a mock implementation of shopify.CatalogService.
+type CatalogServiceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, input model.CatalogCreateInput) (*shopify.Catalog, error)
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, id string, input model.CatalogUpdateInput) (*shopify.Catalog, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id string, deleteDependentResources bool) (string, error)
+
+	// CreatePriceListFunc mocks the CreatePriceList method.
+	CreatePriceListFunc func(ctx context.Context, input model.PriceListCreateInput) (*model.PriceList, error)
+
+	// AddFixedPricesFunc mocks the AddFixedPrices method.
+	AddFixedPricesFunc func(ctx context.Context, priceListID string, prices []model.PriceListPriceInput) ([]model.PriceListPrice, error)
+
+	// AddFixedPricesBulkFunc mocks the AddFixedPricesBulk method.
+	AddFixedPricesBulkFunc func(ctx context.Context, priceListID string, prices []model.PriceListPriceInput) ([]model.PriceListPrice, error)
+
+	// DeleteFixedPricesFunc mocks the DeleteFixedPrices method.
+	DeleteFixedPricesFunc func(ctx context.Context, priceListID string, variantIDs []string) ([]string, error)
+
+	// DeleteFixedPricesBulkFunc mocks the DeleteFixedPricesBulk method.
+	DeleteFixedPricesBulkFunc func(ctx context.Context, priceListID string, variantIDs []string) ([]string, error)
+
+	// UpdateFixedPricesByProductFunc mocks the UpdateFixedPricesByProduct method.
+	UpdateFixedPricesByProductFunc func(ctx context.Context, priceListID string, pricesToAdd []model.PriceListProductPriceInput, productIDsToDelete []string) error
+
+	// UpdateQuantityPricingFunc mocks the UpdateQuantityPricing method.
+	UpdateQuantityPricingFunc func(ctx context.Context, priceListID string, input model.QuantityPricingByVariantUpdateInput) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.CatalogCreateInput
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Input is the input argument value.
+			Input model.CatalogUpdateInput
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// DeleteDependentResources is the deleteDependentResources argument value.
+			DeleteDependentResources bool
+		}
+		// CreatePriceList holds details about calls to the CreatePriceList method.
+		CreatePriceList []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.PriceListCreateInput
+		}
+		// AddFixedPrices holds details about calls to the AddFixedPrices method.
+		AddFixedPrices []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PriceListID is the priceListID argument value.
+			PriceListID string
+			// Prices is the prices argument value.
+			Prices []model.PriceListPriceInput
+		}
+		// AddFixedPricesBulk holds details about calls to the AddFixedPricesBulk method.
+		AddFixedPricesBulk []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PriceListID is the priceListID argument value.
+			PriceListID string
+			// Prices is the prices argument value.
+			Prices []model.PriceListPriceInput
+		}
+		// DeleteFixedPrices holds details about calls to the DeleteFixedPrices method.
+		DeleteFixedPrices []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PriceListID is the priceListID argument value.
+			PriceListID string
+			// VariantIDs is the variantIDs argument value.
+			VariantIDs []string
+		}
+		// DeleteFixedPricesBulk holds details about calls to the DeleteFixedPricesBulk method.
+		DeleteFixedPricesBulk []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PriceListID is the priceListID argument value.
+			PriceListID string
+			// VariantIDs is the variantIDs argument value.
+			VariantIDs []string
+		}
+		// UpdateFixedPricesByProduct holds details about calls to the UpdateFixedPricesByProduct method.
+		UpdateFixedPricesByProduct []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PriceListID is the priceListID argument value.
+			PriceListID string
+			// PricesToAdd is the pricesToAdd argument value.
+			PricesToAdd []model.PriceListProductPriceInput
+			// ProductIDsToDelete is the productIDsToDelete argument value.
+			ProductIDsToDelete []string
+		}
+		// UpdateQuantityPricing holds details about calls to the UpdateQuantityPricing method.
+		UpdateQuantityPricing []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PriceListID is the priceListID argument value.
+			PriceListID string
+			// Input is the input argument value.
+			Input model.QuantityPricingByVariantUpdateInput
+		}
+	}
+	lockCreate                     sync.RWMutex
+	lockUpdate                     sync.RWMutex
+	lockDelete                     sync.RWMutex
+	lockCreatePriceList            sync.RWMutex
+	lockAddFixedPrices             sync.RWMutex
+	lockAddFixedPricesBulk         sync.RWMutex
+	lockDeleteFixedPrices          sync.RWMutex
+	lockDeleteFixedPricesBulk      sync.RWMutex
+	lockUpdateFixedPricesByProduct sync.RWMutex
+	lockUpdateQuantityPricing      sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *CatalogServiceMock) Create(ctx context.Context, input model.CatalogCreateInput) (*shopify.Catalog, error) {
+	if mock.CreateFunc == nil {
+		panic("CatalogServiceMock.CreateFunc: method is nil but CatalogService.Create was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.CatalogCreateInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, input)
+}
+
+// CreateCalls returns the calls made to Create.
+func (mock *CatalogServiceMock) CreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.CatalogCreateInput
+} {
+	mock.lockCreate.RLock()
+	defer mock.lockCreate.RUnlock()
+	return mock.calls.Create
+}
+
+// Update calls UpdateFunc.
+func (mock *CatalogServiceMock) Update(ctx context.Context, id string, input model.CatalogUpdateInput) (*shopify.Catalog, error) {
+	if mock.UpdateFunc == nil {
+		panic("CatalogServiceMock.UpdateFunc: method is nil but CatalogService.Update was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Input is the input argument value.
+		Input model.CatalogUpdateInput
+	}{
+		Ctx:   ctx,
+		Id:    id,
+		Input: input,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, id, input)
+}
+
+// UpdateCalls returns the calls made to Update.
+func (mock *CatalogServiceMock) UpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Input is the input argument value.
+	Input model.CatalogUpdateInput
+} {
+	mock.lockUpdate.RLock()
+	defer mock.lockUpdate.RUnlock()
+	return mock.calls.Update
+}
+
+// Delete calls DeleteFunc.
+func (mock *CatalogServiceMock) Delete(ctx context.Context, id string, deleteDependentResources bool) (string, error) {
+	if mock.DeleteFunc == nil {
+		panic("CatalogServiceMock.DeleteFunc: method is nil but CatalogService.Delete was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// DeleteDependentResources is the deleteDependentResources argument value.
+		DeleteDependentResources bool
+	}{
+		Ctx:                      ctx,
+		Id:                       id,
+		DeleteDependentResources: deleteDependentResources,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id, deleteDependentResources)
+}
+
+// DeleteCalls returns the calls made to Delete.
+func (mock *CatalogServiceMock) DeleteCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// DeleteDependentResources is the deleteDependentResources argument value.
+	DeleteDependentResources bool
+} {
+	mock.lockDelete.RLock()
+	defer mock.lockDelete.RUnlock()
+	return mock.calls.Delete
+}
+
+// CreatePriceList calls CreatePriceListFunc.
+func (mock *CatalogServiceMock) CreatePriceList(ctx context.Context, input model.PriceListCreateInput) (*model.PriceList, error) {
+	if mock.CreatePriceListFunc == nil {
+		panic("CatalogServiceMock.CreatePriceListFunc: method is nil but CatalogService.CreatePriceList was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.PriceListCreateInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCreatePriceList.Lock()
+	mock.calls.CreatePriceList = append(mock.calls.CreatePriceList, callInfo)
+	mock.lockCreatePriceList.Unlock()
+	return mock.CreatePriceListFunc(ctx, input)
+}
+
+// CreatePriceListCalls returns the calls made to CreatePriceList.
+func (mock *CatalogServiceMock) CreatePriceListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.PriceListCreateInput
+} {
+	mock.lockCreatePriceList.RLock()
+	defer mock.lockCreatePriceList.RUnlock()
+	return mock.calls.CreatePriceList
+}
+
+// AddFixedPrices calls AddFixedPricesFunc.
+func (mock *CatalogServiceMock) AddFixedPrices(ctx context.Context, priceListID string, prices []model.PriceListPriceInput) ([]model.PriceListPrice, error) {
+	if mock.AddFixedPricesFunc == nil {
+		panic("CatalogServiceMock.AddFixedPricesFunc: method is nil but CatalogService.AddFixedPrices was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// PriceListID is the priceListID argument value.
+		PriceListID string
+		// Prices is the prices argument value.
+		Prices []model.PriceListPriceInput
+	}{
+		Ctx:         ctx,
+		PriceListID: priceListID,
+		Prices:      prices,
+	}
+	mock.lockAddFixedPrices.Lock()
+	mock.calls.AddFixedPrices = append(mock.calls.AddFixedPrices, callInfo)
+	mock.lockAddFixedPrices.Unlock()
+	return mock.AddFixedPricesFunc(ctx, priceListID, prices)
+}
+
+// AddFixedPricesCalls returns the calls made to AddFixedPrices.
+func (mock *CatalogServiceMock) AddFixedPricesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// PriceListID is the priceListID argument value.
+	PriceListID string
+	// Prices is the prices argument value.
+	Prices []model.PriceListPriceInput
+} {
+	mock.lockAddFixedPrices.RLock()
+	defer mock.lockAddFixedPrices.RUnlock()
+	return mock.calls.AddFixedPrices
+}
+
+// AddFixedPricesBulk calls AddFixedPricesBulkFunc.
+func (mock *CatalogServiceMock) AddFixedPricesBulk(ctx context.Context, priceListID string, prices []model.PriceListPriceInput) ([]model.PriceListPrice, error) {
+	if mock.AddFixedPricesBulkFunc == nil {
+		panic("CatalogServiceMock.AddFixedPricesBulkFunc: method is nil but CatalogService.AddFixedPricesBulk was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// PriceListID is the priceListID argument value.
+		PriceListID string
+		// Prices is the prices argument value.
+		Prices []model.PriceListPriceInput
+	}{
+		Ctx:         ctx,
+		PriceListID: priceListID,
+		Prices:      prices,
+	}
+	mock.lockAddFixedPricesBulk.Lock()
+	mock.calls.AddFixedPricesBulk = append(mock.calls.AddFixedPricesBulk, callInfo)
+	mock.lockAddFixedPricesBulk.Unlock()
+	return mock.AddFixedPricesBulkFunc(ctx, priceListID, prices)
+}
+
+// AddFixedPricesBulkCalls returns the calls made to AddFixedPricesBulk.
+func (mock *CatalogServiceMock) AddFixedPricesBulkCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// PriceListID is the priceListID argument value.
+	PriceListID string
+	// Prices is the prices argument value.
+	Prices []model.PriceListPriceInput
+} {
+	mock.lockAddFixedPricesBulk.RLock()
+	defer mock.lockAddFixedPricesBulk.RUnlock()
+	return mock.calls.AddFixedPricesBulk
+}
+
+// DeleteFixedPrices calls DeleteFixedPricesFunc.
+func (mock *CatalogServiceMock) DeleteFixedPrices(ctx context.Context, priceListID string, variantIDs []string) ([]string, error) {
+	if mock.DeleteFixedPricesFunc == nil {
+		panic("CatalogServiceMock.DeleteFixedPricesFunc: method is nil but CatalogService.DeleteFixedPrices was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// PriceListID is the priceListID argument value.
+		PriceListID string
+		// VariantIDs is the variantIDs argument value.
+		VariantIDs []string
+	}{
+		Ctx:         ctx,
+		PriceListID: priceListID,
+		VariantIDs:  variantIDs,
+	}
+	mock.lockDeleteFixedPrices.Lock()
+	mock.calls.DeleteFixedPrices = append(mock.calls.DeleteFixedPrices, callInfo)
+	mock.lockDeleteFixedPrices.Unlock()
+	return mock.DeleteFixedPricesFunc(ctx, priceListID, variantIDs)
+}
+
+// DeleteFixedPricesCalls returns the calls made to DeleteFixedPrices.
+func (mock *CatalogServiceMock) DeleteFixedPricesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// PriceListID is the priceListID argument value.
+	PriceListID string
+	// VariantIDs is the variantIDs argument value.
+	VariantIDs []string
+} {
+	mock.lockDeleteFixedPrices.RLock()
+	defer mock.lockDeleteFixedPrices.RUnlock()
+	return mock.calls.DeleteFixedPrices
+}
+
+// DeleteFixedPricesBulk calls DeleteFixedPricesBulkFunc.
+func (mock *CatalogServiceMock) DeleteFixedPricesBulk(ctx context.Context, priceListID string, variantIDs []string) ([]string, error) {
+	if mock.DeleteFixedPricesBulkFunc == nil {
+		panic("CatalogServiceMock.DeleteFixedPricesBulkFunc: method is nil but CatalogService.DeleteFixedPricesBulk was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// PriceListID is the priceListID argument value.
+		PriceListID string
+		// VariantIDs is the variantIDs argument value.
+		VariantIDs []string
+	}{
+		Ctx:         ctx,
+		PriceListID: priceListID,
+		VariantIDs:  variantIDs,
+	}
+	mock.lockDeleteFixedPricesBulk.Lock()
+	mock.calls.DeleteFixedPricesBulk = append(mock.calls.DeleteFixedPricesBulk, callInfo)
+	mock.lockDeleteFixedPricesBulk.Unlock()
+	return mock.DeleteFixedPricesBulkFunc(ctx, priceListID, variantIDs)
+}
+
+// DeleteFixedPricesBulkCalls returns the calls made to DeleteFixedPricesBulk.
+func (mock *CatalogServiceMock) DeleteFixedPricesBulkCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// PriceListID is the priceListID argument value.
+	PriceListID string
+	// VariantIDs is the variantIDs argument value.
+	VariantIDs []string
+} {
+	mock.lockDeleteFixedPricesBulk.RLock()
+	defer mock.lockDeleteFixedPricesBulk.RUnlock()
+	return mock.calls.DeleteFixedPricesBulk
+}
+
+// UpdateFixedPricesByProduct calls UpdateFixedPricesByProductFunc.
+func (mock *CatalogServiceMock) UpdateFixedPricesByProduct(ctx context.Context, priceListID string, pricesToAdd []model.PriceListProductPriceInput, productIDsToDelete []string) error {
+	if mock.UpdateFixedPricesByProductFunc == nil {
+		panic("CatalogServiceMock.UpdateFixedPricesByProductFunc: method is nil but CatalogService.UpdateFixedPricesByProduct was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// PriceListID is the priceListID argument value.
+		PriceListID string
+		// PricesToAdd is the pricesToAdd argument value.
+		PricesToAdd []model.PriceListProductPriceInput
+		// ProductIDsToDelete is the productIDsToDelete argument value.
+		ProductIDsToDelete []string
+	}{
+		Ctx:                ctx,
+		PriceListID:        priceListID,
+		PricesToAdd:        pricesToAdd,
+		ProductIDsToDelete: productIDsToDelete,
+	}
+	mock.lockUpdateFixedPricesByProduct.Lock()
+	mock.calls.UpdateFixedPricesByProduct = append(mock.calls.UpdateFixedPricesByProduct, callInfo)
+	mock.lockUpdateFixedPricesByProduct.Unlock()
+	return mock.UpdateFixedPricesByProductFunc(ctx, priceListID, pricesToAdd, productIDsToDelete)
+}
+
+// UpdateFixedPricesByProductCalls returns the calls made to UpdateFixedPricesByProduct.
+func (mock *CatalogServiceMock) UpdateFixedPricesByProductCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// PriceListID is the priceListID argument value.
+	PriceListID string
+	// PricesToAdd is the pricesToAdd argument value.
+	PricesToAdd []model.PriceListProductPriceInput
+	// ProductIDsToDelete is the productIDsToDelete argument value.
+	ProductIDsToDelete []string
+} {
+	mock.lockUpdateFixedPricesByProduct.RLock()
+	defer mock.lockUpdateFixedPricesByProduct.RUnlock()
+	return mock.calls.UpdateFixedPricesByProduct
+}
+
+// UpdateQuantityPricing calls UpdateQuantityPricingFunc.
+func (mock *CatalogServiceMock) UpdateQuantityPricing(ctx context.Context, priceListID string, input model.QuantityPricingByVariantUpdateInput) error {
+	if mock.UpdateQuantityPricingFunc == nil {
+		panic("CatalogServiceMock.UpdateQuantityPricingFunc: method is nil but CatalogService.UpdateQuantityPricing was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// PriceListID is the priceListID argument value.
+		PriceListID string
+		// Input is the input argument value.
+		Input model.QuantityPricingByVariantUpdateInput
+	}{
+		Ctx:         ctx,
+		PriceListID: priceListID,
+		Input:       input,
+	}
+	mock.lockUpdateQuantityPricing.Lock()
+	mock.calls.UpdateQuantityPricing = append(mock.calls.UpdateQuantityPricing, callInfo)
+	mock.lockUpdateQuantityPricing.Unlock()
+	return mock.UpdateQuantityPricingFunc(ctx, priceListID, input)
+}
+
+// UpdateQuantityPricingCalls returns the calls made to UpdateQuantityPricing.
+func (mock *CatalogServiceMock) UpdateQuantityPricingCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// PriceListID is the priceListID argument value.
+	PriceListID string
+	// Input is the input argument value.
+	Input model.QuantityPricingByVariantUpdateInput
+} {
+	mock.lockUpdateQuantityPricing.RLock()
+	defer mock.lockUpdateQuantityPricing.RUnlock()
+	return mock.calls.UpdateQuantityPricing
+}
+
+var _ shopify.CheckoutBrandingService = &CheckoutBrandingServiceMock{}
+
+// CheckoutBrandingServiceMock is a mock implementation of shopify.CheckoutBrandingService.
+type CheckoutBrandingServiceMock struct {
+	// ListProfilesFunc mocks the ListProfiles method.
+	ListProfilesFunc func(ctx context.Context, opts shopify.ListOptions) ([]*model.CheckoutProfile, string, error)
+
+	// PublishedProfileFunc mocks the PublishedProfile method.
+	PublishedProfileFunc func(ctx context.Context) (*model.CheckoutProfile, error)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, profileID string) (*model.CheckoutBranding, error)
+
+	// UpsertFunc mocks the Upsert method.
+	UpsertFunc func(ctx context.Context, profileID string, input model.CheckoutBrandingInput) (*model.CheckoutBranding, error)
+
+	// ResetFunc mocks the Reset method.
+	ResetFunc func(ctx context.Context, profileID string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListProfiles holds details about calls to the ListProfiles method.
+		ListProfiles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+		// PublishedProfile holds details about calls to the PublishedProfile method.
+		PublishedProfile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProfileID is the profileID argument value.
+			ProfileID string
+		}
+		// Upsert holds details about calls to the Upsert method.
+		Upsert []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProfileID is the profileID argument value.
+			ProfileID string
+			// Input is the input argument value.
+			Input model.CheckoutBrandingInput
+		}
+		// Reset holds details about calls to the Reset method.
+		Reset []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProfileID is the profileID argument value.
+			ProfileID string
+		}
+	}
+	lockListProfiles     sync.RWMutex
+	lockPublishedProfile sync.RWMutex
+	lockGet              sync.RWMutex
+	lockUpsert           sync.RWMutex
+	lockReset            sync.RWMutex
+}
+
+// ListProfiles calls ListProfilesFunc.
+func (mock *CheckoutBrandingServiceMock) ListProfiles(ctx context.Context, opts shopify.ListOptions) ([]*model.CheckoutProfile, string, error) {
+	if mock.ListProfilesFunc == nil {
+		panic("CheckoutBrandingServiceMock.ListProfilesFunc: method is nil but CheckoutBrandingService.ListProfiles was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockListProfiles.Lock()
+	mock.calls.ListProfiles = append(mock.calls.ListProfiles, callInfo)
+	mock.lockListProfiles.Unlock()
+	return mock.ListProfilesFunc(ctx, opts)
+}
+
+// ListProfilesCalls returns the calls made to ListProfiles.
+func (mock *CheckoutBrandingServiceMock) ListProfilesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockListProfiles.RLock()
+	defer mock.lockListProfiles.RUnlock()
+	return mock.calls.ListProfiles
+}
+
+// PublishedProfile calls PublishedProfileFunc.
+func (mock *CheckoutBrandingServiceMock) PublishedProfile(ctx context.Context) (*model.CheckoutProfile, error) {
+	if mock.PublishedProfileFunc == nil {
+		panic("CheckoutBrandingServiceMock.PublishedProfileFunc: method is nil but CheckoutBrandingService.PublishedProfile was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockPublishedProfile.Lock()
+	mock.calls.PublishedProfile = append(mock.calls.PublishedProfile, callInfo)
+	mock.lockPublishedProfile.Unlock()
+	return mock.PublishedProfileFunc(ctx)
+}
+
+// PublishedProfileCalls returns the calls made to PublishedProfile.
+func (mock *CheckoutBrandingServiceMock) PublishedProfileCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+} {
+	mock.lockPublishedProfile.RLock()
+	defer mock.lockPublishedProfile.RUnlock()
+	return mock.calls.PublishedProfile
+}
+
+// Get calls GetFunc.
+func (mock *CheckoutBrandingServiceMock) Get(ctx context.Context, profileID string) (*model.CheckoutBranding, error) {
+	if mock.GetFunc == nil {
+		panic("CheckoutBrandingServiceMock.GetFunc: method is nil but CheckoutBrandingService.Get was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ProfileID is the profileID argument value.
+		ProfileID string
+	}{
+		Ctx:       ctx,
+		ProfileID: profileID,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, profileID)
+}
+
+// GetCalls returns the calls made to Get.
+func (mock *CheckoutBrandingServiceMock) GetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ProfileID is the profileID argument value.
+	ProfileID string
+} {
+	mock.lockGet.RLock()
+	defer mock.lockGet.RUnlock()
+	return mock.calls.Get
+}
+
+// Upsert calls UpsertFunc.
+func (mock *CheckoutBrandingServiceMock) Upsert(ctx context.Context, profileID string, input model.CheckoutBrandingInput) (*model.CheckoutBranding, error) {
+	if mock.UpsertFunc == nil {
+		panic("CheckoutBrandingServiceMock.UpsertFunc: method is nil but CheckoutBrandingService.Upsert was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ProfileID is the profileID argument value.
+		ProfileID string
+		// Input is the input argument value.
+		Input model.CheckoutBrandingInput
+	}{
+		Ctx:       ctx,
+		ProfileID: profileID,
+		Input:     input,
+	}
+	mock.lockUpsert.Lock()
+	mock.calls.Upsert = append(mock.calls.Upsert, callInfo)
+	mock.lockUpsert.Unlock()
+	return mock.UpsertFunc(ctx, profileID, input)
+}
+
+// UpsertCalls returns the calls made to Upsert.
+func (mock *CheckoutBrandingServiceMock) UpsertCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ProfileID is the profileID argument value.
+	ProfileID string
+	// Input is the input argument value.
+	Input model.CheckoutBrandingInput
+} {
+	mock.lockUpsert.RLock()
+	defer mock.lockUpsert.RUnlock()
+	return mock.calls.Upsert
+}
+
+// Reset calls ResetFunc.
+func (mock *CheckoutBrandingServiceMock) Reset(ctx context.Context, profileID string) error {
+	if mock.ResetFunc == nil {
+		panic("CheckoutBrandingServiceMock.ResetFunc: method is nil but CheckoutBrandingService.Reset was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ProfileID is the profileID argument value.
+		ProfileID string
+	}{
+		Ctx:       ctx,
+		ProfileID: profileID,
+	}
+	mock.lockReset.Lock()
+	mock.calls.Reset = append(mock.calls.Reset, callInfo)
+	mock.lockReset.Unlock()
+	return mock.ResetFunc(ctx, profileID)
+}
+
+// ResetCalls returns the calls made to Reset.
+func (mock *CheckoutBrandingServiceMock) ResetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ProfileID is the profileID argument value.
+	ProfileID string
+} {
+	mock.lockReset.RLock()
+	defer mock.lockReset.RUnlock()
+	return mock.calls.Reset
+}
+
+var _ shopify.CollectionService = &CollectionServiceMock{}
+
+// CollectionServiceMock is a mock implementation of shopify.CollectionService.
+type CollectionServiceMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, opts ...shopify.QueryOption) ([]*model.Collection, error)
+
+	// ListWithFieldsFunc mocks the ListWithFields method.
+	ListWithFieldsFunc func(ctx context.Context, first int, cursor string, query string, fields string) (*model.CollectionConnection, error)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id string) (*model.Collection, error)
+
+	// GetSingleCollectionFunc mocks the GetSingleCollection method.
+	GetSingleCollectionFunc func(ctx context.Context, id string, cursor string) (*model.Collection, error)
+
+	// GetAllProductsFunc mocks the GetAllProducts method.
+	GetAllProductsFunc func(ctx context.Context, id string) ([]*model.Product, error)
+
+	// PreviewRuleSetFunc mocks the PreviewRuleSet method.
+	PreviewRuleSetFunc func(ctx context.Context, ruleSet model.CollectionRuleSetInput) ([]*model.Product, error)
+
+	// CountFunc mocks the Count method.
+	CountFunc func(ctx context.Context, query string) (*model.Count, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, collection model.CollectionInput) (output *model.Collection, err error)
+
+	// CreateBulkFunc mocks the CreateBulk method.
+	CreateBulkFunc func(ctx context.Context, collections []model.CollectionInput) error
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, collection model.CollectionInput) (output *model.Collection, err error)
+
+	// UpdateSortOrderFunc mocks the UpdateSortOrder method.
+	UpdateSortOrderFunc func(ctx context.Context, id string, sortOrder model.CollectionSortOrder) (*model.Collection, error)
+
+	// SetImageFunc mocks the SetImage method.
+	SetImageFunc func(ctx context.Context, id string, image *shopify.UploadInput, altText *string) (*model.Collection, error)
+
+	// RemoveImageFunc mocks the RemoveImage method.
+	RemoveImageFunc func(ctx context.Context, id string) (*model.Collection, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts []shopify.QueryOption
+		}
+		// ListWithFields holds details about calls to the ListWithFields method.
+		ListWithFields []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// First is the first argument value.
+			First int
+			// Cursor is the cursor argument value.
+			Cursor string
+			// Query is the query argument value.
+			Query string
+			// Fields is the fields argument value.
+			Fields string
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// GetSingleCollection holds details about calls to the GetSingleCollection method.
+		GetSingleCollection []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Cursor is the cursor argument value.
+			Cursor string
+		}
+		// GetAllProducts holds details about calls to the GetAllProducts method.
+		GetAllProducts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// PreviewRuleSet holds details about calls to the PreviewRuleSet method.
+		PreviewRuleSet []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// RuleSet is the ruleSet argument value.
+			RuleSet model.CollectionRuleSetInput
+		}
+		// Count holds details about calls to the Count method.
+		Count []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Collection is the collection argument value.
+			Collection model.CollectionInput
+		}
+		// CreateBulk holds details about calls to the CreateBulk method.
+		CreateBulk []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Collections is the collections argument value.
+			Collections []model.CollectionInput
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Collection is the collection argument value.
+			Collection model.CollectionInput
+		}
+		// UpdateSortOrder holds details about calls to the UpdateSortOrder method.
+		UpdateSortOrder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// SortOrder is the sortOrder argument value.
+			SortOrder model.CollectionSortOrder
+		}
+		// SetImage holds details about calls to the SetImage method.
+		SetImage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Image is the image argument value.
+			Image *shopify.UploadInput
+			// AltText is the altText argument value.
+			AltText *string
+		}
+		// RemoveImage holds details about calls to the RemoveImage method.
+		RemoveImage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+	}
+	lockList                sync.RWMutex
+	lockListWithFields      sync.RWMutex
+	lockGet                 sync.RWMutex
+	lockGetSingleCollection sync.RWMutex
+	lockGetAllProducts      sync.RWMutex
+	lockPreviewRuleSet      sync.RWMutex
+	lockCount               sync.RWMutex
+	lockCreate              sync.RWMutex
+	lockCreateBulk          sync.RWMutex
+	lockUpdate              sync.RWMutex
+	lockUpdateSortOrder     sync.RWMutex
+	lockSetImage            sync.RWMutex
+	lockRemoveImage         sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *CollectionServiceMock) List(ctx context.Context, opts ...shopify.QueryOption) ([]*model.Collection, error) {
+	if mock.ListFunc == nil {
+		panic("CollectionServiceMock.ListFunc: method is nil but CollectionService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts []shopify.QueryOption
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, opts...)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *CollectionServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts []shopify.QueryOption
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// ListWithFields calls ListWithFieldsFunc.
+func (mock *CollectionServiceMock) ListWithFields(ctx context.Context, first int, cursor string, query string, fields string) (*model.CollectionConnection, error) {
+	if mock.ListWithFieldsFunc == nil {
+		panic("CollectionServiceMock.ListWithFieldsFunc: method is nil but CollectionService.ListWithFields was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// First is the first argument value.
+		First int
+		// Cursor is the cursor argument value.
+		Cursor string
+		// Query is the query argument value.
+		Query string
+		// Fields is the fields argument value.
+		Fields string
+	}{
+		Ctx:    ctx,
+		First:  first,
+		Cursor: cursor,
+		Query:  query,
+		Fields: fields,
+	}
+	mock.lockListWithFields.Lock()
+	mock.calls.ListWithFields = append(mock.calls.ListWithFields, callInfo)
+	mock.lockListWithFields.Unlock()
+	return mock.ListWithFieldsFunc(ctx, first, cursor, query, fields)
+}
+
+// ListWithFieldsCalls returns the calls made to ListWithFields.
+func (mock *CollectionServiceMock) ListWithFieldsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// First is the first argument value.
+	First int
+	// Cursor is the cursor argument value.
+	Cursor string
+	// Query is the query argument value.
+	Query string
+	// Fields is the fields argument value.
+	Fields string
+} {
+	mock.lockListWithFields.RLock()
+	defer mock.lockListWithFields.RUnlock()
+	return mock.calls.ListWithFields
+}
+
+// Get calls GetFunc.
+func (mock *CollectionServiceMock) Get(ctx context.Context, id string) (*model.Collection, error) {
+	if mock.GetFunc == nil {
+		panic("CollectionServiceMock.GetFunc: method is nil but CollectionService.Get was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls returns the calls made to Get.
+func (mock *CollectionServiceMock) GetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGet.RLock()
+	defer mock.lockGet.RUnlock()
+	return mock.calls.Get
+}
+
+// GetSingleCollection calls GetSingleCollectionFunc.
+func (mock *CollectionServiceMock) GetSingleCollection(ctx context.Context, id string, cursor string) (*model.Collection, error) {
+	if mock.GetSingleCollectionFunc == nil {
+		panic("CollectionServiceMock.GetSingleCollectionFunc: method is nil but CollectionService.GetSingleCollection was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Cursor is the cursor argument value.
+		Cursor string
+	}{
+		Ctx:    ctx,
+		Id:     id,
+		Cursor: cursor,
+	}
+	mock.lockGetSingleCollection.Lock()
+	mock.calls.GetSingleCollection = append(mock.calls.GetSingleCollection, callInfo)
+	mock.lockGetSingleCollection.Unlock()
+	return mock.GetSingleCollectionFunc(ctx, id, cursor)
+}
+
+// GetSingleCollectionCalls returns the calls made to GetSingleCollection.
+func (mock *CollectionServiceMock) GetSingleCollectionCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Cursor is the cursor argument value.
+	Cursor string
+} {
+	mock.lockGetSingleCollection.RLock()
+	defer mock.lockGetSingleCollection.RUnlock()
+	return mock.calls.GetSingleCollection
+}
+
+// GetAllProducts calls GetAllProductsFunc.
+func (mock *CollectionServiceMock) GetAllProducts(ctx context.Context, id string) ([]*model.Product, error) {
+	if mock.GetAllProductsFunc == nil {
+		panic("CollectionServiceMock.GetAllProductsFunc: method is nil but CollectionService.GetAllProducts was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGetAllProducts.Lock()
+	mock.calls.GetAllProducts = append(mock.calls.GetAllProducts, callInfo)
+	mock.lockGetAllProducts.Unlock()
+	return mock.GetAllProductsFunc(ctx, id)
+}
+
+// GetAllProductsCalls returns the calls made to GetAllProducts.
+func (mock *CollectionServiceMock) GetAllProductsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGetAllProducts.RLock()
+	defer mock.lockGetAllProducts.RUnlock()
+	return mock.calls.GetAllProducts
+}
+
+// PreviewRuleSet calls PreviewRuleSetFunc.
+func (mock *CollectionServiceMock) PreviewRuleSet(ctx context.Context, ruleSet model.CollectionRuleSetInput) ([]*model.Product, error) {
+	if mock.PreviewRuleSetFunc == nil {
+		panic("CollectionServiceMock.PreviewRuleSetFunc: method is nil but CollectionService.PreviewRuleSet was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// RuleSet is the ruleSet argument value.
+		RuleSet model.CollectionRuleSetInput
+	}{
+		Ctx:     ctx,
+		RuleSet: ruleSet,
+	}
+	mock.lockPreviewRuleSet.Lock()
+	mock.calls.PreviewRuleSet = append(mock.calls.PreviewRuleSet, callInfo)
+	mock.lockPreviewRuleSet.Unlock()
+	return mock.PreviewRuleSetFunc(ctx, ruleSet)
+}
+
+// PreviewRuleSetCalls returns the calls made to PreviewRuleSet.
+func (mock *CollectionServiceMock) PreviewRuleSetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// RuleSet is the ruleSet argument value.
+	RuleSet model.CollectionRuleSetInput
+} {
+	mock.lockPreviewRuleSet.RLock()
+	defer mock.lockPreviewRuleSet.RUnlock()
+	return mock.calls.PreviewRuleSet
+}
+
+// Count calls CountFunc.
+func (mock *CollectionServiceMock) Count(ctx context.Context, query string) (*model.Count, error) {
+	if mock.CountFunc == nil {
+		panic("CollectionServiceMock.CountFunc: method is nil but CollectionService.Count was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Query is the query argument value.
+		Query string
+	}{
+		Ctx:   ctx,
+		Query: query,
+	}
+	mock.lockCount.Lock()
+	mock.calls.Count = append(mock.calls.Count, callInfo)
+	mock.lockCount.Unlock()
+	return mock.CountFunc(ctx, query)
+}
+
+// CountCalls returns the calls made to Count.
+func (mock *CollectionServiceMock) CountCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Query is the query argument value.
+	Query string
+} {
+	mock.lockCount.RLock()
+	defer mock.lockCount.RUnlock()
+	return mock.calls.Count
+}
+
+// Create calls CreateFunc.
+func (mock *CollectionServiceMock) Create(ctx context.Context, collection model.CollectionInput) (output *model.Collection, err error) {
+	if mock.CreateFunc == nil {
+		panic("CollectionServiceMock.CreateFunc: method is nil but CollectionService.Create was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Collection is the collection argument value.
+		Collection model.CollectionInput
+	}{
+		Ctx:        ctx,
+		Collection: collection,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, collection)
+}
+
+// CreateCalls returns the calls made to Create.
+func (mock *CollectionServiceMock) CreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Collection is the collection argument value.
+	Collection model.CollectionInput
+} {
+	mock.lockCreate.RLock()
+	defer mock.lockCreate.RUnlock()
+	return mock.calls.Create
+}
+
+// CreateBulk calls CreateBulkFunc.
+func (mock *CollectionServiceMock) CreateBulk(ctx context.Context, collections []model.CollectionInput) error {
+	if mock.CreateBulkFunc == nil {
+		panic("CollectionServiceMock.CreateBulkFunc: method is nil but CollectionService.CreateBulk was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Collections is the collections argument value.
+		Collections []model.CollectionInput
+	}{
+		Ctx:         ctx,
+		Collections: collections,
+	}
+	mock.lockCreateBulk.Lock()
+	mock.calls.CreateBulk = append(mock.calls.CreateBulk, callInfo)
+	mock.lockCreateBulk.Unlock()
+	return mock.CreateBulkFunc(ctx, collections)
+}
+
+// CreateBulkCalls returns the calls made to CreateBulk.
+func (mock *CollectionServiceMock) CreateBulkCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Collections is the collections argument value.
+	Collections []model.CollectionInput
+} {
+	mock.lockCreateBulk.RLock()
+	defer mock.lockCreateBulk.RUnlock()
+	return mock.calls.CreateBulk
+}
+
+// Update calls UpdateFunc.
+func (mock *CollectionServiceMock) Update(ctx context.Context, collection model.CollectionInput) (output *model.Collection, err error) {
+	if mock.UpdateFunc == nil {
+		panic("CollectionServiceMock.UpdateFunc: method is nil but CollectionService.Update was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Collection is the collection argument value.
+		Collection model.CollectionInput
+	}{
+		Ctx:        ctx,
+		Collection: collection,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, collection)
+}
+
+// UpdateCalls returns the calls made to Update.
+func (mock *CollectionServiceMock) UpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Collection is the collection argument value.
+	Collection model.CollectionInput
+} {
+	mock.lockUpdate.RLock()
+	defer mock.lockUpdate.RUnlock()
+	return mock.calls.Update
+}
+
+// UpdateSortOrder calls UpdateSortOrderFunc.
+func (mock *CollectionServiceMock) UpdateSortOrder(ctx context.Context, id string, sortOrder model.CollectionSortOrder) (*model.Collection, error) {
+	if mock.UpdateSortOrderFunc == nil {
+		panic("CollectionServiceMock.UpdateSortOrderFunc: method is nil but CollectionService.UpdateSortOrder was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// SortOrder is the sortOrder argument value.
+		SortOrder model.CollectionSortOrder
+	}{
+		Ctx:       ctx,
+		Id:        id,
+		SortOrder: sortOrder,
+	}
+	mock.lockUpdateSortOrder.Lock()
+	mock.calls.UpdateSortOrder = append(mock.calls.UpdateSortOrder, callInfo)
+	mock.lockUpdateSortOrder.Unlock()
+	return mock.UpdateSortOrderFunc(ctx, id, sortOrder)
+}
+
+// UpdateSortOrderCalls returns the calls made to UpdateSortOrder.
+func (mock *CollectionServiceMock) UpdateSortOrderCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// SortOrder is the sortOrder argument value.
+	SortOrder model.CollectionSortOrder
+} {
+	mock.lockUpdateSortOrder.RLock()
+	defer mock.lockUpdateSortOrder.RUnlock()
+	return mock.calls.UpdateSortOrder
+}
+
+// SetImage calls SetImageFunc.
+func (mock *CollectionServiceMock) SetImage(ctx context.Context, id string, image *shopify.UploadInput, altText *string) (*model.Collection, error) {
+	if mock.SetImageFunc == nil {
+		panic("CollectionServiceMock.SetImageFunc: method is nil but CollectionService.SetImage was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Image is the image argument value.
+		Image *shopify.UploadInput
+		// AltText is the altText argument value.
+		AltText *string
+	}{
+		Ctx:     ctx,
+		Id:      id,
+		Image:   image,
+		AltText: altText,
+	}
+	mock.lockSetImage.Lock()
+	mock.calls.SetImage = append(mock.calls.SetImage, callInfo)
+	mock.lockSetImage.Unlock()
+	return mock.SetImageFunc(ctx, id, image, altText)
+}
+
+// SetImageCalls returns the calls made to SetImage.
+func (mock *CollectionServiceMock) SetImageCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Image is the image argument value.
+	Image *shopify.UploadInput
+	// AltText is the altText argument value.
+	AltText *string
+} {
+	mock.lockSetImage.RLock()
+	defer mock.lockSetImage.RUnlock()
+	return mock.calls.SetImage
+}
+
+// RemoveImage calls RemoveImageFunc.
+func (mock *CollectionServiceMock) RemoveImage(ctx context.Context, id string) (*model.Collection, error) {
+	if mock.RemoveImageFunc == nil {
+		panic("CollectionServiceMock.RemoveImageFunc: method is nil but CollectionService.RemoveImage was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockRemoveImage.Lock()
+	mock.calls.RemoveImage = append(mock.calls.RemoveImage, callInfo)
+	mock.lockRemoveImage.Unlock()
+	return mock.RemoveImageFunc(ctx, id)
+}
+
+// RemoveImageCalls returns the calls made to RemoveImage.
+func (mock *CollectionServiceMock) RemoveImageCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockRemoveImage.RLock()
+	defer mock.lockRemoveImage.RUnlock()
+	return mock.calls.RemoveImage
+}
+
+var _ shopify.CustomerAccountService = &CustomerAccountServiceMock{}
+
+// CustomerAccountServiceMock is a mock implementation of shopify.CustomerAccountService.
+type CustomerAccountServiceMock struct {
+	// CreateAccessTokenFunc mocks the CreateAccessToken method.
+	CreateAccessTokenFunc func(ctx context.Context, email string, password string) (*shopify.CustomerAccessToken, error)
+
+	// RenewAccessTokenFunc mocks the RenewAccessToken method.
+	RenewAccessTokenFunc func(ctx context.Context, accessToken string) (*shopify.CustomerAccessToken, error)
+
+	// DeleteAccessTokenFunc mocks the DeleteAccessToken method.
+	DeleteAccessTokenFunc func(ctx context.Context, accessToken string) error
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, input shopify.CustomerAccountCreateInput) (*shopify.CustomerAccount, error)
+
+	// RecoverFunc mocks the Recover method.
+	RecoverFunc func(ctx context.Context, email string) error
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, accessToken string, input shopify.CustomerAccountUpdateInput) (*shopify.CustomerAccount, *shopify.CustomerAccessToken, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// CreateAccessToken holds details about calls to the CreateAccessToken method.
+		CreateAccessToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Email is the email argument value.
+			Email string
+			// Password is the password argument value.
+			Password string
+		}
+		// RenewAccessToken holds details about calls to the RenewAccessToken method.
+		RenewAccessToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccessToken is the accessToken argument value.
+			AccessToken string
+		}
+		// DeleteAccessToken holds details about calls to the DeleteAccessToken method.
+		DeleteAccessToken []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccessToken is the accessToken argument value.
+			AccessToken string
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input shopify.CustomerAccountCreateInput
+		}
+		// Recover holds details about calls to the Recover method.
+		Recover []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Email is the email argument value.
+			Email string
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccessToken is the accessToken argument value.
+			AccessToken string
+			// Input is the input argument value.
+			Input shopify.CustomerAccountUpdateInput
+		}
+	}
+	lockCreateAccessToken sync.RWMutex
+	lockRenewAccessToken  sync.RWMutex
+	lockDeleteAccessToken sync.RWMutex
+	lockCreate            sync.RWMutex
+	lockRecover           sync.RWMutex
+	lockUpdate            sync.RWMutex
+}
+
+// CreateAccessToken calls CreateAccessTokenFunc.
+func (mock *CustomerAccountServiceMock) CreateAccessToken(ctx context.Context, email string, password string) (*shopify.CustomerAccessToken, error) {
+	if mock.CreateAccessTokenFunc == nil {
+		panic("CustomerAccountServiceMock.CreateAccessTokenFunc: method is nil but CustomerAccountService.CreateAccessToken was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Email is the email argument value.
+		Email string
+		// Password is the password argument value.
+		Password string
+	}{
+		Ctx:      ctx,
+		Email:    email,
+		Password: password,
+	}
+	mock.lockCreateAccessToken.Lock()
+	mock.calls.CreateAccessToken = append(mock.calls.CreateAccessToken, callInfo)
+	mock.lockCreateAccessToken.Unlock()
+	return mock.CreateAccessTokenFunc(ctx, email, password)
+}
+
+// CreateAccessTokenCalls returns the calls made to CreateAccessToken.
+func (mock *CustomerAccountServiceMock) CreateAccessTokenCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Email is the email argument value.
+	Email string
+	// Password is the password argument value.
+	Password string
+} {
+	mock.lockCreateAccessToken.RLock()
+	defer mock.lockCreateAccessToken.RUnlock()
+	return mock.calls.CreateAccessToken
+}
+
+// RenewAccessToken calls RenewAccessTokenFunc.
+func (mock *CustomerAccountServiceMock) RenewAccessToken(ctx context.Context, accessToken string) (*shopify.CustomerAccessToken, error) {
+	if mock.RenewAccessTokenFunc == nil {
+		panic("CustomerAccountServiceMock.RenewAccessTokenFunc: method is nil but CustomerAccountService.RenewAccessToken was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// AccessToken is the accessToken argument value.
+		AccessToken string
+	}{
+		Ctx:         ctx,
+		AccessToken: accessToken,
+	}
+	mock.lockRenewAccessToken.Lock()
+	mock.calls.RenewAccessToken = append(mock.calls.RenewAccessToken, callInfo)
+	mock.lockRenewAccessToken.Unlock()
+	return mock.RenewAccessTokenFunc(ctx, accessToken)
+}
+
+// RenewAccessTokenCalls returns the calls made to RenewAccessToken.
+func (mock *CustomerAccountServiceMock) RenewAccessTokenCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// AccessToken is the accessToken argument value.
+	AccessToken string
+} {
+	mock.lockRenewAccessToken.RLock()
+	defer mock.lockRenewAccessToken.RUnlock()
+	return mock.calls.RenewAccessToken
+}
+
+// DeleteAccessToken calls DeleteAccessTokenFunc.
+func (mock *CustomerAccountServiceMock) DeleteAccessToken(ctx context.Context, accessToken string) error {
+	if mock.DeleteAccessTokenFunc == nil {
+		panic("CustomerAccountServiceMock.DeleteAccessTokenFunc: method is nil but CustomerAccountService.DeleteAccessToken was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// AccessToken is the accessToken argument value.
+		AccessToken string
+	}{
+		Ctx:         ctx,
+		AccessToken: accessToken,
+	}
+	mock.lockDeleteAccessToken.Lock()
+	mock.calls.DeleteAccessToken = append(mock.calls.DeleteAccessToken, callInfo)
+	mock.lockDeleteAccessToken.Unlock()
+	return mock.DeleteAccessTokenFunc(ctx, accessToken)
+}
+
+// DeleteAccessTokenCalls returns the calls made to DeleteAccessToken.
+func (mock *CustomerAccountServiceMock) DeleteAccessTokenCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// AccessToken is the accessToken argument value.
+	AccessToken string
+} {
+	mock.lockDeleteAccessToken.RLock()
+	defer mock.lockDeleteAccessToken.RUnlock()
+	return mock.calls.DeleteAccessToken
+}
+
+// Create calls CreateFunc.
+func (mock *CustomerAccountServiceMock) Create(ctx context.Context, input shopify.CustomerAccountCreateInput) (*shopify.CustomerAccount, error) {
+	if mock.CreateFunc == nil {
+		panic("CustomerAccountServiceMock.CreateFunc: method is nil but CustomerAccountService.Create was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input shopify.CustomerAccountCreateInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, input)
+}
+
+// CreateCalls returns the calls made to Create.
+func (mock *CustomerAccountServiceMock) CreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input shopify.CustomerAccountCreateInput
+} {
+	mock.lockCreate.RLock()
+	defer mock.lockCreate.RUnlock()
+	return mock.calls.Create
+}
+
+// Recover calls RecoverFunc.
+func (mock *CustomerAccountServiceMock) Recover(ctx context.Context, email string) error {
+	if mock.RecoverFunc == nil {
+		panic("CustomerAccountServiceMock.RecoverFunc: method is nil but CustomerAccountService.Recover was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Email is the email argument value.
+		Email string
+	}{
+		Ctx:   ctx,
+		Email: email,
+	}
+	mock.lockRecover.Lock()
+	mock.calls.Recover = append(mock.calls.Recover, callInfo)
+	mock.lockRecover.Unlock()
+	return mock.RecoverFunc(ctx, email)
+}
+
+// RecoverCalls returns the calls made to Recover.
+func (mock *CustomerAccountServiceMock) RecoverCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Email is the email argument value.
+	Email string
+} {
+	mock.lockRecover.RLock()
+	defer mock.lockRecover.RUnlock()
+	return mock.calls.Recover
+}
+
+// Update calls UpdateFunc.
+func (mock *CustomerAccountServiceMock) Update(ctx context.Context, accessToken string, input shopify.CustomerAccountUpdateInput) (*shopify.CustomerAccount, *shopify.CustomerAccessToken, error) {
+	if mock.UpdateFunc == nil {
+		panic("CustomerAccountServiceMock.UpdateFunc: method is nil but CustomerAccountService.Update was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// AccessToken is the accessToken argument value.
+		AccessToken string
+		// Input is the input argument value.
+		Input shopify.CustomerAccountUpdateInput
+	}{
+		Ctx:         ctx,
+		AccessToken: accessToken,
+		Input:       input,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, accessToken, input)
+}
+
+// UpdateCalls returns the calls made to Update.
+func (mock *CustomerAccountServiceMock) UpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// AccessToken is the accessToken argument value.
+	AccessToken string
+	// Input is the input argument value.
+	Input shopify.CustomerAccountUpdateInput
+} {
+	mock.lockUpdate.RLock()
+	defer mock.lockUpdate.RUnlock()
+	return mock.calls.Update
+}
+
+var _ shopify.CustomerService = &CustomerServiceMock{}
+
+// CustomerServiceMock is a mock implementation of shopify.CustomerService.
+type CustomerServiceMock struct {
+	// CountFunc mocks the Count method.
+	CountFunc func(ctx context.Context, query string) (*model.Count, error)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id string) (*model.Customer, error)
+
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, opts shopify.ListOptions) ([]*model.Customer, string, error)
+
+	// ListAllFunc mocks the ListAll method.
+	ListAllFunc func(ctx context.Context, query string) ([]*model.Customer, error)
+
+	// FindByEmailFunc mocks the FindByEmail method.
+	FindByEmailFunc func(ctx context.Context, email string) ([]*model.Customer, error)
+
+	// FindByPhoneFunc mocks the FindByPhone method.
+	FindByPhoneFunc func(ctx context.Context, phone string) ([]*model.Customer, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, input model.CustomerInput) (*model.Customer, error)
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, input model.CustomerInput) (*model.Customer, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id string) (string, error)
+
+	// SetAddressesFunc mocks the SetAddresses method.
+	SetAddressesFunc func(ctx context.Context, customerID string, addresses []model.MailingAddressInput) (*model.Customer, error)
+
+	// SetDefaultAddressFunc mocks the SetDefaultAddress method.
+	SetDefaultAddressFunc func(ctx context.Context, customerID string, addressID string) (*model.Customer, error)
+
+	// UpdateTaxExemptionsFunc mocks the UpdateTaxExemptions method.
+	UpdateTaxExemptionsFunc func(ctx context.Context, customerID string, op shopify.TaxExemptionsOperation, exemptions []model.TaxExemption) ([]model.TaxExemption, error)
+
+	// UpdateEmailMarketingConsentFunc mocks the UpdateEmailMarketingConsent method.
+	UpdateEmailMarketingConsentFunc func(ctx context.Context, customerID string, consent model.CustomerEmailMarketingConsentInput) (*model.CustomerEmailMarketingConsentState, error)
+
+	// MergePreviewFunc mocks the MergePreview method.
+	MergePreviewFunc func(ctx context.Context, keepID string, discardID string) (*model.CustomerMergePreview, error)
+
+	// MergeFunc mocks the Merge method.
+	MergeFunc func(ctx context.Context, keepID string, discardID string) (*shopify.CustomerMergeResult, error)
+
+	// MergeStatusFunc mocks the MergeStatus method.
+	MergeStatusFunc func(ctx context.Context, jobID string) (*model.CustomerMergeRequest, error)
+
+	// SendAccountInviteFunc mocks the SendAccountInvite method.
+	SendAccountInviteFunc func(ctx context.Context, id string) error
+
+	// AccountActivationURLFunc mocks the AccountActivationURL method.
+	AccountActivationURLFunc func(ctx context.Context, id string) (string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Count holds details about calls to the Count method.
+		Count []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+		// ListAll holds details about calls to the ListAll method.
+		ListAll []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
+		}
+		// FindByEmail holds details about calls to the FindByEmail method.
+		FindByEmail []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Email is the email argument value.
+			Email string
+		}
+		// FindByPhone holds details about calls to the FindByPhone method.
+		FindByPhone []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Phone is the phone argument value.
+			Phone string
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.CustomerInput
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.CustomerInput
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// SetAddresses holds details about calls to the SetAddresses method.
+		SetAddresses []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CustomerID is the customerID argument value.
+			CustomerID string
+			// Addresses is the addresses argument value.
+			Addresses []model.MailingAddressInput
+		}
+		// SetDefaultAddress holds details about calls to the SetDefaultAddress method.
+		SetDefaultAddress []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CustomerID is the customerID argument value.
+			CustomerID string
+			// AddressID is the addressID argument value.
+			AddressID string
+		}
+		// UpdateTaxExemptions holds details about calls to the UpdateTaxExemptions method.
+		UpdateTaxExemptions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CustomerID is the customerID argument value.
+			CustomerID string
+			// Op is the op argument value.
+			Op shopify.TaxExemptionsOperation
+			// Exemptions is the exemptions argument value.
+			Exemptions []model.TaxExemption
+		}
+		// UpdateEmailMarketingConsent holds details about calls to the UpdateEmailMarketingConsent method.
+		UpdateEmailMarketingConsent []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CustomerID is the customerID argument value.
+			CustomerID string
+			// Consent is the consent argument value.
+			Consent model.CustomerEmailMarketingConsentInput
+		}
+		// MergePreview holds details about calls to the MergePreview method.
+		MergePreview []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// KeepID is the keepID argument value.
+			KeepID string
+			// DiscardID is the discardID argument value.
+			DiscardID string
+		}
+		// Merge holds details about calls to the Merge method.
+		Merge []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// KeepID is the keepID argument value.
+			KeepID string
+			// DiscardID is the discardID argument value.
+			DiscardID string
+		}
+		// MergeStatus holds details about calls to the MergeStatus method.
+		MergeStatus []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// JobID is the jobID argument value.
+			JobID string
+		}
+		// SendAccountInvite holds details about calls to the SendAccountInvite method.
+		SendAccountInvite []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// AccountActivationURL holds details about calls to the AccountActivationURL method.
+		AccountActivationURL []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+	}
+	lockCount                       sync.RWMutex
+	lockGet                         sync.RWMutex
+	lockList                        sync.RWMutex
+	lockListAll                     sync.RWMutex
+	lockFindByEmail                 sync.RWMutex
+	lockFindByPhone                 sync.RWMutex
+	lockCreate                      sync.RWMutex
+	lockUpdate                      sync.RWMutex
+	lockDelete                      sync.RWMutex
+	lockSetAddresses                sync.RWMutex
+	lockSetDefaultAddress           sync.RWMutex
+	lockUpdateTaxExemptions         sync.RWMutex
+	lockUpdateEmailMarketingConsent sync.RWMutex
+	lockMergePreview                sync.RWMutex
+	lockMerge                       sync.RWMutex
+	lockMergeStatus                 sync.RWMutex
+	lockSendAccountInvite           sync.RWMutex
+	lockAccountActivationURL        sync.RWMutex
+}
+
+// Count calls CountFunc.
+func (mock *CustomerServiceMock) Count(ctx context.Context, query string) (*model.Count, error) {
+	if mock.CountFunc == nil {
+		panic("CustomerServiceMock.CountFunc: method is nil but CustomerService.Count was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Query is the query argument value.
+		Query string
+	}{
+		Ctx:   ctx,
+		Query: query,
+	}
+	mock.lockCount.Lock()
+	mock.calls.Count = append(mock.calls.Count, callInfo)
+	mock.lockCount.Unlock()
+	return mock.CountFunc(ctx, query)
+}
+
+// CountCalls returns the calls made to Count.
+func (mock *CustomerServiceMock) CountCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Query is the query argument value.
+	Query string
+} {
+	mock.lockCount.RLock()
+	defer mock.lockCount.RUnlock()
+	return mock.calls.Count
+}
+
+// Get calls GetFunc.
+func (mock *CustomerServiceMock) Get(ctx context.Context, id string) (*model.Customer, error) {
+	if mock.GetFunc == nil {
+		panic("CustomerServiceMock.GetFunc: method is nil but CustomerService.Get was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls returns the calls made to Get.
+func (mock *CustomerServiceMock) GetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGet.RLock()
+	defer mock.lockGet.RUnlock()
+	return mock.calls.Get
+}
+
+// List calls ListFunc.
+func (mock *CustomerServiceMock) List(ctx context.Context, opts shopify.ListOptions) ([]*model.Customer, string, error) {
+	if mock.ListFunc == nil {
+		panic("CustomerServiceMock.ListFunc: method is nil but CustomerService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, opts)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *CustomerServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// ListAll calls ListAllFunc.
+func (mock *CustomerServiceMock) ListAll(ctx context.Context, query string) ([]*model.Customer, error) {
+	if mock.ListAllFunc == nil {
+		panic("CustomerServiceMock.ListAllFunc: method is nil but CustomerService.ListAll was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Query is the query argument value.
+		Query string
+	}{
+		Ctx:   ctx,
+		Query: query,
+	}
+	mock.lockListAll.Lock()
+	mock.calls.ListAll = append(mock.calls.ListAll, callInfo)
+	mock.lockListAll.Unlock()
+	return mock.ListAllFunc(ctx, query)
+}
+
+// ListAllCalls returns the calls made to ListAll.
+func (mock *CustomerServiceMock) ListAllCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Query is the query argument value.
+	Query string
+} {
+	mock.lockListAll.RLock()
+	defer mock.lockListAll.RUnlock()
+	return mock.calls.ListAll
+}
+
+// FindByEmail calls FindByEmailFunc.
+func (mock *CustomerServiceMock) FindByEmail(ctx context.Context, email string) ([]*model.Customer, error) {
+	if mock.FindByEmailFunc == nil {
+		panic("CustomerServiceMock.FindByEmailFunc: method is nil but CustomerService.FindByEmail was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Email is the email argument value.
+		Email string
+	}{
+		Ctx:   ctx,
+		Email: email,
+	}
+	mock.lockFindByEmail.Lock()
+	mock.calls.FindByEmail = append(mock.calls.FindByEmail, callInfo)
+	mock.lockFindByEmail.Unlock()
+	return mock.FindByEmailFunc(ctx, email)
+}
+
+// FindByEmailCalls returns the calls made to FindByEmail.
+func (mock *CustomerServiceMock) FindByEmailCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Email is the email argument value.
+	Email string
+} {
+	mock.lockFindByEmail.RLock()
+	defer mock.lockFindByEmail.RUnlock()
+	return mock.calls.FindByEmail
+}
+
+// FindByPhone calls FindByPhoneFunc.
+func (mock *CustomerServiceMock) FindByPhone(ctx context.Context, phone string) ([]*model.Customer, error) {
+	if mock.FindByPhoneFunc == nil {
+		panic("CustomerServiceMock.FindByPhoneFunc: method is nil but CustomerService.FindByPhone was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Phone is the phone argument value.
+		Phone string
+	}{
+		Ctx:   ctx,
+		Phone: phone,
+	}
+	mock.lockFindByPhone.Lock()
+	mock.calls.FindByPhone = append(mock.calls.FindByPhone, callInfo)
+	mock.lockFindByPhone.Unlock()
+	return mock.FindByPhoneFunc(ctx, phone)
+}
+
+// FindByPhoneCalls returns the calls made to FindByPhone.
+func (mock *CustomerServiceMock) FindByPhoneCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Phone is the phone argument value.
+	Phone string
+} {
+	mock.lockFindByPhone.RLock()
+	defer mock.lockFindByPhone.RUnlock()
+	return mock.calls.FindByPhone
+}
+
+// Create calls CreateFunc.
+func (mock *CustomerServiceMock) Create(ctx context.Context, input model.CustomerInput) (*model.Customer, error) {
+	if mock.CreateFunc == nil {
+		panic("CustomerServiceMock.CreateFunc: method is nil but CustomerService.Create was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.CustomerInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, input)
+}
+
+// CreateCalls returns the calls made to Create.
+func (mock *CustomerServiceMock) CreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.CustomerInput
+} {
+	mock.lockCreate.RLock()
+	defer mock.lockCreate.RUnlock()
+	return mock.calls.Create
+}
+
+// Update calls UpdateFunc.
+func (mock *CustomerServiceMock) Update(ctx context.Context, input model.CustomerInput) (*model.Customer, error) {
+	if mock.UpdateFunc == nil {
+		panic("CustomerServiceMock.UpdateFunc: method is nil but CustomerService.Update was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.CustomerInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, input)
+}
+
+// UpdateCalls returns the calls made to Update.
+func (mock *CustomerServiceMock) UpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.CustomerInput
+} {
+	mock.lockUpdate.RLock()
+	defer mock.lockUpdate.RUnlock()
+	return mock.calls.Update
+}
+
+// Delete calls DeleteFunc.
+func (mock *CustomerServiceMock) Delete(ctx context.Context, id string) (string, error) {
+	if mock.DeleteFunc == nil {
+		panic("CustomerServiceMock.DeleteFunc: method is nil but CustomerService.Delete was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id)
+}
+
+// DeleteCalls returns the calls made to Delete.
+func (mock *CustomerServiceMock) DeleteCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockDelete.RLock()
+	defer mock.lockDelete.RUnlock()
+	return mock.calls.Delete
+}
+
+// SetAddresses calls SetAddressesFunc.
+func (mock *CustomerServiceMock) SetAddresses(ctx context.Context, customerID string, addresses []model.MailingAddressInput) (*model.Customer, error) {
+	if mock.SetAddressesFunc == nil {
+		panic("CustomerServiceMock.SetAddressesFunc: method is nil but CustomerService.SetAddresses was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// CustomerID is the customerID argument value.
+		CustomerID string
+		// Addresses is the addresses argument value.
+		Addresses []model.MailingAddressInput
+	}{
+		Ctx:        ctx,
+		CustomerID: customerID,
+		Addresses:  addresses,
+	}
+	mock.lockSetAddresses.Lock()
+	mock.calls.SetAddresses = append(mock.calls.SetAddresses, callInfo)
+	mock.lockSetAddresses.Unlock()
+	return mock.SetAddressesFunc(ctx, customerID, addresses)
+}
+
+// SetAddressesCalls returns the calls made to SetAddresses.
+func (mock *CustomerServiceMock) SetAddressesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// CustomerID is the customerID argument value.
+	CustomerID string
+	// Addresses is the addresses argument value.
+	Addresses []model.MailingAddressInput
+} {
+	mock.lockSetAddresses.RLock()
+	defer mock.lockSetAddresses.RUnlock()
+	return mock.calls.SetAddresses
+}
+
+// SetDefaultAddress calls SetDefaultAddressFunc.
+func (mock *CustomerServiceMock) SetDefaultAddress(ctx context.Context, customerID string, addressID string) (*model.Customer, error) {
+	if mock.SetDefaultAddressFunc == nil {
+		panic("CustomerServiceMock.SetDefaultAddressFunc: method is nil but CustomerService.SetDefaultAddress was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// CustomerID is the customerID argument value.
+		CustomerID string
+		// AddressID is the addressID argument value.
+		AddressID string
+	}{
+		Ctx:        ctx,
+		CustomerID: customerID,
+		AddressID:  addressID,
+	}
+	mock.lockSetDefaultAddress.Lock()
+	mock.calls.SetDefaultAddress = append(mock.calls.SetDefaultAddress, callInfo)
+	mock.lockSetDefaultAddress.Unlock()
+	return mock.SetDefaultAddressFunc(ctx, customerID, addressID)
+}
+
+// SetDefaultAddressCalls returns the calls made to SetDefaultAddress.
+func (mock *CustomerServiceMock) SetDefaultAddressCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// CustomerID is the customerID argument value.
+	CustomerID string
+	// AddressID is the addressID argument value.
+	AddressID string
+} {
+	mock.lockSetDefaultAddress.RLock()
+	defer mock.lockSetDefaultAddress.RUnlock()
+	return mock.calls.SetDefaultAddress
+}
+
+// UpdateTaxExemptions calls UpdateTaxExemptionsFunc.
+func (mock *CustomerServiceMock) UpdateTaxExemptions(ctx context.Context, customerID string, op shopify.TaxExemptionsOperation, exemptions []model.TaxExemption) ([]model.TaxExemption, error) {
+	if mock.UpdateTaxExemptionsFunc == nil {
+		panic("CustomerServiceMock.UpdateTaxExemptionsFunc: method is nil but CustomerService.UpdateTaxExemptions was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// CustomerID is the customerID argument value.
+		CustomerID string
+		// Op is the op argument value.
+		Op shopify.TaxExemptionsOperation
+		// Exemptions is the exemptions argument value.
+		Exemptions []model.TaxExemption
+	}{
+		Ctx:        ctx,
+		CustomerID: customerID,
+		Op:         op,
+		Exemptions: exemptions,
+	}
+	mock.lockUpdateTaxExemptions.Lock()
+	mock.calls.UpdateTaxExemptions = append(mock.calls.UpdateTaxExemptions, callInfo)
+	mock.lockUpdateTaxExemptions.Unlock()
+	return mock.UpdateTaxExemptionsFunc(ctx, customerID, op, exemptions)
+}
+
+// UpdateTaxExemptionsCalls returns the calls made to UpdateTaxExemptions.
+func (mock *CustomerServiceMock) UpdateTaxExemptionsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// CustomerID is the customerID argument value.
+	CustomerID string
+	// Op is the op argument value.
+	Op shopify.TaxExemptionsOperation
+	// Exemptions is the exemptions argument value.
+	Exemptions []model.TaxExemption
+} {
+	mock.lockUpdateTaxExemptions.RLock()
+	defer mock.lockUpdateTaxExemptions.RUnlock()
+	return mock.calls.UpdateTaxExemptions
+}
+
+// UpdateEmailMarketingConsent calls UpdateEmailMarketingConsentFunc.
+func (mock *CustomerServiceMock) UpdateEmailMarketingConsent(ctx context.Context, customerID string, consent model.CustomerEmailMarketingConsentInput) (*model.CustomerEmailMarketingConsentState, error) {
+	if mock.UpdateEmailMarketingConsentFunc == nil {
+		panic("CustomerServiceMock.UpdateEmailMarketingConsentFunc: method is nil but CustomerService.UpdateEmailMarketingConsent was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// CustomerID is the customerID argument value.
+		CustomerID string
+		// Consent is the consent argument value.
+		Consent model.CustomerEmailMarketingConsentInput
+	}{
+		Ctx:        ctx,
+		CustomerID: customerID,
+		Consent:    consent,
+	}
+	mock.lockUpdateEmailMarketingConsent.Lock()
+	mock.calls.UpdateEmailMarketingConsent = append(mock.calls.UpdateEmailMarketingConsent, callInfo)
+	mock.lockUpdateEmailMarketingConsent.Unlock()
+	return mock.UpdateEmailMarketingConsentFunc(ctx, customerID, consent)
+}
+
+// UpdateEmailMarketingConsentCalls returns the calls made to UpdateEmailMarketingConsent.
+func (mock *CustomerServiceMock) UpdateEmailMarketingConsentCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// CustomerID is the customerID argument value.
+	CustomerID string
+	// Consent is the consent argument value.
+	Consent model.CustomerEmailMarketingConsentInput
+} {
+	mock.lockUpdateEmailMarketingConsent.RLock()
+	defer mock.lockUpdateEmailMarketingConsent.RUnlock()
+	return mock.calls.UpdateEmailMarketingConsent
+}
+
+// MergePreview calls MergePreviewFunc.
+func (mock *CustomerServiceMock) MergePreview(ctx context.Context, keepID string, discardID string) (*model.CustomerMergePreview, error) {
+	if mock.MergePreviewFunc == nil {
+		panic("CustomerServiceMock.MergePreviewFunc: method is nil but CustomerService.MergePreview was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// KeepID is the keepID argument value.
+		KeepID string
+		// DiscardID is the discardID argument value.
+		DiscardID string
+	}{
+		Ctx:       ctx,
+		KeepID:    keepID,
+		DiscardID: discardID,
+	}
+	mock.lockMergePreview.Lock()
+	mock.calls.MergePreview = append(mock.calls.MergePreview, callInfo)
+	mock.lockMergePreview.Unlock()
+	return mock.MergePreviewFunc(ctx, keepID, discardID)
+}
+
+// MergePreviewCalls returns the calls made to MergePreview.
+func (mock *CustomerServiceMock) MergePreviewCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// KeepID is the keepID argument value.
+	KeepID string
+	// DiscardID is the discardID argument value.
+	DiscardID string
+} {
+	mock.lockMergePreview.RLock()
+	defer mock.lockMergePreview.RUnlock()
+	return mock.calls.MergePreview
+}
+
+// Merge calls MergeFunc.
+func (mock *CustomerServiceMock) Merge(ctx context.Context, keepID string, discardID string) (*shopify.CustomerMergeResult, error) {
+	if mock.MergeFunc == nil {
+		panic("CustomerServiceMock.MergeFunc: method is nil but CustomerService.Merge was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// KeepID is the keepID argument value.
+		KeepID string
+		// DiscardID is the discardID argument value.
+		DiscardID string
+	}{
+		Ctx:       ctx,
+		KeepID:    keepID,
+		DiscardID: discardID,
+	}
+	mock.lockMerge.Lock()
+	mock.calls.Merge = append(mock.calls.Merge, callInfo)
+	mock.lockMerge.Unlock()
+	return mock.MergeFunc(ctx, keepID, discardID)
+}
+
+// MergeCalls returns the calls made to Merge.
+func (mock *CustomerServiceMock) MergeCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// KeepID is the keepID argument value.
+	KeepID string
+	// DiscardID is the discardID argument value.
+	DiscardID string
+} {
+	mock.lockMerge.RLock()
+	defer mock.lockMerge.RUnlock()
+	return mock.calls.Merge
+}
+
+// MergeStatus calls MergeStatusFunc.
+func (mock *CustomerServiceMock) MergeStatus(ctx context.Context, jobID string) (*model.CustomerMergeRequest, error) {
+	if mock.MergeStatusFunc == nil {
+		panic("CustomerServiceMock.MergeStatusFunc: method is nil but CustomerService.MergeStatus was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// JobID is the jobID argument value.
+		JobID string
+	}{
+		Ctx:   ctx,
+		JobID: jobID,
+	}
+	mock.lockMergeStatus.Lock()
+	mock.calls.MergeStatus = append(mock.calls.MergeStatus, callInfo)
+	mock.lockMergeStatus.Unlock()
+	return mock.MergeStatusFunc(ctx, jobID)
+}
+
+// MergeStatusCalls returns the calls made to MergeStatus.
+func (mock *CustomerServiceMock) MergeStatusCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// JobID is the jobID argument value.
+	JobID string
+} {
+	mock.lockMergeStatus.RLock()
+	defer mock.lockMergeStatus.RUnlock()
+	return mock.calls.MergeStatus
+}
+
+// SendAccountInvite calls SendAccountInviteFunc.
+func (mock *CustomerServiceMock) SendAccountInvite(ctx context.Context, id string) error {
+	if mock.SendAccountInviteFunc == nil {
+		panic("CustomerServiceMock.SendAccountInviteFunc: method is nil but CustomerService.SendAccountInvite was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockSendAccountInvite.Lock()
+	mock.calls.SendAccountInvite = append(mock.calls.SendAccountInvite, callInfo)
+	mock.lockSendAccountInvite.Unlock()
+	return mock.SendAccountInviteFunc(ctx, id)
+}
+
+// SendAccountInviteCalls returns the calls made to SendAccountInvite.
+func (mock *CustomerServiceMock) SendAccountInviteCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockSendAccountInvite.RLock()
+	defer mock.lockSendAccountInvite.RUnlock()
+	return mock.calls.SendAccountInvite
+}
+
+// AccountActivationURL calls AccountActivationURLFunc.
+func (mock *CustomerServiceMock) AccountActivationURL(ctx context.Context, id string) (string, error) {
+	if mock.AccountActivationURLFunc == nil {
+		panic("CustomerServiceMock.AccountActivationURLFunc: method is nil but CustomerService.AccountActivationURL was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockAccountActivationURL.Lock()
+	mock.calls.AccountActivationURL = append(mock.calls.AccountActivationURL, callInfo)
+	mock.lockAccountActivationURL.Unlock()
+	return mock.AccountActivationURLFunc(ctx, id)
+}
+
+// AccountActivationURLCalls returns the calls made to AccountActivationURL.
+func (mock *CustomerServiceMock) AccountActivationURLCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockAccountActivationURL.RLock()
+	defer mock.lockAccountActivationURL.RUnlock()
+	return mock.calls.AccountActivationURL
+}
+
+var _ shopify.DeliveryCustomizationService = &DeliveryCustomizationServiceMock{}
+
+// DeliveryCustomizationServiceMock is a mock implementation of shopify.DeliveryCustomizationService.
+type DeliveryCustomizationServiceMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, opts shopify.ListOptions) ([]*model.DeliveryCustomization, string, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, input model.DeliveryCustomizationInput) (*model.DeliveryCustomization, error)
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, id string, input model.DeliveryCustomizationInput) (*model.DeliveryCustomization, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id string) (string, error)
+
+	// ActivateFunc mocks the Activate method.
+	ActivateFunc func(ctx context.Context, ids []string, enabled bool) ([]string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.DeliveryCustomizationInput
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Input is the input argument value.
+			Input model.DeliveryCustomizationInput
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// Activate holds details about calls to the Activate method.
+		Activate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// IDs is the ids argument value.
+			IDs []string
+			// Enabled is the enabled argument value.
+			Enabled bool
+		}
+	}
+	lockList     sync.RWMutex
+	lockCreate   sync.RWMutex
+	lockUpdate   sync.RWMutex
+	lockDelete   sync.RWMutex
+	lockActivate sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *DeliveryCustomizationServiceMock) List(ctx context.Context, opts shopify.ListOptions) ([]*model.DeliveryCustomization, string, error) {
+	if mock.ListFunc == nil {
+		panic("DeliveryCustomizationServiceMock.ListFunc: method is nil but DeliveryCustomizationService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, opts)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *DeliveryCustomizationServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// Create calls CreateFunc.
+func (mock *DeliveryCustomizationServiceMock) Create(ctx context.Context, input model.DeliveryCustomizationInput) (*model.DeliveryCustomization, error) {
+	if mock.CreateFunc == nil {
+		panic("DeliveryCustomizationServiceMock.CreateFunc: method is nil but DeliveryCustomizationService.Create was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.DeliveryCustomizationInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, input)
+}
+
+// CreateCalls returns the calls made to Create.
+func (mock *DeliveryCustomizationServiceMock) CreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.DeliveryCustomizationInput
+} {
+	mock.lockCreate.RLock()
+	defer mock.lockCreate.RUnlock()
+	return mock.calls.Create
+}
+
+// Update calls UpdateFunc.
+func (mock *DeliveryCustomizationServiceMock) Update(ctx context.Context, id string, input model.DeliveryCustomizationInput) (*model.DeliveryCustomization, error) {
+	if mock.UpdateFunc == nil {
+		panic("DeliveryCustomizationServiceMock.UpdateFunc: method is nil but DeliveryCustomizationService.Update was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Input is the input argument value.
+		Input model.DeliveryCustomizationInput
+	}{
+		Ctx:   ctx,
+		Id:    id,
+		Input: input,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, id, input)
+}
+
+// UpdateCalls returns the calls made to Update.
+func (mock *DeliveryCustomizationServiceMock) UpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Input is the input argument value.
+	Input model.DeliveryCustomizationInput
+} {
+	mock.lockUpdate.RLock()
+	defer mock.lockUpdate.RUnlock()
+	return mock.calls.Update
+}
+
+// Delete calls DeleteFunc.
+func (mock *DeliveryCustomizationServiceMock) Delete(ctx context.Context, id string) (string, error) {
+	if mock.DeleteFunc == nil {
+		panic("DeliveryCustomizationServiceMock.DeleteFunc: method is nil but DeliveryCustomizationService.Delete was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id)
+}
+
+// DeleteCalls returns the calls made to Delete.
+func (mock *DeliveryCustomizationServiceMock) DeleteCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockDelete.RLock()
+	defer mock.lockDelete.RUnlock()
+	return mock.calls.Delete
+}
+
+// Activate calls ActivateFunc.
+func (mock *DeliveryCustomizationServiceMock) Activate(ctx context.Context, ids []string, enabled bool) ([]string, error) {
+	if mock.ActivateFunc == nil {
+		panic("DeliveryCustomizationServiceMock.ActivateFunc: method is nil but DeliveryCustomizationService.Activate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// IDs is the ids argument value.
+		IDs []string
+		// Enabled is the enabled argument value.
+		Enabled bool
+	}{
+		Ctx:     ctx,
+		IDs:     ids,
+		Enabled: enabled,
+	}
+	mock.lockActivate.Lock()
+	mock.calls.Activate = append(mock.calls.Activate, callInfo)
+	mock.lockActivate.Unlock()
+	return mock.ActivateFunc(ctx, ids, enabled)
+}
+
+// ActivateCalls returns the calls made to Activate.
+func (mock *DeliveryCustomizationServiceMock) ActivateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// IDs is the ids argument value.
+	IDs []string
+	// Enabled is the enabled argument value.
+	Enabled bool
+} {
+	mock.lockActivate.RLock()
+	defer mock.lockActivate.RUnlock()
+	return mock.calls.Activate
+}
+
+var _ shopify.DiscountService = &DiscountServiceMock{}
+
+// DiscountServiceMock is a mock implementation of shopify.DiscountService.
+type DiscountServiceMock struct {
+	// AutomaticAppCreateFunc mocks the AutomaticAppCreate method.
+	AutomaticAppCreateFunc func(ctx context.Context, discount model.DiscountAutomaticAppInput) (*model.DiscountAutomaticApp, error)
+
+	// AutomaticAppUpdateFunc mocks the AutomaticAppUpdate method.
+	AutomaticAppUpdateFunc func(ctx context.Context, discountBaseID string, discount shopify.DiscountAutomaticAppInput) (*model.DiscountAutomaticApp, error)
+
+	// AutomaticDeleteFunc mocks the AutomaticDelete method.
+	AutomaticDeleteFunc func(ctx context.Context, discountBaseID string) error
+
+	// AutomaticActivateFunc mocks the AutomaticActivate method.
+	AutomaticActivateFunc func(ctx context.Context, discountBaseID string) (*model.DiscountAutomaticNode, error)
+
+	// AutomaticDeactivateFunc mocks the AutomaticDeactivate method.
+	AutomaticDeactivateFunc func(ctx context.Context, discountBaseID string) (*model.DiscountAutomaticNode, error)
+
+	// AutomaticNodeFunc mocks the AutomaticNode method.
+	AutomaticNodeFunc func(ctx context.Context, discountBaseID string, metafieldKey string, metafieldNamespace string) (*model.DiscountAutomaticNode, error)
+
+	// AutomaticBasicCreateFunc mocks the AutomaticBasicCreate method.
+	AutomaticBasicCreateFunc func(ctx context.Context, input model.DiscountAutomaticBasicInput) (*model.DiscountAutomaticNode, error)
+
+	// AutomaticBasicUpdateFunc mocks the AutomaticBasicUpdate method.
+	AutomaticBasicUpdateFunc func(ctx context.Context, id string, input model.DiscountAutomaticBasicInput) (*model.DiscountAutomaticNode, error)
+
+	// AutomaticBxgyCreateFunc mocks the AutomaticBxgyCreate method.
+	AutomaticBxgyCreateFunc func(ctx context.Context, input model.DiscountAutomaticBxgyInput) (*model.DiscountAutomaticNode, error)
+
+	// AutomaticBxgyUpdateFunc mocks the AutomaticBxgyUpdate method.
+	AutomaticBxgyUpdateFunc func(ctx context.Context, id string, input model.DiscountAutomaticBxgyInput) (*model.DiscountAutomaticNode, error)
+
+	// AutomaticFreeShippingCreateFunc mocks the AutomaticFreeShippingCreate method.
+	AutomaticFreeShippingCreateFunc func(ctx context.Context, input model.DiscountAutomaticFreeShippingInput) (*model.DiscountAutomaticNode, error)
+
+	// AutomaticFreeShippingUpdateFunc mocks the AutomaticFreeShippingUpdate method.
+	AutomaticFreeShippingUpdateFunc func(ctx context.Context, id string, input model.DiscountAutomaticFreeShippingInput) (*model.DiscountAutomaticNode, error)
+
+	// CodeBasicCreateFunc mocks the CodeBasicCreate method.
+	CodeBasicCreateFunc func(ctx context.Context, input model.DiscountCodeBasicInput) (*shopify.CodeDiscount, error)
+
+	// CodeBasicUpdateFunc mocks the CodeBasicUpdate method.
+	CodeBasicUpdateFunc func(ctx context.Context, id string, input model.DiscountCodeBasicInput) (*shopify.CodeDiscount, error)
+
+	// CodeBxgyCreateFunc mocks the CodeBxgyCreate method.
+	CodeBxgyCreateFunc func(ctx context.Context, input model.DiscountCodeBxgyInput) (*shopify.CodeDiscount, error)
+
+	// CodeBxgyUpdateFunc mocks the CodeBxgyUpdate method.
+	CodeBxgyUpdateFunc func(ctx context.Context, id string, input model.DiscountCodeBxgyInput) (*shopify.CodeDiscount, error)
+
+	// CodeFreeShippingCreateFunc mocks the CodeFreeShippingCreate method.
+	CodeFreeShippingCreateFunc func(ctx context.Context, input model.DiscountCodeFreeShippingInput) (*shopify.CodeDiscount, error)
+
+	// CodeFreeShippingUpdateFunc mocks the CodeFreeShippingUpdate method.
+	CodeFreeShippingUpdateFunc func(ctx context.Context, id string, input model.DiscountCodeFreeShippingInput) (*shopify.CodeDiscount, error)
+
+	// CodeAppCreateFunc mocks the CodeAppCreate method.
+	CodeAppCreateFunc func(ctx context.Context, input model.DiscountCodeAppInput) (*model.DiscountCodeApp, error)
+
+	// CodeDeleteFunc mocks the CodeDelete method.
+	CodeDeleteFunc func(ctx context.Context, id string) error
+
+	// CodeNodeByCodeFunc mocks the CodeNodeByCode method.
+	CodeNodeByCodeFunc func(ctx context.Context, code string) (*shopify.CodeDiscount, error)
+
+	// CodeNodesFunc mocks the CodeNodes method.
+	CodeNodesFunc func(ctx context.Context, opts shopify.ListOptions) ([]*shopify.CodeDiscount, string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// AutomaticAppCreate holds details about calls to the AutomaticAppCreate method.
+		AutomaticAppCreate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Discount is the discount argument value.
+			Discount model.DiscountAutomaticAppInput
+		}
+		// AutomaticAppUpdate holds details about calls to the AutomaticAppUpdate method.
+		AutomaticAppUpdate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DiscountBaseID is the discountBaseID argument value.
+			DiscountBaseID string
+			// Discount is the discount argument value.
+			Discount shopify.DiscountAutomaticAppInput
+		}
+		// AutomaticDelete holds details about calls to the AutomaticDelete method.
+		AutomaticDelete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DiscountBaseID is the discountBaseID argument value.
+			DiscountBaseID string
+		}
+		// AutomaticActivate holds details about calls to the AutomaticActivate method.
+		AutomaticActivate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DiscountBaseID is the discountBaseID argument value.
+			DiscountBaseID string
+		}
+		// AutomaticDeactivate holds details about calls to the AutomaticDeactivate method.
+		AutomaticDeactivate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DiscountBaseID is the discountBaseID argument value.
+			DiscountBaseID string
+		}
+		// AutomaticNode holds details about calls to the AutomaticNode method.
+		AutomaticNode []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DiscountBaseID is the discountBaseID argument value.
+			DiscountBaseID string
+			// MetafieldKey is the metafieldKey argument value.
+			MetafieldKey string
+			// MetafieldNamespace is the metafieldNamespace argument value.
+			MetafieldNamespace string
+		}
+		// AutomaticBasicCreate holds details about calls to the AutomaticBasicCreate method.
+		AutomaticBasicCreate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.DiscountAutomaticBasicInput
+		}
+		// AutomaticBasicUpdate holds details about calls to the AutomaticBasicUpdate method.
+		AutomaticBasicUpdate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Input is the input argument value.
+			Input model.DiscountAutomaticBasicInput
+		}
+		// AutomaticBxgyCreate holds details about calls to the AutomaticBxgyCreate method.
+		AutomaticBxgyCreate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.DiscountAutomaticBxgyInput
+		}
+		// AutomaticBxgyUpdate holds details about calls to the AutomaticBxgyUpdate method.
+		AutomaticBxgyUpdate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Input is the input argument value.
+			Input model.DiscountAutomaticBxgyInput
+		}
+		// AutomaticFreeShippingCreate holds details about calls to the AutomaticFreeShippingCreate method.
+		AutomaticFreeShippingCreate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.DiscountAutomaticFreeShippingInput
+		}
+		// AutomaticFreeShippingUpdate holds details about calls to the AutomaticFreeShippingUpdate method.
+		AutomaticFreeShippingUpdate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Input is the input argument value.
+			Input model.DiscountAutomaticFreeShippingInput
+		}
+		// CodeBasicCreate holds details about calls to the CodeBasicCreate method.
+		CodeBasicCreate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.DiscountCodeBasicInput
+		}
+		// CodeBasicUpdate holds details about calls to the CodeBasicUpdate method.
+		CodeBasicUpdate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Input is the input argument value.
+			Input model.DiscountCodeBasicInput
+		}
+		// CodeBxgyCreate holds details about calls to the CodeBxgyCreate method.
+		CodeBxgyCreate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.DiscountCodeBxgyInput
+		}
+		// CodeBxgyUpdate holds details about calls to the CodeBxgyUpdate method.
+		CodeBxgyUpdate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Input is the input argument value.
+			Input model.DiscountCodeBxgyInput
+		}
+		// CodeFreeShippingCreate holds details about calls to the CodeFreeShippingCreate method.
+		CodeFreeShippingCreate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.DiscountCodeFreeShippingInput
+		}
+		// CodeFreeShippingUpdate holds details about calls to the CodeFreeShippingUpdate method.
+		CodeFreeShippingUpdate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Input is the input argument value.
+			Input model.DiscountCodeFreeShippingInput
+		}
+		// CodeAppCreate holds details about calls to the CodeAppCreate method.
+		CodeAppCreate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.DiscountCodeAppInput
+		}
+		// CodeDelete holds details about calls to the CodeDelete method.
+		CodeDelete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// CodeNodeByCode holds details about calls to the CodeNodeByCode method.
+		CodeNodeByCode []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Code is the code argument value.
+			Code string
+		}
+		// CodeNodes holds details about calls to the CodeNodes method.
+		CodeNodes []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+	}
+	lockAutomaticAppCreate          sync.RWMutex
+	lockAutomaticAppUpdate          sync.RWMutex
+	lockAutomaticDelete             sync.RWMutex
+	lockAutomaticActivate           sync.RWMutex
+	lockAutomaticDeactivate         sync.RWMutex
+	lockAutomaticNode               sync.RWMutex
+	lockAutomaticBasicCreate        sync.RWMutex
+	lockAutomaticBasicUpdate        sync.RWMutex
+	lockAutomaticBxgyCreate         sync.RWMutex
+	lockAutomaticBxgyUpdate         sync.RWMutex
+	lockAutomaticFreeShippingCreate sync.RWMutex
+	lockAutomaticFreeShippingUpdate sync.RWMutex
+	lockCodeBasicCreate             sync.RWMutex
+	lockCodeBasicUpdate             sync.RWMutex
+	lockCodeBxgyCreate              sync.RWMutex
+	lockCodeBxgyUpdate              sync.RWMutex
+	lockCodeFreeShippingCreate      sync.RWMutex
+	lockCodeFreeShippingUpdate      sync.RWMutex
+	lockCodeAppCreate               sync.RWMutex
+	lockCodeDelete                  sync.RWMutex
+	lockCodeNodeByCode              sync.RWMutex
+	lockCodeNodes                   sync.RWMutex
+}
+
+// AutomaticAppCreate calls AutomaticAppCreateFunc.
+func (mock *DiscountServiceMock) AutomaticAppCreate(ctx context.Context, discount model.DiscountAutomaticAppInput) (*model.DiscountAutomaticApp, error) {
+	if mock.AutomaticAppCreateFunc == nil {
+		panic("DiscountServiceMock.AutomaticAppCreateFunc: method is nil but DiscountService.AutomaticAppCreate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Discount is the discount argument value.
+		Discount model.DiscountAutomaticAppInput
+	}{
+		Ctx:      ctx,
+		Discount: discount,
+	}
+	mock.lockAutomaticAppCreate.Lock()
+	mock.calls.AutomaticAppCreate = append(mock.calls.AutomaticAppCreate, callInfo)
+	mock.lockAutomaticAppCreate.Unlock()
+	return mock.AutomaticAppCreateFunc(ctx, discount)
+}
+
+// AutomaticAppCreateCalls returns the calls made to AutomaticAppCreate.
+func (mock *DiscountServiceMock) AutomaticAppCreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Discount is the discount argument value.
+	Discount model.DiscountAutomaticAppInput
+} {
+	mock.lockAutomaticAppCreate.RLock()
+	defer mock.lockAutomaticAppCreate.RUnlock()
+	return mock.calls.AutomaticAppCreate
+}
+
+// AutomaticAppUpdate calls AutomaticAppUpdateFunc.
+func (mock *DiscountServiceMock) AutomaticAppUpdate(ctx context.Context, discountBaseID string, discount shopify.DiscountAutomaticAppInput) (*model.DiscountAutomaticApp, error) {
+	if mock.AutomaticAppUpdateFunc == nil {
+		panic("DiscountServiceMock.AutomaticAppUpdateFunc: method is nil but DiscountService.AutomaticAppUpdate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// DiscountBaseID is the discountBaseID argument value.
+		DiscountBaseID string
+		// Discount is the discount argument value.
+		Discount shopify.DiscountAutomaticAppInput
+	}{
+		Ctx:            ctx,
+		DiscountBaseID: discountBaseID,
+		Discount:       discount,
+	}
+	mock.lockAutomaticAppUpdate.Lock()
+	mock.calls.AutomaticAppUpdate = append(mock.calls.AutomaticAppUpdate, callInfo)
+	mock.lockAutomaticAppUpdate.Unlock()
+	return mock.AutomaticAppUpdateFunc(ctx, discountBaseID, discount)
+}
+
+// AutomaticAppUpdateCalls returns the calls made to AutomaticAppUpdate.
+func (mock *DiscountServiceMock) AutomaticAppUpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// DiscountBaseID is the discountBaseID argument value.
+	DiscountBaseID string
+	// Discount is the discount argument value.
+	Discount shopify.DiscountAutomaticAppInput
+} {
+	mock.lockAutomaticAppUpdate.RLock()
+	defer mock.lockAutomaticAppUpdate.RUnlock()
+	return mock.calls.AutomaticAppUpdate
+}
+
+// AutomaticDelete calls AutomaticDeleteFunc.
+func (mock *DiscountServiceMock) AutomaticDelete(ctx context.Context, discountBaseID string) error {
+	if mock.AutomaticDeleteFunc == nil {
+		panic("DiscountServiceMock.AutomaticDeleteFunc: method is nil but DiscountService.AutomaticDelete was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// DiscountBaseID is the discountBaseID argument value.
+		DiscountBaseID string
+	}{
+		Ctx:            ctx,
+		DiscountBaseID: discountBaseID,
+	}
+	mock.lockAutomaticDelete.Lock()
+	mock.calls.AutomaticDelete = append(mock.calls.AutomaticDelete, callInfo)
+	mock.lockAutomaticDelete.Unlock()
+	return mock.AutomaticDeleteFunc(ctx, discountBaseID)
+}
+
+// AutomaticDeleteCalls returns the calls made to AutomaticDelete.
+func (mock *DiscountServiceMock) AutomaticDeleteCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// DiscountBaseID is the discountBaseID argument value.
+	DiscountBaseID string
+} {
+	mock.lockAutomaticDelete.RLock()
+	defer mock.lockAutomaticDelete.RUnlock()
+	return mock.calls.AutomaticDelete
+}
+
+// AutomaticActivate calls AutomaticActivateFunc.
+func (mock *DiscountServiceMock) AutomaticActivate(ctx context.Context, discountBaseID string) (*model.DiscountAutomaticNode, error) {
+	if mock.AutomaticActivateFunc == nil {
+		panic("DiscountServiceMock.AutomaticActivateFunc: method is nil but DiscountService.AutomaticActivate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// DiscountBaseID is the discountBaseID argument value.
+		DiscountBaseID string
+	}{
+		Ctx:            ctx,
+		DiscountBaseID: discountBaseID,
+	}
+	mock.lockAutomaticActivate.Lock()
+	mock.calls.AutomaticActivate = append(mock.calls.AutomaticActivate, callInfo)
+	mock.lockAutomaticActivate.Unlock()
+	return mock.AutomaticActivateFunc(ctx, discountBaseID)
+}
+
+// AutomaticActivateCalls returns the calls made to AutomaticActivate.
+func (mock *DiscountServiceMock) AutomaticActivateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// DiscountBaseID is the discountBaseID argument value.
+	DiscountBaseID string
+} {
+	mock.lockAutomaticActivate.RLock()
+	defer mock.lockAutomaticActivate.RUnlock()
+	return mock.calls.AutomaticActivate
+}
+
+// AutomaticDeactivate calls AutomaticDeactivateFunc.
+func (mock *DiscountServiceMock) AutomaticDeactivate(ctx context.Context, discountBaseID string) (*model.DiscountAutomaticNode, error) {
+	if mock.AutomaticDeactivateFunc == nil {
+		panic("DiscountServiceMock.AutomaticDeactivateFunc: method is nil but DiscountService.AutomaticDeactivate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// DiscountBaseID is the discountBaseID argument value.
+		DiscountBaseID string
+	}{
+		Ctx:            ctx,
+		DiscountBaseID: discountBaseID,
+	}
+	mock.lockAutomaticDeactivate.Lock()
+	mock.calls.AutomaticDeactivate = append(mock.calls.AutomaticDeactivate, callInfo)
+	mock.lockAutomaticDeactivate.Unlock()
+	return mock.AutomaticDeactivateFunc(ctx, discountBaseID)
+}
+
+// AutomaticDeactivateCalls returns the calls made to AutomaticDeactivate.
+func (mock *DiscountServiceMock) AutomaticDeactivateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// DiscountBaseID is the discountBaseID argument value.
+	DiscountBaseID string
+} {
+	mock.lockAutomaticDeactivate.RLock()
+	defer mock.lockAutomaticDeactivate.RUnlock()
+	return mock.calls.AutomaticDeactivate
+}
+
+// AutomaticNode calls AutomaticNodeFunc.
+func (mock *DiscountServiceMock) AutomaticNode(ctx context.Context, discountBaseID string, metafieldKey string, metafieldNamespace string) (*model.DiscountAutomaticNode, error) {
+	if mock.AutomaticNodeFunc == nil {
+		panic("DiscountServiceMock.AutomaticNodeFunc: method is nil but DiscountService.AutomaticNode was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// DiscountBaseID is the discountBaseID argument value.
+		DiscountBaseID string
+		// MetafieldKey is the metafieldKey argument value.
+		MetafieldKey string
+		// MetafieldNamespace is the metafieldNamespace argument value.
+		MetafieldNamespace string
+	}{
+		Ctx:                ctx,
+		DiscountBaseID:     discountBaseID,
+		MetafieldKey:       metafieldKey,
+		MetafieldNamespace: metafieldNamespace,
+	}
+	mock.lockAutomaticNode.Lock()
+	mock.calls.AutomaticNode = append(mock.calls.AutomaticNode, callInfo)
+	mock.lockAutomaticNode.Unlock()
+	return mock.AutomaticNodeFunc(ctx, discountBaseID, metafieldKey, metafieldNamespace)
+}
+
+// AutomaticNodeCalls returns the calls made to AutomaticNode.
+func (mock *DiscountServiceMock) AutomaticNodeCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// DiscountBaseID is the discountBaseID argument value.
+	DiscountBaseID string
+	// MetafieldKey is the metafieldKey argument value.
+	MetafieldKey string
+	// MetafieldNamespace is the metafieldNamespace argument value.
+	MetafieldNamespace string
+} {
+	mock.lockAutomaticNode.RLock()
+	defer mock.lockAutomaticNode.RUnlock()
+	return mock.calls.AutomaticNode
+}
+
+// AutomaticBasicCreate calls AutomaticBasicCreateFunc.
+func (mock *DiscountServiceMock) AutomaticBasicCreate(ctx context.Context, input model.DiscountAutomaticBasicInput) (*model.DiscountAutomaticNode, error) {
+	if mock.AutomaticBasicCreateFunc == nil {
+		panic("DiscountServiceMock.AutomaticBasicCreateFunc: method is nil but DiscountService.AutomaticBasicCreate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.DiscountAutomaticBasicInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockAutomaticBasicCreate.Lock()
+	mock.calls.AutomaticBasicCreate = append(mock.calls.AutomaticBasicCreate, callInfo)
+	mock.lockAutomaticBasicCreate.Unlock()
+	return mock.AutomaticBasicCreateFunc(ctx, input)
+}
+
+// AutomaticBasicCreateCalls returns the calls made to AutomaticBasicCreate.
+func (mock *DiscountServiceMock) AutomaticBasicCreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.DiscountAutomaticBasicInput
+} {
+	mock.lockAutomaticBasicCreate.RLock()
+	defer mock.lockAutomaticBasicCreate.RUnlock()
+	return mock.calls.AutomaticBasicCreate
+}
+
+// AutomaticBasicUpdate calls AutomaticBasicUpdateFunc.
+func (mock *DiscountServiceMock) AutomaticBasicUpdate(ctx context.Context, id string, input model.DiscountAutomaticBasicInput) (*model.DiscountAutomaticNode, error) {
+	if mock.AutomaticBasicUpdateFunc == nil {
+		panic("DiscountServiceMock.AutomaticBasicUpdateFunc: method is nil but DiscountService.AutomaticBasicUpdate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Input is the input argument value.
+		Input model.DiscountAutomaticBasicInput
+	}{
+		Ctx:   ctx,
+		Id:    id,
+		Input: input,
+	}
+	mock.lockAutomaticBasicUpdate.Lock()
+	mock.calls.AutomaticBasicUpdate = append(mock.calls.AutomaticBasicUpdate, callInfo)
+	mock.lockAutomaticBasicUpdate.Unlock()
+	return mock.AutomaticBasicUpdateFunc(ctx, id, input)
+}
+
+// AutomaticBasicUpdateCalls returns the calls made to AutomaticBasicUpdate.
+func (mock *DiscountServiceMock) AutomaticBasicUpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Input is the input argument value.
+	Input model.DiscountAutomaticBasicInput
+} {
+	mock.lockAutomaticBasicUpdate.RLock()
+	defer mock.lockAutomaticBasicUpdate.RUnlock()
+	return mock.calls.AutomaticBasicUpdate
+}
+
+// AutomaticBxgyCreate calls AutomaticBxgyCreateFunc.
+func (mock *DiscountServiceMock) AutomaticBxgyCreate(ctx context.Context, input model.DiscountAutomaticBxgyInput) (*model.DiscountAutomaticNode, error) {
+	if mock.AutomaticBxgyCreateFunc == nil {
+		panic("DiscountServiceMock.AutomaticBxgyCreateFunc: method is nil but DiscountService.AutomaticBxgyCreate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.DiscountAutomaticBxgyInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockAutomaticBxgyCreate.Lock()
+	mock.calls.AutomaticBxgyCreate = append(mock.calls.AutomaticBxgyCreate, callInfo)
+	mock.lockAutomaticBxgyCreate.Unlock()
+	return mock.AutomaticBxgyCreateFunc(ctx, input)
+}
+
+// AutomaticBxgyCreateCalls returns the calls made to AutomaticBxgyCreate.
+func (mock *DiscountServiceMock) AutomaticBxgyCreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.DiscountAutomaticBxgyInput
+} {
+	mock.lockAutomaticBxgyCreate.RLock()
+	defer mock.lockAutomaticBxgyCreate.RUnlock()
+	return mock.calls.AutomaticBxgyCreate
+}
+
+// AutomaticBxgyUpdate calls AutomaticBxgyUpdateFunc.
+func (mock *DiscountServiceMock) AutomaticBxgyUpdate(ctx context.Context, id string, input model.DiscountAutomaticBxgyInput) (*model.DiscountAutomaticNode, error) {
+	if mock.AutomaticBxgyUpdateFunc == nil {
+		panic("DiscountServiceMock.AutomaticBxgyUpdateFunc: method is nil but DiscountService.AutomaticBxgyUpdate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Input is the input argument value.
+		Input model.DiscountAutomaticBxgyInput
+	}{
+		Ctx:   ctx,
+		Id:    id,
+		Input: input,
+	}
+	mock.lockAutomaticBxgyUpdate.Lock()
+	mock.calls.AutomaticBxgyUpdate = append(mock.calls.AutomaticBxgyUpdate, callInfo)
+	mock.lockAutomaticBxgyUpdate.Unlock()
+	return mock.AutomaticBxgyUpdateFunc(ctx, id, input)
+}
+
+// AutomaticBxgyUpdateCalls returns the calls made to AutomaticBxgyUpdate.
+func (mock *DiscountServiceMock) AutomaticBxgyUpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Input is the input argument value.
+	Input model.DiscountAutomaticBxgyInput
+} {
+	mock.lockAutomaticBxgyUpdate.RLock()
+	defer mock.lockAutomaticBxgyUpdate.RUnlock()
+	return mock.calls.AutomaticBxgyUpdate
+}
+
+// AutomaticFreeShippingCreate calls AutomaticFreeShippingCreateFunc.
+func (mock *DiscountServiceMock) AutomaticFreeShippingCreate(ctx context.Context, input model.DiscountAutomaticFreeShippingInput) (*model.DiscountAutomaticNode, error) {
+	if mock.AutomaticFreeShippingCreateFunc == nil {
+		panic("DiscountServiceMock.AutomaticFreeShippingCreateFunc: method is nil but DiscountService.AutomaticFreeShippingCreate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.DiscountAutomaticFreeShippingInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockAutomaticFreeShippingCreate.Lock()
+	mock.calls.AutomaticFreeShippingCreate = append(mock.calls.AutomaticFreeShippingCreate, callInfo)
+	mock.lockAutomaticFreeShippingCreate.Unlock()
+	return mock.AutomaticFreeShippingCreateFunc(ctx, input)
+}
+
+// AutomaticFreeShippingCreateCalls returns the calls made to AutomaticFreeShippingCreate.
+func (mock *DiscountServiceMock) AutomaticFreeShippingCreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.DiscountAutomaticFreeShippingInput
+} {
+	mock.lockAutomaticFreeShippingCreate.RLock()
+	defer mock.lockAutomaticFreeShippingCreate.RUnlock()
+	return mock.calls.AutomaticFreeShippingCreate
+}
+
+// AutomaticFreeShippingUpdate calls AutomaticFreeShippingUpdateFunc.
+func (mock *DiscountServiceMock) AutomaticFreeShippingUpdate(ctx context.Context, id string, input model.DiscountAutomaticFreeShippingInput) (*model.DiscountAutomaticNode, error) {
+	if mock.AutomaticFreeShippingUpdateFunc == nil {
+		panic("DiscountServiceMock.AutomaticFreeShippingUpdateFunc: method is nil but DiscountService.AutomaticFreeShippingUpdate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Input is the input argument value.
+		Input model.DiscountAutomaticFreeShippingInput
+	}{
+		Ctx:   ctx,
+		Id:    id,
+		Input: input,
+	}
+	mock.lockAutomaticFreeShippingUpdate.Lock()
+	mock.calls.AutomaticFreeShippingUpdate = append(mock.calls.AutomaticFreeShippingUpdate, callInfo)
+	mock.lockAutomaticFreeShippingUpdate.Unlock()
+	return mock.AutomaticFreeShippingUpdateFunc(ctx, id, input)
+}
+
+// AutomaticFreeShippingUpdateCalls returns the calls made to AutomaticFreeShippingUpdate.
+func (mock *DiscountServiceMock) AutomaticFreeShippingUpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Input is the input argument value.
+	Input model.DiscountAutomaticFreeShippingInput
+} {
+	mock.lockAutomaticFreeShippingUpdate.RLock()
+	defer mock.lockAutomaticFreeShippingUpdate.RUnlock()
+	return mock.calls.AutomaticFreeShippingUpdate
+}
+
+// CodeBasicCreate calls CodeBasicCreateFunc.
+func (mock *DiscountServiceMock) CodeBasicCreate(ctx context.Context, input model.DiscountCodeBasicInput) (*shopify.CodeDiscount, error) {
+	if mock.CodeBasicCreateFunc == nil {
+		panic("DiscountServiceMock.CodeBasicCreateFunc: method is nil but DiscountService.CodeBasicCreate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.DiscountCodeBasicInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCodeBasicCreate.Lock()
+	mock.calls.CodeBasicCreate = append(mock.calls.CodeBasicCreate, callInfo)
+	mock.lockCodeBasicCreate.Unlock()
+	return mock.CodeBasicCreateFunc(ctx, input)
+}
+
+// CodeBasicCreateCalls returns the calls made to CodeBasicCreate.
+func (mock *DiscountServiceMock) CodeBasicCreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.DiscountCodeBasicInput
+} {
+	mock.lockCodeBasicCreate.RLock()
+	defer mock.lockCodeBasicCreate.RUnlock()
+	return mock.calls.CodeBasicCreate
+}
+
+// CodeBasicUpdate calls CodeBasicUpdateFunc.
+func (mock *DiscountServiceMock) CodeBasicUpdate(ctx context.Context, id string, input model.DiscountCodeBasicInput) (*shopify.CodeDiscount, error) {
+	if mock.CodeBasicUpdateFunc == nil {
+		panic("DiscountServiceMock.CodeBasicUpdateFunc: method is nil but DiscountService.CodeBasicUpdate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Input is the input argument value.
+		Input model.DiscountCodeBasicInput
+	}{
+		Ctx:   ctx,
+		Id:    id,
+		Input: input,
+	}
+	mock.lockCodeBasicUpdate.Lock()
+	mock.calls.CodeBasicUpdate = append(mock.calls.CodeBasicUpdate, callInfo)
+	mock.lockCodeBasicUpdate.Unlock()
+	return mock.CodeBasicUpdateFunc(ctx, id, input)
+}
+
+// CodeBasicUpdateCalls returns the calls made to CodeBasicUpdate.
+func (mock *DiscountServiceMock) CodeBasicUpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Input is the input argument value.
+	Input model.DiscountCodeBasicInput
+} {
+	mock.lockCodeBasicUpdate.RLock()
+	defer mock.lockCodeBasicUpdate.RUnlock()
+	return mock.calls.CodeBasicUpdate
+}
+
+// CodeBxgyCreate calls CodeBxgyCreateFunc.
+func (mock *DiscountServiceMock) CodeBxgyCreate(ctx context.Context, input model.DiscountCodeBxgyInput) (*shopify.CodeDiscount, error) {
+	if mock.CodeBxgyCreateFunc == nil {
+		panic("DiscountServiceMock.CodeBxgyCreateFunc: method is nil but DiscountService.CodeBxgyCreate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.DiscountCodeBxgyInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCodeBxgyCreate.Lock()
+	mock.calls.CodeBxgyCreate = append(mock.calls.CodeBxgyCreate, callInfo)
+	mock.lockCodeBxgyCreate.Unlock()
+	return mock.CodeBxgyCreateFunc(ctx, input)
+}
+
+// CodeBxgyCreateCalls returns the calls made to CodeBxgyCreate.
+func (mock *DiscountServiceMock) CodeBxgyCreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.DiscountCodeBxgyInput
+} {
+	mock.lockCodeBxgyCreate.RLock()
+	defer mock.lockCodeBxgyCreate.RUnlock()
+	return mock.calls.CodeBxgyCreate
+}
+
+// CodeBxgyUpdate calls CodeBxgyUpdateFunc.
+func (mock *DiscountServiceMock) CodeBxgyUpdate(ctx context.Context, id string, input model.DiscountCodeBxgyInput) (*shopify.CodeDiscount, error) {
+	if mock.CodeBxgyUpdateFunc == nil {
+		panic("DiscountServiceMock.CodeBxgyUpdateFunc: method is nil but DiscountService.CodeBxgyUpdate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Input is the input argument value.
+		Input model.DiscountCodeBxgyInput
+	}{
+		Ctx:   ctx,
+		Id:    id,
+		Input: input,
+	}
+	mock.lockCodeBxgyUpdate.Lock()
+	mock.calls.CodeBxgyUpdate = append(mock.calls.CodeBxgyUpdate, callInfo)
+	mock.lockCodeBxgyUpdate.Unlock()
+	return mock.CodeBxgyUpdateFunc(ctx, id, input)
+}
+
+// CodeBxgyUpdateCalls returns the calls made to CodeBxgyUpdate.
+func (mock *DiscountServiceMock) CodeBxgyUpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Input is the input argument value.
+	Input model.DiscountCodeBxgyInput
+} {
+	mock.lockCodeBxgyUpdate.RLock()
+	defer mock.lockCodeBxgyUpdate.RUnlock()
+	return mock.calls.CodeBxgyUpdate
+}
+
+// CodeFreeShippingCreate calls CodeFreeShippingCreateFunc.
+func (mock *DiscountServiceMock) CodeFreeShippingCreate(ctx context.Context, input model.DiscountCodeFreeShippingInput) (*shopify.CodeDiscount, error) {
+	if mock.CodeFreeShippingCreateFunc == nil {
+		panic("DiscountServiceMock.CodeFreeShippingCreateFunc: method is nil but DiscountService.CodeFreeShippingCreate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.DiscountCodeFreeShippingInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCodeFreeShippingCreate.Lock()
+	mock.calls.CodeFreeShippingCreate = append(mock.calls.CodeFreeShippingCreate, callInfo)
+	mock.lockCodeFreeShippingCreate.Unlock()
+	return mock.CodeFreeShippingCreateFunc(ctx, input)
+}
+
+// CodeFreeShippingCreateCalls returns the calls made to CodeFreeShippingCreate.
+func (mock *DiscountServiceMock) CodeFreeShippingCreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.DiscountCodeFreeShippingInput
+} {
+	mock.lockCodeFreeShippingCreate.RLock()
+	defer mock.lockCodeFreeShippingCreate.RUnlock()
+	return mock.calls.CodeFreeShippingCreate
+}
+
+// CodeFreeShippingUpdate calls CodeFreeShippingUpdateFunc.
+func (mock *DiscountServiceMock) CodeFreeShippingUpdate(ctx context.Context, id string, input model.DiscountCodeFreeShippingInput) (*shopify.CodeDiscount, error) {
+	if mock.CodeFreeShippingUpdateFunc == nil {
+		panic("DiscountServiceMock.CodeFreeShippingUpdateFunc: method is nil but DiscountService.CodeFreeShippingUpdate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Input is the input argument value.
+		Input model.DiscountCodeFreeShippingInput
+	}{
+		Ctx:   ctx,
+		Id:    id,
+		Input: input,
+	}
+	mock.lockCodeFreeShippingUpdate.Lock()
+	mock.calls.CodeFreeShippingUpdate = append(mock.calls.CodeFreeShippingUpdate, callInfo)
+	mock.lockCodeFreeShippingUpdate.Unlock()
+	return mock.CodeFreeShippingUpdateFunc(ctx, id, input)
+}
+
+// CodeFreeShippingUpdateCalls returns the calls made to CodeFreeShippingUpdate.
+func (mock *DiscountServiceMock) CodeFreeShippingUpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Input is the input argument value.
+	Input model.DiscountCodeFreeShippingInput
+} {
+	mock.lockCodeFreeShippingUpdate.RLock()
+	defer mock.lockCodeFreeShippingUpdate.RUnlock()
+	return mock.calls.CodeFreeShippingUpdate
+}
+
+// CodeAppCreate calls CodeAppCreateFunc.
+func (mock *DiscountServiceMock) CodeAppCreate(ctx context.Context, input model.DiscountCodeAppInput) (*model.DiscountCodeApp, error) {
+	if mock.CodeAppCreateFunc == nil {
+		panic("DiscountServiceMock.CodeAppCreateFunc: method is nil but DiscountService.CodeAppCreate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.DiscountCodeAppInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCodeAppCreate.Lock()
+	mock.calls.CodeAppCreate = append(mock.calls.CodeAppCreate, callInfo)
+	mock.lockCodeAppCreate.Unlock()
+	return mock.CodeAppCreateFunc(ctx, input)
+}
+
+// CodeAppCreateCalls returns the calls made to CodeAppCreate.
+func (mock *DiscountServiceMock) CodeAppCreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.DiscountCodeAppInput
+} {
+	mock.lockCodeAppCreate.RLock()
+	defer mock.lockCodeAppCreate.RUnlock()
+	return mock.calls.CodeAppCreate
+}
+
+// CodeDelete calls CodeDeleteFunc.
+func (mock *DiscountServiceMock) CodeDelete(ctx context.Context, id string) error {
+	if mock.CodeDeleteFunc == nil {
+		panic("DiscountServiceMock.CodeDeleteFunc: method is nil but DiscountService.CodeDelete was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockCodeDelete.Lock()
+	mock.calls.CodeDelete = append(mock.calls.CodeDelete, callInfo)
+	mock.lockCodeDelete.Unlock()
+	return mock.CodeDeleteFunc(ctx, id)
+}
+
+// CodeDeleteCalls returns the calls made to CodeDelete.
+func (mock *DiscountServiceMock) CodeDeleteCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockCodeDelete.RLock()
+	defer mock.lockCodeDelete.RUnlock()
+	return mock.calls.CodeDelete
+}
+
+// CodeNodeByCode calls CodeNodeByCodeFunc.
+func (mock *DiscountServiceMock) CodeNodeByCode(ctx context.Context, code string) (*shopify.CodeDiscount, error) {
+	if mock.CodeNodeByCodeFunc == nil {
+		panic("DiscountServiceMock.CodeNodeByCodeFunc: method is nil but DiscountService.CodeNodeByCode was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Code is the code argument value.
+		Code string
+	}{
+		Ctx:  ctx,
+		Code: code,
+	}
+	mock.lockCodeNodeByCode.Lock()
+	mock.calls.CodeNodeByCode = append(mock.calls.CodeNodeByCode, callInfo)
+	mock.lockCodeNodeByCode.Unlock()
+	return mock.CodeNodeByCodeFunc(ctx, code)
+}
+
+// CodeNodeByCodeCalls returns the calls made to CodeNodeByCode.
+func (mock *DiscountServiceMock) CodeNodeByCodeCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Code is the code argument value.
+	Code string
+} {
+	mock.lockCodeNodeByCode.RLock()
+	defer mock.lockCodeNodeByCode.RUnlock()
+	return mock.calls.CodeNodeByCode
+}
+
+// CodeNodes calls CodeNodesFunc.
+func (mock *DiscountServiceMock) CodeNodes(ctx context.Context, opts shopify.ListOptions) ([]*shopify.CodeDiscount, string, error) {
+	if mock.CodeNodesFunc == nil {
+		panic("DiscountServiceMock.CodeNodesFunc: method is nil but DiscountService.CodeNodes was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockCodeNodes.Lock()
+	mock.calls.CodeNodes = append(mock.calls.CodeNodes, callInfo)
+	mock.lockCodeNodes.Unlock()
+	return mock.CodeNodesFunc(ctx, opts)
+}
+
+// CodeNodesCalls returns the calls made to CodeNodes.
+func (mock *DiscountServiceMock) CodeNodesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockCodeNodes.RLock()
+	defer mock.lockCodeNodes.RUnlock()
+	return mock.calls.CodeNodes
+}
+
+var _ shopify.DisputeService = &DisputeServiceMock{}
+
+// DisputeServiceMock is a mock implementation of shopify.DisputeService.
+type DisputeServiceMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, opts shopify.ListOptions) ([]*model.ShopifyPaymentsDispute, string, error)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id string) (*model.ShopifyPaymentsDispute, error)
+
+	// GetEvidenceFunc mocks the GetEvidence method.
+	GetEvidenceFunc func(ctx context.Context, evidenceID string) (*model.ShopifyPaymentsDisputeEvidence, error)
+
+	// UpdateEvidenceFunc mocks the UpdateEvidence method.
+	UpdateEvidenceFunc func(ctx context.Context, evidenceID string, input model.ShopifyPaymentsDisputeEvidenceUpdateInput) (*model.ShopifyPaymentsDisputeEvidence, error)
+
+	// AttachEvidenceFileFunc mocks the AttachEvidenceFile method.
+	AttachEvidenceFileFunc func(ctx context.Context, evidenceID string, fileType model.ShopifyPaymentsDisputeEvidenceFileType, file *shopify.UploadInput) (*model.ShopifyPaymentsDisputeEvidence, error)
+
+	// SubmitEvidenceFunc mocks the SubmitEvidence method.
+	SubmitEvidenceFunc func(ctx context.Context, evidenceID string) (*model.ShopifyPaymentsDisputeEvidence, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// GetEvidence holds details about calls to the GetEvidence method.
+		GetEvidence []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EvidenceID is the evidenceID argument value.
+			EvidenceID string
+		}
+		// UpdateEvidence holds details about calls to the UpdateEvidence method.
+		UpdateEvidence []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EvidenceID is the evidenceID argument value.
+			EvidenceID string
+			// Input is the input argument value.
+			Input model.ShopifyPaymentsDisputeEvidenceUpdateInput
+		}
+		// AttachEvidenceFile holds details about calls to the AttachEvidenceFile method.
+		AttachEvidenceFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EvidenceID is the evidenceID argument value.
+			EvidenceID string
+			// FileType is the fileType argument value.
+			FileType model.ShopifyPaymentsDisputeEvidenceFileType
+			// File is the file argument value.
+			File *shopify.UploadInput
+		}
+		// SubmitEvidence holds details about calls to the SubmitEvidence method.
+		SubmitEvidence []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// EvidenceID is the evidenceID argument value.
+			EvidenceID string
+		}
+	}
+	lockList               sync.RWMutex
+	lockGet                sync.RWMutex
+	lockGetEvidence        sync.RWMutex
+	lockUpdateEvidence     sync.RWMutex
+	lockAttachEvidenceFile sync.RWMutex
+	lockSubmitEvidence     sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *DisputeServiceMock) List(ctx context.Context, opts shopify.ListOptions) ([]*model.ShopifyPaymentsDispute, string, error) {
+	if mock.ListFunc == nil {
+		panic("DisputeServiceMock.ListFunc: method is nil but DisputeService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, opts)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *DisputeServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// Get calls GetFunc.
+func (mock *DisputeServiceMock) Get(ctx context.Context, id string) (*model.ShopifyPaymentsDispute, error) {
+	if mock.GetFunc == nil {
+		panic("DisputeServiceMock.GetFunc: method is nil but DisputeService.Get was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls returns the calls made to Get.
+func (mock *DisputeServiceMock) GetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGet.RLock()
+	defer mock.lockGet.RUnlock()
+	return mock.calls.Get
+}
+
+// GetEvidence calls GetEvidenceFunc.
+func (mock *DisputeServiceMock) GetEvidence(ctx context.Context, evidenceID string) (*model.ShopifyPaymentsDisputeEvidence, error) {
+	if mock.GetEvidenceFunc == nil {
+		panic("DisputeServiceMock.GetEvidenceFunc: method is nil but DisputeService.GetEvidence was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// EvidenceID is the evidenceID argument value.
+		EvidenceID string
+	}{
+		Ctx:        ctx,
+		EvidenceID: evidenceID,
+	}
+	mock.lockGetEvidence.Lock()
+	mock.calls.GetEvidence = append(mock.calls.GetEvidence, callInfo)
+	mock.lockGetEvidence.Unlock()
+	return mock.GetEvidenceFunc(ctx, evidenceID)
+}
+
+// GetEvidenceCalls returns the calls made to GetEvidence.
+func (mock *DisputeServiceMock) GetEvidenceCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// EvidenceID is the evidenceID argument value.
+	EvidenceID string
+} {
+	mock.lockGetEvidence.RLock()
+	defer mock.lockGetEvidence.RUnlock()
+	return mock.calls.GetEvidence
+}
+
+// UpdateEvidence calls UpdateEvidenceFunc.
+func (mock *DisputeServiceMock) UpdateEvidence(ctx context.Context, evidenceID string, input model.ShopifyPaymentsDisputeEvidenceUpdateInput) (*model.ShopifyPaymentsDisputeEvidence, error) {
+	if mock.UpdateEvidenceFunc == nil {
+		panic("DisputeServiceMock.UpdateEvidenceFunc: method is nil but DisputeService.UpdateEvidence was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// EvidenceID is the evidenceID argument value.
+		EvidenceID string
+		// Input is the input argument value.
+		Input model.ShopifyPaymentsDisputeEvidenceUpdateInput
+	}{
+		Ctx:        ctx,
+		EvidenceID: evidenceID,
+		Input:      input,
+	}
+	mock.lockUpdateEvidence.Lock()
+	mock.calls.UpdateEvidence = append(mock.calls.UpdateEvidence, callInfo)
+	mock.lockUpdateEvidence.Unlock()
+	return mock.UpdateEvidenceFunc(ctx, evidenceID, input)
+}
+
+// UpdateEvidenceCalls returns the calls made to UpdateEvidence.
+func (mock *DisputeServiceMock) UpdateEvidenceCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// EvidenceID is the evidenceID argument value.
+	EvidenceID string
+	// Input is the input argument value.
+	Input model.ShopifyPaymentsDisputeEvidenceUpdateInput
+} {
+	mock.lockUpdateEvidence.RLock()
+	defer mock.lockUpdateEvidence.RUnlock()
+	return mock.calls.UpdateEvidence
+}
+
+// AttachEvidenceFile calls AttachEvidenceFileFunc.
+func (mock *DisputeServiceMock) AttachEvidenceFile(ctx context.Context, evidenceID string, fileType model.ShopifyPaymentsDisputeEvidenceFileType, file *shopify.UploadInput) (*model.ShopifyPaymentsDisputeEvidence, error) {
+	if mock.AttachEvidenceFileFunc == nil {
+		panic("DisputeServiceMock.AttachEvidenceFileFunc: method is nil but DisputeService.AttachEvidenceFile was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// EvidenceID is the evidenceID argument value.
+		EvidenceID string
+		// FileType is the fileType argument value.
+		FileType model.ShopifyPaymentsDisputeEvidenceFileType
+		// File is the file argument value.
+		File *shopify.UploadInput
+	}{
+		Ctx:        ctx,
+		EvidenceID: evidenceID,
+		FileType:   fileType,
+		File:       file,
+	}
+	mock.lockAttachEvidenceFile.Lock()
+	mock.calls.AttachEvidenceFile = append(mock.calls.AttachEvidenceFile, callInfo)
+	mock.lockAttachEvidenceFile.Unlock()
+	return mock.AttachEvidenceFileFunc(ctx, evidenceID, fileType, file)
+}
+
+// AttachEvidenceFileCalls returns the calls made to AttachEvidenceFile.
+func (mock *DisputeServiceMock) AttachEvidenceFileCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// EvidenceID is the evidenceID argument value.
+	EvidenceID string
+	// FileType is the fileType argument value.
+	FileType model.ShopifyPaymentsDisputeEvidenceFileType
+	// File is the file argument value.
+	File *shopify.UploadInput
+} {
+	mock.lockAttachEvidenceFile.RLock()
+	defer mock.lockAttachEvidenceFile.RUnlock()
+	return mock.calls.AttachEvidenceFile
+}
+
+// SubmitEvidence calls SubmitEvidenceFunc.
+func (mock *DisputeServiceMock) SubmitEvidence(ctx context.Context, evidenceID string) (*model.ShopifyPaymentsDisputeEvidence, error) {
+	if mock.SubmitEvidenceFunc == nil {
+		panic("DisputeServiceMock.SubmitEvidenceFunc: method is nil but DisputeService.SubmitEvidence was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// EvidenceID is the evidenceID argument value.
+		EvidenceID string
+	}{
+		Ctx:        ctx,
+		EvidenceID: evidenceID,
+	}
+	mock.lockSubmitEvidence.Lock()
+	mock.calls.SubmitEvidence = append(mock.calls.SubmitEvidence, callInfo)
+	mock.lockSubmitEvidence.Unlock()
+	return mock.SubmitEvidenceFunc(ctx, evidenceID)
+}
+
+// SubmitEvidenceCalls returns the calls made to SubmitEvidence.
+func (mock *DisputeServiceMock) SubmitEvidenceCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// EvidenceID is the evidenceID argument value.
+	EvidenceID string
+} {
+	mock.lockSubmitEvidence.RLock()
+	defer mock.lockSubmitEvidence.RUnlock()
+	return mock.calls.SubmitEvidence
+}
+
+var _ shopify.FileService = &FileServiceMock{}
+
+// FileServiceMock is a mock implementation of shopify.FileService.
+type FileServiceMock struct {
+	// UploadFunc mocks the Upload method.
+	UploadFunc func(ctx context.Context, input *shopify.UploadInput) (model.File, error)
+
+	// QueryFileFunc mocks the QueryFile method.
+	QueryFileFunc func(ctx context.Context, fileID string) (model.File, error)
+
+	// QueryGenericFileFunc mocks the QueryGenericFile method.
+	QueryGenericFileFunc func(ctx context.Context, fileID string) (*model.GenericFile, error)
+
+	// QueryMediaImageFunc mocks the QueryMediaImage method.
+	QueryMediaImageFunc func(ctx context.Context, fileID string) (*model.MediaImage, error)
+
+	// QueryVideoFunc mocks the QueryVideo method.
+	QueryVideoFunc func(ctx context.Context, fileID string) (*model.Video, error)
+
+	// QueryModel3dFunc mocks the QueryModel3d method.
+	QueryModel3dFunc func(ctx context.Context, modelID string) (*model.Model3d, error)
+
+	// UploadVideoFunc mocks the UploadVideo method.
+	UploadVideoFunc func(ctx context.Context, input *shopify.UploadInput) (*model.Video, error)
+
+	// UploadModel3dFunc mocks the UploadModel3d method.
+	UploadModel3dFunc func(ctx context.Context, productID string, input *shopify.UploadInput) (*model.Model3d, error)
+
+	// CreateExternalVideoFunc mocks the CreateExternalVideo method.
+	CreateExternalVideoFunc func(ctx context.Context, productID string, originURL string, alt *string) (*model.ExternalVideo, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, fileID []graphql.ID) ([]string, error)
+
+	// WaitUntilReadyFunc mocks the WaitUntilReady method.
+	WaitUntilReadyFunc func(ctx context.Context, fileID string, timeout time.Duration) (model.File, error)
+
+	// DeleteByURLFunc mocks the DeleteByURL method.
+	DeleteByURLFunc func(ctx context.Context, urls []string) ([]string, error)
+
+	// PurgeUnreferencedFunc mocks the PurgeUnreferenced method.
+	PurgeUnreferencedFunc func(ctx context.Context, prefix string, olderThan time.Duration) ([]string, error)
+
+	// StageBulkMutationFileFunc mocks the StageBulkMutationFile method.
+	StageBulkMutationFileFunc func(ctx context.Context, jsonl io.Reader) (string, error)
+
+	// ImportImagesFunc mocks the ImportImages method.
+	ImportImagesFunc func(ctx context.Context, sources []shopify.ImageSource, opts shopify.ImportImagesOptions) *shopify.ImageImportReport
+
+	// UploadLargeFunc mocks the UploadLarge method.
+	UploadLargeFunc func(ctx context.Context, input *shopify.LargeUploadInput) (model.File, error)
+
+	// UploadFilesFunc mocks the UploadFiles method.
+	UploadFilesFunc func(ctx context.Context, files []model.FileCreateInput) ([]model.File, error)
+
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, query shopify.FileQuery, opts shopify.ListOptions) ([]model.File, string, error)
+
+	// ListAllFunc mocks the ListAll method.
+	ListAllFunc func(ctx context.Context, query shopify.FileQuery) ([]model.File, error)
+
+	// UploadMediaImagesFunc mocks the UploadMediaImages method.
+	UploadMediaImagesFunc func(ctx context.Context, images []shopify.UploadMediaImageInput) ([]*model.MediaImage, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Upload holds details about calls to the Upload method.
+		Upload []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input *shopify.UploadInput
+		}
+		// QueryFile holds details about calls to the QueryFile method.
+		QueryFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+		}
+		// QueryGenericFile holds details about calls to the QueryGenericFile method.
+		QueryGenericFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+		}
+		// QueryMediaImage holds details about calls to the QueryMediaImage method.
+		QueryMediaImage []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+		}
+		// QueryVideo holds details about calls to the QueryVideo method.
+		QueryVideo []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+		}
+		// QueryModel3d holds details about calls to the QueryModel3d method.
+		QueryModel3d []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ModelID is the modelID argument value.
+			ModelID string
+		}
+		// UploadVideo holds details about calls to the UploadVideo method.
+		UploadVideo []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input *shopify.UploadInput
+		}
+		// UploadModel3d holds details about calls to the UploadModel3d method.
+		UploadModel3d []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProductID is the productID argument value.
+			ProductID string
+			// Input is the input argument value.
+			Input *shopify.UploadInput
+		}
+		// CreateExternalVideo holds details about calls to the CreateExternalVideo method.
+		CreateExternalVideo []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProductID is the productID argument value.
+			ProductID string
+			// OriginURL is the originURL argument value.
+			OriginURL string
+			// Alt is the alt argument value.
+			Alt *string
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID []graphql.ID
+		}
+		// WaitUntilReady holds details about calls to the WaitUntilReady method.
+		WaitUntilReady []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FileID is the fileID argument value.
+			FileID string
+			// Timeout is the timeout argument value.
+			Timeout time.Duration
+		}
+		// DeleteByURL holds details about calls to the DeleteByURL method.
+		DeleteByURL []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// URLs is the urls argument value.
+			URLs []string
+		}
+		// PurgeUnreferenced holds details about calls to the PurgeUnreferenced method.
+		PurgeUnreferenced []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Prefix is the prefix argument value.
+			Prefix string
+			// OlderThan is the olderThan argument value.
+			OlderThan time.Duration
+		}
+		// StageBulkMutationFile holds details about calls to the StageBulkMutationFile method.
+		StageBulkMutationFile []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Jsonl is the jsonl argument value.
+			Jsonl io.Reader
+		}
+		// ImportImages holds details about calls to the ImportImages method.
+		ImportImages []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Sources is the sources argument value.
+			Sources []shopify.ImageSource
+			// Opts is the opts argument value.
+			Opts shopify.ImportImagesOptions
+		}
+		// UploadLarge holds details about calls to the UploadLarge method.
+		UploadLarge []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input *shopify.LargeUploadInput
+		}
+		// UploadFiles holds details about calls to the UploadFiles method.
+		UploadFiles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Files is the files argument value.
+			Files []model.FileCreateInput
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query shopify.FileQuery
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+		// ListAll holds details about calls to the ListAll method.
+		ListAll []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query shopify.FileQuery
+		}
+		// UploadMediaImages holds details about calls to the UploadMediaImages method.
+		UploadMediaImages []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Images is the images argument value.
+			Images []shopify.UploadMediaImageInput
+		}
+	}
+	lockUpload                sync.RWMutex
+	lockQueryFile             sync.RWMutex
+	lockQueryGenericFile      sync.RWMutex
+	lockQueryMediaImage       sync.RWMutex
+	lockQueryVideo            sync.RWMutex
+	lockQueryModel3d          sync.RWMutex
+	lockUploadVideo           sync.RWMutex
+	lockUploadModel3d         sync.RWMutex
+	lockCreateExternalVideo   sync.RWMutex
+	lockDelete                sync.RWMutex
+	lockWaitUntilReady        sync.RWMutex
+	lockDeleteByURL           sync.RWMutex
+	lockPurgeUnreferenced     sync.RWMutex
+	lockStageBulkMutationFile sync.RWMutex
+	lockImportImages          sync.RWMutex
+	lockUploadLarge           sync.RWMutex
+	lockUploadFiles           sync.RWMutex
+	lockList                  sync.RWMutex
+	lockListAll               sync.RWMutex
+	lockUploadMediaImages     sync.RWMutex
+}
+
+// Upload calls UploadFunc.
+func (mock *FileServiceMock) Upload(ctx context.Context, input *shopify.UploadInput) (model.File, error) {
+	if mock.UploadFunc == nil {
+		panic("FileServiceMock.UploadFunc: method is nil but FileService.Upload was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input *shopify.UploadInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockUpload.Lock()
+	mock.calls.Upload = append(mock.calls.Upload, callInfo)
+	mock.lockUpload.Unlock()
+	return mock.UploadFunc(ctx, input)
+}
+
+// UploadCalls returns the calls made to Upload.
+func (mock *FileServiceMock) UploadCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input *shopify.UploadInput
+} {
+	mock.lockUpload.RLock()
+	defer mock.lockUpload.RUnlock()
+	return mock.calls.Upload
+}
+
+// QueryFile calls QueryFileFunc.
+func (mock *FileServiceMock) QueryFile(ctx context.Context, fileID string) (model.File, error) {
+	if mock.QueryFileFunc == nil {
+		panic("FileServiceMock.QueryFileFunc: method is nil but FileService.QueryFile was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// FileID is the fileID argument value.
+		FileID string
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+	}
+	mock.lockQueryFile.Lock()
+	mock.calls.QueryFile = append(mock.calls.QueryFile, callInfo)
+	mock.lockQueryFile.Unlock()
+	return mock.QueryFileFunc(ctx, fileID)
+}
+
+// QueryFileCalls returns the calls made to QueryFile.
+func (mock *FileServiceMock) QueryFileCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// FileID is the fileID argument value.
+	FileID string
+} {
+	mock.lockQueryFile.RLock()
+	defer mock.lockQueryFile.RUnlock()
+	return mock.calls.QueryFile
+}
+
+// QueryGenericFile calls QueryGenericFileFunc.
+func (mock *FileServiceMock) QueryGenericFile(ctx context.Context, fileID string) (*model.GenericFile, error) {
+	if mock.QueryGenericFileFunc == nil {
+		panic("FileServiceMock.QueryGenericFileFunc: method is nil but FileService.QueryGenericFile was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// FileID is the fileID argument value.
+		FileID string
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+	}
+	mock.lockQueryGenericFile.Lock()
+	mock.calls.QueryGenericFile = append(mock.calls.QueryGenericFile, callInfo)
+	mock.lockQueryGenericFile.Unlock()
+	return mock.QueryGenericFileFunc(ctx, fileID)
+}
+
+// QueryGenericFileCalls returns the calls made to QueryGenericFile.
+func (mock *FileServiceMock) QueryGenericFileCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// FileID is the fileID argument value.
+	FileID string
+} {
+	mock.lockQueryGenericFile.RLock()
+	defer mock.lockQueryGenericFile.RUnlock()
+	return mock.calls.QueryGenericFile
+}
+
+// QueryMediaImage calls QueryMediaImageFunc.
+func (mock *FileServiceMock) QueryMediaImage(ctx context.Context, fileID string) (*model.MediaImage, error) {
+	if mock.QueryMediaImageFunc == nil {
+		panic("FileServiceMock.QueryMediaImageFunc: method is nil but FileService.QueryMediaImage was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// FileID is the fileID argument value.
+		FileID string
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+	}
+	mock.lockQueryMediaImage.Lock()
+	mock.calls.QueryMediaImage = append(mock.calls.QueryMediaImage, callInfo)
+	mock.lockQueryMediaImage.Unlock()
+	return mock.QueryMediaImageFunc(ctx, fileID)
+}
+
+// QueryMediaImageCalls returns the calls made to QueryMediaImage.
+func (mock *FileServiceMock) QueryMediaImageCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// FileID is the fileID argument value.
+	FileID string
+} {
+	mock.lockQueryMediaImage.RLock()
+	defer mock.lockQueryMediaImage.RUnlock()
+	return mock.calls.QueryMediaImage
+}
+
+// QueryVideo calls QueryVideoFunc.
+func (mock *FileServiceMock) QueryVideo(ctx context.Context, fileID string) (*model.Video, error) {
+	if mock.QueryVideoFunc == nil {
+		panic("FileServiceMock.QueryVideoFunc: method is nil but FileService.QueryVideo was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// FileID is the fileID argument value.
+		FileID string
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+	}
+	mock.lockQueryVideo.Lock()
+	mock.calls.QueryVideo = append(mock.calls.QueryVideo, callInfo)
+	mock.lockQueryVideo.Unlock()
+	return mock.QueryVideoFunc(ctx, fileID)
+}
+
+// QueryVideoCalls returns the calls made to QueryVideo.
+func (mock *FileServiceMock) QueryVideoCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// FileID is the fileID argument value.
+	FileID string
+} {
+	mock.lockQueryVideo.RLock()
+	defer mock.lockQueryVideo.RUnlock()
+	return mock.calls.QueryVideo
+}
+
+// QueryModel3d calls QueryModel3dFunc.
+func (mock *FileServiceMock) QueryModel3d(ctx context.Context, modelID string) (*model.Model3d, error) {
+	if mock.QueryModel3dFunc == nil {
+		panic("FileServiceMock.QueryModel3dFunc: method is nil but FileService.QueryModel3d was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ModelID is the modelID argument value.
+		ModelID string
+	}{
+		Ctx:     ctx,
+		ModelID: modelID,
+	}
+	mock.lockQueryModel3d.Lock()
+	mock.calls.QueryModel3d = append(mock.calls.QueryModel3d, callInfo)
+	mock.lockQueryModel3d.Unlock()
+	return mock.QueryModel3dFunc(ctx, modelID)
+}
+
+// QueryModel3dCalls returns the calls made to QueryModel3d.
+func (mock *FileServiceMock) QueryModel3dCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ModelID is the modelID argument value.
+	ModelID string
+} {
+	mock.lockQueryModel3d.RLock()
+	defer mock.lockQueryModel3d.RUnlock()
+	return mock.calls.QueryModel3d
+}
+
+// UploadVideo calls UploadVideoFunc.
+func (mock *FileServiceMock) UploadVideo(ctx context.Context, input *shopify.UploadInput) (*model.Video, error) {
+	if mock.UploadVideoFunc == nil {
+		panic("FileServiceMock.UploadVideoFunc: method is nil but FileService.UploadVideo was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input *shopify.UploadInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockUploadVideo.Lock()
+	mock.calls.UploadVideo = append(mock.calls.UploadVideo, callInfo)
+	mock.lockUploadVideo.Unlock()
+	return mock.UploadVideoFunc(ctx, input)
+}
+
+// UploadVideoCalls returns the calls made to UploadVideo.
+func (mock *FileServiceMock) UploadVideoCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input *shopify.UploadInput
+} {
+	mock.lockUploadVideo.RLock()
+	defer mock.lockUploadVideo.RUnlock()
+	return mock.calls.UploadVideo
+}
+
+// UploadModel3d calls UploadModel3dFunc.
+func (mock *FileServiceMock) UploadModel3d(ctx context.Context, productID string, input *shopify.UploadInput) (*model.Model3d, error) {
+	if mock.UploadModel3dFunc == nil {
+		panic("FileServiceMock.UploadModel3dFunc: method is nil but FileService.UploadModel3d was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ProductID is the productID argument value.
+		ProductID string
+		// Input is the input argument value.
+		Input *shopify.UploadInput
+	}{
+		Ctx:       ctx,
+		ProductID: productID,
+		Input:     input,
+	}
+	mock.lockUploadModel3d.Lock()
+	mock.calls.UploadModel3d = append(mock.calls.UploadModel3d, callInfo)
+	mock.lockUploadModel3d.Unlock()
+	return mock.UploadModel3dFunc(ctx, productID, input)
+}
+
+// UploadModel3dCalls returns the calls made to UploadModel3d.
+func (mock *FileServiceMock) UploadModel3dCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ProductID is the productID argument value.
+	ProductID string
+	// Input is the input argument value.
+	Input *shopify.UploadInput
+} {
+	mock.lockUploadModel3d.RLock()
+	defer mock.lockUploadModel3d.RUnlock()
+	return mock.calls.UploadModel3d
+}
+
+// CreateExternalVideo calls CreateExternalVideoFunc.
+func (mock *FileServiceMock) CreateExternalVideo(ctx context.Context, productID string, originURL string, alt *string) (*model.ExternalVideo, error) {
+	if mock.CreateExternalVideoFunc == nil {
+		panic("FileServiceMock.CreateExternalVideoFunc: method is nil but FileService.CreateExternalVideo was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ProductID is the productID argument value.
+		ProductID string
+		// OriginURL is the originURL argument value.
+		OriginURL string
+		// Alt is the alt argument value.
+		Alt *string
+	}{
+		Ctx:       ctx,
+		ProductID: productID,
+		OriginURL: originURL,
+		Alt:       alt,
+	}
+	mock.lockCreateExternalVideo.Lock()
+	mock.calls.CreateExternalVideo = append(mock.calls.CreateExternalVideo, callInfo)
+	mock.lockCreateExternalVideo.Unlock()
+	return mock.CreateExternalVideoFunc(ctx, productID, originURL, alt)
+}
+
+// CreateExternalVideoCalls returns the calls made to CreateExternalVideo.
+func (mock *FileServiceMock) CreateExternalVideoCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ProductID is the productID argument value.
+	ProductID string
+	// OriginURL is the originURL argument value.
+	OriginURL string
+	// Alt is the alt argument value.
+	Alt *string
+} {
+	mock.lockCreateExternalVideo.RLock()
+	defer mock.lockCreateExternalVideo.RUnlock()
+	return mock.calls.CreateExternalVideo
+}
+
+// Delete calls DeleteFunc.
+func (mock *FileServiceMock) Delete(ctx context.Context, fileID []graphql.ID) ([]string, error) {
+	if mock.DeleteFunc == nil {
+		panic("FileServiceMock.DeleteFunc: method is nil but FileService.Delete was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// FileID is the fileID argument value.
+		FileID []graphql.ID
+	}{
+		Ctx:    ctx,
+		FileID: fileID,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, fileID)
+}
+
+// DeleteCalls returns the calls made to Delete.
+func (mock *FileServiceMock) DeleteCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// FileID is the fileID argument value.
+	FileID []graphql.ID
+} {
+	mock.lockDelete.RLock()
+	defer mock.lockDelete.RUnlock()
+	return mock.calls.Delete
+}
+
+// WaitUntilReady calls WaitUntilReadyFunc.
+func (mock *FileServiceMock) WaitUntilReady(ctx context.Context, fileID string, timeout time.Duration) (model.File, error) {
+	if mock.WaitUntilReadyFunc == nil {
+		panic("FileServiceMock.WaitUntilReadyFunc: method is nil but FileService.WaitUntilReady was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// FileID is the fileID argument value.
+		FileID string
+		// Timeout is the timeout argument value.
+		Timeout time.Duration
+	}{
+		Ctx:     ctx,
+		FileID:  fileID,
+		Timeout: timeout,
+	}
+	mock.lockWaitUntilReady.Lock()
+	mock.calls.WaitUntilReady = append(mock.calls.WaitUntilReady, callInfo)
+	mock.lockWaitUntilReady.Unlock()
+	return mock.WaitUntilReadyFunc(ctx, fileID, timeout)
+}
+
+// WaitUntilReadyCalls returns the calls made to WaitUntilReady.
+func (mock *FileServiceMock) WaitUntilReadyCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// FileID is the fileID argument value.
+	FileID string
+	// Timeout is the timeout argument value.
+	Timeout time.Duration
+} {
+	mock.lockWaitUntilReady.RLock()
+	defer mock.lockWaitUntilReady.RUnlock()
+	return mock.calls.WaitUntilReady
+}
+
+// DeleteByURL calls DeleteByURLFunc.
+func (mock *FileServiceMock) DeleteByURL(ctx context.Context, urls []string) ([]string, error) {
+	if mock.DeleteByURLFunc == nil {
+		panic("FileServiceMock.DeleteByURLFunc: method is nil but FileService.DeleteByURL was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// URLs is the urls argument value.
+		URLs []string
+	}{
+		Ctx:  ctx,
+		URLs: urls,
+	}
+	mock.lockDeleteByURL.Lock()
+	mock.calls.DeleteByURL = append(mock.calls.DeleteByURL, callInfo)
+	mock.lockDeleteByURL.Unlock()
+	return mock.DeleteByURLFunc(ctx, urls)
+}
+
+// DeleteByURLCalls returns the calls made to DeleteByURL.
+func (mock *FileServiceMock) DeleteByURLCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// URLs is the urls argument value.
+	URLs []string
+} {
+	mock.lockDeleteByURL.RLock()
+	defer mock.lockDeleteByURL.RUnlock()
+	return mock.calls.DeleteByURL
+}
+
+// PurgeUnreferenced calls PurgeUnreferencedFunc.
+func (mock *FileServiceMock) PurgeUnreferenced(ctx context.Context, prefix string, olderThan time.Duration) ([]string, error) {
+	if mock.PurgeUnreferencedFunc == nil {
+		panic("FileServiceMock.PurgeUnreferencedFunc: method is nil but FileService.PurgeUnreferenced was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Prefix is the prefix argument value.
+		Prefix string
+		// OlderThan is the olderThan argument value.
+		OlderThan time.Duration
+	}{
+		Ctx:       ctx,
+		Prefix:    prefix,
+		OlderThan: olderThan,
+	}
+	mock.lockPurgeUnreferenced.Lock()
+	mock.calls.PurgeUnreferenced = append(mock.calls.PurgeUnreferenced, callInfo)
+	mock.lockPurgeUnreferenced.Unlock()
+	return mock.PurgeUnreferencedFunc(ctx, prefix, olderThan)
+}
+
+// PurgeUnreferencedCalls returns the calls made to PurgeUnreferenced.
+func (mock *FileServiceMock) PurgeUnreferencedCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Prefix is the prefix argument value.
+	Prefix string
+	// OlderThan is the olderThan argument value.
+	OlderThan time.Duration
+} {
+	mock.lockPurgeUnreferenced.RLock()
+	defer mock.lockPurgeUnreferenced.RUnlock()
+	return mock.calls.PurgeUnreferenced
+}
+
+// StageBulkMutationFile calls StageBulkMutationFileFunc.
+func (mock *FileServiceMock) StageBulkMutationFile(ctx context.Context, jsonl io.Reader) (string, error) {
+	if mock.StageBulkMutationFileFunc == nil {
+		panic("FileServiceMock.StageBulkMutationFileFunc: method is nil but FileService.StageBulkMutationFile was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Jsonl is the jsonl argument value.
+		Jsonl io.Reader
+	}{
+		Ctx:   ctx,
+		Jsonl: jsonl,
+	}
+	mock.lockStageBulkMutationFile.Lock()
+	mock.calls.StageBulkMutationFile = append(mock.calls.StageBulkMutationFile, callInfo)
+	mock.lockStageBulkMutationFile.Unlock()
+	return mock.StageBulkMutationFileFunc(ctx, jsonl)
+}
+
+// StageBulkMutationFileCalls returns the calls made to StageBulkMutationFile.
+func (mock *FileServiceMock) StageBulkMutationFileCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Jsonl is the jsonl argument value.
+	Jsonl io.Reader
+} {
+	mock.lockStageBulkMutationFile.RLock()
+	defer mock.lockStageBulkMutationFile.RUnlock()
+	return mock.calls.StageBulkMutationFile
+}
+
+// ImportImages calls ImportImagesFunc.
+func (mock *FileServiceMock) ImportImages(ctx context.Context, sources []shopify.ImageSource, opts shopify.ImportImagesOptions) *shopify.ImageImportReport {
+	if mock.ImportImagesFunc == nil {
+		panic("FileServiceMock.ImportImagesFunc: method is nil but FileService.ImportImages was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Sources is the sources argument value.
+		Sources []shopify.ImageSource
+		// Opts is the opts argument value.
+		Opts shopify.ImportImagesOptions
+	}{
+		Ctx:     ctx,
+		Sources: sources,
+		Opts:    opts,
+	}
+	mock.lockImportImages.Lock()
+	mock.calls.ImportImages = append(mock.calls.ImportImages, callInfo)
+	mock.lockImportImages.Unlock()
+	return mock.ImportImagesFunc(ctx, sources, opts)
+}
+
+// ImportImagesCalls returns the calls made to ImportImages.
+func (mock *FileServiceMock) ImportImagesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Sources is the sources argument value.
+	Sources []shopify.ImageSource
+	// Opts is the opts argument value.
+	Opts shopify.ImportImagesOptions
+} {
+	mock.lockImportImages.RLock()
+	defer mock.lockImportImages.RUnlock()
+	return mock.calls.ImportImages
+}
+
+// UploadLarge calls UploadLargeFunc.
+func (mock *FileServiceMock) UploadLarge(ctx context.Context, input *shopify.LargeUploadInput) (model.File, error) {
+	if mock.UploadLargeFunc == nil {
+		panic("FileServiceMock.UploadLargeFunc: method is nil but FileService.UploadLarge was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input *shopify.LargeUploadInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockUploadLarge.Lock()
+	mock.calls.UploadLarge = append(mock.calls.UploadLarge, callInfo)
+	mock.lockUploadLarge.Unlock()
+	return mock.UploadLargeFunc(ctx, input)
+}
+
+// UploadLargeCalls returns the calls made to UploadLarge.
+func (mock *FileServiceMock) UploadLargeCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input *shopify.LargeUploadInput
+} {
+	mock.lockUploadLarge.RLock()
+	defer mock.lockUploadLarge.RUnlock()
+	return mock.calls.UploadLarge
+}
+
+// UploadFiles calls UploadFilesFunc.
+func (mock *FileServiceMock) UploadFiles(ctx context.Context, files []model.FileCreateInput) ([]model.File, error) {
+	if mock.UploadFilesFunc == nil {
+		panic("FileServiceMock.UploadFilesFunc: method is nil but FileService.UploadFiles was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Files is the files argument value.
+		Files []model.FileCreateInput
+	}{
+		Ctx:   ctx,
+		Files: files,
+	}
+	mock.lockUploadFiles.Lock()
+	mock.calls.UploadFiles = append(mock.calls.UploadFiles, callInfo)
+	mock.lockUploadFiles.Unlock()
+	return mock.UploadFilesFunc(ctx, files)
+}
+
+// UploadFilesCalls returns the calls made to UploadFiles.
+func (mock *FileServiceMock) UploadFilesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Files is the files argument value.
+	Files []model.FileCreateInput
+} {
+	mock.lockUploadFiles.RLock()
+	defer mock.lockUploadFiles.RUnlock()
+	return mock.calls.UploadFiles
+}
+
+// List calls ListFunc.
+func (mock *FileServiceMock) List(ctx context.Context, query shopify.FileQuery, opts shopify.ListOptions) ([]model.File, string, error) {
+	if mock.ListFunc == nil {
+		panic("FileServiceMock.ListFunc: method is nil but FileService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Query is the query argument value.
+		Query shopify.FileQuery
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:   ctx,
+		Query: query,
+		Opts:  opts,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, query, opts)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *FileServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Query is the query argument value.
+	Query shopify.FileQuery
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// ListAll calls ListAllFunc.
+func (mock *FileServiceMock) ListAll(ctx context.Context, query shopify.FileQuery) ([]model.File, error) {
+	if mock.ListAllFunc == nil {
+		panic("FileServiceMock.ListAllFunc: method is nil but FileService.ListAll was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Query is the query argument value.
+		Query shopify.FileQuery
+	}{
+		Ctx:   ctx,
+		Query: query,
+	}
+	mock.lockListAll.Lock()
+	mock.calls.ListAll = append(mock.calls.ListAll, callInfo)
+	mock.lockListAll.Unlock()
+	return mock.ListAllFunc(ctx, query)
+}
+
+// ListAllCalls returns the calls made to ListAll.
+func (mock *FileServiceMock) ListAllCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Query is the query argument value.
+	Query shopify.FileQuery
+} {
+	mock.lockListAll.RLock()
+	defer mock.lockListAll.RUnlock()
+	return mock.calls.ListAll
+}
+
+// UploadMediaImages calls UploadMediaImagesFunc.
+func (mock *FileServiceMock) UploadMediaImages(ctx context.Context, images []shopify.UploadMediaImageInput) ([]*model.MediaImage, error) {
+	if mock.UploadMediaImagesFunc == nil {
+		panic("FileServiceMock.UploadMediaImagesFunc: method is nil but FileService.UploadMediaImages was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Images is the images argument value.
+		Images []shopify.UploadMediaImageInput
+	}{
+		Ctx:    ctx,
+		Images: images,
+	}
+	mock.lockUploadMediaImages.Lock()
+	mock.calls.UploadMediaImages = append(mock.calls.UploadMediaImages, callInfo)
+	mock.lockUploadMediaImages.Unlock()
+	return mock.UploadMediaImagesFunc(ctx, images)
+}
+
+// UploadMediaImagesCalls returns the calls made to UploadMediaImages.
+func (mock *FileServiceMock) UploadMediaImagesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Images is the images argument value.
+	Images []shopify.UploadMediaImageInput
+} {
+	mock.lockUploadMediaImages.RLock()
+	defer mock.lockUploadMediaImages.RUnlock()
+	return mock.calls.UploadMediaImages
+}
+
+var _ shopify.FulfillmentService = &FulfillmentServiceMock{}
+
+// FulfillmentServiceMock is a mock implementation of shopify.FulfillmentService.
+type FulfillmentServiceMock struct {
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, input model.FulfillmentV2Input) (*model.Fulfillment, error)
+
+	// UpdateTrackingFunc mocks the UpdateTracking method.
+	UpdateTrackingFunc func(ctx context.Context, fulfillmentID string, trackingInfo model.FulfillmentTrackingInput, notifyCustomer bool) (*model.Fulfillment, error)
+
+	// CancelFunc mocks the Cancel method.
+	CancelFunc func(ctx context.Context, fulfillmentID string) (*model.Fulfillment, error)
+
+	// GetFulfillmentOrderFunc mocks the GetFulfillmentOrder method.
+	GetFulfillmentOrderFunc func(ctx context.Context, id string) (*model.FulfillmentOrder, error)
+
+	// MoveFulfillmentOrderFunc mocks the MoveFulfillmentOrder method.
+	MoveFulfillmentOrderFunc func(ctx context.Context, id string, newLocationID string, lineItems []model.FulfillmentOrderLineItemInput) (*shopify.FulfillmentOrderMoveResult, error)
+
+	// HoldFulfillmentOrderFunc mocks the HoldFulfillmentOrder method.
+	HoldFulfillmentOrderFunc func(ctx context.Context, id string, hold model.FulfillmentOrderHoldInput) (*model.FulfillmentOrder, error)
+
+	// ReleaseFulfillmentOrderHoldFunc mocks the ReleaseFulfillmentOrderHold method.
+	ReleaseFulfillmentOrderHoldFunc func(ctx context.Context, id string) (*model.FulfillmentOrder, error)
+
+	// SplitFulfillmentOrdersFunc mocks the SplitFulfillmentOrders method.
+	SplitFulfillmentOrdersFunc func(ctx context.Context, splits []model.FulfillmentOrderSplitInput) ([]shopify.FulfillmentOrderSplitResult, error)
+
+	// MergeFulfillmentOrdersFunc mocks the MergeFulfillmentOrders method.
+	MergeFulfillmentOrdersFunc func(ctx context.Context, merges []model.FulfillmentOrderMergeInput) ([]*model.FulfillmentOrder, error)
+
+	// CloseFulfillmentOrderFunc mocks the CloseFulfillmentOrder method.
+	CloseFulfillmentOrderFunc func(ctx context.Context, id string, message *string) (*model.FulfillmentOrder, error)
+
+	// RescheduleFulfillmentOrderFunc mocks the RescheduleFulfillmentOrder method.
+	RescheduleFulfillmentOrderFunc func(ctx context.Context, id string, fulfillAt time.Time) (*model.FulfillmentOrder, error)
+
+	// CreateFulfillmentServiceFunc mocks the CreateFulfillmentService method.
+	CreateFulfillmentServiceFunc func(ctx context.Context, input shopify.FulfillmentServiceInput) (*model.FulfillmentService, error)
+
+	// UpdateFulfillmentServiceFunc mocks the UpdateFulfillmentService method.
+	UpdateFulfillmentServiceFunc func(ctx context.Context, id string, input shopify.FulfillmentServiceInput) (*model.FulfillmentService, error)
+
+	// DeleteFulfillmentServiceFunc mocks the DeleteFulfillmentService method.
+	DeleteFulfillmentServiceFunc func(ctx context.Context, id string, destinationLocationID *string) error
+
+	// ListAssignedFulfillmentOrdersFunc mocks the ListAssignedFulfillmentOrders method.
+	ListAssignedFulfillmentOrdersFunc func(ctx context.Context, assignmentStatus model.FulfillmentOrderAssignmentStatus, locationIDs []string) ([]*model.FulfillmentOrder, error)
+
+	// AcceptFulfillmentRequestFunc mocks the AcceptFulfillmentRequest method.
+	AcceptFulfillmentRequestFunc func(ctx context.Context, id string, message *string) (*model.FulfillmentOrder, error)
+
+	// RejectFulfillmentRequestFunc mocks the RejectFulfillmentRequest method.
+	RejectFulfillmentRequestFunc func(ctx context.Context, id string, reason *model.FulfillmentOrderRejectionReason, message *string) (*model.FulfillmentOrder, error)
+
+	// AcceptCancellationRequestFunc mocks the AcceptCancellationRequest method.
+	AcceptCancellationRequestFunc func(ctx context.Context, id string, message *string) (*model.FulfillmentOrder, error)
+
+	// RejectCancellationRequestFunc mocks the RejectCancellationRequest method.
+	RejectCancellationRequestFunc func(ctx context.Context, id string, message *string) (*model.FulfillmentOrder, error)
+
+	// ListFulfillmentOrdersByDeliveryMethodFunc mocks the ListFulfillmentOrdersByDeliveryMethod method.
+	ListFulfillmentOrdersByDeliveryMethodFunc func(ctx context.Context, locationID string, methodType model.DeliveryMethodType) ([]*model.FulfillmentOrder, error)
+
+	// MarkPreparedForPickupFunc mocks the MarkPreparedForPickup method.
+	MarkPreparedForPickupFunc func(ctx context.Context, fulfillmentOrderIDs []string) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.FulfillmentV2Input
+		}
+		// UpdateTracking holds details about calls to the UpdateTracking method.
+		UpdateTracking []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FulfillmentID is the fulfillmentID argument value.
+			FulfillmentID string
+			// TrackingInfo is the trackingInfo argument value.
+			TrackingInfo model.FulfillmentTrackingInput
+			// NotifyCustomer is the notifyCustomer argument value.
+			NotifyCustomer bool
+		}
+		// Cancel holds details about calls to the Cancel method.
+		Cancel []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FulfillmentID is the fulfillmentID argument value.
+			FulfillmentID string
+		}
+		// GetFulfillmentOrder holds details about calls to the GetFulfillmentOrder method.
+		GetFulfillmentOrder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// MoveFulfillmentOrder holds details about calls to the MoveFulfillmentOrder method.
+		MoveFulfillmentOrder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// NewLocationID is the newLocationID argument value.
+			NewLocationID string
+			// LineItems is the lineItems argument value.
+			LineItems []model.FulfillmentOrderLineItemInput
+		}
+		// HoldFulfillmentOrder holds details about calls to the HoldFulfillmentOrder method.
+		HoldFulfillmentOrder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Hold is the hold argument value.
+			Hold model.FulfillmentOrderHoldInput
+		}
+		// ReleaseFulfillmentOrderHold holds details about calls to the ReleaseFulfillmentOrderHold method.
+		ReleaseFulfillmentOrderHold []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// SplitFulfillmentOrders holds details about calls to the SplitFulfillmentOrders method.
+		SplitFulfillmentOrders []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Splits is the splits argument value.
+			Splits []model.FulfillmentOrderSplitInput
+		}
+		// MergeFulfillmentOrders holds details about calls to the MergeFulfillmentOrders method.
+		MergeFulfillmentOrders []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Merges is the merges argument value.
+			Merges []model.FulfillmentOrderMergeInput
+		}
+		// CloseFulfillmentOrder holds details about calls to the CloseFulfillmentOrder method.
+		CloseFulfillmentOrder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Message is the message argument value.
+			Message *string
+		}
+		// RescheduleFulfillmentOrder holds details about calls to the RescheduleFulfillmentOrder method.
+		RescheduleFulfillmentOrder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// FulfillAt is the fulfillAt argument value.
+			FulfillAt time.Time
+		}
+		// CreateFulfillmentService holds details about calls to the CreateFulfillmentService method.
+		CreateFulfillmentService []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input shopify.FulfillmentServiceInput
+		}
+		// UpdateFulfillmentService holds details about calls to the UpdateFulfillmentService method.
+		UpdateFulfillmentService []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Input is the input argument value.
+			Input shopify.FulfillmentServiceInput
+		}
+		// DeleteFulfillmentService holds details about calls to the DeleteFulfillmentService method.
+		DeleteFulfillmentService []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// DestinationLocationID is the destinationLocationID argument value.
+			DestinationLocationID *string
+		}
+		// ListAssignedFulfillmentOrders holds details about calls to the ListAssignedFulfillmentOrders method.
+		ListAssignedFulfillmentOrders []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AssignmentStatus is the assignmentStatus argument value.
+			AssignmentStatus model.FulfillmentOrderAssignmentStatus
+			// LocationIDs is the locationIDs argument value.
+			LocationIDs []string
+		}
+		// AcceptFulfillmentRequest holds details about calls to the AcceptFulfillmentRequest method.
+		AcceptFulfillmentRequest []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Message is the message argument value.
+			Message *string
+		}
+		// RejectFulfillmentRequest holds details about calls to the RejectFulfillmentRequest method.
+		RejectFulfillmentRequest []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Reason is the reason argument value.
+			Reason *model.FulfillmentOrderRejectionReason
+			// Message is the message argument value.
+			Message *string
+		}
+		// AcceptCancellationRequest holds details about calls to the AcceptCancellationRequest method.
+		AcceptCancellationRequest []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Message is the message argument value.
+			Message *string
+		}
+		// RejectCancellationRequest holds details about calls to the RejectCancellationRequest method.
+		RejectCancellationRequest []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Message is the message argument value.
+			Message *string
+		}
+		// ListFulfillmentOrdersByDeliveryMethod holds details about calls to the ListFulfillmentOrdersByDeliveryMethod method.
+		ListFulfillmentOrdersByDeliveryMethod []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// LocationID is the locationID argument value.
+			LocationID string
+			// MethodType is the methodType argument value.
+			MethodType model.DeliveryMethodType
+		}
+		// MarkPreparedForPickup holds details about calls to the MarkPreparedForPickup method.
+		MarkPreparedForPickup []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// FulfillmentOrderIDs is the fulfillmentOrderIDs argument value.
+			FulfillmentOrderIDs []string
+		}
+	}
+	lockCreate                                sync.RWMutex
+	lockUpdateTracking                        sync.RWMutex
+	lockCancel                                sync.RWMutex
+	lockGetFulfillmentOrder                   sync.RWMutex
+	lockMoveFulfillmentOrder                  sync.RWMutex
+	lockHoldFulfillmentOrder                  sync.RWMutex
+	lockReleaseFulfillmentOrderHold           sync.RWMutex
+	lockSplitFulfillmentOrders                sync.RWMutex
+	lockMergeFulfillmentOrders                sync.RWMutex
+	lockCloseFulfillmentOrder                 sync.RWMutex
+	lockRescheduleFulfillmentOrder            sync.RWMutex
+	lockCreateFulfillmentService              sync.RWMutex
+	lockUpdateFulfillmentService              sync.RWMutex
+	lockDeleteFulfillmentService              sync.RWMutex
+	lockListAssignedFulfillmentOrders         sync.RWMutex
+	lockAcceptFulfillmentRequest              sync.RWMutex
+	lockRejectFulfillmentRequest              sync.RWMutex
+	lockAcceptCancellationRequest             sync.RWMutex
+	lockRejectCancellationRequest             sync.RWMutex
+	lockListFulfillmentOrdersByDeliveryMethod sync.RWMutex
+	lockMarkPreparedForPickup                 sync.RWMutex
+}
+
+// Create calls CreateFunc.
+func (mock *FulfillmentServiceMock) Create(ctx context.Context, input model.FulfillmentV2Input) (*model.Fulfillment, error) {
+	if mock.CreateFunc == nil {
+		panic("FulfillmentServiceMock.CreateFunc: method is nil but FulfillmentService.Create was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.FulfillmentV2Input
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, input)
+}
+
+// CreateCalls returns the calls made to Create.
+func (mock *FulfillmentServiceMock) CreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.FulfillmentV2Input
+} {
+	mock.lockCreate.RLock()
+	defer mock.lockCreate.RUnlock()
+	return mock.calls.Create
+}
+
+// UpdateTracking calls UpdateTrackingFunc.
+func (mock *FulfillmentServiceMock) UpdateTracking(ctx context.Context, fulfillmentID string, trackingInfo model.FulfillmentTrackingInput, notifyCustomer bool) (*model.Fulfillment, error) {
+	if mock.UpdateTrackingFunc == nil {
+		panic("FulfillmentServiceMock.UpdateTrackingFunc: method is nil but FulfillmentService.UpdateTracking was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// FulfillmentID is the fulfillmentID argument value.
+		FulfillmentID string
+		// TrackingInfo is the trackingInfo argument value.
+		TrackingInfo model.FulfillmentTrackingInput
+		// NotifyCustomer is the notifyCustomer argument value.
+		NotifyCustomer bool
+	}{
+		Ctx:            ctx,
+		FulfillmentID:  fulfillmentID,
+		TrackingInfo:   trackingInfo,
+		NotifyCustomer: notifyCustomer,
+	}
+	mock.lockUpdateTracking.Lock()
+	mock.calls.UpdateTracking = append(mock.calls.UpdateTracking, callInfo)
+	mock.lockUpdateTracking.Unlock()
+	return mock.UpdateTrackingFunc(ctx, fulfillmentID, trackingInfo, notifyCustomer)
+}
+
+// UpdateTrackingCalls returns the calls made to UpdateTracking.
+func (mock *FulfillmentServiceMock) UpdateTrackingCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// FulfillmentID is the fulfillmentID argument value.
+	FulfillmentID string
+	// TrackingInfo is the trackingInfo argument value.
+	TrackingInfo model.FulfillmentTrackingInput
+	// NotifyCustomer is the notifyCustomer argument value.
+	NotifyCustomer bool
+} {
+	mock.lockUpdateTracking.RLock()
+	defer mock.lockUpdateTracking.RUnlock()
+	return mock.calls.UpdateTracking
+}
+
+// Cancel calls CancelFunc.
+func (mock *FulfillmentServiceMock) Cancel(ctx context.Context, fulfillmentID string) (*model.Fulfillment, error) {
+	if mock.CancelFunc == nil {
+		panic("FulfillmentServiceMock.CancelFunc: method is nil but FulfillmentService.Cancel was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// FulfillmentID is the fulfillmentID argument value.
+		FulfillmentID string
+	}{
+		Ctx:           ctx,
+		FulfillmentID: fulfillmentID,
+	}
+	mock.lockCancel.Lock()
+	mock.calls.Cancel = append(mock.calls.Cancel, callInfo)
+	mock.lockCancel.Unlock()
+	return mock.CancelFunc(ctx, fulfillmentID)
+}
+
+// CancelCalls returns the calls made to Cancel.
+func (mock *FulfillmentServiceMock) CancelCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// FulfillmentID is the fulfillmentID argument value.
+	FulfillmentID string
+} {
+	mock.lockCancel.RLock()
+	defer mock.lockCancel.RUnlock()
+	return mock.calls.Cancel
+}
+
+// GetFulfillmentOrder calls GetFulfillmentOrderFunc.
+func (mock *FulfillmentServiceMock) GetFulfillmentOrder(ctx context.Context, id string) (*model.FulfillmentOrder, error) {
+	if mock.GetFulfillmentOrderFunc == nil {
+		panic("FulfillmentServiceMock.GetFulfillmentOrderFunc: method is nil but FulfillmentService.GetFulfillmentOrder was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGetFulfillmentOrder.Lock()
+	mock.calls.GetFulfillmentOrder = append(mock.calls.GetFulfillmentOrder, callInfo)
+	mock.lockGetFulfillmentOrder.Unlock()
+	return mock.GetFulfillmentOrderFunc(ctx, id)
+}
+
+// GetFulfillmentOrderCalls returns the calls made to GetFulfillmentOrder.
+func (mock *FulfillmentServiceMock) GetFulfillmentOrderCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGetFulfillmentOrder.RLock()
+	defer mock.lockGetFulfillmentOrder.RUnlock()
+	return mock.calls.GetFulfillmentOrder
+}
+
+// MoveFulfillmentOrder calls MoveFulfillmentOrderFunc.
+func (mock *FulfillmentServiceMock) MoveFulfillmentOrder(ctx context.Context, id string, newLocationID string, lineItems []model.FulfillmentOrderLineItemInput) (*shopify.FulfillmentOrderMoveResult, error) {
+	if mock.MoveFulfillmentOrderFunc == nil {
+		panic("FulfillmentServiceMock.MoveFulfillmentOrderFunc: method is nil but FulfillmentService.MoveFulfillmentOrder was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// NewLocationID is the newLocationID argument value.
+		NewLocationID string
+		// LineItems is the lineItems argument value.
+		LineItems []model.FulfillmentOrderLineItemInput
+	}{
+		Ctx:           ctx,
+		Id:            id,
+		NewLocationID: newLocationID,
+		LineItems:     lineItems,
+	}
+	mock.lockMoveFulfillmentOrder.Lock()
+	mock.calls.MoveFulfillmentOrder = append(mock.calls.MoveFulfillmentOrder, callInfo)
+	mock.lockMoveFulfillmentOrder.Unlock()
+	return mock.MoveFulfillmentOrderFunc(ctx, id, newLocationID, lineItems)
+}
+
+// MoveFulfillmentOrderCalls returns the calls made to MoveFulfillmentOrder.
+func (mock *FulfillmentServiceMock) MoveFulfillmentOrderCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// NewLocationID is the newLocationID argument value.
+	NewLocationID string
+	// LineItems is the lineItems argument value.
+	LineItems []model.FulfillmentOrderLineItemInput
+} {
+	mock.lockMoveFulfillmentOrder.RLock()
+	defer mock.lockMoveFulfillmentOrder.RUnlock()
+	return mock.calls.MoveFulfillmentOrder
+}
+
+// HoldFulfillmentOrder calls HoldFulfillmentOrderFunc.
+func (mock *FulfillmentServiceMock) HoldFulfillmentOrder(ctx context.Context, id string, hold model.FulfillmentOrderHoldInput) (*model.FulfillmentOrder, error) {
+	if mock.HoldFulfillmentOrderFunc == nil {
+		panic("FulfillmentServiceMock.HoldFulfillmentOrderFunc: method is nil but FulfillmentService.HoldFulfillmentOrder was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Hold is the hold argument value.
+		Hold model.FulfillmentOrderHoldInput
+	}{
+		Ctx:  ctx,
+		Id:   id,
+		Hold: hold,
+	}
+	mock.lockHoldFulfillmentOrder.Lock()
+	mock.calls.HoldFulfillmentOrder = append(mock.calls.HoldFulfillmentOrder, callInfo)
+	mock.lockHoldFulfillmentOrder.Unlock()
+	return mock.HoldFulfillmentOrderFunc(ctx, id, hold)
+}
+
+// HoldFulfillmentOrderCalls returns the calls made to HoldFulfillmentOrder.
+func (mock *FulfillmentServiceMock) HoldFulfillmentOrderCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Hold is the hold argument value.
+	Hold model.FulfillmentOrderHoldInput
+} {
+	mock.lockHoldFulfillmentOrder.RLock()
+	defer mock.lockHoldFulfillmentOrder.RUnlock()
+	return mock.calls.HoldFulfillmentOrder
+}
+
+// ReleaseFulfillmentOrderHold calls ReleaseFulfillmentOrderHoldFunc.
+func (mock *FulfillmentServiceMock) ReleaseFulfillmentOrderHold(ctx context.Context, id string) (*model.FulfillmentOrder, error) {
+	if mock.ReleaseFulfillmentOrderHoldFunc == nil {
+		panic("FulfillmentServiceMock.ReleaseFulfillmentOrderHoldFunc: method is nil but FulfillmentService.ReleaseFulfillmentOrderHold was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockReleaseFulfillmentOrderHold.Lock()
+	mock.calls.ReleaseFulfillmentOrderHold = append(mock.calls.ReleaseFulfillmentOrderHold, callInfo)
+	mock.lockReleaseFulfillmentOrderHold.Unlock()
+	return mock.ReleaseFulfillmentOrderHoldFunc(ctx, id)
+}
+
+// ReleaseFulfillmentOrderHoldCalls returns the calls made to ReleaseFulfillmentOrderHold.
+func (mock *FulfillmentServiceMock) ReleaseFulfillmentOrderHoldCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockReleaseFulfillmentOrderHold.RLock()
+	defer mock.lockReleaseFulfillmentOrderHold.RUnlock()
+	return mock.calls.ReleaseFulfillmentOrderHold
+}
+
+// SplitFulfillmentOrders calls SplitFulfillmentOrdersFunc.
+func (mock *FulfillmentServiceMock) SplitFulfillmentOrders(ctx context.Context, splits []model.FulfillmentOrderSplitInput) ([]shopify.FulfillmentOrderSplitResult, error) {
+	if mock.SplitFulfillmentOrdersFunc == nil {
+		panic("FulfillmentServiceMock.SplitFulfillmentOrdersFunc: method is nil but FulfillmentService.SplitFulfillmentOrders was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Splits is the splits argument value.
+		Splits []model.FulfillmentOrderSplitInput
+	}{
+		Ctx:    ctx,
+		Splits: splits,
+	}
+	mock.lockSplitFulfillmentOrders.Lock()
+	mock.calls.SplitFulfillmentOrders = append(mock.calls.SplitFulfillmentOrders, callInfo)
+	mock.lockSplitFulfillmentOrders.Unlock()
+	return mock.SplitFulfillmentOrdersFunc(ctx, splits)
+}
+
+// SplitFulfillmentOrdersCalls returns the calls made to SplitFulfillmentOrders.
+func (mock *FulfillmentServiceMock) SplitFulfillmentOrdersCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Splits is the splits argument value.
+	Splits []model.FulfillmentOrderSplitInput
+} {
+	mock.lockSplitFulfillmentOrders.RLock()
+	defer mock.lockSplitFulfillmentOrders.RUnlock()
+	return mock.calls.SplitFulfillmentOrders
+}
+
+// MergeFulfillmentOrders calls MergeFulfillmentOrdersFunc.
+func (mock *FulfillmentServiceMock) MergeFulfillmentOrders(ctx context.Context, merges []model.FulfillmentOrderMergeInput) ([]*model.FulfillmentOrder, error) {
+	if mock.MergeFulfillmentOrdersFunc == nil {
+		panic("FulfillmentServiceMock.MergeFulfillmentOrdersFunc: method is nil but FulfillmentService.MergeFulfillmentOrders was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Merges is the merges argument value.
+		Merges []model.FulfillmentOrderMergeInput
+	}{
+		Ctx:    ctx,
+		Merges: merges,
+	}
+	mock.lockMergeFulfillmentOrders.Lock()
+	mock.calls.MergeFulfillmentOrders = append(mock.calls.MergeFulfillmentOrders, callInfo)
+	mock.lockMergeFulfillmentOrders.Unlock()
+	return mock.MergeFulfillmentOrdersFunc(ctx, merges)
+}
+
+// MergeFulfillmentOrdersCalls returns the calls made to MergeFulfillmentOrders.
+func (mock *FulfillmentServiceMock) MergeFulfillmentOrdersCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Merges is the merges argument value.
+	Merges []model.FulfillmentOrderMergeInput
+} {
+	mock.lockMergeFulfillmentOrders.RLock()
+	defer mock.lockMergeFulfillmentOrders.RUnlock()
+	return mock.calls.MergeFulfillmentOrders
+}
+
+// CloseFulfillmentOrder calls CloseFulfillmentOrderFunc.
+func (mock *FulfillmentServiceMock) CloseFulfillmentOrder(ctx context.Context, id string, message *string) (*model.FulfillmentOrder, error) {
+	if mock.CloseFulfillmentOrderFunc == nil {
+		panic("FulfillmentServiceMock.CloseFulfillmentOrderFunc: method is nil but FulfillmentService.CloseFulfillmentOrder was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Message is the message argument value.
+		Message *string
+	}{
+		Ctx:     ctx,
+		Id:      id,
+		Message: message,
+	}
+	mock.lockCloseFulfillmentOrder.Lock()
+	mock.calls.CloseFulfillmentOrder = append(mock.calls.CloseFulfillmentOrder, callInfo)
+	mock.lockCloseFulfillmentOrder.Unlock()
+	return mock.CloseFulfillmentOrderFunc(ctx, id, message)
+}
+
+// CloseFulfillmentOrderCalls returns the calls made to CloseFulfillmentOrder.
+func (mock *FulfillmentServiceMock) CloseFulfillmentOrderCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Message is the message argument value.
+	Message *string
+} {
+	mock.lockCloseFulfillmentOrder.RLock()
+	defer mock.lockCloseFulfillmentOrder.RUnlock()
+	return mock.calls.CloseFulfillmentOrder
+}
+
+// RescheduleFulfillmentOrder calls RescheduleFulfillmentOrderFunc.
+func (mock *FulfillmentServiceMock) RescheduleFulfillmentOrder(ctx context.Context, id string, fulfillAt time.Time) (*model.FulfillmentOrder, error) {
+	if mock.RescheduleFulfillmentOrderFunc == nil {
+		panic("FulfillmentServiceMock.RescheduleFulfillmentOrderFunc: method is nil but FulfillmentService.RescheduleFulfillmentOrder was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// FulfillAt is the fulfillAt argument value.
+		FulfillAt time.Time
+	}{
+		Ctx:       ctx,
+		Id:        id,
+		FulfillAt: fulfillAt,
+	}
+	mock.lockRescheduleFulfillmentOrder.Lock()
+	mock.calls.RescheduleFulfillmentOrder = append(mock.calls.RescheduleFulfillmentOrder, callInfo)
+	mock.lockRescheduleFulfillmentOrder.Unlock()
+	return mock.RescheduleFulfillmentOrderFunc(ctx, id, fulfillAt)
+}
+
+// RescheduleFulfillmentOrderCalls returns the calls made to RescheduleFulfillmentOrder.
+func (mock *FulfillmentServiceMock) RescheduleFulfillmentOrderCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// FulfillAt is the fulfillAt argument value.
+	FulfillAt time.Time
+} {
+	mock.lockRescheduleFulfillmentOrder.RLock()
+	defer mock.lockRescheduleFulfillmentOrder.RUnlock()
+	return mock.calls.RescheduleFulfillmentOrder
+}
+
+// CreateFulfillmentService calls CreateFulfillmentServiceFunc.
+func (mock *FulfillmentServiceMock) CreateFulfillmentService(ctx context.Context, input shopify.FulfillmentServiceInput) (*model.FulfillmentService, error) {
+	if mock.CreateFulfillmentServiceFunc == nil {
+		panic("FulfillmentServiceMock.CreateFulfillmentServiceFunc: method is nil but FulfillmentService.CreateFulfillmentService was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input shopify.FulfillmentServiceInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCreateFulfillmentService.Lock()
+	mock.calls.CreateFulfillmentService = append(mock.calls.CreateFulfillmentService, callInfo)
+	mock.lockCreateFulfillmentService.Unlock()
+	return mock.CreateFulfillmentServiceFunc(ctx, input)
+}
+
+// CreateFulfillmentServiceCalls returns the calls made to CreateFulfillmentService.
+func (mock *FulfillmentServiceMock) CreateFulfillmentServiceCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input shopify.FulfillmentServiceInput
+} {
+	mock.lockCreateFulfillmentService.RLock()
+	defer mock.lockCreateFulfillmentService.RUnlock()
+	return mock.calls.CreateFulfillmentService
+}
+
+// UpdateFulfillmentService calls UpdateFulfillmentServiceFunc.
+func (mock *FulfillmentServiceMock) UpdateFulfillmentService(ctx context.Context, id string, input shopify.FulfillmentServiceInput) (*model.FulfillmentService, error) {
+	if mock.UpdateFulfillmentServiceFunc == nil {
+		panic("FulfillmentServiceMock.UpdateFulfillmentServiceFunc: method is nil but FulfillmentService.UpdateFulfillmentService was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Input is the input argument value.
+		Input shopify.FulfillmentServiceInput
+	}{
+		Ctx:   ctx,
+		Id:    id,
+		Input: input,
+	}
+	mock.lockUpdateFulfillmentService.Lock()
+	mock.calls.UpdateFulfillmentService = append(mock.calls.UpdateFulfillmentService, callInfo)
+	mock.lockUpdateFulfillmentService.Unlock()
+	return mock.UpdateFulfillmentServiceFunc(ctx, id, input)
+}
+
+// UpdateFulfillmentServiceCalls returns the calls made to UpdateFulfillmentService.
+func (mock *FulfillmentServiceMock) UpdateFulfillmentServiceCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Input is the input argument value.
+	Input shopify.FulfillmentServiceInput
+} {
+	mock.lockUpdateFulfillmentService.RLock()
+	defer mock.lockUpdateFulfillmentService.RUnlock()
+	return mock.calls.UpdateFulfillmentService
+}
+
+// DeleteFulfillmentService calls DeleteFulfillmentServiceFunc.
+func (mock *FulfillmentServiceMock) DeleteFulfillmentService(ctx context.Context, id string, destinationLocationID *string) error {
+	if mock.DeleteFulfillmentServiceFunc == nil {
+		panic("FulfillmentServiceMock.DeleteFulfillmentServiceFunc: method is nil but FulfillmentService.DeleteFulfillmentService was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// DestinationLocationID is the destinationLocationID argument value.
+		DestinationLocationID *string
+	}{
+		Ctx:                   ctx,
+		Id:                    id,
+		DestinationLocationID: destinationLocationID,
+	}
+	mock.lockDeleteFulfillmentService.Lock()
+	mock.calls.DeleteFulfillmentService = append(mock.calls.DeleteFulfillmentService, callInfo)
+	mock.lockDeleteFulfillmentService.Unlock()
+	return mock.DeleteFulfillmentServiceFunc(ctx, id, destinationLocationID)
+}
+
+// DeleteFulfillmentServiceCalls returns the calls made to DeleteFulfillmentService.
+func (mock *FulfillmentServiceMock) DeleteFulfillmentServiceCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// DestinationLocationID is the destinationLocationID argument value.
+	DestinationLocationID *string
+} {
+	mock.lockDeleteFulfillmentService.RLock()
+	defer mock.lockDeleteFulfillmentService.RUnlock()
+	return mock.calls.DeleteFulfillmentService
+}
+
+// ListAssignedFulfillmentOrders calls ListAssignedFulfillmentOrdersFunc.
+func (mock *FulfillmentServiceMock) ListAssignedFulfillmentOrders(ctx context.Context, assignmentStatus model.FulfillmentOrderAssignmentStatus, locationIDs []string) ([]*model.FulfillmentOrder, error) {
+	if mock.ListAssignedFulfillmentOrdersFunc == nil {
+		panic("FulfillmentServiceMock.ListAssignedFulfillmentOrdersFunc: method is nil but FulfillmentService.ListAssignedFulfillmentOrders was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// AssignmentStatus is the assignmentStatus argument value.
+		AssignmentStatus model.FulfillmentOrderAssignmentStatus
+		// LocationIDs is the locationIDs argument value.
+		LocationIDs []string
+	}{
+		Ctx:              ctx,
+		AssignmentStatus: assignmentStatus,
+		LocationIDs:      locationIDs,
+	}
+	mock.lockListAssignedFulfillmentOrders.Lock()
+	mock.calls.ListAssignedFulfillmentOrders = append(mock.calls.ListAssignedFulfillmentOrders, callInfo)
+	mock.lockListAssignedFulfillmentOrders.Unlock()
+	return mock.ListAssignedFulfillmentOrdersFunc(ctx, assignmentStatus, locationIDs)
+}
+
+// ListAssignedFulfillmentOrdersCalls returns the calls made to ListAssignedFulfillmentOrders.
+func (mock *FulfillmentServiceMock) ListAssignedFulfillmentOrdersCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// AssignmentStatus is the assignmentStatus argument value.
+	AssignmentStatus model.FulfillmentOrderAssignmentStatus
+	// LocationIDs is the locationIDs argument value.
+	LocationIDs []string
+} {
+	mock.lockListAssignedFulfillmentOrders.RLock()
+	defer mock.lockListAssignedFulfillmentOrders.RUnlock()
+	return mock.calls.ListAssignedFulfillmentOrders
+}
+
+// AcceptFulfillmentRequest calls AcceptFulfillmentRequestFunc.
+func (mock *FulfillmentServiceMock) AcceptFulfillmentRequest(ctx context.Context, id string, message *string) (*model.FulfillmentOrder, error) {
+	if mock.AcceptFulfillmentRequestFunc == nil {
+		panic("FulfillmentServiceMock.AcceptFulfillmentRequestFunc: method is nil but FulfillmentService.AcceptFulfillmentRequest was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Message is the message argument value.
+		Message *string
+	}{
+		Ctx:     ctx,
+		Id:      id,
+		Message: message,
+	}
+	mock.lockAcceptFulfillmentRequest.Lock()
+	mock.calls.AcceptFulfillmentRequest = append(mock.calls.AcceptFulfillmentRequest, callInfo)
+	mock.lockAcceptFulfillmentRequest.Unlock()
+	return mock.AcceptFulfillmentRequestFunc(ctx, id, message)
+}
+
+// AcceptFulfillmentRequestCalls returns the calls made to AcceptFulfillmentRequest.
+func (mock *FulfillmentServiceMock) AcceptFulfillmentRequestCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Message is the message argument value.
+	Message *string
+} {
+	mock.lockAcceptFulfillmentRequest.RLock()
+	defer mock.lockAcceptFulfillmentRequest.RUnlock()
+	return mock.calls.AcceptFulfillmentRequest
+}
+
+// RejectFulfillmentRequest calls RejectFulfillmentRequestFunc.
+func (mock *FulfillmentServiceMock) RejectFulfillmentRequest(ctx context.Context, id string, reason *model.FulfillmentOrderRejectionReason, message *string) (*model.FulfillmentOrder, error) {
+	if mock.RejectFulfillmentRequestFunc == nil {
+		panic("FulfillmentServiceMock.RejectFulfillmentRequestFunc: method is nil but FulfillmentService.RejectFulfillmentRequest was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Reason is the reason argument value.
+		Reason *model.FulfillmentOrderRejectionReason
+		// Message is the message argument value.
+		Message *string
+	}{
+		Ctx:     ctx,
+		Id:      id,
+		Reason:  reason,
+		Message: message,
+	}
+	mock.lockRejectFulfillmentRequest.Lock()
+	mock.calls.RejectFulfillmentRequest = append(mock.calls.RejectFulfillmentRequest, callInfo)
+	mock.lockRejectFulfillmentRequest.Unlock()
+	return mock.RejectFulfillmentRequestFunc(ctx, id, reason, message)
+}
+
+// RejectFulfillmentRequestCalls returns the calls made to RejectFulfillmentRequest.
+func (mock *FulfillmentServiceMock) RejectFulfillmentRequestCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Reason is the reason argument value.
+	Reason *model.FulfillmentOrderRejectionReason
+	// Message is the message argument value.
+	Message *string
+} {
+	mock.lockRejectFulfillmentRequest.RLock()
+	defer mock.lockRejectFulfillmentRequest.RUnlock()
+	return mock.calls.RejectFulfillmentRequest
+}
+
+// AcceptCancellationRequest calls AcceptCancellationRequestFunc.
+func (mock *FulfillmentServiceMock) AcceptCancellationRequest(ctx context.Context, id string, message *string) (*model.FulfillmentOrder, error) {
+	if mock.AcceptCancellationRequestFunc == nil {
+		panic("FulfillmentServiceMock.AcceptCancellationRequestFunc: method is nil but FulfillmentService.AcceptCancellationRequest was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Message is the message argument value.
+		Message *string
+	}{
+		Ctx:     ctx,
+		Id:      id,
+		Message: message,
+	}
+	mock.lockAcceptCancellationRequest.Lock()
+	mock.calls.AcceptCancellationRequest = append(mock.calls.AcceptCancellationRequest, callInfo)
+	mock.lockAcceptCancellationRequest.Unlock()
+	return mock.AcceptCancellationRequestFunc(ctx, id, message)
+}
+
+// AcceptCancellationRequestCalls returns the calls made to AcceptCancellationRequest.
+func (mock *FulfillmentServiceMock) AcceptCancellationRequestCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Message is the message argument value.
+	Message *string
+} {
+	mock.lockAcceptCancellationRequest.RLock()
+	defer mock.lockAcceptCancellationRequest.RUnlock()
+	return mock.calls.AcceptCancellationRequest
+}
+
+// RejectCancellationRequest calls RejectCancellationRequestFunc.
+func (mock *FulfillmentServiceMock) RejectCancellationRequest(ctx context.Context, id string, message *string) (*model.FulfillmentOrder, error) {
+	if mock.RejectCancellationRequestFunc == nil {
+		panic("FulfillmentServiceMock.RejectCancellationRequestFunc: method is nil but FulfillmentService.RejectCancellationRequest was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Message is the message argument value.
+		Message *string
+	}{
+		Ctx:     ctx,
+		Id:      id,
+		Message: message,
+	}
+	mock.lockRejectCancellationRequest.Lock()
+	mock.calls.RejectCancellationRequest = append(mock.calls.RejectCancellationRequest, callInfo)
+	mock.lockRejectCancellationRequest.Unlock()
+	return mock.RejectCancellationRequestFunc(ctx, id, message)
+}
+
+// RejectCancellationRequestCalls returns the calls made to RejectCancellationRequest.
+func (mock *FulfillmentServiceMock) RejectCancellationRequestCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Message is the message argument value.
+	Message *string
+} {
+	mock.lockRejectCancellationRequest.RLock()
+	defer mock.lockRejectCancellationRequest.RUnlock()
+	return mock.calls.RejectCancellationRequest
+}
+
+// ListFulfillmentOrdersByDeliveryMethod calls ListFulfillmentOrdersByDeliveryMethodFunc.
+func (mock *FulfillmentServiceMock) ListFulfillmentOrdersByDeliveryMethod(ctx context.Context, locationID string, methodType model.DeliveryMethodType) ([]*model.FulfillmentOrder, error) {
+	if mock.ListFulfillmentOrdersByDeliveryMethodFunc == nil {
+		panic("FulfillmentServiceMock.ListFulfillmentOrdersByDeliveryMethodFunc: method is nil but FulfillmentService.ListFulfillmentOrdersByDeliveryMethod was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// LocationID is the locationID argument value.
+		LocationID string
+		// MethodType is the methodType argument value.
+		MethodType model.DeliveryMethodType
+	}{
+		Ctx:        ctx,
+		LocationID: locationID,
+		MethodType: methodType,
+	}
+	mock.lockListFulfillmentOrdersByDeliveryMethod.Lock()
+	mock.calls.ListFulfillmentOrdersByDeliveryMethod = append(mock.calls.ListFulfillmentOrdersByDeliveryMethod, callInfo)
+	mock.lockListFulfillmentOrdersByDeliveryMethod.Unlock()
+	return mock.ListFulfillmentOrdersByDeliveryMethodFunc(ctx, locationID, methodType)
+}
+
+// ListFulfillmentOrdersByDeliveryMethodCalls returns the calls made to ListFulfillmentOrdersByDeliveryMethod.
+func (mock *FulfillmentServiceMock) ListFulfillmentOrdersByDeliveryMethodCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// LocationID is the locationID argument value.
+	LocationID string
+	// MethodType is the methodType argument value.
+	MethodType model.DeliveryMethodType
+} {
+	mock.lockListFulfillmentOrdersByDeliveryMethod.RLock()
+	defer mock.lockListFulfillmentOrdersByDeliveryMethod.RUnlock()
+	return mock.calls.ListFulfillmentOrdersByDeliveryMethod
+}
+
+// MarkPreparedForPickup calls MarkPreparedForPickupFunc.
+func (mock *FulfillmentServiceMock) MarkPreparedForPickup(ctx context.Context, fulfillmentOrderIDs []string) error {
+	if mock.MarkPreparedForPickupFunc == nil {
+		panic("FulfillmentServiceMock.MarkPreparedForPickupFunc: method is nil but FulfillmentService.MarkPreparedForPickup was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// FulfillmentOrderIDs is the fulfillmentOrderIDs argument value.
+		FulfillmentOrderIDs []string
+	}{
+		Ctx:                 ctx,
+		FulfillmentOrderIDs: fulfillmentOrderIDs,
+	}
+	mock.lockMarkPreparedForPickup.Lock()
+	mock.calls.MarkPreparedForPickup = append(mock.calls.MarkPreparedForPickup, callInfo)
+	mock.lockMarkPreparedForPickup.Unlock()
+	return mock.MarkPreparedForPickupFunc(ctx, fulfillmentOrderIDs)
+}
+
+// MarkPreparedForPickupCalls returns the calls made to MarkPreparedForPickup.
+func (mock *FulfillmentServiceMock) MarkPreparedForPickupCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// FulfillmentOrderIDs is the fulfillmentOrderIDs argument value.
+	FulfillmentOrderIDs []string
+} {
+	mock.lockMarkPreparedForPickup.RLock()
+	defer mock.lockMarkPreparedForPickup.RUnlock()
+	return mock.calls.MarkPreparedForPickup
+}
+
+var _ shopify.FunctionService = &FunctionServiceMock{}
+
+// FunctionServiceMock is a mock implementation of shopify.FunctionService.
+type FunctionServiceMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, apiType shopify.FunctionAPIType) ([]*model.ShopifyFunction, error)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id string) (*model.ShopifyFunction, error)
+
+	// FindFunc mocks the Find method.
+	FindFunc func(ctx context.Context, apiType shopify.FunctionAPIType, title string) (*model.ShopifyFunction, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ApiType is the apiType argument value.
+			ApiType shopify.FunctionAPIType
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// Find holds details about calls to the Find method.
+		Find []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ApiType is the apiType argument value.
+			ApiType shopify.FunctionAPIType
+			// Title is the title argument value.
+			Title string
+		}
+	}
+	lockList sync.RWMutex
+	lockGet  sync.RWMutex
+	lockFind sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *FunctionServiceMock) List(ctx context.Context, apiType shopify.FunctionAPIType) ([]*model.ShopifyFunction, error) {
+	if mock.ListFunc == nil {
+		panic("FunctionServiceMock.ListFunc: method is nil but FunctionService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ApiType is the apiType argument value.
+		ApiType shopify.FunctionAPIType
+	}{
+		Ctx:     ctx,
+		ApiType: apiType,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, apiType)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *FunctionServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ApiType is the apiType argument value.
+	ApiType shopify.FunctionAPIType
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// Get calls GetFunc.
+func (mock *FunctionServiceMock) Get(ctx context.Context, id string) (*model.ShopifyFunction, error) {
+	if mock.GetFunc == nil {
+		panic("FunctionServiceMock.GetFunc: method is nil but FunctionService.Get was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls returns the calls made to Get.
+func (mock *FunctionServiceMock) GetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGet.RLock()
+	defer mock.lockGet.RUnlock()
+	return mock.calls.Get
+}
+
+// Find calls FindFunc.
+func (mock *FunctionServiceMock) Find(ctx context.Context, apiType shopify.FunctionAPIType, title string) (*model.ShopifyFunction, error) {
+	if mock.FindFunc == nil {
+		panic("FunctionServiceMock.FindFunc: method is nil but FunctionService.Find was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ApiType is the apiType argument value.
+		ApiType shopify.FunctionAPIType
+		// Title is the title argument value.
+		Title string
+	}{
+		Ctx:     ctx,
+		ApiType: apiType,
+		Title:   title,
+	}
+	mock.lockFind.Lock()
+	mock.calls.Find = append(mock.calls.Find, callInfo)
+	mock.lockFind.Unlock()
+	return mock.FindFunc(ctx, apiType, title)
+}
+
+// FindCalls returns the calls made to Find.
+func (mock *FunctionServiceMock) FindCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ApiType is the apiType argument value.
+	ApiType shopify.FunctionAPIType
+	// Title is the title argument value.
+	Title string
+} {
+	mock.lockFind.RLock()
+	defer mock.lockFind.RUnlock()
+	return mock.calls.Find
+}
+
+var _ shopify.InventoryService = &InventoryServiceMock{}
+
+// InventoryServiceMock is a mock implementation of shopify.InventoryService.
+type InventoryServiceMock struct {
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, id graphql.ID, input shopify.InventoryItemUpdateInput) error
+
+	// UpdateItemFunc mocks the UpdateItem method.
+	UpdateItemFunc func(ctx context.Context, id string, input shopify.InventoryItemInput) (*model.InventoryItem, error)
+
+	// AdjustFunc mocks the Adjust method.
+	AdjustFunc func(ctx context.Context, locationID graphql.ID, input []shopify.InventoryAdjustItemInput) error
+
+	// AdjustQuantitiesFunc mocks the AdjustQuantities method.
+	AdjustQuantitiesFunc func(ctx context.Context, input model.InventoryAdjustQuantitiesInput) (*model.InventoryAdjustmentGroup, error)
+
+	// SetOnHandFunc mocks the SetOnHand method.
+	SetOnHandFunc func(ctx context.Context, input model.InventorySetOnHandQuantitiesInput) (*model.InventoryAdjustmentGroup, error)
+
+	// SetScheduledChangesFunc mocks the SetScheduledChanges method.
+	SetScheduledChangesFunc func(ctx context.Context, input model.InventorySetScheduledChangesInput) ([]*model.InventoryScheduledChange, error)
+
+	// ActivateInventoryFunc mocks the ActivateInventory method.
+	ActivateInventoryFunc func(ctx context.Context, locationID graphql.ID, id graphql.ID) error
+
+	// ActivateFunc mocks the Activate method.
+	ActivateFunc func(ctx context.Context, itemID string, locationID string) (*model.InventoryLevel, error)
+
+	// DeactivateFunc mocks the Deactivate method.
+	DeactivateFunc func(ctx context.Context, itemID string, locationID string) error
+
+	// BulkToggleActivationFunc mocks the BulkToggleActivation method.
+	BulkToggleActivationFunc func(ctx context.Context, itemID string, updates []model.InventoryBulkToggleActivationInput) ([]*model.InventoryLevel, error)
+
+	// ListLevelsFunc mocks the ListLevels method.
+	ListLevelsFunc func(ctx context.Context, itemID string) ([]*shopify.InventoryLevelQuantities, error)
+
+	// ListLevelsAtLocationFunc mocks the ListLevelsAtLocation method.
+	ListLevelsAtLocationFunc func(ctx context.Context, locationID string, opts shopify.ListOptions) ([]*shopify.InventoryLevelQuantities, string, error)
+
+	// BulkListLevelsAtLocationFunc mocks the BulkListLevelsAtLocation method.
+	BulkListLevelsAtLocationFunc func(ctx context.Context, locationID string) ([]*shopify.InventoryLevelQuantities, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id graphql.ID
+			// Input is the input argument value.
+			Input shopify.InventoryItemUpdateInput
+		}
+		// UpdateItem holds details about calls to the UpdateItem method.
+		UpdateItem []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Input is the input argument value.
+			Input shopify.InventoryItemInput
+		}
+		// Adjust holds details about calls to the Adjust method.
+		Adjust []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// LocationID is the locationID argument value.
+			LocationID graphql.ID
+			// Input is the input argument value.
+			Input []shopify.InventoryAdjustItemInput
+		}
+		// AdjustQuantities holds details about calls to the AdjustQuantities method.
+		AdjustQuantities []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.InventoryAdjustQuantitiesInput
+		}
+		// SetOnHand holds details about calls to the SetOnHand method.
+		SetOnHand []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.InventorySetOnHandQuantitiesInput
+		}
+		// SetScheduledChanges holds details about calls to the SetScheduledChanges method.
+		SetScheduledChanges []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.InventorySetScheduledChangesInput
+		}
+		// ActivateInventory holds details about calls to the ActivateInventory method.
+		ActivateInventory []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// LocationID is the locationID argument value.
+			LocationID graphql.ID
+			// Id is the id argument value.
+			Id graphql.ID
+		}
+		// Activate holds details about calls to the Activate method.
+		Activate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemID is the itemID argument value.
+			ItemID string
+			// LocationID is the locationID argument value.
+			LocationID string
+		}
+		// Deactivate holds details about calls to the Deactivate method.
+		Deactivate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemID is the itemID argument value.
+			ItemID string
+			// LocationID is the locationID argument value.
+			LocationID string
+		}
+		// BulkToggleActivation holds details about calls to the BulkToggleActivation method.
+		BulkToggleActivation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemID is the itemID argument value.
+			ItemID string
+			// Updates is the updates argument value.
+			Updates []model.InventoryBulkToggleActivationInput
+		}
+		// ListLevels holds details about calls to the ListLevels method.
+		ListLevels []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ItemID is the itemID argument value.
+			ItemID string
+		}
+		// ListLevelsAtLocation holds details about calls to the ListLevelsAtLocation method.
+		ListLevelsAtLocation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// LocationID is the locationID argument value.
+			LocationID string
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+		// BulkListLevelsAtLocation holds details about calls to the BulkListLevelsAtLocation method.
+		BulkListLevelsAtLocation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// LocationID is the locationID argument value.
+			LocationID string
+		}
+	}
+	lockUpdate                   sync.RWMutex
+	lockUpdateItem               sync.RWMutex
+	lockAdjust                   sync.RWMutex
+	lockAdjustQuantities         sync.RWMutex
+	lockSetOnHand                sync.RWMutex
+	lockSetScheduledChanges      sync.RWMutex
+	lockActivateInventory        sync.RWMutex
+	lockActivate                 sync.RWMutex
+	lockDeactivate               sync.RWMutex
+	lockBulkToggleActivation     sync.RWMutex
+	lockListLevels               sync.RWMutex
+	lockListLevelsAtLocation     sync.RWMutex
+	lockBulkListLevelsAtLocation sync.RWMutex
+}
+
+// Update calls UpdateFunc.
+func (mock *InventoryServiceMock) Update(ctx context.Context, id graphql.ID, input shopify.InventoryItemUpdateInput) error {
+	if mock.UpdateFunc == nil {
+		panic("InventoryServiceMock.UpdateFunc: method is nil but InventoryService.Update was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id graphql.ID
+		// Input is the input argument value.
+		Input shopify.InventoryItemUpdateInput
+	}{
+		Ctx:   ctx,
+		Id:    id,
+		Input: input,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, id, input)
+}
+
+// UpdateCalls returns the calls made to Update.
+func (mock *InventoryServiceMock) UpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id graphql.ID
+	// Input is the input argument value.
+	Input shopify.InventoryItemUpdateInput
+} {
+	mock.lockUpdate.RLock()
+	defer mock.lockUpdate.RUnlock()
+	return mock.calls.Update
+}
+
+// UpdateItem calls UpdateItemFunc.
+func (mock *InventoryServiceMock) UpdateItem(ctx context.Context, id string, input shopify.InventoryItemInput) (*model.InventoryItem, error) {
+	if mock.UpdateItemFunc == nil {
+		panic("InventoryServiceMock.UpdateItemFunc: method is nil but InventoryService.UpdateItem was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Input is the input argument value.
+		Input shopify.InventoryItemInput
+	}{
+		Ctx:   ctx,
+		Id:    id,
+		Input: input,
+	}
+	mock.lockUpdateItem.Lock()
+	mock.calls.UpdateItem = append(mock.calls.UpdateItem, callInfo)
+	mock.lockUpdateItem.Unlock()
+	return mock.UpdateItemFunc(ctx, id, input)
+}
+
+// UpdateItemCalls returns the calls made to UpdateItem.
+func (mock *InventoryServiceMock) UpdateItemCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Input is the input argument value.
+	Input shopify.InventoryItemInput
+} {
+	mock.lockUpdateItem.RLock()
+	defer mock.lockUpdateItem.RUnlock()
+	return mock.calls.UpdateItem
+}
+
+// Adjust calls AdjustFunc.
+func (mock *InventoryServiceMock) Adjust(ctx context.Context, locationID graphql.ID, input []shopify.InventoryAdjustItemInput) error {
+	if mock.AdjustFunc == nil {
+		panic("InventoryServiceMock.AdjustFunc: method is nil but InventoryService.Adjust was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// LocationID is the locationID argument value.
+		LocationID graphql.ID
+		// Input is the input argument value.
+		Input []shopify.InventoryAdjustItemInput
+	}{
+		Ctx:        ctx,
+		LocationID: locationID,
+		Input:      input,
+	}
+	mock.lockAdjust.Lock()
+	mock.calls.Adjust = append(mock.calls.Adjust, callInfo)
+	mock.lockAdjust.Unlock()
+	return mock.AdjustFunc(ctx, locationID, input)
+}
+
+// AdjustCalls returns the calls made to Adjust.
+func (mock *InventoryServiceMock) AdjustCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// LocationID is the locationID argument value.
+	LocationID graphql.ID
+	// Input is the input argument value.
+	Input []shopify.InventoryAdjustItemInput
+} {
+	mock.lockAdjust.RLock()
+	defer mock.lockAdjust.RUnlock()
+	return mock.calls.Adjust
+}
+
+// AdjustQuantities calls AdjustQuantitiesFunc.
+func (mock *InventoryServiceMock) AdjustQuantities(ctx context.Context, input model.InventoryAdjustQuantitiesInput) (*model.InventoryAdjustmentGroup, error) {
+	if mock.AdjustQuantitiesFunc == nil {
+		panic("InventoryServiceMock.AdjustQuantitiesFunc: method is nil but InventoryService.AdjustQuantities was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.InventoryAdjustQuantitiesInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockAdjustQuantities.Lock()
+	mock.calls.AdjustQuantities = append(mock.calls.AdjustQuantities, callInfo)
+	mock.lockAdjustQuantities.Unlock()
+	return mock.AdjustQuantitiesFunc(ctx, input)
+}
+
+// AdjustQuantitiesCalls returns the calls made to AdjustQuantities.
+func (mock *InventoryServiceMock) AdjustQuantitiesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.InventoryAdjustQuantitiesInput
+} {
+	mock.lockAdjustQuantities.RLock()
+	defer mock.lockAdjustQuantities.RUnlock()
+	return mock.calls.AdjustQuantities
+}
+
+// SetOnHand calls SetOnHandFunc.
+func (mock *InventoryServiceMock) SetOnHand(ctx context.Context, input model.InventorySetOnHandQuantitiesInput) (*model.InventoryAdjustmentGroup, error) {
+	if mock.SetOnHandFunc == nil {
+		panic("InventoryServiceMock.SetOnHandFunc: method is nil but InventoryService.SetOnHand was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.InventorySetOnHandQuantitiesInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockSetOnHand.Lock()
+	mock.calls.SetOnHand = append(mock.calls.SetOnHand, callInfo)
+	mock.lockSetOnHand.Unlock()
+	return mock.SetOnHandFunc(ctx, input)
+}
+
+// SetOnHandCalls returns the calls made to SetOnHand.
+func (mock *InventoryServiceMock) SetOnHandCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.InventorySetOnHandQuantitiesInput
+} {
+	mock.lockSetOnHand.RLock()
+	defer mock.lockSetOnHand.RUnlock()
+	return mock.calls.SetOnHand
+}
+
+// SetScheduledChanges calls SetScheduledChangesFunc.
+func (mock *InventoryServiceMock) SetScheduledChanges(ctx context.Context, input model.InventorySetScheduledChangesInput) ([]*model.InventoryScheduledChange, error) {
+	if mock.SetScheduledChangesFunc == nil {
+		panic("InventoryServiceMock.SetScheduledChangesFunc: method is nil but InventoryService.SetScheduledChanges was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.InventorySetScheduledChangesInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockSetScheduledChanges.Lock()
+	mock.calls.SetScheduledChanges = append(mock.calls.SetScheduledChanges, callInfo)
+	mock.lockSetScheduledChanges.Unlock()
+	return mock.SetScheduledChangesFunc(ctx, input)
+}
+
+// SetScheduledChangesCalls returns the calls made to SetScheduledChanges.
+func (mock *InventoryServiceMock) SetScheduledChangesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.InventorySetScheduledChangesInput
+} {
+	mock.lockSetScheduledChanges.RLock()
+	defer mock.lockSetScheduledChanges.RUnlock()
+	return mock.calls.SetScheduledChanges
+}
+
+// ActivateInventory calls ActivateInventoryFunc.
+func (mock *InventoryServiceMock) ActivateInventory(ctx context.Context, locationID graphql.ID, id graphql.ID) error {
+	if mock.ActivateInventoryFunc == nil {
+		panic("InventoryServiceMock.ActivateInventoryFunc: method is nil but InventoryService.ActivateInventory was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// LocationID is the locationID argument value.
+		LocationID graphql.ID
+		// Id is the id argument value.
+		Id graphql.ID
+	}{
+		Ctx:        ctx,
+		LocationID: locationID,
+		Id:         id,
+	}
+	mock.lockActivateInventory.Lock()
+	mock.calls.ActivateInventory = append(mock.calls.ActivateInventory, callInfo)
+	mock.lockActivateInventory.Unlock()
+	return mock.ActivateInventoryFunc(ctx, locationID, id)
+}
+
+// ActivateInventoryCalls returns the calls made to ActivateInventory.
+func (mock *InventoryServiceMock) ActivateInventoryCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// LocationID is the locationID argument value.
+	LocationID graphql.ID
+	// Id is the id argument value.
+	Id graphql.ID
+} {
+	mock.lockActivateInventory.RLock()
+	defer mock.lockActivateInventory.RUnlock()
+	return mock.calls.ActivateInventory
+}
+
+// Activate calls ActivateFunc.
+func (mock *InventoryServiceMock) Activate(ctx context.Context, itemID string, locationID string) (*model.InventoryLevel, error) {
+	if mock.ActivateFunc == nil {
+		panic("InventoryServiceMock.ActivateFunc: method is nil but InventoryService.Activate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ItemID is the itemID argument value.
+		ItemID string
+		// LocationID is the locationID argument value.
+		LocationID string
+	}{
+		Ctx:        ctx,
+		ItemID:     itemID,
+		LocationID: locationID,
+	}
+	mock.lockActivate.Lock()
+	mock.calls.Activate = append(mock.calls.Activate, callInfo)
+	mock.lockActivate.Unlock()
+	return mock.ActivateFunc(ctx, itemID, locationID)
+}
+
+// ActivateCalls returns the calls made to Activate.
+func (mock *InventoryServiceMock) ActivateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ItemID is the itemID argument value.
+	ItemID string
+	// LocationID is the locationID argument value.
+	LocationID string
+} {
+	mock.lockActivate.RLock()
+	defer mock.lockActivate.RUnlock()
+	return mock.calls.Activate
+}
+
+// Deactivate calls DeactivateFunc.
+func (mock *InventoryServiceMock) Deactivate(ctx context.Context, itemID string, locationID string) error {
+	if mock.DeactivateFunc == nil {
+		panic("InventoryServiceMock.DeactivateFunc: method is nil but InventoryService.Deactivate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ItemID is the itemID argument value.
+		ItemID string
+		// LocationID is the locationID argument value.
+		LocationID string
+	}{
+		Ctx:        ctx,
+		ItemID:     itemID,
+		LocationID: locationID,
+	}
+	mock.lockDeactivate.Lock()
+	mock.calls.Deactivate = append(mock.calls.Deactivate, callInfo)
+	mock.lockDeactivate.Unlock()
+	return mock.DeactivateFunc(ctx, itemID, locationID)
+}
+
+// DeactivateCalls returns the calls made to Deactivate.
+func (mock *InventoryServiceMock) DeactivateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ItemID is the itemID argument value.
+	ItemID string
+	// LocationID is the locationID argument value.
+	LocationID string
+} {
+	mock.lockDeactivate.RLock()
+	defer mock.lockDeactivate.RUnlock()
+	return mock.calls.Deactivate
+}
+
+// BulkToggleActivation calls BulkToggleActivationFunc.
+func (mock *InventoryServiceMock) BulkToggleActivation(ctx context.Context, itemID string, updates []model.InventoryBulkToggleActivationInput) ([]*model.InventoryLevel, error) {
+	if mock.BulkToggleActivationFunc == nil {
+		panic("InventoryServiceMock.BulkToggleActivationFunc: method is nil but InventoryService.BulkToggleActivation was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ItemID is the itemID argument value.
+		ItemID string
+		// Updates is the updates argument value.
+		Updates []model.InventoryBulkToggleActivationInput
+	}{
+		Ctx:     ctx,
+		ItemID:  itemID,
+		Updates: updates,
+	}
+	mock.lockBulkToggleActivation.Lock()
+	mock.calls.BulkToggleActivation = append(mock.calls.BulkToggleActivation, callInfo)
+	mock.lockBulkToggleActivation.Unlock()
+	return mock.BulkToggleActivationFunc(ctx, itemID, updates)
+}
+
+// BulkToggleActivationCalls returns the calls made to BulkToggleActivation.
+func (mock *InventoryServiceMock) BulkToggleActivationCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ItemID is the itemID argument value.
+	ItemID string
+	// Updates is the updates argument value.
+	Updates []model.InventoryBulkToggleActivationInput
+} {
+	mock.lockBulkToggleActivation.RLock()
+	defer mock.lockBulkToggleActivation.RUnlock()
+	return mock.calls.BulkToggleActivation
+}
+
+// ListLevels calls ListLevelsFunc.
+func (mock *InventoryServiceMock) ListLevels(ctx context.Context, itemID string) ([]*shopify.InventoryLevelQuantities, error) {
+	if mock.ListLevelsFunc == nil {
+		panic("InventoryServiceMock.ListLevelsFunc: method is nil but InventoryService.ListLevels was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ItemID is the itemID argument value.
+		ItemID string
+	}{
+		Ctx:    ctx,
+		ItemID: itemID,
+	}
+	mock.lockListLevels.Lock()
+	mock.calls.ListLevels = append(mock.calls.ListLevels, callInfo)
+	mock.lockListLevels.Unlock()
+	return mock.ListLevelsFunc(ctx, itemID)
+}
+
+// ListLevelsCalls returns the calls made to ListLevels.
+func (mock *InventoryServiceMock) ListLevelsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ItemID is the itemID argument value.
+	ItemID string
+} {
+	mock.lockListLevels.RLock()
+	defer mock.lockListLevels.RUnlock()
+	return mock.calls.ListLevels
+}
+
+// ListLevelsAtLocation calls ListLevelsAtLocationFunc.
+func (mock *InventoryServiceMock) ListLevelsAtLocation(ctx context.Context, locationID string, opts shopify.ListOptions) ([]*shopify.InventoryLevelQuantities, string, error) {
+	if mock.ListLevelsAtLocationFunc == nil {
+		panic("InventoryServiceMock.ListLevelsAtLocationFunc: method is nil but InventoryService.ListLevelsAtLocation was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// LocationID is the locationID argument value.
+		LocationID string
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:        ctx,
+		LocationID: locationID,
+		Opts:       opts,
+	}
+	mock.lockListLevelsAtLocation.Lock()
+	mock.calls.ListLevelsAtLocation = append(mock.calls.ListLevelsAtLocation, callInfo)
+	mock.lockListLevelsAtLocation.Unlock()
+	return mock.ListLevelsAtLocationFunc(ctx, locationID, opts)
+}
+
+// ListLevelsAtLocationCalls returns the calls made to ListLevelsAtLocation.
+func (mock *InventoryServiceMock) ListLevelsAtLocationCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// LocationID is the locationID argument value.
+	LocationID string
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockListLevelsAtLocation.RLock()
+	defer mock.lockListLevelsAtLocation.RUnlock()
+	return mock.calls.ListLevelsAtLocation
+}
+
+// BulkListLevelsAtLocation calls BulkListLevelsAtLocationFunc.
+func (mock *InventoryServiceMock) BulkListLevelsAtLocation(ctx context.Context, locationID string) ([]*shopify.InventoryLevelQuantities, error) {
+	if mock.BulkListLevelsAtLocationFunc == nil {
+		panic("InventoryServiceMock.BulkListLevelsAtLocationFunc: method is nil but InventoryService.BulkListLevelsAtLocation was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// LocationID is the locationID argument value.
+		LocationID string
+	}{
+		Ctx:        ctx,
+		LocationID: locationID,
+	}
+	mock.lockBulkListLevelsAtLocation.Lock()
+	mock.calls.BulkListLevelsAtLocation = append(mock.calls.BulkListLevelsAtLocation, callInfo)
+	mock.lockBulkListLevelsAtLocation.Unlock()
+	return mock.BulkListLevelsAtLocationFunc(ctx, locationID)
+}
+
+// BulkListLevelsAtLocationCalls returns the calls made to BulkListLevelsAtLocation.
+func (mock *InventoryServiceMock) BulkListLevelsAtLocationCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// LocationID is the locationID argument value.
+	LocationID string
+} {
+	mock.lockBulkListLevelsAtLocation.RLock()
+	defer mock.lockBulkListLevelsAtLocation.RUnlock()
+	return mock.calls.BulkListLevelsAtLocation
+}
+
+var _ shopify.LocationService = &LocationServiceMock{}
+
+// LocationServiceMock is a mock implementation of shopify.LocationService.
+type LocationServiceMock struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id graphql.ID) (*shopify.Location, error)
+
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, opts shopify.LocationListOptions) ([]*shopify.Location, error)
+
+	// AddFunc mocks the Add method.
+	AddFunc func(ctx context.Context, input model.LocationAddInput) (*shopify.Location, error)
+
+	// EditFunc mocks the Edit method.
+	EditFunc func(ctx context.Context, id string, input model.LocationEditInput) (*shopify.Location, error)
+
+	// ActivateFunc mocks the Activate method.
+	ActivateFunc func(ctx context.Context, id string) (*shopify.Location, error)
+
+	// DeactivateFunc mocks the Deactivate method.
+	DeactivateFunc func(ctx context.Context, id string, destinationLocationID *string) (*shopify.Location, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id graphql.ID
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts shopify.LocationListOptions
+		}
+		// Add holds details about calls to the Add method.
+		Add []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.LocationAddInput
+		}
+		// Edit holds details about calls to the Edit method.
+		Edit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Input is the input argument value.
+			Input model.LocationEditInput
+		}
+		// Activate holds details about calls to the Activate method.
+		Activate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// Deactivate holds details about calls to the Deactivate method.
+		Deactivate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// DestinationLocationID is the destinationLocationID argument value.
+			DestinationLocationID *string
+		}
+	}
+	lockGet        sync.RWMutex
+	lockList       sync.RWMutex
+	lockAdd        sync.RWMutex
+	lockEdit       sync.RWMutex
+	lockActivate   sync.RWMutex
+	lockDeactivate sync.RWMutex
+}
+
+// Get calls GetFunc.
+func (mock *LocationServiceMock) Get(ctx context.Context, id graphql.ID) (*shopify.Location, error) {
+	if mock.GetFunc == nil {
+		panic("LocationServiceMock.GetFunc: method is nil but LocationService.Get was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id graphql.ID
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls returns the calls made to Get.
+func (mock *LocationServiceMock) GetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id graphql.ID
+} {
+	mock.lockGet.RLock()
+	defer mock.lockGet.RUnlock()
+	return mock.calls.Get
+}
+
+// List calls ListFunc.
+func (mock *LocationServiceMock) List(ctx context.Context, opts shopify.LocationListOptions) ([]*shopify.Location, error) {
+	if mock.ListFunc == nil {
+		panic("LocationServiceMock.ListFunc: method is nil but LocationService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts shopify.LocationListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, opts)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *LocationServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts shopify.LocationListOptions
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// Add calls AddFunc.
+func (mock *LocationServiceMock) Add(ctx context.Context, input model.LocationAddInput) (*shopify.Location, error) {
+	if mock.AddFunc == nil {
+		panic("LocationServiceMock.AddFunc: method is nil but LocationService.Add was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.LocationAddInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockAdd.Lock()
+	mock.calls.Add = append(mock.calls.Add, callInfo)
+	mock.lockAdd.Unlock()
+	return mock.AddFunc(ctx, input)
+}
+
+// AddCalls returns the calls made to Add.
+func (mock *LocationServiceMock) AddCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.LocationAddInput
+} {
+	mock.lockAdd.RLock()
+	defer mock.lockAdd.RUnlock()
+	return mock.calls.Add
+}
+
+// Edit calls EditFunc.
+func (mock *LocationServiceMock) Edit(ctx context.Context, id string, input model.LocationEditInput) (*shopify.Location, error) {
+	if mock.EditFunc == nil {
+		panic("LocationServiceMock.EditFunc: method is nil but LocationService.Edit was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Input is the input argument value.
+		Input model.LocationEditInput
+	}{
+		Ctx:   ctx,
+		Id:    id,
+		Input: input,
+	}
+	mock.lockEdit.Lock()
+	mock.calls.Edit = append(mock.calls.Edit, callInfo)
+	mock.lockEdit.Unlock()
+	return mock.EditFunc(ctx, id, input)
+}
+
+// EditCalls returns the calls made to Edit.
+func (mock *LocationServiceMock) EditCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Input is the input argument value.
+	Input model.LocationEditInput
+} {
+	mock.lockEdit.RLock()
+	defer mock.lockEdit.RUnlock()
+	return mock.calls.Edit
+}
+
+// Activate calls ActivateFunc.
+func (mock *LocationServiceMock) Activate(ctx context.Context, id string) (*shopify.Location, error) {
+	if mock.ActivateFunc == nil {
+		panic("LocationServiceMock.ActivateFunc: method is nil but LocationService.Activate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockActivate.Lock()
+	mock.calls.Activate = append(mock.calls.Activate, callInfo)
+	mock.lockActivate.Unlock()
+	return mock.ActivateFunc(ctx, id)
+}
+
+// ActivateCalls returns the calls made to Activate.
+func (mock *LocationServiceMock) ActivateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockActivate.RLock()
+	defer mock.lockActivate.RUnlock()
+	return mock.calls.Activate
+}
+
+// Deactivate calls DeactivateFunc.
+func (mock *LocationServiceMock) Deactivate(ctx context.Context, id string, destinationLocationID *string) (*shopify.Location, error) {
+	if mock.DeactivateFunc == nil {
+		panic("LocationServiceMock.DeactivateFunc: method is nil but LocationService.Deactivate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// DestinationLocationID is the destinationLocationID argument value.
+		DestinationLocationID *string
+	}{
+		Ctx:                   ctx,
+		Id:                    id,
+		DestinationLocationID: destinationLocationID,
+	}
+	mock.lockDeactivate.Lock()
+	mock.calls.Deactivate = append(mock.calls.Deactivate, callInfo)
+	mock.lockDeactivate.Unlock()
+	return mock.DeactivateFunc(ctx, id, destinationLocationID)
+}
+
+// DeactivateCalls returns the calls made to Deactivate.
+func (mock *LocationServiceMock) DeactivateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// DestinationLocationID is the destinationLocationID argument value.
+	DestinationLocationID *string
+} {
+	mock.lockDeactivate.RLock()
+	defer mock.lockDeactivate.RUnlock()
+	return mock.calls.Deactivate
+}
+
+var _ shopify.MenuService = &MenuServiceMock{}
+
+// MenuServiceMock is a mock implementation of shopify.MenuService.
+type MenuServiceMock struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id string) (*shopify.Menu, error)
+
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, opts shopify.ListOptions) ([]*shopify.Menu, string, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, title string, handle string, items []shopify.MenuItemInput) (*shopify.Menu, error)
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, id string, title string, handle *string, items []shopify.MenuItemInput) (*shopify.Menu, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id string) (string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Title is the title argument value.
+			Title string
+			// Handle is the handle argument value.
+			Handle string
+			// Items is the items argument value.
+			Items []shopify.MenuItemInput
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Title is the title argument value.
+			Title string
+			// Handle is the handle argument value.
+			Handle *string
+			// Items is the items argument value.
+			Items []shopify.MenuItemInput
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+	}
+	lockGet    sync.RWMutex
+	lockList   sync.RWMutex
+	lockCreate sync.RWMutex
+	lockUpdate sync.RWMutex
+	lockDelete sync.RWMutex
+}
+
+// Get calls GetFunc.
+func (mock *MenuServiceMock) Get(ctx context.Context, id string) (*shopify.Menu, error) {
+	if mock.GetFunc == nil {
+		panic("MenuServiceMock.GetFunc: method is nil but MenuService.Get was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls returns the calls made to Get.
+func (mock *MenuServiceMock) GetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGet.RLock()
+	defer mock.lockGet.RUnlock()
+	return mock.calls.Get
+}
+
+// List calls ListFunc.
+func (mock *MenuServiceMock) List(ctx context.Context, opts shopify.ListOptions) ([]*shopify.Menu, string, error) {
+	if mock.ListFunc == nil {
+		panic("MenuServiceMock.ListFunc: method is nil but MenuService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, opts)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *MenuServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// Create calls CreateFunc.
+func (mock *MenuServiceMock) Create(ctx context.Context, title string, handle string, items []shopify.MenuItemInput) (*shopify.Menu, error) {
+	if mock.CreateFunc == nil {
+		panic("MenuServiceMock.CreateFunc: method is nil but MenuService.Create was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Title is the title argument value.
+		Title string
+		// Handle is the handle argument value.
+		Handle string
+		// Items is the items argument value.
+		Items []shopify.MenuItemInput
+	}{
+		Ctx:    ctx,
+		Title:  title,
+		Handle: handle,
+		Items:  items,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, title, handle, items)
+}
+
+// CreateCalls returns the calls made to Create.
+func (mock *MenuServiceMock) CreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Title is the title argument value.
+	Title string
+	// Handle is the handle argument value.
+	Handle string
+	// Items is the items argument value.
+	Items []shopify.MenuItemInput
+} {
+	mock.lockCreate.RLock()
+	defer mock.lockCreate.RUnlock()
+	return mock.calls.Create
+}
+
+// Update calls UpdateFunc.
+func (mock *MenuServiceMock) Update(ctx context.Context, id string, title string, handle *string, items []shopify.MenuItemInput) (*shopify.Menu, error) {
+	if mock.UpdateFunc == nil {
+		panic("MenuServiceMock.UpdateFunc: method is nil but MenuService.Update was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Title is the title argument value.
+		Title string
+		// Handle is the handle argument value.
+		Handle *string
+		// Items is the items argument value.
+		Items []shopify.MenuItemInput
+	}{
+		Ctx:    ctx,
+		Id:     id,
+		Title:  title,
+		Handle: handle,
+		Items:  items,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, id, title, handle, items)
+}
+
+// UpdateCalls returns the calls made to Update.
+func (mock *MenuServiceMock) UpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Title is the title argument value.
+	Title string
+	// Handle is the handle argument value.
+	Handle *string
+	// Items is the items argument value.
+	Items []shopify.MenuItemInput
+} {
+	mock.lockUpdate.RLock()
+	defer mock.lockUpdate.RUnlock()
+	return mock.calls.Update
+}
+
+// Delete calls DeleteFunc.
+func (mock *MenuServiceMock) Delete(ctx context.Context, id string) (string, error) {
+	if mock.DeleteFunc == nil {
+		panic("MenuServiceMock.DeleteFunc: method is nil but MenuService.Delete was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id)
+}
+
+// DeleteCalls returns the calls made to Delete.
+func (mock *MenuServiceMock) DeleteCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockDelete.RLock()
+	defer mock.lockDelete.RUnlock()
+	return mock.calls.Delete
+}
+
+var _ shopify.MetafieldDefinitionService = &MetafieldDefinitionServiceMock{}
+
+// MetafieldDefinitionServiceMock is a mock implementation of shopify.MetafieldDefinitionService.
+type MetafieldDefinitionServiceMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, ownerType model.MetafieldOwnerType, namespace string) ([]*model.MetafieldDefinition, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, definition model.MetafieldDefinitionInput) (*model.MetafieldDefinition, error)
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, definition model.MetafieldDefinitionUpdateInput) (*model.MetafieldDefinition, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id string, deleteAllAssociatedMetafields bool) error
+
+	// PinFunc mocks the Pin method.
+	PinFunc func(ctx context.Context, id string) (*model.MetafieldDefinition, error)
+
+	// UnpinFunc mocks the Unpin method.
+	UnpinFunc func(ctx context.Context, id string) (*model.MetafieldDefinition, error)
+
+	// EnableStandardFunc mocks the EnableStandard method.
+	EnableStandardFunc func(ctx context.Context, input shopify.StandardMetafieldDefinitionEnableInput) (*model.MetafieldDefinition, error)
+
+	// SetStorefrontAccessFunc mocks the SetStorefrontAccess method.
+	SetStorefrontAccessFunc func(ctx context.Context, ownerType model.MetafieldOwnerType, namespace string, key string, admin model.MetafieldAdminAccess, storefront model.MetafieldStorefrontAccess) (*model.MetafieldDefinition, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerType is the ownerType argument value.
+			OwnerType model.MetafieldOwnerType
+			// Namespace is the namespace argument value.
+			Namespace string
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Definition is the definition argument value.
+			Definition model.MetafieldDefinitionInput
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Definition is the definition argument value.
+			Definition model.MetafieldDefinitionUpdateInput
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// DeleteAllAssociatedMetafields is the deleteAllAssociatedMetafields argument value.
+			DeleteAllAssociatedMetafields bool
+		}
+		// Pin holds details about calls to the Pin method.
+		Pin []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// Unpin holds details about calls to the Unpin method.
+		Unpin []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// EnableStandard holds details about calls to the EnableStandard method.
+		EnableStandard []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input shopify.StandardMetafieldDefinitionEnableInput
+		}
+		// SetStorefrontAccess holds details about calls to the SetStorefrontAccess method.
+		SetStorefrontAccess []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerType is the ownerType argument value.
+			OwnerType model.MetafieldOwnerType
+			// Namespace is the namespace argument value.
+			Namespace string
+			// Key is the key argument value.
+			Key string
+			// Admin is the admin argument value.
+			Admin model.MetafieldAdminAccess
+			// Storefront is the storefront argument value.
+			Storefront model.MetafieldStorefrontAccess
+		}
+	}
+	lockList                sync.RWMutex
+	lockCreate              sync.RWMutex
+	lockUpdate              sync.RWMutex
+	lockDelete              sync.RWMutex
+	lockPin                 sync.RWMutex
+	lockUnpin               sync.RWMutex
+	lockEnableStandard      sync.RWMutex
+	lockSetStorefrontAccess sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *MetafieldDefinitionServiceMock) List(ctx context.Context, ownerType model.MetafieldOwnerType, namespace string) ([]*model.MetafieldDefinition, error) {
+	if mock.ListFunc == nil {
+		panic("MetafieldDefinitionServiceMock.ListFunc: method is nil but MetafieldDefinitionService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// OwnerType is the ownerType argument value.
+		OwnerType model.MetafieldOwnerType
+		// Namespace is the namespace argument value.
+		Namespace string
+	}{
+		Ctx:       ctx,
+		OwnerType: ownerType,
+		Namespace: namespace,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, ownerType, namespace)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *MetafieldDefinitionServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// OwnerType is the ownerType argument value.
+	OwnerType model.MetafieldOwnerType
+	// Namespace is the namespace argument value.
+	Namespace string
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// Create calls CreateFunc.
+func (mock *MetafieldDefinitionServiceMock) Create(ctx context.Context, definition model.MetafieldDefinitionInput) (*model.MetafieldDefinition, error) {
+	if mock.CreateFunc == nil {
+		panic("MetafieldDefinitionServiceMock.CreateFunc: method is nil but MetafieldDefinitionService.Create was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Definition is the definition argument value.
+		Definition model.MetafieldDefinitionInput
+	}{
+		Ctx:        ctx,
+		Definition: definition,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, definition)
+}
+
+// CreateCalls returns the calls made to Create.
+func (mock *MetafieldDefinitionServiceMock) CreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Definition is the definition argument value.
+	Definition model.MetafieldDefinitionInput
+} {
+	mock.lockCreate.RLock()
+	defer mock.lockCreate.RUnlock()
+	return mock.calls.Create
+}
+
+// Update calls UpdateFunc.
+func (mock *MetafieldDefinitionServiceMock) Update(ctx context.Context, definition model.MetafieldDefinitionUpdateInput) (*model.MetafieldDefinition, error) {
+	if mock.UpdateFunc == nil {
+		panic("MetafieldDefinitionServiceMock.UpdateFunc: method is nil but MetafieldDefinitionService.Update was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Definition is the definition argument value.
+		Definition model.MetafieldDefinitionUpdateInput
+	}{
+		Ctx:        ctx,
+		Definition: definition,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, definition)
+}
+
+// UpdateCalls returns the calls made to Update.
+func (mock *MetafieldDefinitionServiceMock) UpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Definition is the definition argument value.
+	Definition model.MetafieldDefinitionUpdateInput
+} {
+	mock.lockUpdate.RLock()
+	defer mock.lockUpdate.RUnlock()
+	return mock.calls.Update
+}
+
+// Delete calls DeleteFunc.
+func (mock *MetafieldDefinitionServiceMock) Delete(ctx context.Context, id string, deleteAllAssociatedMetafields bool) error {
+	if mock.DeleteFunc == nil {
+		panic("MetafieldDefinitionServiceMock.DeleteFunc: method is nil but MetafieldDefinitionService.Delete was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// DeleteAllAssociatedMetafields is the deleteAllAssociatedMetafields argument value.
+		DeleteAllAssociatedMetafields bool
+	}{
+		Ctx:                           ctx,
+		Id:                            id,
+		DeleteAllAssociatedMetafields: deleteAllAssociatedMetafields,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id, deleteAllAssociatedMetafields)
+}
+
+// DeleteCalls returns the calls made to Delete.
+func (mock *MetafieldDefinitionServiceMock) DeleteCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// DeleteAllAssociatedMetafields is the deleteAllAssociatedMetafields argument value.
+	DeleteAllAssociatedMetafields bool
+} {
+	mock.lockDelete.RLock()
+	defer mock.lockDelete.RUnlock()
+	return mock.calls.Delete
+}
+
+// Pin calls PinFunc.
+func (mock *MetafieldDefinitionServiceMock) Pin(ctx context.Context, id string) (*model.MetafieldDefinition, error) {
+	if mock.PinFunc == nil {
+		panic("MetafieldDefinitionServiceMock.PinFunc: method is nil but MetafieldDefinitionService.Pin was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockPin.Lock()
+	mock.calls.Pin = append(mock.calls.Pin, callInfo)
+	mock.lockPin.Unlock()
+	return mock.PinFunc(ctx, id)
+}
+
+// PinCalls returns the calls made to Pin.
+func (mock *MetafieldDefinitionServiceMock) PinCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockPin.RLock()
+	defer mock.lockPin.RUnlock()
+	return mock.calls.Pin
+}
+
+// Unpin calls UnpinFunc.
+func (mock *MetafieldDefinitionServiceMock) Unpin(ctx context.Context, id string) (*model.MetafieldDefinition, error) {
+	if mock.UnpinFunc == nil {
+		panic("MetafieldDefinitionServiceMock.UnpinFunc: method is nil but MetafieldDefinitionService.Unpin was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockUnpin.Lock()
+	mock.calls.Unpin = append(mock.calls.Unpin, callInfo)
+	mock.lockUnpin.Unlock()
+	return mock.UnpinFunc(ctx, id)
+}
+
+// UnpinCalls returns the calls made to Unpin.
+func (mock *MetafieldDefinitionServiceMock) UnpinCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockUnpin.RLock()
+	defer mock.lockUnpin.RUnlock()
+	return mock.calls.Unpin
+}
+
+// EnableStandard calls EnableStandardFunc.
+func (mock *MetafieldDefinitionServiceMock) EnableStandard(ctx context.Context, input shopify.StandardMetafieldDefinitionEnableInput) (*model.MetafieldDefinition, error) {
+	if mock.EnableStandardFunc == nil {
+		panic("MetafieldDefinitionServiceMock.EnableStandardFunc: method is nil but MetafieldDefinitionService.EnableStandard was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input shopify.StandardMetafieldDefinitionEnableInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockEnableStandard.Lock()
+	mock.calls.EnableStandard = append(mock.calls.EnableStandard, callInfo)
+	mock.lockEnableStandard.Unlock()
+	return mock.EnableStandardFunc(ctx, input)
+}
+
+// EnableStandardCalls returns the calls made to EnableStandard.
+func (mock *MetafieldDefinitionServiceMock) EnableStandardCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input shopify.StandardMetafieldDefinitionEnableInput
+} {
+	mock.lockEnableStandard.RLock()
+	defer mock.lockEnableStandard.RUnlock()
+	return mock.calls.EnableStandard
+}
+
+// SetStorefrontAccess calls SetStorefrontAccessFunc.
+func (mock *MetafieldDefinitionServiceMock) SetStorefrontAccess(ctx context.Context, ownerType model.MetafieldOwnerType, namespace string, key string, admin model.MetafieldAdminAccess, storefront model.MetafieldStorefrontAccess) (*model.MetafieldDefinition, error) {
+	if mock.SetStorefrontAccessFunc == nil {
+		panic("MetafieldDefinitionServiceMock.SetStorefrontAccessFunc: method is nil but MetafieldDefinitionService.SetStorefrontAccess was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// OwnerType is the ownerType argument value.
+		OwnerType model.MetafieldOwnerType
+		// Namespace is the namespace argument value.
+		Namespace string
+		// Key is the key argument value.
+		Key string
+		// Admin is the admin argument value.
+		Admin model.MetafieldAdminAccess
+		// Storefront is the storefront argument value.
+		Storefront model.MetafieldStorefrontAccess
+	}{
+		Ctx:        ctx,
+		OwnerType:  ownerType,
+		Namespace:  namespace,
+		Key:        key,
+		Admin:      admin,
+		Storefront: storefront,
+	}
+	mock.lockSetStorefrontAccess.Lock()
+	mock.calls.SetStorefrontAccess = append(mock.calls.SetStorefrontAccess, callInfo)
+	mock.lockSetStorefrontAccess.Unlock()
+	return mock.SetStorefrontAccessFunc(ctx, ownerType, namespace, key, admin, storefront)
+}
+
+// SetStorefrontAccessCalls returns the calls made to SetStorefrontAccess.
+func (mock *MetafieldDefinitionServiceMock) SetStorefrontAccessCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// OwnerType is the ownerType argument value.
+	OwnerType model.MetafieldOwnerType
+	// Namespace is the namespace argument value.
+	Namespace string
+	// Key is the key argument value.
+	Key string
+	// Admin is the admin argument value.
+	Admin model.MetafieldAdminAccess
+	// Storefront is the storefront argument value.
+	Storefront model.MetafieldStorefrontAccess
+} {
+	mock.lockSetStorefrontAccess.RLock()
+	defer mock.lockSetStorefrontAccess.RUnlock()
+	return mock.calls.SetStorefrontAccess
+}
+
+var _ shopify.MetafieldService = &MetafieldServiceMock{}
+
+// MetafieldServiceMock is a mock implementation of shopify.MetafieldService.
+type MetafieldServiceMock struct {
+	// ListAllShopMetafieldsFunc mocks the ListAllShopMetafields method.
+	ListAllShopMetafieldsFunc func(ctx context.Context) ([]*shopify.Metafield, error)
+
+	// ListShopMetafieldsByNamespaceFunc mocks the ListShopMetafieldsByNamespace method.
+	ListShopMetafieldsByNamespaceFunc func(ctx context.Context, namespace string) ([]*shopify.Metafield, error)
+
+	// GetShopMetafieldByKeyFunc mocks the GetShopMetafieldByKey method.
+	GetShopMetafieldByKeyFunc func(ctx context.Context, namespace string, key string) (*shopify.Metafield, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, input model.MetafieldDeleteInput) error
+
+	// DeleteBulkFunc mocks the DeleteBulk method.
+	DeleteBulkFunc func(ctx context.Context, metafields []model.MetafieldIdentifierInput) error
+
+	// CreateBulkFunc mocks the CreateBulk method.
+	CreateBulkFunc func(ctx context.Context, metafields []model.MetafieldsSetInput) ([]model.Metafield, error)
+
+	// SetFunc mocks the Set method.
+	SetFunc func(ctx context.Context, metafields []model.MetafieldsSetInput) ([]shopify.MetafieldSetResult, error)
+
+	// ListByOwnerFunc mocks the ListByOwner method.
+	ListByOwnerFunc func(ctx context.Context, ownerID string, namespace string, opts shopify.ListOptions) ([]*shopify.Metafield, string, error)
+
+	// ListAllByOwnerFunc mocks the ListAllByOwner method.
+	ListAllByOwnerFunc func(ctx context.Context, ownerID string, namespace string) ([]*shopify.Metafield, error)
+
+	// ResolveReferencesFunc mocks the ResolveReferences method.
+	ResolveReferencesFunc func(ctx context.Context, metafields []*shopify.Metafield) ([]shopify.ResolvedMetafield, error)
+
+	// GetShopMetafieldFunc mocks the GetShopMetafield method.
+	GetShopMetafieldFunc func(ctx context.Context, owner shopify.ShopMetafieldOwner, namespace string, key string, v any) (*shopify.Metafield, error)
+
+	// SetShopMetafieldFunc mocks the SetShopMetafield method.
+	SetShopMetafieldFunc func(ctx context.Context, owner shopify.ShopMetafieldOwner, namespace string, key string, value any) (*shopify.Metafield, error)
+
+	// ListAppMetafieldsFunc mocks the ListAppMetafields method.
+	ListAppMetafieldsFunc func(ctx context.Context, ownerID string, subNamespace string) ([]*shopify.Metafield, error)
+
+	// SetAppMetafieldFunc mocks the SetAppMetafield method.
+	SetAppMetafieldFunc func(ctx context.Context, ownerID string, subNamespace string, key string, valueType string, value string) (*shopify.Metafield, error)
+
+	// MigratePrivateMetafieldsFunc mocks the MigratePrivateMetafields method.
+	MigratePrivateMetafieldsFunc func(ctx context.Context, ownerID string, namespace string, deleteMigrated bool) ([]*shopify.Metafield, error)
+
+	// ListStorefrontVisibilitiesFunc mocks the ListStorefrontVisibilities method.
+	ListStorefrontVisibilitiesFunc func(ctx context.Context, namespace string) ([]*model.MetafieldStorefrontVisibility, error)
+
+	// CreateStorefrontVisibilityFunc mocks the CreateStorefrontVisibility method.
+	CreateStorefrontVisibilityFunc func(ctx context.Context, input model.MetafieldStorefrontVisibilityInput) (*model.MetafieldStorefrontVisibility, error)
+
+	// DeleteStorefrontVisibilityFunc mocks the DeleteStorefrontVisibility method.
+	DeleteStorefrontVisibilityFunc func(ctx context.Context, id string) error
+
+	// BulkExportFunc mocks the BulkExport method.
+	BulkExportFunc func(ctx context.Context, ownerType model.MetafieldOwnerType, namespace string) ([]shopify.OwnedMetafield, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListAllShopMetafields holds details about calls to the ListAllShopMetafields method.
+		ListAllShopMetafields []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ListShopMetafieldsByNamespace holds details about calls to the ListShopMetafieldsByNamespace method.
+		ListShopMetafieldsByNamespace []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Namespace is the namespace argument value.
+			Namespace string
+		}
+		// GetShopMetafieldByKey holds details about calls to the GetShopMetafieldByKey method.
+		GetShopMetafieldByKey []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Namespace is the namespace argument value.
+			Namespace string
+			// Key is the key argument value.
+			Key string
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.MetafieldDeleteInput
+		}
+		// DeleteBulk holds details about calls to the DeleteBulk method.
+		DeleteBulk []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Metafields is the metafields argument value.
+			Metafields []model.MetafieldIdentifierInput
+		}
+		// CreateBulk holds details about calls to the CreateBulk method.
+		CreateBulk []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Metafields is the metafields argument value.
+			Metafields []model.MetafieldsSetInput
+		}
+		// Set holds details about calls to the Set method.
+		Set []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Metafields is the metafields argument value.
+			Metafields []model.MetafieldsSetInput
+		}
+		// ListByOwner holds details about calls to the ListByOwner method.
+		ListByOwner []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID string
+			// Namespace is the namespace argument value.
+			Namespace string
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+		// ListAllByOwner holds details about calls to the ListAllByOwner method.
+		ListAllByOwner []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID string
+			// Namespace is the namespace argument value.
+			Namespace string
+		}
+		// ResolveReferences holds details about calls to the ResolveReferences method.
+		ResolveReferences []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Metafields is the metafields argument value.
+			Metafields []*shopify.Metafield
+		}
+		// GetShopMetafield holds details about calls to the GetShopMetafield method.
+		GetShopMetafield []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner shopify.ShopMetafieldOwner
+			// Namespace is the namespace argument value.
+			Namespace string
+			// Key is the key argument value.
+			Key string
+			// V is the v argument value.
+			V any
+		}
+		// SetShopMetafield holds details about calls to the SetShopMetafield method.
+		SetShopMetafield []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Owner is the owner argument value.
+			Owner shopify.ShopMetafieldOwner
+			// Namespace is the namespace argument value.
+			Namespace string
+			// Key is the key argument value.
+			Key string
+			// Value is the value argument value.
+			Value any
+		}
+		// ListAppMetafields holds details about calls to the ListAppMetafields method.
+		ListAppMetafields []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID string
+			// SubNamespace is the subNamespace argument value.
+			SubNamespace string
+		}
+		// SetAppMetafield holds details about calls to the SetAppMetafield method.
+		SetAppMetafield []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID string
+			// SubNamespace is the subNamespace argument value.
+			SubNamespace string
+			// Key is the key argument value.
+			Key string
+			// ValueType is the valueType argument value.
+			ValueType string
+			// Value is the value argument value.
+			Value string
+		}
+		// MigratePrivateMetafields holds details about calls to the MigratePrivateMetafields method.
+		MigratePrivateMetafields []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerID is the ownerID argument value.
+			OwnerID string
+			// Namespace is the namespace argument value.
+			Namespace string
+			// DeleteMigrated is the deleteMigrated argument value.
+			DeleteMigrated bool
+		}
+		// ListStorefrontVisibilities holds details about calls to the ListStorefrontVisibilities method.
+		ListStorefrontVisibilities []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Namespace is the namespace argument value.
+			Namespace string
+		}
+		// CreateStorefrontVisibility holds details about calls to the CreateStorefrontVisibility method.
+		CreateStorefrontVisibility []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.MetafieldStorefrontVisibilityInput
+		}
+		// DeleteStorefrontVisibility holds details about calls to the DeleteStorefrontVisibility method.
+		DeleteStorefrontVisibility []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// BulkExport holds details about calls to the BulkExport method.
+		BulkExport []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OwnerType is the ownerType argument value.
+			OwnerType model.MetafieldOwnerType
+			// Namespace is the namespace argument value.
+			Namespace string
+		}
+	}
+	lockListAllShopMetafields         sync.RWMutex
+	lockListShopMetafieldsByNamespace sync.RWMutex
+	lockGetShopMetafieldByKey         sync.RWMutex
+	lockDelete                        sync.RWMutex
+	lockDeleteBulk                    sync.RWMutex
+	lockCreateBulk                    sync.RWMutex
+	lockSet                           sync.RWMutex
+	lockListByOwner                   sync.RWMutex
+	lockListAllByOwner                sync.RWMutex
+	lockResolveReferences             sync.RWMutex
+	lockGetShopMetafield              sync.RWMutex
+	lockSetShopMetafield              sync.RWMutex
+	lockListAppMetafields             sync.RWMutex
+	lockSetAppMetafield               sync.RWMutex
+	lockMigratePrivateMetafields      sync.RWMutex
+	lockListStorefrontVisibilities    sync.RWMutex
+	lockCreateStorefrontVisibility    sync.RWMutex
+	lockDeleteStorefrontVisibility    sync.RWMutex
+	lockBulkExport                    sync.RWMutex
+}
+
+// ListAllShopMetafields calls ListAllShopMetafieldsFunc.
+func (mock *MetafieldServiceMock) ListAllShopMetafields(ctx context.Context) ([]*shopify.Metafield, error) {
+	if mock.ListAllShopMetafieldsFunc == nil {
+		panic("MetafieldServiceMock.ListAllShopMetafieldsFunc: method is nil but MetafieldService.ListAllShopMetafields was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListAllShopMetafields.Lock()
+	mock.calls.ListAllShopMetafields = append(mock.calls.ListAllShopMetafields, callInfo)
+	mock.lockListAllShopMetafields.Unlock()
+	return mock.ListAllShopMetafieldsFunc(ctx)
+}
+
+// ListAllShopMetafieldsCalls returns the calls made to ListAllShopMetafields.
+func (mock *MetafieldServiceMock) ListAllShopMetafieldsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+} {
+	mock.lockListAllShopMetafields.RLock()
+	defer mock.lockListAllShopMetafields.RUnlock()
+	return mock.calls.ListAllShopMetafields
+}
+
+// ListShopMetafieldsByNamespace calls ListShopMetafieldsByNamespaceFunc.
+func (mock *MetafieldServiceMock) ListShopMetafieldsByNamespace(ctx context.Context, namespace string) ([]*shopify.Metafield, error) {
+	if mock.ListShopMetafieldsByNamespaceFunc == nil {
+		panic("MetafieldServiceMock.ListShopMetafieldsByNamespaceFunc: method is nil but MetafieldService.ListShopMetafieldsByNamespace was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Namespace is the namespace argument value.
+		Namespace string
+	}{
+		Ctx:       ctx,
+		Namespace: namespace,
+	}
+	mock.lockListShopMetafieldsByNamespace.Lock()
+	mock.calls.ListShopMetafieldsByNamespace = append(mock.calls.ListShopMetafieldsByNamespace, callInfo)
+	mock.lockListShopMetafieldsByNamespace.Unlock()
+	return mock.ListShopMetafieldsByNamespaceFunc(ctx, namespace)
+}
+
+// ListShopMetafieldsByNamespaceCalls returns the calls made to ListShopMetafieldsByNamespace.
+func (mock *MetafieldServiceMock) ListShopMetafieldsByNamespaceCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Namespace is the namespace argument value.
+	Namespace string
+} {
+	mock.lockListShopMetafieldsByNamespace.RLock()
+	defer mock.lockListShopMetafieldsByNamespace.RUnlock()
+	return mock.calls.ListShopMetafieldsByNamespace
+}
+
+// GetShopMetafieldByKey calls GetShopMetafieldByKeyFunc.
+func (mock *MetafieldServiceMock) GetShopMetafieldByKey(ctx context.Context, namespace string, key string) (*shopify.Metafield, error) {
+	if mock.GetShopMetafieldByKeyFunc == nil {
+		panic("MetafieldServiceMock.GetShopMetafieldByKeyFunc: method is nil but MetafieldService.GetShopMetafieldByKey was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Namespace is the namespace argument value.
+		Namespace string
+		// Key is the key argument value.
+		Key string
+	}{
+		Ctx:       ctx,
+		Namespace: namespace,
+		Key:       key,
+	}
+	mock.lockGetShopMetafieldByKey.Lock()
+	mock.calls.GetShopMetafieldByKey = append(mock.calls.GetShopMetafieldByKey, callInfo)
+	mock.lockGetShopMetafieldByKey.Unlock()
+	return mock.GetShopMetafieldByKeyFunc(ctx, namespace, key)
+}
+
+// GetShopMetafieldByKeyCalls returns the calls made to GetShopMetafieldByKey.
+func (mock *MetafieldServiceMock) GetShopMetafieldByKeyCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Namespace is the namespace argument value.
+	Namespace string
+	// Key is the key argument value.
+	Key string
+} {
+	mock.lockGetShopMetafieldByKey.RLock()
+	defer mock.lockGetShopMetafieldByKey.RUnlock()
+	return mock.calls.GetShopMetafieldByKey
+}
+
+// Delete calls DeleteFunc.
+func (mock *MetafieldServiceMock) Delete(ctx context.Context, input model.MetafieldDeleteInput) error {
+	if mock.DeleteFunc == nil {
+		panic("MetafieldServiceMock.DeleteFunc: method is nil but MetafieldService.Delete was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.MetafieldDeleteInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, input)
+}
+
+// DeleteCalls returns the calls made to Delete.
+func (mock *MetafieldServiceMock) DeleteCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.MetafieldDeleteInput
+} {
+	mock.lockDelete.RLock()
+	defer mock.lockDelete.RUnlock()
+	return mock.calls.Delete
+}
+
+// DeleteBulk calls DeleteBulkFunc.
+func (mock *MetafieldServiceMock) DeleteBulk(ctx context.Context, metafields []model.MetafieldIdentifierInput) error {
+	if mock.DeleteBulkFunc == nil {
+		panic("MetafieldServiceMock.DeleteBulkFunc: method is nil but MetafieldService.DeleteBulk was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Metafields is the metafields argument value.
+		Metafields []model.MetafieldIdentifierInput
+	}{
+		Ctx:        ctx,
+		Metafields: metafields,
+	}
+	mock.lockDeleteBulk.Lock()
+	mock.calls.DeleteBulk = append(mock.calls.DeleteBulk, callInfo)
+	mock.lockDeleteBulk.Unlock()
+	return mock.DeleteBulkFunc(ctx, metafields)
+}
+
+// DeleteBulkCalls returns the calls made to DeleteBulk.
+func (mock *MetafieldServiceMock) DeleteBulkCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Metafields is the metafields argument value.
+	Metafields []model.MetafieldIdentifierInput
+} {
+	mock.lockDeleteBulk.RLock()
+	defer mock.lockDeleteBulk.RUnlock()
+	return mock.calls.DeleteBulk
+}
+
+// CreateBulk calls CreateBulkFunc.
+func (mock *MetafieldServiceMock) CreateBulk(ctx context.Context, metafields []model.MetafieldsSetInput) ([]model.Metafield, error) {
+	if mock.CreateBulkFunc == nil {
+		panic("MetafieldServiceMock.CreateBulkFunc: method is nil but MetafieldService.CreateBulk was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Metafields is the metafields argument value.
+		Metafields []model.MetafieldsSetInput
+	}{
+		Ctx:        ctx,
+		Metafields: metafields,
+	}
+	mock.lockCreateBulk.Lock()
+	mock.calls.CreateBulk = append(mock.calls.CreateBulk, callInfo)
+	mock.lockCreateBulk.Unlock()
+	return mock.CreateBulkFunc(ctx, metafields)
+}
+
+// CreateBulkCalls returns the calls made to CreateBulk.
+func (mock *MetafieldServiceMock) CreateBulkCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Metafields is the metafields argument value.
+	Metafields []model.MetafieldsSetInput
+} {
+	mock.lockCreateBulk.RLock()
+	defer mock.lockCreateBulk.RUnlock()
+	return mock.calls.CreateBulk
+}
+
+// Set calls SetFunc.
+func (mock *MetafieldServiceMock) Set(ctx context.Context, metafields []model.MetafieldsSetInput) ([]shopify.MetafieldSetResult, error) {
+	if mock.SetFunc == nil {
+		panic("MetafieldServiceMock.SetFunc: method is nil but MetafieldService.Set was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Metafields is the metafields argument value.
+		Metafields []model.MetafieldsSetInput
+	}{
+		Ctx:        ctx,
+		Metafields: metafields,
+	}
+	mock.lockSet.Lock()
+	mock.calls.Set = append(mock.calls.Set, callInfo)
+	mock.lockSet.Unlock()
+	return mock.SetFunc(ctx, metafields)
+}
+
+// SetCalls returns the calls made to Set.
+func (mock *MetafieldServiceMock) SetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Metafields is the metafields argument value.
+	Metafields []model.MetafieldsSetInput
+} {
+	mock.lockSet.RLock()
+	defer mock.lockSet.RUnlock()
+	return mock.calls.Set
+}
+
+// ListByOwner calls ListByOwnerFunc.
+func (mock *MetafieldServiceMock) ListByOwner(ctx context.Context, ownerID string, namespace string, opts shopify.ListOptions) ([]*shopify.Metafield, string, error) {
+	if mock.ListByOwnerFunc == nil {
+		panic("MetafieldServiceMock.ListByOwnerFunc: method is nil but MetafieldService.ListByOwner was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// OwnerID is the ownerID argument value.
+		OwnerID string
+		// Namespace is the namespace argument value.
+		Namespace string
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:       ctx,
+		OwnerID:   ownerID,
+		Namespace: namespace,
+		Opts:      opts,
+	}
+	mock.lockListByOwner.Lock()
+	mock.calls.ListByOwner = append(mock.calls.ListByOwner, callInfo)
+	mock.lockListByOwner.Unlock()
+	return mock.ListByOwnerFunc(ctx, ownerID, namespace, opts)
+}
+
+// ListByOwnerCalls returns the calls made to ListByOwner.
+func (mock *MetafieldServiceMock) ListByOwnerCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// OwnerID is the ownerID argument value.
+	OwnerID string
+	// Namespace is the namespace argument value.
+	Namespace string
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockListByOwner.RLock()
+	defer mock.lockListByOwner.RUnlock()
+	return mock.calls.ListByOwner
+}
+
+// ListAllByOwner calls ListAllByOwnerFunc.
+func (mock *MetafieldServiceMock) ListAllByOwner(ctx context.Context, ownerID string, namespace string) ([]*shopify.Metafield, error) {
+	if mock.ListAllByOwnerFunc == nil {
+		panic("MetafieldServiceMock.ListAllByOwnerFunc: method is nil but MetafieldService.ListAllByOwner was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// OwnerID is the ownerID argument value.
+		OwnerID string
+		// Namespace is the namespace argument value.
+		Namespace string
+	}{
+		Ctx:       ctx,
+		OwnerID:   ownerID,
+		Namespace: namespace,
+	}
+	mock.lockListAllByOwner.Lock()
+	mock.calls.ListAllByOwner = append(mock.calls.ListAllByOwner, callInfo)
+	mock.lockListAllByOwner.Unlock()
+	return mock.ListAllByOwnerFunc(ctx, ownerID, namespace)
+}
+
+// ListAllByOwnerCalls returns the calls made to ListAllByOwner.
+func (mock *MetafieldServiceMock) ListAllByOwnerCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// OwnerID is the ownerID argument value.
+	OwnerID string
+	// Namespace is the namespace argument value.
+	Namespace string
+} {
+	mock.lockListAllByOwner.RLock()
+	defer mock.lockListAllByOwner.RUnlock()
+	return mock.calls.ListAllByOwner
+}
+
+// ResolveReferences calls ResolveReferencesFunc.
+func (mock *MetafieldServiceMock) ResolveReferences(ctx context.Context, metafields []*shopify.Metafield) ([]shopify.ResolvedMetafield, error) {
+	if mock.ResolveReferencesFunc == nil {
+		panic("MetafieldServiceMock.ResolveReferencesFunc: method is nil but MetafieldService.ResolveReferences was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Metafields is the metafields argument value.
+		Metafields []*shopify.Metafield
+	}{
+		Ctx:        ctx,
+		Metafields: metafields,
+	}
+	mock.lockResolveReferences.Lock()
+	mock.calls.ResolveReferences = append(mock.calls.ResolveReferences, callInfo)
+	mock.lockResolveReferences.Unlock()
+	return mock.ResolveReferencesFunc(ctx, metafields)
+}
+
+// ResolveReferencesCalls returns the calls made to ResolveReferences.
+func (mock *MetafieldServiceMock) ResolveReferencesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Metafields is the metafields argument value.
+	Metafields []*shopify.Metafield
+} {
+	mock.lockResolveReferences.RLock()
+	defer mock.lockResolveReferences.RUnlock()
+	return mock.calls.ResolveReferences
+}
+
+// GetShopMetafield calls GetShopMetafieldFunc.
+func (mock *MetafieldServiceMock) GetShopMetafield(ctx context.Context, owner shopify.ShopMetafieldOwner, namespace string, key string, v any) (*shopify.Metafield, error) {
+	if mock.GetShopMetafieldFunc == nil {
+		panic("MetafieldServiceMock.GetShopMetafieldFunc: method is nil but MetafieldService.GetShopMetafield was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Owner is the owner argument value.
+		Owner shopify.ShopMetafieldOwner
+		// Namespace is the namespace argument value.
+		Namespace string
+		// Key is the key argument value.
+		Key string
+		// V is the v argument value.
+		V any
+	}{
+		Ctx:       ctx,
+		Owner:     owner,
+		Namespace: namespace,
+		Key:       key,
+		V:         v,
+	}
+	mock.lockGetShopMetafield.Lock()
+	mock.calls.GetShopMetafield = append(mock.calls.GetShopMetafield, callInfo)
+	mock.lockGetShopMetafield.Unlock()
+	return mock.GetShopMetafieldFunc(ctx, owner, namespace, key, v)
+}
+
+// GetShopMetafieldCalls returns the calls made to GetShopMetafield.
+func (mock *MetafieldServiceMock) GetShopMetafieldCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Owner is the owner argument value.
+	Owner shopify.ShopMetafieldOwner
+	// Namespace is the namespace argument value.
+	Namespace string
+	// Key is the key argument value.
+	Key string
+	// V is the v argument value.
+	V any
+} {
+	mock.lockGetShopMetafield.RLock()
+	defer mock.lockGetShopMetafield.RUnlock()
+	return mock.calls.GetShopMetafield
+}
+
+// SetShopMetafield calls SetShopMetafieldFunc.
+func (mock *MetafieldServiceMock) SetShopMetafield(ctx context.Context, owner shopify.ShopMetafieldOwner, namespace string, key string, value any) (*shopify.Metafield, error) {
+	if mock.SetShopMetafieldFunc == nil {
+		panic("MetafieldServiceMock.SetShopMetafieldFunc: method is nil but MetafieldService.SetShopMetafield was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Owner is the owner argument value.
+		Owner shopify.ShopMetafieldOwner
+		// Namespace is the namespace argument value.
+		Namespace string
+		// Key is the key argument value.
+		Key string
+		// Value is the value argument value.
+		Value any
+	}{
+		Ctx:       ctx,
+		Owner:     owner,
+		Namespace: namespace,
+		Key:       key,
+		Value:     value,
+	}
+	mock.lockSetShopMetafield.Lock()
+	mock.calls.SetShopMetafield = append(mock.calls.SetShopMetafield, callInfo)
+	mock.lockSetShopMetafield.Unlock()
+	return mock.SetShopMetafieldFunc(ctx, owner, namespace, key, value)
+}
+
+// SetShopMetafieldCalls returns the calls made to SetShopMetafield.
+func (mock *MetafieldServiceMock) SetShopMetafieldCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Owner is the owner argument value.
+	Owner shopify.ShopMetafieldOwner
+	// Namespace is the namespace argument value.
+	Namespace string
+	// Key is the key argument value.
+	Key string
+	// Value is the value argument value.
+	Value any
+} {
+	mock.lockSetShopMetafield.RLock()
+	defer mock.lockSetShopMetafield.RUnlock()
+	return mock.calls.SetShopMetafield
+}
+
+// ListAppMetafields calls ListAppMetafieldsFunc.
+func (mock *MetafieldServiceMock) ListAppMetafields(ctx context.Context, ownerID string, subNamespace string) ([]*shopify.Metafield, error) {
+	if mock.ListAppMetafieldsFunc == nil {
+		panic("MetafieldServiceMock.ListAppMetafieldsFunc: method is nil but MetafieldService.ListAppMetafields was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// OwnerID is the ownerID argument value.
+		OwnerID string
+		// SubNamespace is the subNamespace argument value.
+		SubNamespace string
+	}{
+		Ctx:          ctx,
+		OwnerID:      ownerID,
+		SubNamespace: subNamespace,
+	}
+	mock.lockListAppMetafields.Lock()
+	mock.calls.ListAppMetafields = append(mock.calls.ListAppMetafields, callInfo)
+	mock.lockListAppMetafields.Unlock()
+	return mock.ListAppMetafieldsFunc(ctx, ownerID, subNamespace)
+}
+
+// ListAppMetafieldsCalls returns the calls made to ListAppMetafields.
+func (mock *MetafieldServiceMock) ListAppMetafieldsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// OwnerID is the ownerID argument value.
+	OwnerID string
+	// SubNamespace is the subNamespace argument value.
+	SubNamespace string
+} {
+	mock.lockListAppMetafields.RLock()
+	defer mock.lockListAppMetafields.RUnlock()
+	return mock.calls.ListAppMetafields
+}
+
+// SetAppMetafield calls SetAppMetafieldFunc.
+func (mock *MetafieldServiceMock) SetAppMetafield(ctx context.Context, ownerID string, subNamespace string, key string, valueType string, value string) (*shopify.Metafield, error) {
+	if mock.SetAppMetafieldFunc == nil {
+		panic("MetafieldServiceMock.SetAppMetafieldFunc: method is nil but MetafieldService.SetAppMetafield was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// OwnerID is the ownerID argument value.
+		OwnerID string
+		// SubNamespace is the subNamespace argument value.
+		SubNamespace string
+		// Key is the key argument value.
+		Key string
+		// ValueType is the valueType argument value.
+		ValueType string
+		// Value is the value argument value.
+		Value string
+	}{
+		Ctx:          ctx,
+		OwnerID:      ownerID,
+		SubNamespace: subNamespace,
+		Key:          key,
+		ValueType:    valueType,
+		Value:        value,
+	}
+	mock.lockSetAppMetafield.Lock()
+	mock.calls.SetAppMetafield = append(mock.calls.SetAppMetafield, callInfo)
+	mock.lockSetAppMetafield.Unlock()
+	return mock.SetAppMetafieldFunc(ctx, ownerID, subNamespace, key, valueType, value)
+}
+
+// SetAppMetafieldCalls returns the calls made to SetAppMetafield.
+func (mock *MetafieldServiceMock) SetAppMetafieldCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// OwnerID is the ownerID argument value.
+	OwnerID string
+	// SubNamespace is the subNamespace argument value.
+	SubNamespace string
+	// Key is the key argument value.
+	Key string
+	// ValueType is the valueType argument value.
+	ValueType string
+	// Value is the value argument value.
+	Value string
+} {
+	mock.lockSetAppMetafield.RLock()
+	defer mock.lockSetAppMetafield.RUnlock()
+	return mock.calls.SetAppMetafield
+}
+
+// MigratePrivateMetafields calls MigratePrivateMetafieldsFunc.
+func (mock *MetafieldServiceMock) MigratePrivateMetafields(ctx context.Context, ownerID string, namespace string, deleteMigrated bool) ([]*shopify.Metafield, error) {
+	if mock.MigratePrivateMetafieldsFunc == nil {
+		panic("MetafieldServiceMock.MigratePrivateMetafieldsFunc: method is nil but MetafieldService.MigratePrivateMetafields was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// OwnerID is the ownerID argument value.
+		OwnerID string
+		// Namespace is the namespace argument value.
+		Namespace string
+		// DeleteMigrated is the deleteMigrated argument value.
+		DeleteMigrated bool
+	}{
+		Ctx:            ctx,
+		OwnerID:        ownerID,
+		Namespace:      namespace,
+		DeleteMigrated: deleteMigrated,
+	}
+	mock.lockMigratePrivateMetafields.Lock()
+	mock.calls.MigratePrivateMetafields = append(mock.calls.MigratePrivateMetafields, callInfo)
+	mock.lockMigratePrivateMetafields.Unlock()
+	return mock.MigratePrivateMetafieldsFunc(ctx, ownerID, namespace, deleteMigrated)
+}
+
+// MigratePrivateMetafieldsCalls returns the calls made to MigratePrivateMetafields.
+func (mock *MetafieldServiceMock) MigratePrivateMetafieldsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// OwnerID is the ownerID argument value.
+	OwnerID string
+	// Namespace is the namespace argument value.
+	Namespace string
+	// DeleteMigrated is the deleteMigrated argument value.
+	DeleteMigrated bool
+} {
+	mock.lockMigratePrivateMetafields.RLock()
+	defer mock.lockMigratePrivateMetafields.RUnlock()
+	return mock.calls.MigratePrivateMetafields
+}
+
+// ListStorefrontVisibilities calls ListStorefrontVisibilitiesFunc.
+func (mock *MetafieldServiceMock) ListStorefrontVisibilities(ctx context.Context, namespace string) ([]*model.MetafieldStorefrontVisibility, error) {
+	if mock.ListStorefrontVisibilitiesFunc == nil {
+		panic("MetafieldServiceMock.ListStorefrontVisibilitiesFunc: method is nil but MetafieldService.ListStorefrontVisibilities was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Namespace is the namespace argument value.
+		Namespace string
+	}{
+		Ctx:       ctx,
+		Namespace: namespace,
+	}
+	mock.lockListStorefrontVisibilities.Lock()
+	mock.calls.ListStorefrontVisibilities = append(mock.calls.ListStorefrontVisibilities, callInfo)
+	mock.lockListStorefrontVisibilities.Unlock()
+	return mock.ListStorefrontVisibilitiesFunc(ctx, namespace)
+}
+
+// ListStorefrontVisibilitiesCalls returns the calls made to ListStorefrontVisibilities.
+func (mock *MetafieldServiceMock) ListStorefrontVisibilitiesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Namespace is the namespace argument value.
+	Namespace string
+} {
+	mock.lockListStorefrontVisibilities.RLock()
+	defer mock.lockListStorefrontVisibilities.RUnlock()
+	return mock.calls.ListStorefrontVisibilities
+}
+
+// CreateStorefrontVisibility calls CreateStorefrontVisibilityFunc.
+func (mock *MetafieldServiceMock) CreateStorefrontVisibility(ctx context.Context, input model.MetafieldStorefrontVisibilityInput) (*model.MetafieldStorefrontVisibility, error) {
+	if mock.CreateStorefrontVisibilityFunc == nil {
+		panic("MetafieldServiceMock.CreateStorefrontVisibilityFunc: method is nil but MetafieldService.CreateStorefrontVisibility was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.MetafieldStorefrontVisibilityInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCreateStorefrontVisibility.Lock()
+	mock.calls.CreateStorefrontVisibility = append(mock.calls.CreateStorefrontVisibility, callInfo)
+	mock.lockCreateStorefrontVisibility.Unlock()
+	return mock.CreateStorefrontVisibilityFunc(ctx, input)
+}
+
+// CreateStorefrontVisibilityCalls returns the calls made to CreateStorefrontVisibility.
+func (mock *MetafieldServiceMock) CreateStorefrontVisibilityCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.MetafieldStorefrontVisibilityInput
+} {
+	mock.lockCreateStorefrontVisibility.RLock()
+	defer mock.lockCreateStorefrontVisibility.RUnlock()
+	return mock.calls.CreateStorefrontVisibility
+}
+
+// DeleteStorefrontVisibility calls DeleteStorefrontVisibilityFunc.
+func (mock *MetafieldServiceMock) DeleteStorefrontVisibility(ctx context.Context, id string) error {
+	if mock.DeleteStorefrontVisibilityFunc == nil {
+		panic("MetafieldServiceMock.DeleteStorefrontVisibilityFunc: method is nil but MetafieldService.DeleteStorefrontVisibility was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockDeleteStorefrontVisibility.Lock()
+	mock.calls.DeleteStorefrontVisibility = append(mock.calls.DeleteStorefrontVisibility, callInfo)
+	mock.lockDeleteStorefrontVisibility.Unlock()
+	return mock.DeleteStorefrontVisibilityFunc(ctx, id)
+}
+
+// DeleteStorefrontVisibilityCalls returns the calls made to DeleteStorefrontVisibility.
+func (mock *MetafieldServiceMock) DeleteStorefrontVisibilityCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockDeleteStorefrontVisibility.RLock()
+	defer mock.lockDeleteStorefrontVisibility.RUnlock()
+	return mock.calls.DeleteStorefrontVisibility
+}
+
+// BulkExport calls BulkExportFunc.
+func (mock *MetafieldServiceMock) BulkExport(ctx context.Context, ownerType model.MetafieldOwnerType, namespace string) ([]shopify.OwnedMetafield, error) {
+	if mock.BulkExportFunc == nil {
+		panic("MetafieldServiceMock.BulkExportFunc: method is nil but MetafieldService.BulkExport was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// OwnerType is the ownerType argument value.
+		OwnerType model.MetafieldOwnerType
+		// Namespace is the namespace argument value.
+		Namespace string
+	}{
+		Ctx:       ctx,
+		OwnerType: ownerType,
+		Namespace: namespace,
+	}
+	mock.lockBulkExport.Lock()
+	mock.calls.BulkExport = append(mock.calls.BulkExport, callInfo)
+	mock.lockBulkExport.Unlock()
+	return mock.BulkExportFunc(ctx, ownerType, namespace)
+}
+
+// BulkExportCalls returns the calls made to BulkExport.
+func (mock *MetafieldServiceMock) BulkExportCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// OwnerType is the ownerType argument value.
+	OwnerType model.MetafieldOwnerType
+	// Namespace is the namespace argument value.
+	Namespace string
+} {
+	mock.lockBulkExport.RLock()
+	defer mock.lockBulkExport.RUnlock()
+	return mock.calls.BulkExport
+}
+
+var _ shopify.OrderService = &OrderServiceMock{}
+
+// OrderServiceMock is a mock implementation of shopify.OrderService.
+type OrderServiceMock struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id graphql.ID) (*shopify.OrderQueryResult, error)
+
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, opts shopify.ListOptions) ([]*shopify.Order, error)
+
+	// ListAllFunc mocks the ListAll method.
+	ListAllFunc func(ctx context.Context) ([]*shopify.Order, error)
+
+	// ListAfterCursorFunc mocks the ListAfterCursor method.
+	ListAfterCursorFunc func(ctx context.Context, opts shopify.ListOptions) ([]*shopify.OrderQueryResult, string, string, error)
+
+	// IterateFunc mocks the Iterate method.
+	IterateFunc func(ctx context.Context, query string, sortKey model.OrderSortKeys) *shopify.OrderIterator
+
+	// IterateFromFunc mocks the IterateFrom method.
+	IterateFromFunc func(ctx context.Context, query string, sortKey model.OrderSortKeys, after string) *shopify.OrderIterator
+
+	// CountFunc mocks the Count method.
+	CountFunc func(ctx context.Context, query string) (*model.Count, error)
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, input shopify.OrderInput) error
+
+	// GetFulfillmentOrdersAtLocationFunc mocks the GetFulfillmentOrdersAtLocation method.
+	GetFulfillmentOrdersAtLocationFunc func(ctx context.Context, orderID graphql.ID, locationID graphql.ID) ([]shopify.FulfillmentOrder, error)
+
+	// RefundFunc mocks the Refund method.
+	RefundFunc func(ctx context.Context, input model.RefundInput) (*model.Refund, error)
+
+	// SuggestedRefundFunc mocks the SuggestedRefund method.
+	SuggestedRefundFunc func(ctx context.Context, orderID string, lineItems []model.RefundLineItemInput, refundShipping bool) (*model.SuggestedRefund, error)
+
+	// CaptureFunc mocks the Capture method.
+	CaptureFunc func(ctx context.Context, input model.OrderCaptureInput) (*shopify.OrderTransaction, error)
+
+	// MarkAsPaidFunc mocks the MarkAsPaid method.
+	MarkAsPaidFunc func(ctx context.Context, id graphql.ID) (*shopify.OrderBase, error)
+
+	// VoidFunc mocks the Void method.
+	VoidFunc func(ctx context.Context, parentTransactionID graphql.ID) (*shopify.OrderTransaction, error)
+
+	// ListTransactionsFunc mocks the ListTransactions method.
+	ListTransactionsFunc func(ctx context.Context, orderID graphql.ID) ([]shopify.OrderTransaction, error)
+
+	// GetPaymentTermsFunc mocks the GetPaymentTerms method.
+	GetPaymentTermsFunc func(ctx context.Context, referenceID string) (*model.PaymentTerms, error)
+
+	// CreatePaymentTermsFunc mocks the CreatePaymentTerms method.
+	CreatePaymentTermsFunc func(ctx context.Context, referenceID string, attrs model.PaymentTermsCreateInput) (*model.PaymentTerms, error)
+
+	// UpdatePaymentTermsFunc mocks the UpdatePaymentTerms method.
+	UpdatePaymentTermsFunc func(ctx context.Context, input model.PaymentTermsUpdateInput) (*model.PaymentTerms, error)
+
+	// DeletePaymentTermsFunc mocks the DeletePaymentTerms method.
+	DeletePaymentTermsFunc func(ctx context.Context, paymentTermsID string) error
+
+	// SendInvoiceFunc mocks the SendInvoice method.
+	SendInvoiceFunc func(ctx context.Context, orderID string, email *model.EmailInput) error
+
+	// SendDraftOrderInvoiceFunc mocks the SendDraftOrderInvoice method.
+	SendDraftOrderInvoiceFunc func(ctx context.Context, draftOrderID string, email *model.EmailInput) (*model.DraftOrder, error)
+
+	// BeginEditFunc mocks the BeginEdit method.
+	BeginEditFunc func(ctx context.Context, orderID string) (*model.CalculatedOrder, error)
+
+	// EditSetQuantityFunc mocks the EditSetQuantity method.
+	EditSetQuantityFunc func(ctx context.Context, calculatedOrderID string, calculatedLineItemID string, quantity int, restock bool) (*model.CalculatedOrder, error)
+
+	// EditAddVariantFunc mocks the EditAddVariant method.
+	EditAddVariantFunc func(ctx context.Context, calculatedOrderID string, variantID string, quantity int, locationID *string) (*model.CalculatedOrder, error)
+
+	// CommitEditFunc mocks the CommitEdit method.
+	CommitEditFunc func(ctx context.Context, calculatedOrderID string, notifyCustomer bool, staffNote *string) (*shopify.OrderEditCommitResult, error)
+
+	// ExchangeFunc mocks the Exchange method.
+	ExchangeFunc func(ctx context.Context, input shopify.OrderExchangeInput) (*shopify.OrderExchangeResult, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id graphql.ID
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+		// ListAll holds details about calls to the ListAll method.
+		ListAll []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// ListAfterCursor holds details about calls to the ListAfterCursor method.
+		ListAfterCursor []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+		// Iterate holds details about calls to the Iterate method.
+		Iterate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
+			// SortKey is the sortKey argument value.
+			SortKey model.OrderSortKeys
+		}
+		// IterateFrom holds details about calls to the IterateFrom method.
+		IterateFrom []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
+			// SortKey is the sortKey argument value.
+			SortKey model.OrderSortKeys
+			// After is the after argument value.
+			After string
+		}
+		// Count holds details about calls to the Count method.
+		Count []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input shopify.OrderInput
+		}
+		// GetFulfillmentOrdersAtLocation holds details about calls to the GetFulfillmentOrdersAtLocation method.
+		GetFulfillmentOrdersAtLocation []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrderID is the orderID argument value.
+			OrderID graphql.ID
+			// LocationID is the locationID argument value.
+			LocationID graphql.ID
+		}
+		// Refund holds details about calls to the Refund method.
+		Refund []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.RefundInput
+		}
+		// SuggestedRefund holds details about calls to the SuggestedRefund method.
+		SuggestedRefund []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrderID is the orderID argument value.
+			OrderID string
+			// LineItems is the lineItems argument value.
+			LineItems []model.RefundLineItemInput
+			// RefundShipping is the refundShipping argument value.
+			RefundShipping bool
+		}
+		// Capture holds details about calls to the Capture method.
+		Capture []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.OrderCaptureInput
+		}
+		// MarkAsPaid holds details about calls to the MarkAsPaid method.
+		MarkAsPaid []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id graphql.ID
+		}
+		// Void holds details about calls to the Void method.
+		Void []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ParentTransactionID is the parentTransactionID argument value.
+			ParentTransactionID graphql.ID
+		}
+		// ListTransactions holds details about calls to the ListTransactions method.
+		ListTransactions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrderID is the orderID argument value.
+			OrderID graphql.ID
+		}
+		// GetPaymentTerms holds details about calls to the GetPaymentTerms method.
+		GetPaymentTerms []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ReferenceID is the referenceID argument value.
+			ReferenceID string
+		}
+		// CreatePaymentTerms holds details about calls to the CreatePaymentTerms method.
+		CreatePaymentTerms []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ReferenceID is the referenceID argument value.
+			ReferenceID string
+			// Attrs is the attrs argument value.
+			Attrs model.PaymentTermsCreateInput
+		}
+		// UpdatePaymentTerms holds details about calls to the UpdatePaymentTerms method.
+		UpdatePaymentTerms []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.PaymentTermsUpdateInput
+		}
+		// DeletePaymentTerms holds details about calls to the DeletePaymentTerms method.
+		DeletePaymentTerms []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// PaymentTermsID is the paymentTermsID argument value.
+			PaymentTermsID string
+		}
+		// SendInvoice holds details about calls to the SendInvoice method.
+		SendInvoice []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrderID is the orderID argument value.
+			OrderID string
+			// Email is the email argument value.
+			Email *model.EmailInput
+		}
+		// SendDraftOrderInvoice holds details about calls to the SendDraftOrderInvoice method.
+		SendDraftOrderInvoice []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// DraftOrderID is the draftOrderID argument value.
+			DraftOrderID string
+			// Email is the email argument value.
+			Email *model.EmailInput
+		}
+		// BeginEdit holds details about calls to the BeginEdit method.
+		BeginEdit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// OrderID is the orderID argument value.
+			OrderID string
+		}
+		// EditSetQuantity holds details about calls to the EditSetQuantity method.
+		EditSetQuantity []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CalculatedOrderID is the calculatedOrderID argument value.
+			CalculatedOrderID string
+			// CalculatedLineItemID is the calculatedLineItemID argument value.
+			CalculatedLineItemID string
+			// Quantity is the quantity argument value.
+			Quantity int
+			// Restock is the restock argument value.
+			Restock bool
+		}
+		// EditAddVariant holds details about calls to the EditAddVariant method.
+		EditAddVariant []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CalculatedOrderID is the calculatedOrderID argument value.
+			CalculatedOrderID string
+			// VariantID is the variantID argument value.
+			VariantID string
+			// Quantity is the quantity argument value.
+			Quantity int
+			// LocationID is the locationID argument value.
+			LocationID *string
+		}
+		// CommitEdit holds details about calls to the CommitEdit method.
+		CommitEdit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CalculatedOrderID is the calculatedOrderID argument value.
+			CalculatedOrderID string
+			// NotifyCustomer is the notifyCustomer argument value.
+			NotifyCustomer bool
+			// StaffNote is the staffNote argument value.
+			StaffNote *string
+		}
+		// Exchange holds details about calls to the Exchange method.
+		Exchange []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input shopify.OrderExchangeInput
+		}
+	}
+	lockGet                            sync.RWMutex
+	lockList                           sync.RWMutex
+	lockListAll                        sync.RWMutex
+	lockListAfterCursor                sync.RWMutex
+	lockIterate                        sync.RWMutex
+	lockIterateFrom                    sync.RWMutex
+	lockCount                          sync.RWMutex
+	lockUpdate                         sync.RWMutex
+	lockGetFulfillmentOrdersAtLocation sync.RWMutex
+	lockRefund                         sync.RWMutex
+	lockSuggestedRefund                sync.RWMutex
+	lockCapture                        sync.RWMutex
+	lockMarkAsPaid                     sync.RWMutex
+	lockVoid                           sync.RWMutex
+	lockListTransactions               sync.RWMutex
+	lockGetPaymentTerms                sync.RWMutex
+	lockCreatePaymentTerms             sync.RWMutex
+	lockUpdatePaymentTerms             sync.RWMutex
+	lockDeletePaymentTerms             sync.RWMutex
+	lockSendInvoice                    sync.RWMutex
+	lockSendDraftOrderInvoice          sync.RWMutex
+	lockBeginEdit                      sync.RWMutex
+	lockEditSetQuantity                sync.RWMutex
+	lockEditAddVariant                 sync.RWMutex
+	lockCommitEdit                     sync.RWMutex
+	lockExchange                       sync.RWMutex
+}
+
+// Get calls GetFunc.
+func (mock *OrderServiceMock) Get(ctx context.Context, id graphql.ID) (*shopify.OrderQueryResult, error) {
+	if mock.GetFunc == nil {
+		panic("OrderServiceMock.GetFunc: method is nil but OrderService.Get was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id graphql.ID
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls returns the calls made to Get.
+func (mock *OrderServiceMock) GetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id graphql.ID
+} {
+	mock.lockGet.RLock()
+	defer mock.lockGet.RUnlock()
+	return mock.calls.Get
+}
+
+// List calls ListFunc.
+func (mock *OrderServiceMock) List(ctx context.Context, opts shopify.ListOptions) ([]*shopify.Order, error) {
+	if mock.ListFunc == nil {
+		panic("OrderServiceMock.ListFunc: method is nil but OrderService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, opts)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *OrderServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// ListAll calls ListAllFunc.
+func (mock *OrderServiceMock) ListAll(ctx context.Context) ([]*shopify.Order, error) {
+	if mock.ListAllFunc == nil {
+		panic("OrderServiceMock.ListAllFunc: method is nil but OrderService.ListAll was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockListAll.Lock()
+	mock.calls.ListAll = append(mock.calls.ListAll, callInfo)
+	mock.lockListAll.Unlock()
+	return mock.ListAllFunc(ctx)
+}
+
+// ListAllCalls returns the calls made to ListAll.
+func (mock *OrderServiceMock) ListAllCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+} {
+	mock.lockListAll.RLock()
+	defer mock.lockListAll.RUnlock()
+	return mock.calls.ListAll
+}
+
+// ListAfterCursor calls ListAfterCursorFunc.
+func (mock *OrderServiceMock) ListAfterCursor(ctx context.Context, opts shopify.ListOptions) ([]*shopify.OrderQueryResult, string, string, error) {
+	if mock.ListAfterCursorFunc == nil {
+		panic("OrderServiceMock.ListAfterCursorFunc: method is nil but OrderService.ListAfterCursor was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockListAfterCursor.Lock()
+	mock.calls.ListAfterCursor = append(mock.calls.ListAfterCursor, callInfo)
+	mock.lockListAfterCursor.Unlock()
+	return mock.ListAfterCursorFunc(ctx, opts)
+}
+
+// ListAfterCursorCalls returns the calls made to ListAfterCursor.
+func (mock *OrderServiceMock) ListAfterCursorCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockListAfterCursor.RLock()
+	defer mock.lockListAfterCursor.RUnlock()
+	return mock.calls.ListAfterCursor
+}
+
+// Iterate calls IterateFunc.
+func (mock *OrderServiceMock) Iterate(ctx context.Context, query string, sortKey model.OrderSortKeys) *shopify.OrderIterator {
+	if mock.IterateFunc == nil {
+		panic("OrderServiceMock.IterateFunc: method is nil but OrderService.Iterate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Query is the query argument value.
+		Query string
+		// SortKey is the sortKey argument value.
+		SortKey model.OrderSortKeys
+	}{
+		Ctx:     ctx,
+		Query:   query,
+		SortKey: sortKey,
+	}
+	mock.lockIterate.Lock()
+	mock.calls.Iterate = append(mock.calls.Iterate, callInfo)
+	mock.lockIterate.Unlock()
+	return mock.IterateFunc(ctx, query, sortKey)
+}
+
+// IterateCalls returns the calls made to Iterate.
+func (mock *OrderServiceMock) IterateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Query is the query argument value.
+	Query string
+	// SortKey is the sortKey argument value.
+	SortKey model.OrderSortKeys
+} {
+	mock.lockIterate.RLock()
+	defer mock.lockIterate.RUnlock()
+	return mock.calls.Iterate
+}
+
+// IterateFrom calls IterateFromFunc.
+func (mock *OrderServiceMock) IterateFrom(ctx context.Context, query string, sortKey model.OrderSortKeys, after string) *shopify.OrderIterator {
+	if mock.IterateFromFunc == nil {
+		panic("OrderServiceMock.IterateFromFunc: method is nil but OrderService.IterateFrom was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Query is the query argument value.
+		Query string
+		// SortKey is the sortKey argument value.
+		SortKey model.OrderSortKeys
+		// After is the after argument value.
+		After string
+	}{
+		Ctx:     ctx,
+		Query:   query,
+		SortKey: sortKey,
+		After:   after,
+	}
+	mock.lockIterateFrom.Lock()
+	mock.calls.IterateFrom = append(mock.calls.IterateFrom, callInfo)
+	mock.lockIterateFrom.Unlock()
+	return mock.IterateFromFunc(ctx, query, sortKey, after)
+}
+
+// IterateFromCalls returns the calls made to IterateFrom.
+func (mock *OrderServiceMock) IterateFromCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Query is the query argument value.
+	Query string
+	// SortKey is the sortKey argument value.
+	SortKey model.OrderSortKeys
+	// After is the after argument value.
+	After string
+} {
+	mock.lockIterateFrom.RLock()
+	defer mock.lockIterateFrom.RUnlock()
+	return mock.calls.IterateFrom
+}
+
+// Count calls CountFunc.
+func (mock *OrderServiceMock) Count(ctx context.Context, query string) (*model.Count, error) {
+	if mock.CountFunc == nil {
+		panic("OrderServiceMock.CountFunc: method is nil but OrderService.Count was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Query is the query argument value.
+		Query string
+	}{
+		Ctx:   ctx,
+		Query: query,
+	}
+	mock.lockCount.Lock()
+	mock.calls.Count = append(mock.calls.Count, callInfo)
+	mock.lockCount.Unlock()
+	return mock.CountFunc(ctx, query)
+}
+
+// CountCalls returns the calls made to Count.
+func (mock *OrderServiceMock) CountCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Query is the query argument value.
+	Query string
+} {
+	mock.lockCount.RLock()
+	defer mock.lockCount.RUnlock()
+	return mock.calls.Count
+}
+
+// Update calls UpdateFunc.
+func (mock *OrderServiceMock) Update(ctx context.Context, input shopify.OrderInput) error {
+	if mock.UpdateFunc == nil {
+		panic("OrderServiceMock.UpdateFunc: method is nil but OrderService.Update was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input shopify.OrderInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, input)
+}
+
+// UpdateCalls returns the calls made to Update.
+func (mock *OrderServiceMock) UpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input shopify.OrderInput
+} {
+	mock.lockUpdate.RLock()
+	defer mock.lockUpdate.RUnlock()
+	return mock.calls.Update
+}
+
+// GetFulfillmentOrdersAtLocation calls GetFulfillmentOrdersAtLocationFunc.
+func (mock *OrderServiceMock) GetFulfillmentOrdersAtLocation(ctx context.Context, orderID graphql.ID, locationID graphql.ID) ([]shopify.FulfillmentOrder, error) {
+	if mock.GetFulfillmentOrdersAtLocationFunc == nil {
+		panic("OrderServiceMock.GetFulfillmentOrdersAtLocationFunc: method is nil but OrderService.GetFulfillmentOrdersAtLocation was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// OrderID is the orderID argument value.
+		OrderID graphql.ID
+		// LocationID is the locationID argument value.
+		LocationID graphql.ID
+	}{
+		Ctx:        ctx,
+		OrderID:    orderID,
+		LocationID: locationID,
+	}
+	mock.lockGetFulfillmentOrdersAtLocation.Lock()
+	mock.calls.GetFulfillmentOrdersAtLocation = append(mock.calls.GetFulfillmentOrdersAtLocation, callInfo)
+	mock.lockGetFulfillmentOrdersAtLocation.Unlock()
+	return mock.GetFulfillmentOrdersAtLocationFunc(ctx, orderID, locationID)
+}
+
+// GetFulfillmentOrdersAtLocationCalls returns the calls made to GetFulfillmentOrdersAtLocation.
+func (mock *OrderServiceMock) GetFulfillmentOrdersAtLocationCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// OrderID is the orderID argument value.
+	OrderID graphql.ID
+	// LocationID is the locationID argument value.
+	LocationID graphql.ID
+} {
+	mock.lockGetFulfillmentOrdersAtLocation.RLock()
+	defer mock.lockGetFulfillmentOrdersAtLocation.RUnlock()
+	return mock.calls.GetFulfillmentOrdersAtLocation
+}
+
+// Refund calls RefundFunc.
+func (mock *OrderServiceMock) Refund(ctx context.Context, input model.RefundInput) (*model.Refund, error) {
+	if mock.RefundFunc == nil {
+		panic("OrderServiceMock.RefundFunc: method is nil but OrderService.Refund was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.RefundInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockRefund.Lock()
+	mock.calls.Refund = append(mock.calls.Refund, callInfo)
+	mock.lockRefund.Unlock()
+	return mock.RefundFunc(ctx, input)
+}
+
+// RefundCalls returns the calls made to Refund.
+func (mock *OrderServiceMock) RefundCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.RefundInput
+} {
+	mock.lockRefund.RLock()
+	defer mock.lockRefund.RUnlock()
+	return mock.calls.Refund
+}
+
+// SuggestedRefund calls SuggestedRefundFunc.
+func (mock *OrderServiceMock) SuggestedRefund(ctx context.Context, orderID string, lineItems []model.RefundLineItemInput, refundShipping bool) (*model.SuggestedRefund, error) {
+	if mock.SuggestedRefundFunc == nil {
+		panic("OrderServiceMock.SuggestedRefundFunc: method is nil but OrderService.SuggestedRefund was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// OrderID is the orderID argument value.
+		OrderID string
+		// LineItems is the lineItems argument value.
+		LineItems []model.RefundLineItemInput
+		// RefundShipping is the refundShipping argument value.
+		RefundShipping bool
+	}{
+		Ctx:            ctx,
+		OrderID:        orderID,
+		LineItems:      lineItems,
+		RefundShipping: refundShipping,
+	}
+	mock.lockSuggestedRefund.Lock()
+	mock.calls.SuggestedRefund = append(mock.calls.SuggestedRefund, callInfo)
+	mock.lockSuggestedRefund.Unlock()
+	return mock.SuggestedRefundFunc(ctx, orderID, lineItems, refundShipping)
+}
+
+// SuggestedRefundCalls returns the calls made to SuggestedRefund.
+func (mock *OrderServiceMock) SuggestedRefundCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// OrderID is the orderID argument value.
+	OrderID string
+	// LineItems is the lineItems argument value.
+	LineItems []model.RefundLineItemInput
+	// RefundShipping is the refundShipping argument value.
+	RefundShipping bool
+} {
+	mock.lockSuggestedRefund.RLock()
+	defer mock.lockSuggestedRefund.RUnlock()
+	return mock.calls.SuggestedRefund
+}
+
+// Capture calls CaptureFunc.
+func (mock *OrderServiceMock) Capture(ctx context.Context, input model.OrderCaptureInput) (*shopify.OrderTransaction, error) {
+	if mock.CaptureFunc == nil {
+		panic("OrderServiceMock.CaptureFunc: method is nil but OrderService.Capture was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.OrderCaptureInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCapture.Lock()
+	mock.calls.Capture = append(mock.calls.Capture, callInfo)
+	mock.lockCapture.Unlock()
+	return mock.CaptureFunc(ctx, input)
+}
+
+// CaptureCalls returns the calls made to Capture.
+func (mock *OrderServiceMock) CaptureCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.OrderCaptureInput
+} {
+	mock.lockCapture.RLock()
+	defer mock.lockCapture.RUnlock()
+	return mock.calls.Capture
+}
+
+// MarkAsPaid calls MarkAsPaidFunc.
+func (mock *OrderServiceMock) MarkAsPaid(ctx context.Context, id graphql.ID) (*shopify.OrderBase, error) {
+	if mock.MarkAsPaidFunc == nil {
+		panic("OrderServiceMock.MarkAsPaidFunc: method is nil but OrderService.MarkAsPaid was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id graphql.ID
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockMarkAsPaid.Lock()
+	mock.calls.MarkAsPaid = append(mock.calls.MarkAsPaid, callInfo)
+	mock.lockMarkAsPaid.Unlock()
+	return mock.MarkAsPaidFunc(ctx, id)
+}
+
+// MarkAsPaidCalls returns the calls made to MarkAsPaid.
+func (mock *OrderServiceMock) MarkAsPaidCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id graphql.ID
+} {
+	mock.lockMarkAsPaid.RLock()
+	defer mock.lockMarkAsPaid.RUnlock()
+	return mock.calls.MarkAsPaid
+}
+
+// Void calls VoidFunc.
+func (mock *OrderServiceMock) Void(ctx context.Context, parentTransactionID graphql.ID) (*shopify.OrderTransaction, error) {
+	if mock.VoidFunc == nil {
+		panic("OrderServiceMock.VoidFunc: method is nil but OrderService.Void was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ParentTransactionID is the parentTransactionID argument value.
+		ParentTransactionID graphql.ID
+	}{
+		Ctx:                 ctx,
+		ParentTransactionID: parentTransactionID,
+	}
+	mock.lockVoid.Lock()
+	mock.calls.Void = append(mock.calls.Void, callInfo)
+	mock.lockVoid.Unlock()
+	return mock.VoidFunc(ctx, parentTransactionID)
+}
+
+// VoidCalls returns the calls made to Void.
+func (mock *OrderServiceMock) VoidCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ParentTransactionID is the parentTransactionID argument value.
+	ParentTransactionID graphql.ID
+} {
+	mock.lockVoid.RLock()
+	defer mock.lockVoid.RUnlock()
+	return mock.calls.Void
+}
+
+// ListTransactions calls ListTransactionsFunc.
+func (mock *OrderServiceMock) ListTransactions(ctx context.Context, orderID graphql.ID) ([]shopify.OrderTransaction, error) {
+	if mock.ListTransactionsFunc == nil {
+		panic("OrderServiceMock.ListTransactionsFunc: method is nil but OrderService.ListTransactions was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// OrderID is the orderID argument value.
+		OrderID graphql.ID
+	}{
+		Ctx:     ctx,
+		OrderID: orderID,
+	}
+	mock.lockListTransactions.Lock()
+	mock.calls.ListTransactions = append(mock.calls.ListTransactions, callInfo)
+	mock.lockListTransactions.Unlock()
+	return mock.ListTransactionsFunc(ctx, orderID)
+}
+
+// ListTransactionsCalls returns the calls made to ListTransactions.
+func (mock *OrderServiceMock) ListTransactionsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// OrderID is the orderID argument value.
+	OrderID graphql.ID
+} {
+	mock.lockListTransactions.RLock()
+	defer mock.lockListTransactions.RUnlock()
+	return mock.calls.ListTransactions
+}
+
+// GetPaymentTerms calls GetPaymentTermsFunc.
+func (mock *OrderServiceMock) GetPaymentTerms(ctx context.Context, referenceID string) (*model.PaymentTerms, error) {
+	if mock.GetPaymentTermsFunc == nil {
+		panic("OrderServiceMock.GetPaymentTermsFunc: method is nil but OrderService.GetPaymentTerms was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ReferenceID is the referenceID argument value.
+		ReferenceID string
+	}{
+		Ctx:         ctx,
+		ReferenceID: referenceID,
+	}
+	mock.lockGetPaymentTerms.Lock()
+	mock.calls.GetPaymentTerms = append(mock.calls.GetPaymentTerms, callInfo)
+	mock.lockGetPaymentTerms.Unlock()
+	return mock.GetPaymentTermsFunc(ctx, referenceID)
+}
+
+// GetPaymentTermsCalls returns the calls made to GetPaymentTerms.
+func (mock *OrderServiceMock) GetPaymentTermsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ReferenceID is the referenceID argument value.
+	ReferenceID string
+} {
+	mock.lockGetPaymentTerms.RLock()
+	defer mock.lockGetPaymentTerms.RUnlock()
+	return mock.calls.GetPaymentTerms
+}
+
+// CreatePaymentTerms calls CreatePaymentTermsFunc.
+func (mock *OrderServiceMock) CreatePaymentTerms(ctx context.Context, referenceID string, attrs model.PaymentTermsCreateInput) (*model.PaymentTerms, error) {
+	if mock.CreatePaymentTermsFunc == nil {
+		panic("OrderServiceMock.CreatePaymentTermsFunc: method is nil but OrderService.CreatePaymentTerms was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ReferenceID is the referenceID argument value.
+		ReferenceID string
+		// Attrs is the attrs argument value.
+		Attrs model.PaymentTermsCreateInput
+	}{
+		Ctx:         ctx,
+		ReferenceID: referenceID,
+		Attrs:       attrs,
+	}
+	mock.lockCreatePaymentTerms.Lock()
+	mock.calls.CreatePaymentTerms = append(mock.calls.CreatePaymentTerms, callInfo)
+	mock.lockCreatePaymentTerms.Unlock()
+	return mock.CreatePaymentTermsFunc(ctx, referenceID, attrs)
+}
+
+// CreatePaymentTermsCalls returns the calls made to CreatePaymentTerms.
+func (mock *OrderServiceMock) CreatePaymentTermsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ReferenceID is the referenceID argument value.
+	ReferenceID string
+	// Attrs is the attrs argument value.
+	Attrs model.PaymentTermsCreateInput
+} {
+	mock.lockCreatePaymentTerms.RLock()
+	defer mock.lockCreatePaymentTerms.RUnlock()
+	return mock.calls.CreatePaymentTerms
+}
+
+// UpdatePaymentTerms calls UpdatePaymentTermsFunc.
+func (mock *OrderServiceMock) UpdatePaymentTerms(ctx context.Context, input model.PaymentTermsUpdateInput) (*model.PaymentTerms, error) {
+	if mock.UpdatePaymentTermsFunc == nil {
+		panic("OrderServiceMock.UpdatePaymentTermsFunc: method is nil but OrderService.UpdatePaymentTerms was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.PaymentTermsUpdateInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockUpdatePaymentTerms.Lock()
+	mock.calls.UpdatePaymentTerms = append(mock.calls.UpdatePaymentTerms, callInfo)
+	mock.lockUpdatePaymentTerms.Unlock()
+	return mock.UpdatePaymentTermsFunc(ctx, input)
+}
+
+// UpdatePaymentTermsCalls returns the calls made to UpdatePaymentTerms.
+func (mock *OrderServiceMock) UpdatePaymentTermsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.PaymentTermsUpdateInput
+} {
+	mock.lockUpdatePaymentTerms.RLock()
+	defer mock.lockUpdatePaymentTerms.RUnlock()
+	return mock.calls.UpdatePaymentTerms
+}
+
+// DeletePaymentTerms calls DeletePaymentTermsFunc.
+func (mock *OrderServiceMock) DeletePaymentTerms(ctx context.Context, paymentTermsID string) error {
+	if mock.DeletePaymentTermsFunc == nil {
+		panic("OrderServiceMock.DeletePaymentTermsFunc: method is nil but OrderService.DeletePaymentTerms was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// PaymentTermsID is the paymentTermsID argument value.
+		PaymentTermsID string
+	}{
+		Ctx:            ctx,
+		PaymentTermsID: paymentTermsID,
+	}
+	mock.lockDeletePaymentTerms.Lock()
+	mock.calls.DeletePaymentTerms = append(mock.calls.DeletePaymentTerms, callInfo)
+	mock.lockDeletePaymentTerms.Unlock()
+	return mock.DeletePaymentTermsFunc(ctx, paymentTermsID)
+}
+
+// DeletePaymentTermsCalls returns the calls made to DeletePaymentTerms.
+func (mock *OrderServiceMock) DeletePaymentTermsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// PaymentTermsID is the paymentTermsID argument value.
+	PaymentTermsID string
+} {
+	mock.lockDeletePaymentTerms.RLock()
+	defer mock.lockDeletePaymentTerms.RUnlock()
+	return mock.calls.DeletePaymentTerms
+}
+
+// SendInvoice calls SendInvoiceFunc.
+func (mock *OrderServiceMock) SendInvoice(ctx context.Context, orderID string, email *model.EmailInput) error {
+	if mock.SendInvoiceFunc == nil {
+		panic("OrderServiceMock.SendInvoiceFunc: method is nil but OrderService.SendInvoice was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// OrderID is the orderID argument value.
+		OrderID string
+		// Email is the email argument value.
+		Email *model.EmailInput
+	}{
+		Ctx:     ctx,
+		OrderID: orderID,
+		Email:   email,
+	}
+	mock.lockSendInvoice.Lock()
+	mock.calls.SendInvoice = append(mock.calls.SendInvoice, callInfo)
+	mock.lockSendInvoice.Unlock()
+	return mock.SendInvoiceFunc(ctx, orderID, email)
+}
+
+// SendInvoiceCalls returns the calls made to SendInvoice.
+func (mock *OrderServiceMock) SendInvoiceCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// OrderID is the orderID argument value.
+	OrderID string
+	// Email is the email argument value.
+	Email *model.EmailInput
+} {
+	mock.lockSendInvoice.RLock()
+	defer mock.lockSendInvoice.RUnlock()
+	return mock.calls.SendInvoice
+}
+
+// SendDraftOrderInvoice calls SendDraftOrderInvoiceFunc.
+func (mock *OrderServiceMock) SendDraftOrderInvoice(ctx context.Context, draftOrderID string, email *model.EmailInput) (*model.DraftOrder, error) {
+	if mock.SendDraftOrderInvoiceFunc == nil {
+		panic("OrderServiceMock.SendDraftOrderInvoiceFunc: method is nil but OrderService.SendDraftOrderInvoice was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// DraftOrderID is the draftOrderID argument value.
+		DraftOrderID string
+		// Email is the email argument value.
+		Email *model.EmailInput
+	}{
+		Ctx:          ctx,
+		DraftOrderID: draftOrderID,
+		Email:        email,
+	}
+	mock.lockSendDraftOrderInvoice.Lock()
+	mock.calls.SendDraftOrderInvoice = append(mock.calls.SendDraftOrderInvoice, callInfo)
+	mock.lockSendDraftOrderInvoice.Unlock()
+	return mock.SendDraftOrderInvoiceFunc(ctx, draftOrderID, email)
+}
+
+// SendDraftOrderInvoiceCalls returns the calls made to SendDraftOrderInvoice.
+func (mock *OrderServiceMock) SendDraftOrderInvoiceCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// DraftOrderID is the draftOrderID argument value.
+	DraftOrderID string
+	// Email is the email argument value.
+	Email *model.EmailInput
+} {
+	mock.lockSendDraftOrderInvoice.RLock()
+	defer mock.lockSendDraftOrderInvoice.RUnlock()
+	return mock.calls.SendDraftOrderInvoice
+}
+
+// BeginEdit calls BeginEditFunc.
+func (mock *OrderServiceMock) BeginEdit(ctx context.Context, orderID string) (*model.CalculatedOrder, error) {
+	if mock.BeginEditFunc == nil {
+		panic("OrderServiceMock.BeginEditFunc: method is nil but OrderService.BeginEdit was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// OrderID is the orderID argument value.
+		OrderID string
+	}{
+		Ctx:     ctx,
+		OrderID: orderID,
+	}
+	mock.lockBeginEdit.Lock()
+	mock.calls.BeginEdit = append(mock.calls.BeginEdit, callInfo)
+	mock.lockBeginEdit.Unlock()
+	return mock.BeginEditFunc(ctx, orderID)
+}
+
+// BeginEditCalls returns the calls made to BeginEdit.
+func (mock *OrderServiceMock) BeginEditCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// OrderID is the orderID argument value.
+	OrderID string
+} {
+	mock.lockBeginEdit.RLock()
+	defer mock.lockBeginEdit.RUnlock()
+	return mock.calls.BeginEdit
+}
+
+// EditSetQuantity calls EditSetQuantityFunc.
+func (mock *OrderServiceMock) EditSetQuantity(ctx context.Context, calculatedOrderID string, calculatedLineItemID string, quantity int, restock bool) (*model.CalculatedOrder, error) {
+	if mock.EditSetQuantityFunc == nil {
+		panic("OrderServiceMock.EditSetQuantityFunc: method is nil but OrderService.EditSetQuantity was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// CalculatedOrderID is the calculatedOrderID argument value.
+		CalculatedOrderID string
+		// CalculatedLineItemID is the calculatedLineItemID argument value.
+		CalculatedLineItemID string
+		// Quantity is the quantity argument value.
+		Quantity int
+		// Restock is the restock argument value.
+		Restock bool
+	}{
+		Ctx:                  ctx,
+		CalculatedOrderID:    calculatedOrderID,
+		CalculatedLineItemID: calculatedLineItemID,
+		Quantity:             quantity,
+		Restock:              restock,
+	}
+	mock.lockEditSetQuantity.Lock()
+	mock.calls.EditSetQuantity = append(mock.calls.EditSetQuantity, callInfo)
+	mock.lockEditSetQuantity.Unlock()
+	return mock.EditSetQuantityFunc(ctx, calculatedOrderID, calculatedLineItemID, quantity, restock)
+}
+
+// EditSetQuantityCalls returns the calls made to EditSetQuantity.
+func (mock *OrderServiceMock) EditSetQuantityCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// CalculatedOrderID is the calculatedOrderID argument value.
+	CalculatedOrderID string
+	// CalculatedLineItemID is the calculatedLineItemID argument value.
+	CalculatedLineItemID string
+	// Quantity is the quantity argument value.
+	Quantity int
+	// Restock is the restock argument value.
+	Restock bool
+} {
+	mock.lockEditSetQuantity.RLock()
+	defer mock.lockEditSetQuantity.RUnlock()
+	return mock.calls.EditSetQuantity
+}
+
+// EditAddVariant calls EditAddVariantFunc.
+func (mock *OrderServiceMock) EditAddVariant(ctx context.Context, calculatedOrderID string, variantID string, quantity int, locationID *string) (*model.CalculatedOrder, error) {
+	if mock.EditAddVariantFunc == nil {
+		panic("OrderServiceMock.EditAddVariantFunc: method is nil but OrderService.EditAddVariant was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// CalculatedOrderID is the calculatedOrderID argument value.
+		CalculatedOrderID string
+		// VariantID is the variantID argument value.
+		VariantID string
+		// Quantity is the quantity argument value.
+		Quantity int
+		// LocationID is the locationID argument value.
+		LocationID *string
+	}{
+		Ctx:               ctx,
+		CalculatedOrderID: calculatedOrderID,
+		VariantID:         variantID,
+		Quantity:          quantity,
+		LocationID:        locationID,
+	}
+	mock.lockEditAddVariant.Lock()
+	mock.calls.EditAddVariant = append(mock.calls.EditAddVariant, callInfo)
+	mock.lockEditAddVariant.Unlock()
+	return mock.EditAddVariantFunc(ctx, calculatedOrderID, variantID, quantity, locationID)
+}
+
+// EditAddVariantCalls returns the calls made to EditAddVariant.
+func (mock *OrderServiceMock) EditAddVariantCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// CalculatedOrderID is the calculatedOrderID argument value.
+	CalculatedOrderID string
+	// VariantID is the variantID argument value.
+	VariantID string
+	// Quantity is the quantity argument value.
+	Quantity int
+	// LocationID is the locationID argument value.
+	LocationID *string
+} {
+	mock.lockEditAddVariant.RLock()
+	defer mock.lockEditAddVariant.RUnlock()
+	return mock.calls.EditAddVariant
+}
+
+// CommitEdit calls CommitEditFunc.
+func (mock *OrderServiceMock) CommitEdit(ctx context.Context, calculatedOrderID string, notifyCustomer bool, staffNote *string) (*shopify.OrderEditCommitResult, error) {
+	if mock.CommitEditFunc == nil {
+		panic("OrderServiceMock.CommitEditFunc: method is nil but OrderService.CommitEdit was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// CalculatedOrderID is the calculatedOrderID argument value.
+		CalculatedOrderID string
+		// NotifyCustomer is the notifyCustomer argument value.
+		NotifyCustomer bool
+		// StaffNote is the staffNote argument value.
+		StaffNote *string
+	}{
+		Ctx:               ctx,
+		CalculatedOrderID: calculatedOrderID,
+		NotifyCustomer:    notifyCustomer,
+		StaffNote:         staffNote,
+	}
+	mock.lockCommitEdit.Lock()
+	mock.calls.CommitEdit = append(mock.calls.CommitEdit, callInfo)
+	mock.lockCommitEdit.Unlock()
+	return mock.CommitEditFunc(ctx, calculatedOrderID, notifyCustomer, staffNote)
+}
+
+// CommitEditCalls returns the calls made to CommitEdit.
+func (mock *OrderServiceMock) CommitEditCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// CalculatedOrderID is the calculatedOrderID argument value.
+	CalculatedOrderID string
+	// NotifyCustomer is the notifyCustomer argument value.
+	NotifyCustomer bool
+	// StaffNote is the staffNote argument value.
+	StaffNote *string
+} {
+	mock.lockCommitEdit.RLock()
+	defer mock.lockCommitEdit.RUnlock()
+	return mock.calls.CommitEdit
+}
+
+// Exchange calls ExchangeFunc.
+func (mock *OrderServiceMock) Exchange(ctx context.Context, input shopify.OrderExchangeInput) (*shopify.OrderExchangeResult, error) {
+	if mock.ExchangeFunc == nil {
+		panic("OrderServiceMock.ExchangeFunc: method is nil but OrderService.Exchange was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input shopify.OrderExchangeInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockExchange.Lock()
+	mock.calls.Exchange = append(mock.calls.Exchange, callInfo)
+	mock.lockExchange.Unlock()
+	return mock.ExchangeFunc(ctx, input)
+}
+
+// ExchangeCalls returns the calls made to Exchange.
+func (mock *OrderServiceMock) ExchangeCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input shopify.OrderExchangeInput
+} {
+	mock.lockExchange.RLock()
+	defer mock.lockExchange.RUnlock()
+	return mock.calls.Exchange
+}
+
+var _ shopify.PageService = &PageServiceMock{}
+
+// PageServiceMock is a mock implementation of shopify.PageService.
+type PageServiceMock struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id string) (*shopify.Page, error)
+
+	// GetByHandleFunc mocks the GetByHandle method.
+	GetByHandleFunc func(ctx context.Context, handle string) (*shopify.Page, error)
+
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, opts shopify.ListOptions) ([]*shopify.Page, string, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, input shopify.PageCreateInput) (*shopify.Page, error)
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, id string, input shopify.PageUpdateInput) (*shopify.Page, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id string) (string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// GetByHandle holds details about calls to the GetByHandle method.
+		GetByHandle []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Handle is the handle argument value.
+			Handle string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input shopify.PageCreateInput
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Input is the input argument value.
+			Input shopify.PageUpdateInput
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+	}
+	lockGet         sync.RWMutex
+	lockGetByHandle sync.RWMutex
+	lockList        sync.RWMutex
+	lockCreate      sync.RWMutex
+	lockUpdate      sync.RWMutex
+	lockDelete      sync.RWMutex
+}
+
+// Get calls GetFunc.
+func (mock *PageServiceMock) Get(ctx context.Context, id string) (*shopify.Page, error) {
+	if mock.GetFunc == nil {
+		panic("PageServiceMock.GetFunc: method is nil but PageService.Get was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls returns the calls made to Get.
+func (mock *PageServiceMock) GetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGet.RLock()
+	defer mock.lockGet.RUnlock()
+	return mock.calls.Get
+}
+
+// GetByHandle calls GetByHandleFunc.
+func (mock *PageServiceMock) GetByHandle(ctx context.Context, handle string) (*shopify.Page, error) {
+	if mock.GetByHandleFunc == nil {
+		panic("PageServiceMock.GetByHandleFunc: method is nil but PageService.GetByHandle was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Handle is the handle argument value.
+		Handle string
+	}{
+		Ctx:    ctx,
+		Handle: handle,
+	}
+	mock.lockGetByHandle.Lock()
+	mock.calls.GetByHandle = append(mock.calls.GetByHandle, callInfo)
+	mock.lockGetByHandle.Unlock()
+	return mock.GetByHandleFunc(ctx, handle)
+}
+
+// GetByHandleCalls returns the calls made to GetByHandle.
+func (mock *PageServiceMock) GetByHandleCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Handle is the handle argument value.
+	Handle string
+} {
+	mock.lockGetByHandle.RLock()
+	defer mock.lockGetByHandle.RUnlock()
+	return mock.calls.GetByHandle
+}
+
+// List calls ListFunc.
+func (mock *PageServiceMock) List(ctx context.Context, opts shopify.ListOptions) ([]*shopify.Page, string, error) {
+	if mock.ListFunc == nil {
+		panic("PageServiceMock.ListFunc: method is nil but PageService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, opts)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *PageServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// Create calls CreateFunc.
+func (mock *PageServiceMock) Create(ctx context.Context, input shopify.PageCreateInput) (*shopify.Page, error) {
+	if mock.CreateFunc == nil {
+		panic("PageServiceMock.CreateFunc: method is nil but PageService.Create was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input shopify.PageCreateInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, input)
+}
+
+// CreateCalls returns the calls made to Create.
+func (mock *PageServiceMock) CreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input shopify.PageCreateInput
+} {
+	mock.lockCreate.RLock()
+	defer mock.lockCreate.RUnlock()
+	return mock.calls.Create
+}
+
+// Update calls UpdateFunc.
+func (mock *PageServiceMock) Update(ctx context.Context, id string, input shopify.PageUpdateInput) (*shopify.Page, error) {
+	if mock.UpdateFunc == nil {
+		panic("PageServiceMock.UpdateFunc: method is nil but PageService.Update was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Input is the input argument value.
+		Input shopify.PageUpdateInput
+	}{
+		Ctx:   ctx,
+		Id:    id,
+		Input: input,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, id, input)
+}
+
+// UpdateCalls returns the calls made to Update.
+func (mock *PageServiceMock) UpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Input is the input argument value.
+	Input shopify.PageUpdateInput
+} {
+	mock.lockUpdate.RLock()
+	defer mock.lockUpdate.RUnlock()
+	return mock.calls.Update
+}
+
+// Delete calls DeleteFunc.
+func (mock *PageServiceMock) Delete(ctx context.Context, id string) (string, error) {
+	if mock.DeleteFunc == nil {
+		panic("PageServiceMock.DeleteFunc: method is nil but PageService.Delete was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id)
+}
+
+// DeleteCalls returns the calls made to Delete.
+func (mock *PageServiceMock) DeleteCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockDelete.RLock()
+	defer mock.lockDelete.RUnlock()
+	return mock.calls.Delete
+}
+
+var _ shopify.PaymentCustomizationService = &PaymentCustomizationServiceMock{}
+
+// PaymentCustomizationServiceMock is a mock implementation of shopify.PaymentCustomizationService.
+type PaymentCustomizationServiceMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, opts shopify.ListOptions) ([]*model.PaymentCustomization, string, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, input model.PaymentCustomizationInput) (*model.PaymentCustomization, error)
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, id string, input model.PaymentCustomizationInput) (*model.PaymentCustomization, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id string) (string, error)
+
+	// ActivateFunc mocks the Activate method.
+	ActivateFunc func(ctx context.Context, ids []string, enabled bool) ([]string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.PaymentCustomizationInput
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Input is the input argument value.
+			Input model.PaymentCustomizationInput
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// Activate holds details about calls to the Activate method.
+		Activate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// IDs is the ids argument value.
+			IDs []string
+			// Enabled is the enabled argument value.
+			Enabled bool
+		}
+	}
+	lockList     sync.RWMutex
+	lockCreate   sync.RWMutex
+	lockUpdate   sync.RWMutex
+	lockDelete   sync.RWMutex
+	lockActivate sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *PaymentCustomizationServiceMock) List(ctx context.Context, opts shopify.ListOptions) ([]*model.PaymentCustomization, string, error) {
+	if mock.ListFunc == nil {
+		panic("PaymentCustomizationServiceMock.ListFunc: method is nil but PaymentCustomizationService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, opts)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *PaymentCustomizationServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// Create calls CreateFunc.
+func (mock *PaymentCustomizationServiceMock) Create(ctx context.Context, input model.PaymentCustomizationInput) (*model.PaymentCustomization, error) {
+	if mock.CreateFunc == nil {
+		panic("PaymentCustomizationServiceMock.CreateFunc: method is nil but PaymentCustomizationService.Create was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.PaymentCustomizationInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, input)
+}
+
+// CreateCalls returns the calls made to Create.
+func (mock *PaymentCustomizationServiceMock) CreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.PaymentCustomizationInput
+} {
+	mock.lockCreate.RLock()
+	defer mock.lockCreate.RUnlock()
+	return mock.calls.Create
+}
+
+// Update calls UpdateFunc.
+func (mock *PaymentCustomizationServiceMock) Update(ctx context.Context, id string, input model.PaymentCustomizationInput) (*model.PaymentCustomization, error) {
+	if mock.UpdateFunc == nil {
+		panic("PaymentCustomizationServiceMock.UpdateFunc: method is nil but PaymentCustomizationService.Update was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Input is the input argument value.
+		Input model.PaymentCustomizationInput
+	}{
+		Ctx:   ctx,
+		Id:    id,
+		Input: input,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, id, input)
+}
+
+// UpdateCalls returns the calls made to Update.
+func (mock *PaymentCustomizationServiceMock) UpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Input is the input argument value.
+	Input model.PaymentCustomizationInput
+} {
+	mock.lockUpdate.RLock()
+	defer mock.lockUpdate.RUnlock()
+	return mock.calls.Update
+}
+
+// Delete calls DeleteFunc.
+func (mock *PaymentCustomizationServiceMock) Delete(ctx context.Context, id string) (string, error) {
+	if mock.DeleteFunc == nil {
+		panic("PaymentCustomizationServiceMock.DeleteFunc: method is nil but PaymentCustomizationService.Delete was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id)
+}
+
+// DeleteCalls returns the calls made to Delete.
+func (mock *PaymentCustomizationServiceMock) DeleteCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockDelete.RLock()
+	defer mock.lockDelete.RUnlock()
+	return mock.calls.Delete
+}
+
+// Activate calls ActivateFunc.
+func (mock *PaymentCustomizationServiceMock) Activate(ctx context.Context, ids []string, enabled bool) ([]string, error) {
+	if mock.ActivateFunc == nil {
+		panic("PaymentCustomizationServiceMock.ActivateFunc: method is nil but PaymentCustomizationService.Activate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// IDs is the ids argument value.
+		IDs []string
+		// Enabled is the enabled argument value.
+		Enabled bool
+	}{
+		Ctx:     ctx,
+		IDs:     ids,
+		Enabled: enabled,
+	}
+	mock.lockActivate.Lock()
+	mock.calls.Activate = append(mock.calls.Activate, callInfo)
+	mock.lockActivate.Unlock()
+	return mock.ActivateFunc(ctx, ids, enabled)
+}
+
+// ActivateCalls returns the calls made to Activate.
+func (mock *PaymentCustomizationServiceMock) ActivateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// IDs is the ids argument value.
+	IDs []string
+	// Enabled is the enabled argument value.
+	Enabled bool
+} {
+	mock.lockActivate.RLock()
+	defer mock.lockActivate.RUnlock()
+	return mock.calls.Activate
+}
+
+var _ shopify.ProductFeedService = &ProductFeedServiceMock{}
+
+// ProductFeedServiceMock is a mock implementation of shopify.ProductFeedService.
+type ProductFeedServiceMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context) ([]*model.ProductFeed, error)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id string) (*model.ProductFeed, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, country model.CountryCode, language model.LanguageCode) (*model.ProductFeed, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id string) (string, error)
+
+	// FullSyncFunc mocks the FullSync method.
+	FullSyncFunc func(ctx context.Context, id string, opts shopify.ProductFullSyncOptions) error
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Country is the country argument value.
+			Country model.CountryCode
+			// Language is the language argument value.
+			Language model.LanguageCode
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// FullSync holds details about calls to the FullSync method.
+		FullSync []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Opts is the opts argument value.
+			Opts shopify.ProductFullSyncOptions
+		}
+	}
+	lockList     sync.RWMutex
+	lockGet      sync.RWMutex
+	lockCreate   sync.RWMutex
+	lockDelete   sync.RWMutex
+	lockFullSync sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *ProductFeedServiceMock) List(ctx context.Context) ([]*model.ProductFeed, error) {
+	if mock.ListFunc == nil {
+		panic("ProductFeedServiceMock.ListFunc: method is nil but ProductFeedService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *ProductFeedServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// Get calls GetFunc.
+func (mock *ProductFeedServiceMock) Get(ctx context.Context, id string) (*model.ProductFeed, error) {
+	if mock.GetFunc == nil {
+		panic("ProductFeedServiceMock.GetFunc: method is nil but ProductFeedService.Get was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls returns the calls made to Get.
+func (mock *ProductFeedServiceMock) GetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGet.RLock()
+	defer mock.lockGet.RUnlock()
+	return mock.calls.Get
+}
+
+// Create calls CreateFunc.
+func (mock *ProductFeedServiceMock) Create(ctx context.Context, country model.CountryCode, language model.LanguageCode) (*model.ProductFeed, error) {
+	if mock.CreateFunc == nil {
+		panic("ProductFeedServiceMock.CreateFunc: method is nil but ProductFeedService.Create was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Country is the country argument value.
+		Country model.CountryCode
+		// Language is the language argument value.
+		Language model.LanguageCode
+	}{
+		Ctx:      ctx,
+		Country:  country,
+		Language: language,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, country, language)
+}
+
+// CreateCalls returns the calls made to Create.
+func (mock *ProductFeedServiceMock) CreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Country is the country argument value.
+	Country model.CountryCode
+	// Language is the language argument value.
+	Language model.LanguageCode
+} {
+	mock.lockCreate.RLock()
+	defer mock.lockCreate.RUnlock()
+	return mock.calls.Create
+}
+
+// Delete calls DeleteFunc.
+func (mock *ProductFeedServiceMock) Delete(ctx context.Context, id string) (string, error) {
+	if mock.DeleteFunc == nil {
+		panic("ProductFeedServiceMock.DeleteFunc: method is nil but ProductFeedService.Delete was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id)
+}
+
+// DeleteCalls returns the calls made to Delete.
+func (mock *ProductFeedServiceMock) DeleteCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockDelete.RLock()
+	defer mock.lockDelete.RUnlock()
+	return mock.calls.Delete
+}
+
+// FullSync calls FullSyncFunc.
+func (mock *ProductFeedServiceMock) FullSync(ctx context.Context, id string, opts shopify.ProductFullSyncOptions) error {
+	if mock.FullSyncFunc == nil {
+		panic("ProductFeedServiceMock.FullSyncFunc: method is nil but ProductFeedService.FullSync was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Opts is the opts argument value.
+		Opts shopify.ProductFullSyncOptions
+	}{
+		Ctx:  ctx,
+		Id:   id,
+		Opts: opts,
+	}
+	mock.lockFullSync.Lock()
+	mock.calls.FullSync = append(mock.calls.FullSync, callInfo)
+	mock.lockFullSync.Unlock()
+	return mock.FullSyncFunc(ctx, id, opts)
+}
+
+// FullSyncCalls returns the calls made to FullSync.
+func (mock *ProductFeedServiceMock) FullSyncCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Opts is the opts argument value.
+	Opts shopify.ProductFullSyncOptions
+} {
+	mock.lockFullSync.RLock()
+	defer mock.lockFullSync.RUnlock()
+	return mock.calls.FullSync
+}
+
+var _ shopify.ProductService = &ProductServiceMock{}
+
+// ProductServiceMock is a mock implementation of shopify.ProductService.
+type ProductServiceMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, opts ...shopify.QueryOption) ([]*model.Product, error)
+
+	// ListWithFieldsFunc mocks the ListWithFields method.
+	ListWithFieldsFunc func(ctx context.Context, query string, fields string, first int, after string) (*model.ProductConnection, error)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id string) (*model.Product, error)
+
+	// GetWithFieldsFunc mocks the GetWithFields method.
+	GetWithFieldsFunc func(ctx context.Context, id string, fields string) (*model.Product, error)
+
+	// GetSingleProductCollectionFunc mocks the GetSingleProductCollection method.
+	GetSingleProductCollectionFunc func(ctx context.Context, id string, cursor string) (*model.Product, error)
+
+	// GetContextualPricingFunc mocks the GetContextualPricing method.
+	GetContextualPricingFunc func(ctx context.Context, id string, countryCode model.CountryCode) (*model.ProductContextualPricing, error)
+
+	// GetSellingPlansFunc mocks the GetSellingPlans method.
+	GetSellingPlansFunc func(ctx context.Context, id string) (*shopify.ProductSellingPlans, error)
+
+	// CountFunc mocks the Count method.
+	CountFunc func(ctx context.Context, query string) (*model.Count, error)
+
+	// GetCombinedListingChildrenFunc mocks the GetCombinedListingChildren method.
+	GetCombinedListingChildrenFunc func(ctx context.Context, id string) ([]shopify.CombinedListingChild, error)
+
+	// CombinedListingUpdateFunc mocks the CombinedListingUpdate method.
+	CombinedListingUpdateFunc func(ctx context.Context, parentProductID string, input shopify.CombinedListingUpdateInput) (*model.Product, error)
+
+	// GetBundleComponentsFunc mocks the GetBundleComponents method.
+	GetBundleComponentsFunc func(ctx context.Context, id string) ([]shopify.ProductBundleComponent, error)
+
+	// BundleCreateFunc mocks the BundleCreate method.
+	BundleCreateFunc func(ctx context.Context, input shopify.ProductBundleCreateInput) (*shopify.ProductBundleOperation, error)
+
+	// BundleUpdateFunc mocks the BundleUpdate method.
+	BundleUpdateFunc func(ctx context.Context, input shopify.ProductBundleUpdateInput) (*shopify.ProductBundleOperation, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, product model.ProductInput, media []model.CreateMediaInput) (output *model.Product, err error)
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, product model.ProductInput) (output *model.Product, err error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, product model.ProductDeleteInput) (deletedID *string, err error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts []shopify.QueryOption
+		}
+		// ListWithFields holds details about calls to the ListWithFields method.
+		ListWithFields []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
+			// Fields is the fields argument value.
+			Fields string
+			// First is the first argument value.
+			First int
+			// After is the after argument value.
+			After string
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// GetWithFields holds details about calls to the GetWithFields method.
+		GetWithFields []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Fields is the fields argument value.
+			Fields string
+		}
+		// GetSingleProductCollection holds details about calls to the GetSingleProductCollection method.
+		GetSingleProductCollection []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Cursor is the cursor argument value.
+			Cursor string
+		}
+		// GetContextualPricing holds details about calls to the GetContextualPricing method.
+		GetContextualPricing []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// CountryCode is the countryCode argument value.
+			CountryCode model.CountryCode
+		}
+		// GetSellingPlans holds details about calls to the GetSellingPlans method.
+		GetSellingPlans []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// Count holds details about calls to the Count method.
+		Count []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Query is the query argument value.
+			Query string
+		}
+		// GetCombinedListingChildren holds details about calls to the GetCombinedListingChildren method.
+		GetCombinedListingChildren []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// CombinedListingUpdate holds details about calls to the CombinedListingUpdate method.
+		CombinedListingUpdate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ParentProductID is the parentProductID argument value.
+			ParentProductID string
+			// Input is the input argument value.
+			Input shopify.CombinedListingUpdateInput
+		}
+		// GetBundleComponents holds details about calls to the GetBundleComponents method.
+		GetBundleComponents []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// BundleCreate holds details about calls to the BundleCreate method.
+		BundleCreate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input shopify.ProductBundleCreateInput
+		}
+		// BundleUpdate holds details about calls to the BundleUpdate method.
+		BundleUpdate []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input shopify.ProductBundleUpdateInput
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Product is the product argument value.
+			Product model.ProductInput
+			// Media is the media argument value.
+			Media []model.CreateMediaInput
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Product is the product argument value.
+			Product model.ProductInput
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Product is the product argument value.
+			Product model.ProductDeleteInput
+		}
+	}
+	lockList                       sync.RWMutex
+	lockListWithFields             sync.RWMutex
+	lockGet                        sync.RWMutex
+	lockGetWithFields              sync.RWMutex
+	lockGetSingleProductCollection sync.RWMutex
+	lockGetContextualPricing       sync.RWMutex
+	lockGetSellingPlans            sync.RWMutex
+	lockCount                      sync.RWMutex
+	lockGetCombinedListingChildren sync.RWMutex
+	lockCombinedListingUpdate      sync.RWMutex
+	lockGetBundleComponents        sync.RWMutex
+	lockBundleCreate               sync.RWMutex
+	lockBundleUpdate               sync.RWMutex
+	lockCreate                     sync.RWMutex
+	lockUpdate                     sync.RWMutex
+	lockDelete                     sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *ProductServiceMock) List(ctx context.Context, opts ...shopify.QueryOption) ([]*model.Product, error) {
+	if mock.ListFunc == nil {
+		panic("ProductServiceMock.ListFunc: method is nil but ProductService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts []shopify.QueryOption
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, opts...)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *ProductServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts []shopify.QueryOption
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// ListWithFields calls ListWithFieldsFunc.
+func (mock *ProductServiceMock) ListWithFields(ctx context.Context, query string, fields string, first int, after string) (*model.ProductConnection, error) {
+	if mock.ListWithFieldsFunc == nil {
+		panic("ProductServiceMock.ListWithFieldsFunc: method is nil but ProductService.ListWithFields was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Query is the query argument value.
+		Query string
+		// Fields is the fields argument value.
+		Fields string
+		// First is the first argument value.
+		First int
+		// After is the after argument value.
+		After string
+	}{
+		Ctx:    ctx,
+		Query:  query,
+		Fields: fields,
+		First:  first,
+		After:  after,
+	}
+	mock.lockListWithFields.Lock()
+	mock.calls.ListWithFields = append(mock.calls.ListWithFields, callInfo)
+	mock.lockListWithFields.Unlock()
+	return mock.ListWithFieldsFunc(ctx, query, fields, first, after)
+}
+
+// ListWithFieldsCalls returns the calls made to ListWithFields.
+func (mock *ProductServiceMock) ListWithFieldsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Query is the query argument value.
+	Query string
+	// Fields is the fields argument value.
+	Fields string
+	// First is the first argument value.
+	First int
+	// After is the after argument value.
+	After string
+} {
+	mock.lockListWithFields.RLock()
+	defer mock.lockListWithFields.RUnlock()
+	return mock.calls.ListWithFields
+}
+
+// Get calls GetFunc.
+func (mock *ProductServiceMock) Get(ctx context.Context, id string) (*model.Product, error) {
+	if mock.GetFunc == nil {
+		panic("ProductServiceMock.GetFunc: method is nil but ProductService.Get was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls returns the calls made to Get.
+func (mock *ProductServiceMock) GetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGet.RLock()
+	defer mock.lockGet.RUnlock()
+	return mock.calls.Get
+}
+
+// GetWithFields calls GetWithFieldsFunc.
+func (mock *ProductServiceMock) GetWithFields(ctx context.Context, id string, fields string) (*model.Product, error) {
+	if mock.GetWithFieldsFunc == nil {
+		panic("ProductServiceMock.GetWithFieldsFunc: method is nil but ProductService.GetWithFields was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Fields is the fields argument value.
+		Fields string
+	}{
+		Ctx:    ctx,
+		Id:     id,
+		Fields: fields,
+	}
+	mock.lockGetWithFields.Lock()
+	mock.calls.GetWithFields = append(mock.calls.GetWithFields, callInfo)
+	mock.lockGetWithFields.Unlock()
+	return mock.GetWithFieldsFunc(ctx, id, fields)
+}
+
+// GetWithFieldsCalls returns the calls made to GetWithFields.
+func (mock *ProductServiceMock) GetWithFieldsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Fields is the fields argument value.
+	Fields string
+} {
+	mock.lockGetWithFields.RLock()
+	defer mock.lockGetWithFields.RUnlock()
+	return mock.calls.GetWithFields
+}
+
+// GetSingleProductCollection calls GetSingleProductCollectionFunc.
+func (mock *ProductServiceMock) GetSingleProductCollection(ctx context.Context, id string, cursor string) (*model.Product, error) {
+	if mock.GetSingleProductCollectionFunc == nil {
+		panic("ProductServiceMock.GetSingleProductCollectionFunc: method is nil but ProductService.GetSingleProductCollection was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Cursor is the cursor argument value.
+		Cursor string
+	}{
+		Ctx:    ctx,
+		Id:     id,
+		Cursor: cursor,
+	}
+	mock.lockGetSingleProductCollection.Lock()
+	mock.calls.GetSingleProductCollection = append(mock.calls.GetSingleProductCollection, callInfo)
+	mock.lockGetSingleProductCollection.Unlock()
+	return mock.GetSingleProductCollectionFunc(ctx, id, cursor)
+}
+
+// GetSingleProductCollectionCalls returns the calls made to GetSingleProductCollection.
+func (mock *ProductServiceMock) GetSingleProductCollectionCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Cursor is the cursor argument value.
+	Cursor string
+} {
+	mock.lockGetSingleProductCollection.RLock()
+	defer mock.lockGetSingleProductCollection.RUnlock()
+	return mock.calls.GetSingleProductCollection
+}
+
+// GetContextualPricing calls GetContextualPricingFunc.
+func (mock *ProductServiceMock) GetContextualPricing(ctx context.Context, id string, countryCode model.CountryCode) (*model.ProductContextualPricing, error) {
+	if mock.GetContextualPricingFunc == nil {
+		panic("ProductServiceMock.GetContextualPricingFunc: method is nil but ProductService.GetContextualPricing was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// CountryCode is the countryCode argument value.
+		CountryCode model.CountryCode
+	}{
+		Ctx:         ctx,
+		Id:          id,
+		CountryCode: countryCode,
+	}
+	mock.lockGetContextualPricing.Lock()
+	mock.calls.GetContextualPricing = append(mock.calls.GetContextualPricing, callInfo)
+	mock.lockGetContextualPricing.Unlock()
+	return mock.GetContextualPricingFunc(ctx, id, countryCode)
+}
+
+// GetContextualPricingCalls returns the calls made to GetContextualPricing.
+func (mock *ProductServiceMock) GetContextualPricingCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// CountryCode is the countryCode argument value.
+	CountryCode model.CountryCode
+} {
+	mock.lockGetContextualPricing.RLock()
+	defer mock.lockGetContextualPricing.RUnlock()
+	return mock.calls.GetContextualPricing
+}
+
+// GetSellingPlans calls GetSellingPlansFunc.
+func (mock *ProductServiceMock) GetSellingPlans(ctx context.Context, id string) (*shopify.ProductSellingPlans, error) {
+	if mock.GetSellingPlansFunc == nil {
+		panic("ProductServiceMock.GetSellingPlansFunc: method is nil but ProductService.GetSellingPlans was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGetSellingPlans.Lock()
+	mock.calls.GetSellingPlans = append(mock.calls.GetSellingPlans, callInfo)
+	mock.lockGetSellingPlans.Unlock()
+	return mock.GetSellingPlansFunc(ctx, id)
+}
+
+// GetSellingPlansCalls returns the calls made to GetSellingPlans.
+func (mock *ProductServiceMock) GetSellingPlansCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGetSellingPlans.RLock()
+	defer mock.lockGetSellingPlans.RUnlock()
+	return mock.calls.GetSellingPlans
+}
+
+// Count calls CountFunc.
+func (mock *ProductServiceMock) Count(ctx context.Context, query string) (*model.Count, error) {
+	if mock.CountFunc == nil {
+		panic("ProductServiceMock.CountFunc: method is nil but ProductService.Count was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Query is the query argument value.
+		Query string
+	}{
+		Ctx:   ctx,
+		Query: query,
+	}
+	mock.lockCount.Lock()
+	mock.calls.Count = append(mock.calls.Count, callInfo)
+	mock.lockCount.Unlock()
+	return mock.CountFunc(ctx, query)
+}
+
+// CountCalls returns the calls made to Count.
+func (mock *ProductServiceMock) CountCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Query is the query argument value.
+	Query string
+} {
+	mock.lockCount.RLock()
+	defer mock.lockCount.RUnlock()
+	return mock.calls.Count
+}
+
+// GetCombinedListingChildren calls GetCombinedListingChildrenFunc.
+func (mock *ProductServiceMock) GetCombinedListingChildren(ctx context.Context, id string) ([]shopify.CombinedListingChild, error) {
+	if mock.GetCombinedListingChildrenFunc == nil {
+		panic("ProductServiceMock.GetCombinedListingChildrenFunc: method is nil but ProductService.GetCombinedListingChildren was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGetCombinedListingChildren.Lock()
+	mock.calls.GetCombinedListingChildren = append(mock.calls.GetCombinedListingChildren, callInfo)
+	mock.lockGetCombinedListingChildren.Unlock()
+	return mock.GetCombinedListingChildrenFunc(ctx, id)
+}
+
+// GetCombinedListingChildrenCalls returns the calls made to GetCombinedListingChildren.
+func (mock *ProductServiceMock) GetCombinedListingChildrenCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGetCombinedListingChildren.RLock()
+	defer mock.lockGetCombinedListingChildren.RUnlock()
+	return mock.calls.GetCombinedListingChildren
+}
+
+// CombinedListingUpdate calls CombinedListingUpdateFunc.
+func (mock *ProductServiceMock) CombinedListingUpdate(ctx context.Context, parentProductID string, input shopify.CombinedListingUpdateInput) (*model.Product, error) {
+	if mock.CombinedListingUpdateFunc == nil {
+		panic("ProductServiceMock.CombinedListingUpdateFunc: method is nil but ProductService.CombinedListingUpdate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ParentProductID is the parentProductID argument value.
+		ParentProductID string
+		// Input is the input argument value.
+		Input shopify.CombinedListingUpdateInput
+	}{
+		Ctx:             ctx,
+		ParentProductID: parentProductID,
+		Input:           input,
+	}
+	mock.lockCombinedListingUpdate.Lock()
+	mock.calls.CombinedListingUpdate = append(mock.calls.CombinedListingUpdate, callInfo)
+	mock.lockCombinedListingUpdate.Unlock()
+	return mock.CombinedListingUpdateFunc(ctx, parentProductID, input)
+}
+
+// CombinedListingUpdateCalls returns the calls made to CombinedListingUpdate.
+func (mock *ProductServiceMock) CombinedListingUpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ParentProductID is the parentProductID argument value.
+	ParentProductID string
+	// Input is the input argument value.
+	Input shopify.CombinedListingUpdateInput
+} {
+	mock.lockCombinedListingUpdate.RLock()
+	defer mock.lockCombinedListingUpdate.RUnlock()
+	return mock.calls.CombinedListingUpdate
+}
+
+// GetBundleComponents calls GetBundleComponentsFunc.
+func (mock *ProductServiceMock) GetBundleComponents(ctx context.Context, id string) ([]shopify.ProductBundleComponent, error) {
+	if mock.GetBundleComponentsFunc == nil {
+		panic("ProductServiceMock.GetBundleComponentsFunc: method is nil but ProductService.GetBundleComponents was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGetBundleComponents.Lock()
+	mock.calls.GetBundleComponents = append(mock.calls.GetBundleComponents, callInfo)
+	mock.lockGetBundleComponents.Unlock()
+	return mock.GetBundleComponentsFunc(ctx, id)
+}
+
+// GetBundleComponentsCalls returns the calls made to GetBundleComponents.
+func (mock *ProductServiceMock) GetBundleComponentsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGetBundleComponents.RLock()
+	defer mock.lockGetBundleComponents.RUnlock()
+	return mock.calls.GetBundleComponents
+}
+
+// BundleCreate calls BundleCreateFunc.
+func (mock *ProductServiceMock) BundleCreate(ctx context.Context, input shopify.ProductBundleCreateInput) (*shopify.ProductBundleOperation, error) {
+	if mock.BundleCreateFunc == nil {
+		panic("ProductServiceMock.BundleCreateFunc: method is nil but ProductService.BundleCreate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input shopify.ProductBundleCreateInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockBundleCreate.Lock()
+	mock.calls.BundleCreate = append(mock.calls.BundleCreate, callInfo)
+	mock.lockBundleCreate.Unlock()
+	return mock.BundleCreateFunc(ctx, input)
+}
+
+// BundleCreateCalls returns the calls made to BundleCreate.
+func (mock *ProductServiceMock) BundleCreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input shopify.ProductBundleCreateInput
+} {
+	mock.lockBundleCreate.RLock()
+	defer mock.lockBundleCreate.RUnlock()
+	return mock.calls.BundleCreate
+}
+
+// BundleUpdate calls BundleUpdateFunc.
+func (mock *ProductServiceMock) BundleUpdate(ctx context.Context, input shopify.ProductBundleUpdateInput) (*shopify.ProductBundleOperation, error) {
+	if mock.BundleUpdateFunc == nil {
+		panic("ProductServiceMock.BundleUpdateFunc: method is nil but ProductService.BundleUpdate was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input shopify.ProductBundleUpdateInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockBundleUpdate.Lock()
+	mock.calls.BundleUpdate = append(mock.calls.BundleUpdate, callInfo)
+	mock.lockBundleUpdate.Unlock()
+	return mock.BundleUpdateFunc(ctx, input)
+}
+
+// BundleUpdateCalls returns the calls made to BundleUpdate.
+func (mock *ProductServiceMock) BundleUpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input shopify.ProductBundleUpdateInput
+} {
+	mock.lockBundleUpdate.RLock()
+	defer mock.lockBundleUpdate.RUnlock()
+	return mock.calls.BundleUpdate
+}
+
+// Create calls CreateFunc.
+func (mock *ProductServiceMock) Create(ctx context.Context, product model.ProductInput, media []model.CreateMediaInput) (output *model.Product, err error) {
+	if mock.CreateFunc == nil {
+		panic("ProductServiceMock.CreateFunc: method is nil but ProductService.Create was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Product is the product argument value.
+		Product model.ProductInput
+		// Media is the media argument value.
+		Media []model.CreateMediaInput
+	}{
+		Ctx:     ctx,
+		Product: product,
+		Media:   media,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, product, media)
+}
+
+// CreateCalls returns the calls made to Create.
+func (mock *ProductServiceMock) CreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Product is the product argument value.
+	Product model.ProductInput
+	// Media is the media argument value.
+	Media []model.CreateMediaInput
+} {
+	mock.lockCreate.RLock()
+	defer mock.lockCreate.RUnlock()
+	return mock.calls.Create
+}
+
+// Update calls UpdateFunc.
+func (mock *ProductServiceMock) Update(ctx context.Context, product model.ProductInput) (output *model.Product, err error) {
+	if mock.UpdateFunc == nil {
+		panic("ProductServiceMock.UpdateFunc: method is nil but ProductService.Update was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Product is the product argument value.
+		Product model.ProductInput
+	}{
+		Ctx:     ctx,
+		Product: product,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, product)
+}
+
+// UpdateCalls returns the calls made to Update.
+func (mock *ProductServiceMock) UpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Product is the product argument value.
+	Product model.ProductInput
+} {
+	mock.lockUpdate.RLock()
+	defer mock.lockUpdate.RUnlock()
+	return mock.calls.Update
+}
+
+// Delete calls DeleteFunc.
+func (mock *ProductServiceMock) Delete(ctx context.Context, product model.ProductDeleteInput) (deletedID *string, err error) {
+	if mock.DeleteFunc == nil {
+		panic("ProductServiceMock.DeleteFunc: method is nil but ProductService.Delete was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Product is the product argument value.
+		Product model.ProductDeleteInput
+	}{
+		Ctx:     ctx,
+		Product: product,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, product)
+}
+
+// DeleteCalls returns the calls made to Delete.
+func (mock *ProductServiceMock) DeleteCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Product is the product argument value.
+	Product model.ProductDeleteInput
+} {
+	mock.lockDelete.RLock()
+	defer mock.lockDelete.RUnlock()
+	return mock.calls.Delete
+}
+
+var _ shopify.ReturnService = &ReturnServiceMock{}
+
+// ReturnServiceMock is a mock implementation of shopify.ReturnService.
+type ReturnServiceMock struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id string) (*model.Return, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, input model.ReturnInput) (*model.Return, error)
+
+	// ApproveRequestFunc mocks the ApproveRequest method.
+	ApproveRequestFunc func(ctx context.Context, input model.ReturnApproveRequestInput) (*model.Return, error)
+
+	// DeclineRequestFunc mocks the DeclineRequest method.
+	DeclineRequestFunc func(ctx context.Context, input model.ReturnDeclineRequestInput) (*model.Return, error)
+
+	// RefundFunc mocks the Refund method.
+	RefundFunc func(ctx context.Context, input model.ReturnRefundInput) (*model.Refund, error)
+
+	// GetReverseFulfillmentOrderFunc mocks the GetReverseFulfillmentOrder method.
+	GetReverseFulfillmentOrderFunc func(ctx context.Context, id string) (*model.ReverseFulfillmentOrder, error)
+
+	// GetReverseDeliveryFunc mocks the GetReverseDelivery method.
+	GetReverseDeliveryFunc func(ctx context.Context, id string) (*model.ReverseDelivery, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.ReturnInput
+		}
+		// ApproveRequest holds details about calls to the ApproveRequest method.
+		ApproveRequest []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.ReturnApproveRequestInput
+		}
+		// DeclineRequest holds details about calls to the DeclineRequest method.
+		DeclineRequest []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.ReturnDeclineRequestInput
+		}
+		// Refund holds details about calls to the Refund method.
+		Refund []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.ReturnRefundInput
+		}
+		// GetReverseFulfillmentOrder holds details about calls to the GetReverseFulfillmentOrder method.
+		GetReverseFulfillmentOrder []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// GetReverseDelivery holds details about calls to the GetReverseDelivery method.
+		GetReverseDelivery []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+	}
+	lockGet                        sync.RWMutex
+	lockCreate                     sync.RWMutex
+	lockApproveRequest             sync.RWMutex
+	lockDeclineRequest             sync.RWMutex
+	lockRefund                     sync.RWMutex
+	lockGetReverseFulfillmentOrder sync.RWMutex
+	lockGetReverseDelivery         sync.RWMutex
+}
+
+// Get calls GetFunc.
+func (mock *ReturnServiceMock) Get(ctx context.Context, id string) (*model.Return, error) {
+	if mock.GetFunc == nil {
+		panic("ReturnServiceMock.GetFunc: method is nil but ReturnService.Get was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls returns the calls made to Get.
+func (mock *ReturnServiceMock) GetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGet.RLock()
+	defer mock.lockGet.RUnlock()
+	return mock.calls.Get
+}
+
+// Create calls CreateFunc.
+func (mock *ReturnServiceMock) Create(ctx context.Context, input model.ReturnInput) (*model.Return, error) {
+	if mock.CreateFunc == nil {
+		panic("ReturnServiceMock.CreateFunc: method is nil but ReturnService.Create was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.ReturnInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, input)
+}
+
+// CreateCalls returns the calls made to Create.
+func (mock *ReturnServiceMock) CreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.ReturnInput
+} {
+	mock.lockCreate.RLock()
+	defer mock.lockCreate.RUnlock()
+	return mock.calls.Create
+}
+
+// ApproveRequest calls ApproveRequestFunc.
+func (mock *ReturnServiceMock) ApproveRequest(ctx context.Context, input model.ReturnApproveRequestInput) (*model.Return, error) {
+	if mock.ApproveRequestFunc == nil {
+		panic("ReturnServiceMock.ApproveRequestFunc: method is nil but ReturnService.ApproveRequest was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.ReturnApproveRequestInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockApproveRequest.Lock()
+	mock.calls.ApproveRequest = append(mock.calls.ApproveRequest, callInfo)
+	mock.lockApproveRequest.Unlock()
+	return mock.ApproveRequestFunc(ctx, input)
+}
+
+// ApproveRequestCalls returns the calls made to ApproveRequest.
+func (mock *ReturnServiceMock) ApproveRequestCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.ReturnApproveRequestInput
+} {
+	mock.lockApproveRequest.RLock()
+	defer mock.lockApproveRequest.RUnlock()
+	return mock.calls.ApproveRequest
+}
+
+// DeclineRequest calls DeclineRequestFunc.
+func (mock *ReturnServiceMock) DeclineRequest(ctx context.Context, input model.ReturnDeclineRequestInput) (*model.Return, error) {
+	if mock.DeclineRequestFunc == nil {
+		panic("ReturnServiceMock.DeclineRequestFunc: method is nil but ReturnService.DeclineRequest was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.ReturnDeclineRequestInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockDeclineRequest.Lock()
+	mock.calls.DeclineRequest = append(mock.calls.DeclineRequest, callInfo)
+	mock.lockDeclineRequest.Unlock()
+	return mock.DeclineRequestFunc(ctx, input)
+}
+
+// DeclineRequestCalls returns the calls made to DeclineRequest.
+func (mock *ReturnServiceMock) DeclineRequestCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.ReturnDeclineRequestInput
+} {
+	mock.lockDeclineRequest.RLock()
+	defer mock.lockDeclineRequest.RUnlock()
+	return mock.calls.DeclineRequest
+}
+
+// Refund calls RefundFunc.
+func (mock *ReturnServiceMock) Refund(ctx context.Context, input model.ReturnRefundInput) (*model.Refund, error) {
+	if mock.RefundFunc == nil {
+		panic("ReturnServiceMock.RefundFunc: method is nil but ReturnService.Refund was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.ReturnRefundInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockRefund.Lock()
+	mock.calls.Refund = append(mock.calls.Refund, callInfo)
+	mock.lockRefund.Unlock()
+	return mock.RefundFunc(ctx, input)
+}
+
+// RefundCalls returns the calls made to Refund.
+func (mock *ReturnServiceMock) RefundCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.ReturnRefundInput
+} {
+	mock.lockRefund.RLock()
+	defer mock.lockRefund.RUnlock()
+	return mock.calls.Refund
+}
+
+// GetReverseFulfillmentOrder calls GetReverseFulfillmentOrderFunc.
+func (mock *ReturnServiceMock) GetReverseFulfillmentOrder(ctx context.Context, id string) (*model.ReverseFulfillmentOrder, error) {
+	if mock.GetReverseFulfillmentOrderFunc == nil {
+		panic("ReturnServiceMock.GetReverseFulfillmentOrderFunc: method is nil but ReturnService.GetReverseFulfillmentOrder was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGetReverseFulfillmentOrder.Lock()
+	mock.calls.GetReverseFulfillmentOrder = append(mock.calls.GetReverseFulfillmentOrder, callInfo)
+	mock.lockGetReverseFulfillmentOrder.Unlock()
+	return mock.GetReverseFulfillmentOrderFunc(ctx, id)
+}
+
+// GetReverseFulfillmentOrderCalls returns the calls made to GetReverseFulfillmentOrder.
+func (mock *ReturnServiceMock) GetReverseFulfillmentOrderCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGetReverseFulfillmentOrder.RLock()
+	defer mock.lockGetReverseFulfillmentOrder.RUnlock()
+	return mock.calls.GetReverseFulfillmentOrder
+}
+
+// GetReverseDelivery calls GetReverseDeliveryFunc.
+func (mock *ReturnServiceMock) GetReverseDelivery(ctx context.Context, id string) (*model.ReverseDelivery, error) {
+	if mock.GetReverseDeliveryFunc == nil {
+		panic("ReturnServiceMock.GetReverseDeliveryFunc: method is nil but ReturnService.GetReverseDelivery was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGetReverseDelivery.Lock()
+	mock.calls.GetReverseDelivery = append(mock.calls.GetReverseDelivery, callInfo)
+	mock.lockGetReverseDelivery.Unlock()
+	return mock.GetReverseDeliveryFunc(ctx, id)
+}
+
+// GetReverseDeliveryCalls returns the calls made to GetReverseDelivery.
+func (mock *ReturnServiceMock) GetReverseDeliveryCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGetReverseDelivery.RLock()
+	defer mock.lockGetReverseDelivery.RUnlock()
+	return mock.calls.GetReverseDelivery
+}
+
+var _ shopify.SegmentService = &SegmentServiceMock{}
+
+// SegmentServiceMock is a mock implementation of shopify.SegmentService.
+type SegmentServiceMock struct {
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id string) (*model.Segment, error)
+
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, opts shopify.ListOptions) ([]*model.Segment, string, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, name string, query string) (*model.Segment, error)
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, id string, name *string, query *string) (*model.Segment, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id string) (string, error)
+
+	// ListMembersFunc mocks the ListMembers method.
+	ListMembersFunc func(ctx context.Context, segmentID string, opts shopify.ListOptions) ([]*model.CustomerSegmentMember, string, error)
+
+	// IsMemberFunc mocks the IsMember method.
+	IsMemberFunc func(ctx context.Context, customerID string, segmentIDs []string) (map[string]bool, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Name is the name argument value.
+			Name string
+			// Query is the query argument value.
+			Query string
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Name is the name argument value.
+			Name *string
+			// Query is the query argument value.
+			Query *string
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// ListMembers holds details about calls to the ListMembers method.
+		ListMembers []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SegmentID is the segmentID argument value.
+			SegmentID string
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+		// IsMember holds details about calls to the IsMember method.
+		IsMember []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CustomerID is the customerID argument value.
+			CustomerID string
+			// SegmentIDs is the segmentIDs argument value.
+			SegmentIDs []string
+		}
+	}
+	lockGet         sync.RWMutex
+	lockList        sync.RWMutex
+	lockCreate      sync.RWMutex
+	lockUpdate      sync.RWMutex
+	lockDelete      sync.RWMutex
+	lockListMembers sync.RWMutex
+	lockIsMember    sync.RWMutex
+}
+
+// Get calls GetFunc.
+func (mock *SegmentServiceMock) Get(ctx context.Context, id string) (*model.Segment, error) {
+	if mock.GetFunc == nil {
+		panic("SegmentServiceMock.GetFunc: method is nil but SegmentService.Get was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls returns the calls made to Get.
+func (mock *SegmentServiceMock) GetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGet.RLock()
+	defer mock.lockGet.RUnlock()
+	return mock.calls.Get
+}
+
+// List calls ListFunc.
+func (mock *SegmentServiceMock) List(ctx context.Context, opts shopify.ListOptions) ([]*model.Segment, string, error) {
+	if mock.ListFunc == nil {
+		panic("SegmentServiceMock.ListFunc: method is nil but SegmentService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, opts)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *SegmentServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// Create calls CreateFunc.
+func (mock *SegmentServiceMock) Create(ctx context.Context, name string, query string) (*model.Segment, error) {
+	if mock.CreateFunc == nil {
+		panic("SegmentServiceMock.CreateFunc: method is nil but SegmentService.Create was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Name is the name argument value.
+		Name string
+		// Query is the query argument value.
+		Query string
+	}{
+		Ctx:   ctx,
+		Name:  name,
+		Query: query,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, name, query)
+}
+
+// CreateCalls returns the calls made to Create.
+func (mock *SegmentServiceMock) CreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Name is the name argument value.
+	Name string
+	// Query is the query argument value.
+	Query string
+} {
+	mock.lockCreate.RLock()
+	defer mock.lockCreate.RUnlock()
+	return mock.calls.Create
+}
+
+// Update calls UpdateFunc.
+func (mock *SegmentServiceMock) Update(ctx context.Context, id string, name *string, query *string) (*model.Segment, error) {
+	if mock.UpdateFunc == nil {
+		panic("SegmentServiceMock.UpdateFunc: method is nil but SegmentService.Update was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Name is the name argument value.
+		Name *string
+		// Query is the query argument value.
+		Query *string
+	}{
+		Ctx:   ctx,
+		Id:    id,
+		Name:  name,
+		Query: query,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, id, name, query)
+}
+
+// UpdateCalls returns the calls made to Update.
+func (mock *SegmentServiceMock) UpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Name is the name argument value.
+	Name *string
+	// Query is the query argument value.
+	Query *string
+} {
+	mock.lockUpdate.RLock()
+	defer mock.lockUpdate.RUnlock()
+	return mock.calls.Update
+}
+
+// Delete calls DeleteFunc.
+func (mock *SegmentServiceMock) Delete(ctx context.Context, id string) (string, error) {
+	if mock.DeleteFunc == nil {
+		panic("SegmentServiceMock.DeleteFunc: method is nil but SegmentService.Delete was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id)
+}
+
+// DeleteCalls returns the calls made to Delete.
+func (mock *SegmentServiceMock) DeleteCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockDelete.RLock()
+	defer mock.lockDelete.RUnlock()
+	return mock.calls.Delete
+}
+
+// ListMembers calls ListMembersFunc.
+func (mock *SegmentServiceMock) ListMembers(ctx context.Context, segmentID string, opts shopify.ListOptions) ([]*model.CustomerSegmentMember, string, error) {
+	if mock.ListMembersFunc == nil {
+		panic("SegmentServiceMock.ListMembersFunc: method is nil but SegmentService.ListMembers was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// SegmentID is the segmentID argument value.
+		SegmentID string
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:       ctx,
+		SegmentID: segmentID,
+		Opts:      opts,
+	}
+	mock.lockListMembers.Lock()
+	mock.calls.ListMembers = append(mock.calls.ListMembers, callInfo)
+	mock.lockListMembers.Unlock()
+	return mock.ListMembersFunc(ctx, segmentID, opts)
+}
+
+// ListMembersCalls returns the calls made to ListMembers.
+func (mock *SegmentServiceMock) ListMembersCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// SegmentID is the segmentID argument value.
+	SegmentID string
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockListMembers.RLock()
+	defer mock.lockListMembers.RUnlock()
+	return mock.calls.ListMembers
+}
+
+// IsMember calls IsMemberFunc.
+func (mock *SegmentServiceMock) IsMember(ctx context.Context, customerID string, segmentIDs []string) (map[string]bool, error) {
+	if mock.IsMemberFunc == nil {
+		panic("SegmentServiceMock.IsMemberFunc: method is nil but SegmentService.IsMember was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// CustomerID is the customerID argument value.
+		CustomerID string
+		// SegmentIDs is the segmentIDs argument value.
+		SegmentIDs []string
+	}{
+		Ctx:        ctx,
+		CustomerID: customerID,
+		SegmentIDs: segmentIDs,
+	}
+	mock.lockIsMember.Lock()
+	mock.calls.IsMember = append(mock.calls.IsMember, callInfo)
+	mock.lockIsMember.Unlock()
+	return mock.IsMemberFunc(ctx, customerID, segmentIDs)
+}
+
+// IsMemberCalls returns the calls made to IsMember.
+func (mock *SegmentServiceMock) IsMemberCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// CustomerID is the customerID argument value.
+	CustomerID string
+	// SegmentIDs is the segmentIDs argument value.
+	SegmentIDs []string
+} {
+	mock.lockIsMember.RLock()
+	defer mock.lockIsMember.RUnlock()
+	return mock.calls.IsMember
+}
+
+var _ shopify.StaffMemberService = &StaffMemberServiceMock{}
+
+// StaffMemberServiceMock is a mock implementation of shopify.StaffMemberService.
+type StaffMemberServiceMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context) ([]*model.StaffMember, error)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id string) (*model.StaffMember, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+	}
+	lockList sync.RWMutex
+	lockGet  sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *StaffMemberServiceMock) List(ctx context.Context) ([]*model.StaffMember, error) {
+	if mock.ListFunc == nil {
+		panic("StaffMemberServiceMock.ListFunc: method is nil but StaffMemberService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+	}{
+		Ctx: ctx,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *StaffMemberServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// Get calls GetFunc.
+func (mock *StaffMemberServiceMock) Get(ctx context.Context, id string) (*model.StaffMember, error) {
+	if mock.GetFunc == nil {
+		panic("StaffMemberServiceMock.GetFunc: method is nil but StaffMemberService.Get was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls returns the calls made to Get.
+func (mock *StaffMemberServiceMock) GetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGet.RLock()
+	defer mock.lockGet.RUnlock()
+	return mock.calls.Get
+}
+
+var _ shopify.StoreCreditService = &StoreCreditServiceMock{}
+
+// StoreCreditServiceMock is a mock implementation of shopify.StoreCreditService.
+type StoreCreditServiceMock struct {
+	// CreditFunc mocks the Credit method.
+	CreditFunc func(ctx context.Context, id string, amount model.MoneyInput, expiresAt *time.Time) (*shopify.StoreCreditTransaction, error)
+
+	// DebitFunc mocks the Debit method.
+	DebitFunc func(ctx context.Context, id string, amount model.MoneyInput) (*shopify.StoreCreditTransaction, error)
+
+	// ListAccountsFunc mocks the ListAccounts method.
+	ListAccountsFunc func(ctx context.Context, customerID string) ([]*shopify.StoreCreditAccount, error)
+
+	// GetAccountFunc mocks the GetAccount method.
+	GetAccountFunc func(ctx context.Context, id string) (*shopify.StoreCreditAccount, error)
+
+	// ListTransactionsFunc mocks the ListTransactions method.
+	ListTransactionsFunc func(ctx context.Context, accountID string, opts shopify.ListOptions) ([]*shopify.StoreCreditTransaction, string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Credit holds details about calls to the Credit method.
+		Credit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Amount is the amount argument value.
+			Amount model.MoneyInput
+			// ExpiresAt is the expiresAt argument value.
+			ExpiresAt *time.Time
+		}
+		// Debit holds details about calls to the Debit method.
+		Debit []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Amount is the amount argument value.
+			Amount model.MoneyInput
+		}
+		// ListAccounts holds details about calls to the ListAccounts method.
+		ListAccounts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// CustomerID is the customerID argument value.
+			CustomerID string
+		}
+		// GetAccount holds details about calls to the GetAccount method.
+		GetAccount []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// ListTransactions holds details about calls to the ListTransactions method.
+		ListTransactions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// AccountID is the accountID argument value.
+			AccountID string
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+	}
+	lockCredit           sync.RWMutex
+	lockDebit            sync.RWMutex
+	lockListAccounts     sync.RWMutex
+	lockGetAccount       sync.RWMutex
+	lockListTransactions sync.RWMutex
+}
+
+// Credit calls CreditFunc.
+func (mock *StoreCreditServiceMock) Credit(ctx context.Context, id string, amount model.MoneyInput, expiresAt *time.Time) (*shopify.StoreCreditTransaction, error) {
+	if mock.CreditFunc == nil {
+		panic("StoreCreditServiceMock.CreditFunc: method is nil but StoreCreditService.Credit was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Amount is the amount argument value.
+		Amount model.MoneyInput
+		// ExpiresAt is the expiresAt argument value.
+		ExpiresAt *time.Time
+	}{
+		Ctx:       ctx,
+		Id:        id,
+		Amount:    amount,
+		ExpiresAt: expiresAt,
+	}
+	mock.lockCredit.Lock()
+	mock.calls.Credit = append(mock.calls.Credit, callInfo)
+	mock.lockCredit.Unlock()
+	return mock.CreditFunc(ctx, id, amount, expiresAt)
+}
+
+// CreditCalls returns the calls made to Credit.
+func (mock *StoreCreditServiceMock) CreditCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Amount is the amount argument value.
+	Amount model.MoneyInput
+	// ExpiresAt is the expiresAt argument value.
+	ExpiresAt *time.Time
+} {
+	mock.lockCredit.RLock()
+	defer mock.lockCredit.RUnlock()
+	return mock.calls.Credit
+}
+
+// Debit calls DebitFunc.
+func (mock *StoreCreditServiceMock) Debit(ctx context.Context, id string, amount model.MoneyInput) (*shopify.StoreCreditTransaction, error) {
+	if mock.DebitFunc == nil {
+		panic("StoreCreditServiceMock.DebitFunc: method is nil but StoreCreditService.Debit was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Amount is the amount argument value.
+		Amount model.MoneyInput
+	}{
+		Ctx:    ctx,
+		Id:     id,
+		Amount: amount,
+	}
+	mock.lockDebit.Lock()
+	mock.calls.Debit = append(mock.calls.Debit, callInfo)
+	mock.lockDebit.Unlock()
+	return mock.DebitFunc(ctx, id, amount)
+}
+
+// DebitCalls returns the calls made to Debit.
+func (mock *StoreCreditServiceMock) DebitCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Amount is the amount argument value.
+	Amount model.MoneyInput
+} {
+	mock.lockDebit.RLock()
+	defer mock.lockDebit.RUnlock()
+	return mock.calls.Debit
+}
+
+// ListAccounts calls ListAccountsFunc.
+func (mock *StoreCreditServiceMock) ListAccounts(ctx context.Context, customerID string) ([]*shopify.StoreCreditAccount, error) {
+	if mock.ListAccountsFunc == nil {
+		panic("StoreCreditServiceMock.ListAccountsFunc: method is nil but StoreCreditService.ListAccounts was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// CustomerID is the customerID argument value.
+		CustomerID string
+	}{
+		Ctx:        ctx,
+		CustomerID: customerID,
+	}
+	mock.lockListAccounts.Lock()
+	mock.calls.ListAccounts = append(mock.calls.ListAccounts, callInfo)
+	mock.lockListAccounts.Unlock()
+	return mock.ListAccountsFunc(ctx, customerID)
+}
+
+// ListAccountsCalls returns the calls made to ListAccounts.
+func (mock *StoreCreditServiceMock) ListAccountsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// CustomerID is the customerID argument value.
+	CustomerID string
+} {
+	mock.lockListAccounts.RLock()
+	defer mock.lockListAccounts.RUnlock()
+	return mock.calls.ListAccounts
+}
+
+// GetAccount calls GetAccountFunc.
+func (mock *StoreCreditServiceMock) GetAccount(ctx context.Context, id string) (*shopify.StoreCreditAccount, error) {
+	if mock.GetAccountFunc == nil {
+		panic("StoreCreditServiceMock.GetAccountFunc: method is nil but StoreCreditService.GetAccount was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGetAccount.Lock()
+	mock.calls.GetAccount = append(mock.calls.GetAccount, callInfo)
+	mock.lockGetAccount.Unlock()
+	return mock.GetAccountFunc(ctx, id)
+}
+
+// GetAccountCalls returns the calls made to GetAccount.
+func (mock *StoreCreditServiceMock) GetAccountCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGetAccount.RLock()
+	defer mock.lockGetAccount.RUnlock()
+	return mock.calls.GetAccount
+}
+
+// ListTransactions calls ListTransactionsFunc.
+func (mock *StoreCreditServiceMock) ListTransactions(ctx context.Context, accountID string, opts shopify.ListOptions) ([]*shopify.StoreCreditTransaction, string, error) {
+	if mock.ListTransactionsFunc == nil {
+		panic("StoreCreditServiceMock.ListTransactionsFunc: method is nil but StoreCreditService.ListTransactions was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// AccountID is the accountID argument value.
+		AccountID string
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:       ctx,
+		AccountID: accountID,
+		Opts:      opts,
+	}
+	mock.lockListTransactions.Lock()
+	mock.calls.ListTransactions = append(mock.calls.ListTransactions, callInfo)
+	mock.lockListTransactions.Unlock()
+	return mock.ListTransactionsFunc(ctx, accountID, opts)
+}
+
+// ListTransactionsCalls returns the calls made to ListTransactions.
+func (mock *StoreCreditServiceMock) ListTransactionsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// AccountID is the accountID argument value.
+	AccountID string
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockListTransactions.RLock()
+	defer mock.lockListTransactions.RUnlock()
+	return mock.calls.ListTransactions
+}
+
+var _ shopify.StorefrontCollectionService = &StorefrontCollectionServiceMock{}
+
+// StorefrontCollectionServiceMock is a mock implementation of shopify.StorefrontCollectionService.
+type StorefrontCollectionServiceMock struct {
+	// ListFilteredProductsFunc mocks the ListFilteredProducts method.
+	ListFilteredProductsFunc func(ctx context.Context, handle string, filters []shopify.ProductFilter, opts shopify.ListOptions) (*shopify.CollectionProductsPage, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// ListFilteredProducts holds details about calls to the ListFilteredProducts method.
+		ListFilteredProducts []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Handle is the handle argument value.
+			Handle string
+			// Filters is the filters argument value.
+			Filters []shopify.ProductFilter
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+	}
+	lockListFilteredProducts sync.RWMutex
+}
+
+// ListFilteredProducts calls ListFilteredProductsFunc.
+func (mock *StorefrontCollectionServiceMock) ListFilteredProducts(ctx context.Context, handle string, filters []shopify.ProductFilter, opts shopify.ListOptions) (*shopify.CollectionProductsPage, error) {
+	if mock.ListFilteredProductsFunc == nil {
+		panic("StorefrontCollectionServiceMock.ListFilteredProductsFunc: method is nil but StorefrontCollectionService.ListFilteredProducts was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Handle is the handle argument value.
+		Handle string
+		// Filters is the filters argument value.
+		Filters []shopify.ProductFilter
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:     ctx,
+		Handle:  handle,
+		Filters: filters,
+		Opts:    opts,
+	}
+	mock.lockListFilteredProducts.Lock()
+	mock.calls.ListFilteredProducts = append(mock.calls.ListFilteredProducts, callInfo)
+	mock.lockListFilteredProducts.Unlock()
+	return mock.ListFilteredProductsFunc(ctx, handle, filters, opts)
+}
+
+// ListFilteredProductsCalls returns the calls made to ListFilteredProducts.
+func (mock *StorefrontCollectionServiceMock) ListFilteredProductsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Handle is the handle argument value.
+	Handle string
+	// Filters is the filters argument value.
+	Filters []shopify.ProductFilter
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockListFilteredProducts.RLock()
+	defer mock.lockListFilteredProducts.RUnlock()
+	return mock.calls.ListFilteredProducts
+}
+
+var _ shopify.TenderTransactionService = &TenderTransactionServiceMock{}
+
+// TenderTransactionServiceMock is a mock implementation of shopify.TenderTransactionService.
+type TenderTransactionServiceMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, opts shopify.TenderTransactionListOptions) ([]*model.TenderTransaction, string, error)
+
+	// ListAllFunc mocks the ListAll method.
+	ListAllFunc func(ctx context.Context, opts shopify.TenderTransactionListOptions) ([]*model.TenderTransaction, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts shopify.TenderTransactionListOptions
+		}
+		// ListAll holds details about calls to the ListAll method.
+		ListAll []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts shopify.TenderTransactionListOptions
+		}
+	}
+	lockList    sync.RWMutex
+	lockListAll sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *TenderTransactionServiceMock) List(ctx context.Context, opts shopify.TenderTransactionListOptions) ([]*model.TenderTransaction, string, error) {
+	if mock.ListFunc == nil {
+		panic("TenderTransactionServiceMock.ListFunc: method is nil but TenderTransactionService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts shopify.TenderTransactionListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, opts)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *TenderTransactionServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts shopify.TenderTransactionListOptions
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// ListAll calls ListAllFunc.
+func (mock *TenderTransactionServiceMock) ListAll(ctx context.Context, opts shopify.TenderTransactionListOptions) ([]*model.TenderTransaction, error) {
+	if mock.ListAllFunc == nil {
+		panic("TenderTransactionServiceMock.ListAllFunc: method is nil but TenderTransactionService.ListAll was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts shopify.TenderTransactionListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockListAll.Lock()
+	mock.calls.ListAll = append(mock.calls.ListAll, callInfo)
+	mock.lockListAll.Unlock()
+	return mock.ListAllFunc(ctx, opts)
+}
+
+// ListAllCalls returns the calls made to ListAll.
+func (mock *TenderTransactionServiceMock) ListAllCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts shopify.TenderTransactionListOptions
+} {
+	mock.lockListAll.RLock()
+	defer mock.lockListAll.RUnlock()
+	return mock.calls.ListAll
+}
+
+var _ shopify.ThemeService = &ThemeServiceMock{}
+
+// ThemeServiceMock is a mock implementation of shopify.ThemeService.
+type ThemeServiceMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, roles ...shopify.ThemeRole) ([]*shopify.Theme, error)
+
+	// GetFunc mocks the Get method.
+	GetFunc func(ctx context.Context, id string) (*shopify.Theme, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, sourceURL string, name string, role shopify.ThemeRole) (*shopify.Theme, error)
+
+	// PublishFunc mocks the Publish method.
+	PublishFunc func(ctx context.Context, id string) (*shopify.Theme, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id string) (string, error)
+
+	// ListFilesFunc mocks the ListFiles method.
+	ListFilesFunc func(ctx context.Context, themeID string, filenames []string, opts shopify.ListOptions) ([]*shopify.ThemeFile, string, error)
+
+	// UpsertFilesFunc mocks the UpsertFiles method.
+	UpsertFilesFunc func(ctx context.Context, themeID string, files []shopify.ThemeFileInput) ([]string, error)
+
+	// CopyFilesFunc mocks the CopyFiles method.
+	CopyFilesFunc func(ctx context.Context, themeID string, files []shopify.ThemeFileCopyInput) ([]string, error)
+
+	// DeleteFilesFunc mocks the DeleteFiles method.
+	DeleteFilesFunc func(ctx context.Context, themeID string, filenames []string) ([]string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Roles is the roles argument value.
+			Roles []shopify.ThemeRole
+		}
+		// Get holds details about calls to the Get method.
+		Get []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// SourceURL is the sourceURL argument value.
+			SourceURL string
+			// Name is the name argument value.
+			Name string
+			// Role is the role argument value.
+			Role shopify.ThemeRole
+		}
+		// Publish holds details about calls to the Publish method.
+		Publish []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+		// ListFiles holds details about calls to the ListFiles method.
+		ListFiles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ThemeID is the themeID argument value.
+			ThemeID string
+			// Filenames is the filenames argument value.
+			Filenames []string
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+		// UpsertFiles holds details about calls to the UpsertFiles method.
+		UpsertFiles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ThemeID is the themeID argument value.
+			ThemeID string
+			// Files is the files argument value.
+			Files []shopify.ThemeFileInput
+		}
+		// CopyFiles holds details about calls to the CopyFiles method.
+		CopyFiles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ThemeID is the themeID argument value.
+			ThemeID string
+			// Files is the files argument value.
+			Files []shopify.ThemeFileCopyInput
+		}
+		// DeleteFiles holds details about calls to the DeleteFiles method.
+		DeleteFiles []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ThemeID is the themeID argument value.
+			ThemeID string
+			// Filenames is the filenames argument value.
+			Filenames []string
+		}
+	}
+	lockList        sync.RWMutex
+	lockGet         sync.RWMutex
+	lockCreate      sync.RWMutex
+	lockPublish     sync.RWMutex
+	lockDelete      sync.RWMutex
+	lockListFiles   sync.RWMutex
+	lockUpsertFiles sync.RWMutex
+	lockCopyFiles   sync.RWMutex
+	lockDeleteFiles sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *ThemeServiceMock) List(ctx context.Context, roles ...shopify.ThemeRole) ([]*shopify.Theme, error) {
+	if mock.ListFunc == nil {
+		panic("ThemeServiceMock.ListFunc: method is nil but ThemeService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Roles is the roles argument value.
+		Roles []shopify.ThemeRole
+	}{
+		Ctx:   ctx,
+		Roles: roles,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, roles...)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *ThemeServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Roles is the roles argument value.
+	Roles []shopify.ThemeRole
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// Get calls GetFunc.
+func (mock *ThemeServiceMock) Get(ctx context.Context, id string) (*shopify.Theme, error) {
+	if mock.GetFunc == nil {
+		panic("ThemeServiceMock.GetFunc: method is nil but ThemeService.Get was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockGet.Lock()
+	mock.calls.Get = append(mock.calls.Get, callInfo)
+	mock.lockGet.Unlock()
+	return mock.GetFunc(ctx, id)
+}
+
+// GetCalls returns the calls made to Get.
+func (mock *ThemeServiceMock) GetCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockGet.RLock()
+	defer mock.lockGet.RUnlock()
+	return mock.calls.Get
+}
+
+// Create calls CreateFunc.
+func (mock *ThemeServiceMock) Create(ctx context.Context, sourceURL string, name string, role shopify.ThemeRole) (*shopify.Theme, error) {
+	if mock.CreateFunc == nil {
+		panic("ThemeServiceMock.CreateFunc: method is nil but ThemeService.Create was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// SourceURL is the sourceURL argument value.
+		SourceURL string
+		// Name is the name argument value.
+		Name string
+		// Role is the role argument value.
+		Role shopify.ThemeRole
+	}{
+		Ctx:       ctx,
+		SourceURL: sourceURL,
+		Name:      name,
+		Role:      role,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, sourceURL, name, role)
+}
+
+// CreateCalls returns the calls made to Create.
+func (mock *ThemeServiceMock) CreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// SourceURL is the sourceURL argument value.
+	SourceURL string
+	// Name is the name argument value.
+	Name string
+	// Role is the role argument value.
+	Role shopify.ThemeRole
+} {
+	mock.lockCreate.RLock()
+	defer mock.lockCreate.RUnlock()
+	return mock.calls.Create
+}
+
+// Publish calls PublishFunc.
+func (mock *ThemeServiceMock) Publish(ctx context.Context, id string) (*shopify.Theme, error) {
+	if mock.PublishFunc == nil {
+		panic("ThemeServiceMock.PublishFunc: method is nil but ThemeService.Publish was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockPublish.Lock()
+	mock.calls.Publish = append(mock.calls.Publish, callInfo)
+	mock.lockPublish.Unlock()
+	return mock.PublishFunc(ctx, id)
+}
+
+// PublishCalls returns the calls made to Publish.
+func (mock *ThemeServiceMock) PublishCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockPublish.RLock()
+	defer mock.lockPublish.RUnlock()
+	return mock.calls.Publish
+}
+
+// Delete calls DeleteFunc.
+func (mock *ThemeServiceMock) Delete(ctx context.Context, id string) (string, error) {
+	if mock.DeleteFunc == nil {
+		panic("ThemeServiceMock.DeleteFunc: method is nil but ThemeService.Delete was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id)
+}
+
+// DeleteCalls returns the calls made to Delete.
+func (mock *ThemeServiceMock) DeleteCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockDelete.RLock()
+	defer mock.lockDelete.RUnlock()
+	return mock.calls.Delete
+}
+
+// ListFiles calls ListFilesFunc.
+func (mock *ThemeServiceMock) ListFiles(ctx context.Context, themeID string, filenames []string, opts shopify.ListOptions) ([]*shopify.ThemeFile, string, error) {
+	if mock.ListFilesFunc == nil {
+		panic("ThemeServiceMock.ListFilesFunc: method is nil but ThemeService.ListFiles was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ThemeID is the themeID argument value.
+		ThemeID string
+		// Filenames is the filenames argument value.
+		Filenames []string
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:       ctx,
+		ThemeID:   themeID,
+		Filenames: filenames,
+		Opts:      opts,
+	}
+	mock.lockListFiles.Lock()
+	mock.calls.ListFiles = append(mock.calls.ListFiles, callInfo)
+	mock.lockListFiles.Unlock()
+	return mock.ListFilesFunc(ctx, themeID, filenames, opts)
+}
+
+// ListFilesCalls returns the calls made to ListFiles.
+func (mock *ThemeServiceMock) ListFilesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ThemeID is the themeID argument value.
+	ThemeID string
+	// Filenames is the filenames argument value.
+	Filenames []string
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockListFiles.RLock()
+	defer mock.lockListFiles.RUnlock()
+	return mock.calls.ListFiles
+}
+
+// UpsertFiles calls UpsertFilesFunc.
+func (mock *ThemeServiceMock) UpsertFiles(ctx context.Context, themeID string, files []shopify.ThemeFileInput) ([]string, error) {
+	if mock.UpsertFilesFunc == nil {
+		panic("ThemeServiceMock.UpsertFilesFunc: method is nil but ThemeService.UpsertFiles was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ThemeID is the themeID argument value.
+		ThemeID string
+		// Files is the files argument value.
+		Files []shopify.ThemeFileInput
+	}{
+		Ctx:     ctx,
+		ThemeID: themeID,
+		Files:   files,
+	}
+	mock.lockUpsertFiles.Lock()
+	mock.calls.UpsertFiles = append(mock.calls.UpsertFiles, callInfo)
+	mock.lockUpsertFiles.Unlock()
+	return mock.UpsertFilesFunc(ctx, themeID, files)
+}
+
+// UpsertFilesCalls returns the calls made to UpsertFiles.
+func (mock *ThemeServiceMock) UpsertFilesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ThemeID is the themeID argument value.
+	ThemeID string
+	// Files is the files argument value.
+	Files []shopify.ThemeFileInput
+} {
+	mock.lockUpsertFiles.RLock()
+	defer mock.lockUpsertFiles.RUnlock()
+	return mock.calls.UpsertFiles
+}
+
+// CopyFiles calls CopyFilesFunc.
+func (mock *ThemeServiceMock) CopyFiles(ctx context.Context, themeID string, files []shopify.ThemeFileCopyInput) ([]string, error) {
+	if mock.CopyFilesFunc == nil {
+		panic("ThemeServiceMock.CopyFilesFunc: method is nil but ThemeService.CopyFiles was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ThemeID is the themeID argument value.
+		ThemeID string
+		// Files is the files argument value.
+		Files []shopify.ThemeFileCopyInput
+	}{
+		Ctx:     ctx,
+		ThemeID: themeID,
+		Files:   files,
+	}
+	mock.lockCopyFiles.Lock()
+	mock.calls.CopyFiles = append(mock.calls.CopyFiles, callInfo)
+	mock.lockCopyFiles.Unlock()
+	return mock.CopyFilesFunc(ctx, themeID, files)
+}
+
+// CopyFilesCalls returns the calls made to CopyFiles.
+func (mock *ThemeServiceMock) CopyFilesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ThemeID is the themeID argument value.
+	ThemeID string
+	// Files is the files argument value.
+	Files []shopify.ThemeFileCopyInput
+} {
+	mock.lockCopyFiles.RLock()
+	defer mock.lockCopyFiles.RUnlock()
+	return mock.calls.CopyFiles
+}
+
+// DeleteFiles calls DeleteFilesFunc.
+func (mock *ThemeServiceMock) DeleteFiles(ctx context.Context, themeID string, filenames []string) ([]string, error) {
+	if mock.DeleteFilesFunc == nil {
+		panic("ThemeServiceMock.DeleteFilesFunc: method is nil but ThemeService.DeleteFiles was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ThemeID is the themeID argument value.
+		ThemeID string
+		// Filenames is the filenames argument value.
+		Filenames []string
+	}{
+		Ctx:       ctx,
+		ThemeID:   themeID,
+		Filenames: filenames,
+	}
+	mock.lockDeleteFiles.Lock()
+	mock.calls.DeleteFiles = append(mock.calls.DeleteFiles, callInfo)
+	mock.lockDeleteFiles.Unlock()
+	return mock.DeleteFilesFunc(ctx, themeID, filenames)
+}
+
+// DeleteFilesCalls returns the calls made to DeleteFiles.
+func (mock *ThemeServiceMock) DeleteFilesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ThemeID is the themeID argument value.
+	ThemeID string
+	// Filenames is the filenames argument value.
+	Filenames []string
+} {
+	mock.lockDeleteFiles.RLock()
+	defer mock.lockDeleteFiles.RUnlock()
+	return mock.calls.DeleteFiles
+}
+
+var _ shopify.ValidationService = &ValidationServiceMock{}
+
+// ValidationServiceMock is a mock implementation of shopify.ValidationService.
+type ValidationServiceMock struct {
+	// ListFunc mocks the List method.
+	ListFunc func(ctx context.Context, opts shopify.ListOptions) ([]*model.Validation, string, error)
+
+	// CreateFunc mocks the Create method.
+	CreateFunc func(ctx context.Context, input model.ValidationCreateInput) (*model.Validation, error)
+
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, id string, input model.ValidationUpdateInput) (*model.Validation, error)
+
+	// SetBlockOnFailureFunc mocks the SetBlockOnFailure method.
+	SetBlockOnFailureFunc func(ctx context.Context, id string, blockOnFailure bool) (*model.Validation, error)
+
+	// DeleteFunc mocks the Delete method.
+	DeleteFunc func(ctx context.Context, id string) (string, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// List holds details about calls to the List method.
+		List []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts shopify.ListOptions
+		}
+		// Create holds details about calls to the Create method.
+		Create []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Input is the input argument value.
+			Input model.ValidationCreateInput
+		}
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// Input is the input argument value.
+			Input model.ValidationUpdateInput
+		}
+		// SetBlockOnFailure holds details about calls to the SetBlockOnFailure method.
+		SetBlockOnFailure []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+			// BlockOnFailure is the blockOnFailure argument value.
+			BlockOnFailure bool
+		}
+		// Delete holds details about calls to the Delete method.
+		Delete []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Id is the id argument value.
+			Id string
+		}
+	}
+	lockList              sync.RWMutex
+	lockCreate            sync.RWMutex
+	lockUpdate            sync.RWMutex
+	lockSetBlockOnFailure sync.RWMutex
+	lockDelete            sync.RWMutex
+}
+
+// List calls ListFunc.
+func (mock *ValidationServiceMock) List(ctx context.Context, opts shopify.ListOptions) ([]*model.Validation, string, error) {
+	if mock.ListFunc == nil {
+		panic("ValidationServiceMock.ListFunc: method is nil but ValidationService.List was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts shopify.ListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockList.Lock()
+	mock.calls.List = append(mock.calls.List, callInfo)
+	mock.lockList.Unlock()
+	return mock.ListFunc(ctx, opts)
+}
+
+// ListCalls returns the calls made to List.
+func (mock *ValidationServiceMock) ListCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts shopify.ListOptions
+} {
+	mock.lockList.RLock()
+	defer mock.lockList.RUnlock()
+	return mock.calls.List
+}
+
+// Create calls CreateFunc.
+func (mock *ValidationServiceMock) Create(ctx context.Context, input model.ValidationCreateInput) (*model.Validation, error) {
+	if mock.CreateFunc == nil {
+		panic("ValidationServiceMock.CreateFunc: method is nil but ValidationService.Create was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Input is the input argument value.
+		Input model.ValidationCreateInput
+	}{
+		Ctx:   ctx,
+		Input: input,
+	}
+	mock.lockCreate.Lock()
+	mock.calls.Create = append(mock.calls.Create, callInfo)
+	mock.lockCreate.Unlock()
+	return mock.CreateFunc(ctx, input)
+}
+
+// CreateCalls returns the calls made to Create.
+func (mock *ValidationServiceMock) CreateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Input is the input argument value.
+	Input model.ValidationCreateInput
+} {
+	mock.lockCreate.RLock()
+	defer mock.lockCreate.RUnlock()
+	return mock.calls.Create
+}
+
+// Update calls UpdateFunc.
+func (mock *ValidationServiceMock) Update(ctx context.Context, id string, input model.ValidationUpdateInput) (*model.Validation, error) {
+	if mock.UpdateFunc == nil {
+		panic("ValidationServiceMock.UpdateFunc: method is nil but ValidationService.Update was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// Input is the input argument value.
+		Input model.ValidationUpdateInput
+	}{
+		Ctx:   ctx,
+		Id:    id,
+		Input: input,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, id, input)
+}
+
+// UpdateCalls returns the calls made to Update.
+func (mock *ValidationServiceMock) UpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// Input is the input argument value.
+	Input model.ValidationUpdateInput
+} {
+	mock.lockUpdate.RLock()
+	defer mock.lockUpdate.RUnlock()
+	return mock.calls.Update
+}
+
+// SetBlockOnFailure calls SetBlockOnFailureFunc.
+func (mock *ValidationServiceMock) SetBlockOnFailure(ctx context.Context, id string, blockOnFailure bool) (*model.Validation, error) {
+	if mock.SetBlockOnFailureFunc == nil {
+		panic("ValidationServiceMock.SetBlockOnFailureFunc: method is nil but ValidationService.SetBlockOnFailure was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+		// BlockOnFailure is the blockOnFailure argument value.
+		BlockOnFailure bool
+	}{
+		Ctx:            ctx,
+		Id:             id,
+		BlockOnFailure: blockOnFailure,
+	}
+	mock.lockSetBlockOnFailure.Lock()
+	mock.calls.SetBlockOnFailure = append(mock.calls.SetBlockOnFailure, callInfo)
+	mock.lockSetBlockOnFailure.Unlock()
+	return mock.SetBlockOnFailureFunc(ctx, id, blockOnFailure)
+}
+
+// SetBlockOnFailureCalls returns the calls made to SetBlockOnFailure.
+func (mock *ValidationServiceMock) SetBlockOnFailureCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+	// BlockOnFailure is the blockOnFailure argument value.
+	BlockOnFailure bool
+} {
+	mock.lockSetBlockOnFailure.RLock()
+	defer mock.lockSetBlockOnFailure.RUnlock()
+	return mock.calls.SetBlockOnFailure
+}
+
+// Delete calls DeleteFunc.
+func (mock *ValidationServiceMock) Delete(ctx context.Context, id string) (string, error) {
+	if mock.DeleteFunc == nil {
+		panic("ValidationServiceMock.DeleteFunc: method is nil but ValidationService.Delete was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Id is the id argument value.
+		Id string
+	}{
+		Ctx: ctx,
+		Id:  id,
+	}
+	mock.lockDelete.Lock()
+	mock.calls.Delete = append(mock.calls.Delete, callInfo)
+	mock.lockDelete.Unlock()
+	return mock.DeleteFunc(ctx, id)
+}
+
+// DeleteCalls returns the calls made to Delete.
+func (mock *ValidationServiceMock) DeleteCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Id is the id argument value.
+	Id string
+} {
+	mock.lockDelete.RLock()
+	defer mock.lockDelete.RUnlock()
+	return mock.calls.Delete
+}
+
+var _ shopify.VariantService = &VariantServiceMock{}
+
+// VariantServiceMock is a mock implementation of shopify.VariantService.
+type VariantServiceMock struct {
+	// UpdateFunc mocks the Update method.
+	UpdateFunc func(ctx context.Context, variant model.ProductVariantInput) error
+
+	// AppendMediaFunc mocks the AppendMedia method.
+	AppendMediaFunc func(ctx context.Context, productID string, variantMedia []model.ProductVariantAppendMediaInput) ([]*model.ProductVariant, error)
+
+	// DetachMediaFunc mocks the DetachMedia method.
+	DetachMediaFunc func(ctx context.Context, productID string, variantMedia []model.ProductVariantDetachMediaInput) ([]*model.ProductVariant, error)
+
+	// BulkUpdatePricesFunc mocks the BulkUpdatePrices method.
+	BulkUpdatePricesFunc func(ctx context.Context, shopID string, updates []shopify.VariantPriceUpdate) ([]shopify.VariantPriceUpdateResult, error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// Update holds details about calls to the Update method.
+		Update []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Variant is the variant argument value.
+			Variant model.ProductVariantInput
+		}
+		// AppendMedia holds details about calls to the AppendMedia method.
+		AppendMedia []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProductID is the productID argument value.
+			ProductID string
+			// VariantMedia is the variantMedia argument value.
+			VariantMedia []model.ProductVariantAppendMediaInput
+		}
+		// DetachMedia holds details about calls to the DetachMedia method.
+		DetachMedia []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ProductID is the productID argument value.
+			ProductID string
+			// VariantMedia is the variantMedia argument value.
+			VariantMedia []model.ProductVariantDetachMediaInput
+		}
+		// BulkUpdatePrices holds details about calls to the BulkUpdatePrices method.
+		BulkUpdatePrices []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// ShopID is the shopID argument value.
+			ShopID string
+			// Updates is the updates argument value.
+			Updates []shopify.VariantPriceUpdate
+		}
+	}
+	lockUpdate           sync.RWMutex
+	lockAppendMedia      sync.RWMutex
+	lockDetachMedia      sync.RWMutex
+	lockBulkUpdatePrices sync.RWMutex
+}
+
+// Update calls UpdateFunc.
+func (mock *VariantServiceMock) Update(ctx context.Context, variant model.ProductVariantInput) error {
+	if mock.UpdateFunc == nil {
+		panic("VariantServiceMock.UpdateFunc: method is nil but VariantService.Update was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Variant is the variant argument value.
+		Variant model.ProductVariantInput
+	}{
+		Ctx:     ctx,
+		Variant: variant,
+	}
+	mock.lockUpdate.Lock()
+	mock.calls.Update = append(mock.calls.Update, callInfo)
+	mock.lockUpdate.Unlock()
+	return mock.UpdateFunc(ctx, variant)
+}
+
+// UpdateCalls returns the calls made to Update.
+func (mock *VariantServiceMock) UpdateCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Variant is the variant argument value.
+	Variant model.ProductVariantInput
+} {
+	mock.lockUpdate.RLock()
+	defer mock.lockUpdate.RUnlock()
+	return mock.calls.Update
+}
+
+// AppendMedia calls AppendMediaFunc.
+func (mock *VariantServiceMock) AppendMedia(ctx context.Context, productID string, variantMedia []model.ProductVariantAppendMediaInput) ([]*model.ProductVariant, error) {
+	if mock.AppendMediaFunc == nil {
+		panic("VariantServiceMock.AppendMediaFunc: method is nil but VariantService.AppendMedia was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ProductID is the productID argument value.
+		ProductID string
+		// VariantMedia is the variantMedia argument value.
+		VariantMedia []model.ProductVariantAppendMediaInput
+	}{
+		Ctx:          ctx,
+		ProductID:    productID,
+		VariantMedia: variantMedia,
+	}
+	mock.lockAppendMedia.Lock()
+	mock.calls.AppendMedia = append(mock.calls.AppendMedia, callInfo)
+	mock.lockAppendMedia.Unlock()
+	return mock.AppendMediaFunc(ctx, productID, variantMedia)
+}
+
+// AppendMediaCalls returns the calls made to AppendMedia.
+func (mock *VariantServiceMock) AppendMediaCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ProductID is the productID argument value.
+	ProductID string
+	// VariantMedia is the variantMedia argument value.
+	VariantMedia []model.ProductVariantAppendMediaInput
+} {
+	mock.lockAppendMedia.RLock()
+	defer mock.lockAppendMedia.RUnlock()
+	return mock.calls.AppendMedia
+}
+
+// DetachMedia calls DetachMediaFunc.
+func (mock *VariantServiceMock) DetachMedia(ctx context.Context, productID string, variantMedia []model.ProductVariantDetachMediaInput) ([]*model.ProductVariant, error) {
+	if mock.DetachMediaFunc == nil {
+		panic("VariantServiceMock.DetachMediaFunc: method is nil but VariantService.DetachMedia was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ProductID is the productID argument value.
+		ProductID string
+		// VariantMedia is the variantMedia argument value.
+		VariantMedia []model.ProductVariantDetachMediaInput
+	}{
+		Ctx:          ctx,
+		ProductID:    productID,
+		VariantMedia: variantMedia,
+	}
+	mock.lockDetachMedia.Lock()
+	mock.calls.DetachMedia = append(mock.calls.DetachMedia, callInfo)
+	mock.lockDetachMedia.Unlock()
+	return mock.DetachMediaFunc(ctx, productID, variantMedia)
+}
+
+// DetachMediaCalls returns the calls made to DetachMedia.
+func (mock *VariantServiceMock) DetachMediaCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ProductID is the productID argument value.
+	ProductID string
+	// VariantMedia is the variantMedia argument value.
+	VariantMedia []model.ProductVariantDetachMediaInput
+} {
+	mock.lockDetachMedia.RLock()
+	defer mock.lockDetachMedia.RUnlock()
+	return mock.calls.DetachMedia
+}
+
+// BulkUpdatePrices calls BulkUpdatePricesFunc.
+func (mock *VariantServiceMock) BulkUpdatePrices(ctx context.Context, shopID string, updates []shopify.VariantPriceUpdate) ([]shopify.VariantPriceUpdateResult, error) {
+	if mock.BulkUpdatePricesFunc == nil {
+		panic("VariantServiceMock.BulkUpdatePricesFunc: method is nil but VariantService.BulkUpdatePrices was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// ShopID is the shopID argument value.
+		ShopID string
+		// Updates is the updates argument value.
+		Updates []shopify.VariantPriceUpdate
+	}{
+		Ctx:     ctx,
+		ShopID:  shopID,
+		Updates: updates,
+	}
+	mock.lockBulkUpdatePrices.Lock()
+	mock.calls.BulkUpdatePrices = append(mock.calls.BulkUpdatePrices, callInfo)
+	mock.lockBulkUpdatePrices.Unlock()
+	return mock.BulkUpdatePricesFunc(ctx, shopID, updates)
+}
+
+// BulkUpdatePricesCalls returns the calls made to BulkUpdatePrices.
+func (mock *VariantServiceMock) BulkUpdatePricesCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// ShopID is the shopID argument value.
+	ShopID string
+	// Updates is the updates argument value.
+	Updates []shopify.VariantPriceUpdate
+} {
+	mock.lockBulkUpdatePrices.RLock()
+	defer mock.lockBulkUpdatePrices.RUnlock()
+	return mock.calls.BulkUpdatePrices
+}
+
+var _ shopify.WebhookService = &WebhookServiceMock{}
+
+// WebhookServiceMock is a mock implementation of shopify.WebhookService.
+type WebhookServiceMock struct {
+	// NewWebhookSubscriptionFunc mocks the NewWebhookSubscription method.
+	NewWebhookSubscriptionFunc func(ctx context.Context, topic model.WebhookSubscriptionTopic, input model.WebhookSubscriptionInput) (output *model.WebhookSubscription, err error)
+
+	// NewEventBridgeWebhookSubscriptionFunc mocks the NewEventBridgeWebhookSubscription method.
+	NewEventBridgeWebhookSubscriptionFunc func(ctx context.Context, topic model.WebhookSubscriptionTopic, input model.EventBridgeWebhookSubscriptionInput) (output *model.WebhookSubscription, err error)
+
+	// ListWebhookSubscriptionsFunc mocks the ListWebhookSubscriptions method.
+	ListWebhookSubscriptionsFunc func(ctx context.Context, topics []model.WebhookSubscriptionTopic) (output []*model.WebhookSubscription, err error)
+
+	// DeleteWebhookFunc mocks the DeleteWebhook method.
+	DeleteWebhookFunc func(ctx context.Context, webhookID string) (deletedID *string, err error)
+
+	// UpdateWebhookSubscriptionFunc mocks the UpdateWebhookSubscription method.
+	UpdateWebhookSubscriptionFunc func(ctx context.Context, webhookID string, input model.WebhookSubscriptionInput) (output *model.WebhookSubscription, err error)
+
+	// NewFilteredWebhookSubscriptionFunc mocks the NewFilteredWebhookSubscription method.
+	NewFilteredWebhookSubscriptionFunc func(ctx context.Context, topic model.WebhookSubscriptionTopic, input shopify.WebhookSubscriptionFilteredInput) (output *model.WebhookSubscription, err error)
+
+	// UpdateFilteredWebhookSubscriptionFunc mocks the UpdateFilteredWebhookSubscription method.
+	UpdateFilteredWebhookSubscriptionFunc func(ctx context.Context, webhookID string, input shopify.WebhookSubscriptionFilteredInput) (output *model.WebhookSubscription, err error)
+
+	// UpdateEventBridgeWebhookSubscriptionFunc mocks the UpdateEventBridgeWebhookSubscription method.
+	UpdateEventBridgeWebhookSubscriptionFunc func(ctx context.Context, webhookID string, input model.EventBridgeWebhookSubscriptionInput) (output *model.WebhookSubscription, err error)
+
+	// NewPubSubWebhookSubscriptionFunc mocks the NewPubSubWebhookSubscription method.
+	NewPubSubWebhookSubscriptionFunc func(ctx context.Context, topic model.WebhookSubscriptionTopic, input model.PubSubWebhookSubscriptionInput) (output *model.WebhookSubscription, err error)
+
+	// UpdatePubSubWebhookSubscriptionFunc mocks the UpdatePubSubWebhookSubscription method.
+	UpdatePubSubWebhookSubscriptionFunc func(ctx context.Context, webhookID string, input model.PubSubWebhookSubscriptionInput) (output *model.WebhookSubscription, err error)
+
+	// ListWebhookSubscriptionsByEndpointFunc mocks the ListWebhookSubscriptionsByEndpoint method.
+	ListWebhookSubscriptionsByEndpointFunc func(ctx context.Context, topics []model.WebhookSubscriptionTopic, endpointType shopify.WebhookEndpointType) (output []*model.WebhookSubscription, err error)
+
+	// ListWebhookSubscriptionsWithOptionsFunc mocks the ListWebhookSubscriptionsWithOptions method.
+	ListWebhookSubscriptionsWithOptionsFunc func(ctx context.Context, opts shopify.WebhookSubscriptionListOptions) (output []*model.WebhookSubscription, err error)
+
+	// calls tracks calls to the methods.
+	calls struct {
+		// NewWebhookSubscription holds details about calls to the NewWebhookSubscription method.
+		NewWebhookSubscription []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Topic is the topic argument value.
+			Topic model.WebhookSubscriptionTopic
+			// Input is the input argument value.
+			Input model.WebhookSubscriptionInput
+		}
+		// NewEventBridgeWebhookSubscription holds details about calls to the NewEventBridgeWebhookSubscription method.
+		NewEventBridgeWebhookSubscription []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Topic is the topic argument value.
+			Topic model.WebhookSubscriptionTopic
+			// Input is the input argument value.
+			Input model.EventBridgeWebhookSubscriptionInput
+		}
+		// ListWebhookSubscriptions holds details about calls to the ListWebhookSubscriptions method.
+		ListWebhookSubscriptions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Topics is the topics argument value.
+			Topics []model.WebhookSubscriptionTopic
+		}
+		// DeleteWebhook holds details about calls to the DeleteWebhook method.
+		DeleteWebhook []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WebhookID is the webhookID argument value.
+			WebhookID string
+		}
+		// UpdateWebhookSubscription holds details about calls to the UpdateWebhookSubscription method.
+		UpdateWebhookSubscription []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WebhookID is the webhookID argument value.
+			WebhookID string
+			// Input is the input argument value.
+			Input model.WebhookSubscriptionInput
+		}
+		// NewFilteredWebhookSubscription holds details about calls to the NewFilteredWebhookSubscription method.
+		NewFilteredWebhookSubscription []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Topic is the topic argument value.
+			Topic model.WebhookSubscriptionTopic
+			// Input is the input argument value.
+			Input shopify.WebhookSubscriptionFilteredInput
+		}
+		// UpdateFilteredWebhookSubscription holds details about calls to the UpdateFilteredWebhookSubscription method.
+		UpdateFilteredWebhookSubscription []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WebhookID is the webhookID argument value.
+			WebhookID string
+			// Input is the input argument value.
+			Input shopify.WebhookSubscriptionFilteredInput
+		}
+		// UpdateEventBridgeWebhookSubscription holds details about calls to the UpdateEventBridgeWebhookSubscription method.
+		UpdateEventBridgeWebhookSubscription []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WebhookID is the webhookID argument value.
+			WebhookID string
+			// Input is the input argument value.
+			Input model.EventBridgeWebhookSubscriptionInput
+		}
+		// NewPubSubWebhookSubscription holds details about calls to the NewPubSubWebhookSubscription method.
+		NewPubSubWebhookSubscription []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Topic is the topic argument value.
+			Topic model.WebhookSubscriptionTopic
+			// Input is the input argument value.
+			Input model.PubSubWebhookSubscriptionInput
+		}
+		// UpdatePubSubWebhookSubscription holds details about calls to the UpdatePubSubWebhookSubscription method.
+		UpdatePubSubWebhookSubscription []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// WebhookID is the webhookID argument value.
+			WebhookID string
+			// Input is the input argument value.
+			Input model.PubSubWebhookSubscriptionInput
+		}
+		// ListWebhookSubscriptionsByEndpoint holds details about calls to the ListWebhookSubscriptionsByEndpoint method.
+		ListWebhookSubscriptionsByEndpoint []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Topics is the topics argument value.
+			Topics []model.WebhookSubscriptionTopic
+			// EndpointType is the endpointType argument value.
+			EndpointType shopify.WebhookEndpointType
+		}
+		// ListWebhookSubscriptionsWithOptions holds details about calls to the ListWebhookSubscriptionsWithOptions method.
+		ListWebhookSubscriptionsWithOptions []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Opts is the opts argument value.
+			Opts shopify.WebhookSubscriptionListOptions
+		}
+	}
+	lockNewWebhookSubscription               sync.RWMutex
+	lockNewEventBridgeWebhookSubscription    sync.RWMutex
+	lockListWebhookSubscriptions             sync.RWMutex
+	lockDeleteWebhook                        sync.RWMutex
+	lockUpdateWebhookSubscription            sync.RWMutex
+	lockNewFilteredWebhookSubscription       sync.RWMutex
+	lockUpdateFilteredWebhookSubscription    sync.RWMutex
+	lockUpdateEventBridgeWebhookSubscription sync.RWMutex
+	lockNewPubSubWebhookSubscription         sync.RWMutex
+	lockUpdatePubSubWebhookSubscription      sync.RWMutex
+	lockListWebhookSubscriptionsByEndpoint   sync.RWMutex
+	lockListWebhookSubscriptionsWithOptions  sync.RWMutex
+}
+
+// NewWebhookSubscription calls NewWebhookSubscriptionFunc.
+func (mock *WebhookServiceMock) NewWebhookSubscription(ctx context.Context, topic model.WebhookSubscriptionTopic, input model.WebhookSubscriptionInput) (output *model.WebhookSubscription, err error) {
+	if mock.NewWebhookSubscriptionFunc == nil {
+		panic("WebhookServiceMock.NewWebhookSubscriptionFunc: method is nil but WebhookService.NewWebhookSubscription was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Topic is the topic argument value.
+		Topic model.WebhookSubscriptionTopic
+		// Input is the input argument value.
+		Input model.WebhookSubscriptionInput
+	}{
+		Ctx:   ctx,
+		Topic: topic,
+		Input: input,
+	}
+	mock.lockNewWebhookSubscription.Lock()
+	mock.calls.NewWebhookSubscription = append(mock.calls.NewWebhookSubscription, callInfo)
+	mock.lockNewWebhookSubscription.Unlock()
+	return mock.NewWebhookSubscriptionFunc(ctx, topic, input)
+}
+
+// NewWebhookSubscriptionCalls returns the calls made to NewWebhookSubscription.
+func (mock *WebhookServiceMock) NewWebhookSubscriptionCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Topic is the topic argument value.
+	Topic model.WebhookSubscriptionTopic
+	// Input is the input argument value.
+	Input model.WebhookSubscriptionInput
+} {
+	mock.lockNewWebhookSubscription.RLock()
+	defer mock.lockNewWebhookSubscription.RUnlock()
+	return mock.calls.NewWebhookSubscription
+}
+
+// NewEventBridgeWebhookSubscription calls NewEventBridgeWebhookSubscriptionFunc.
+func (mock *WebhookServiceMock) NewEventBridgeWebhookSubscription(ctx context.Context, topic model.WebhookSubscriptionTopic, input model.EventBridgeWebhookSubscriptionInput) (output *model.WebhookSubscription, err error) {
+	if mock.NewEventBridgeWebhookSubscriptionFunc == nil {
+		panic("WebhookServiceMock.NewEventBridgeWebhookSubscriptionFunc: method is nil but WebhookService.NewEventBridgeWebhookSubscription was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Topic is the topic argument value.
+		Topic model.WebhookSubscriptionTopic
+		// Input is the input argument value.
+		Input model.EventBridgeWebhookSubscriptionInput
+	}{
+		Ctx:   ctx,
+		Topic: topic,
+		Input: input,
+	}
+	mock.lockNewEventBridgeWebhookSubscription.Lock()
+	mock.calls.NewEventBridgeWebhookSubscription = append(mock.calls.NewEventBridgeWebhookSubscription, callInfo)
+	mock.lockNewEventBridgeWebhookSubscription.Unlock()
+	return mock.NewEventBridgeWebhookSubscriptionFunc(ctx, topic, input)
+}
+
+// NewEventBridgeWebhookSubscriptionCalls returns the calls made to NewEventBridgeWebhookSubscription.
+func (mock *WebhookServiceMock) NewEventBridgeWebhookSubscriptionCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Topic is the topic argument value.
+	Topic model.WebhookSubscriptionTopic
+	// Input is the input argument value.
+	Input model.EventBridgeWebhookSubscriptionInput
+} {
+	mock.lockNewEventBridgeWebhookSubscription.RLock()
+	defer mock.lockNewEventBridgeWebhookSubscription.RUnlock()
+	return mock.calls.NewEventBridgeWebhookSubscription
+}
+
+// ListWebhookSubscriptions calls ListWebhookSubscriptionsFunc.
+func (mock *WebhookServiceMock) ListWebhookSubscriptions(ctx context.Context, topics []model.WebhookSubscriptionTopic) (output []*model.WebhookSubscription, err error) {
+	if mock.ListWebhookSubscriptionsFunc == nil {
+		panic("WebhookServiceMock.ListWebhookSubscriptionsFunc: method is nil but WebhookService.ListWebhookSubscriptions was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Topics is the topics argument value.
+		Topics []model.WebhookSubscriptionTopic
+	}{
+		Ctx:    ctx,
+		Topics: topics,
+	}
+	mock.lockListWebhookSubscriptions.Lock()
+	mock.calls.ListWebhookSubscriptions = append(mock.calls.ListWebhookSubscriptions, callInfo)
+	mock.lockListWebhookSubscriptions.Unlock()
+	return mock.ListWebhookSubscriptionsFunc(ctx, topics)
+}
+
+// ListWebhookSubscriptionsCalls returns the calls made to ListWebhookSubscriptions.
+func (mock *WebhookServiceMock) ListWebhookSubscriptionsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Topics is the topics argument value.
+	Topics []model.WebhookSubscriptionTopic
+} {
+	mock.lockListWebhookSubscriptions.RLock()
+	defer mock.lockListWebhookSubscriptions.RUnlock()
+	return mock.calls.ListWebhookSubscriptions
+}
+
+// DeleteWebhook calls DeleteWebhookFunc.
+func (mock *WebhookServiceMock) DeleteWebhook(ctx context.Context, webhookID string) (deletedID *string, err error) {
+	if mock.DeleteWebhookFunc == nil {
+		panic("WebhookServiceMock.DeleteWebhookFunc: method is nil but WebhookService.DeleteWebhook was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// WebhookID is the webhookID argument value.
+		WebhookID string
+	}{
+		Ctx:       ctx,
+		WebhookID: webhookID,
+	}
+	mock.lockDeleteWebhook.Lock()
+	mock.calls.DeleteWebhook = append(mock.calls.DeleteWebhook, callInfo)
+	mock.lockDeleteWebhook.Unlock()
+	return mock.DeleteWebhookFunc(ctx, webhookID)
+}
+
+// DeleteWebhookCalls returns the calls made to DeleteWebhook.
+func (mock *WebhookServiceMock) DeleteWebhookCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// WebhookID is the webhookID argument value.
+	WebhookID string
+} {
+	mock.lockDeleteWebhook.RLock()
+	defer mock.lockDeleteWebhook.RUnlock()
+	return mock.calls.DeleteWebhook
+}
+
+// UpdateWebhookSubscription calls UpdateWebhookSubscriptionFunc.
+func (mock *WebhookServiceMock) UpdateWebhookSubscription(ctx context.Context, webhookID string, input model.WebhookSubscriptionInput) (output *model.WebhookSubscription, err error) {
+	if mock.UpdateWebhookSubscriptionFunc == nil {
+		panic("WebhookServiceMock.UpdateWebhookSubscriptionFunc: method is nil but WebhookService.UpdateWebhookSubscription was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// WebhookID is the webhookID argument value.
+		WebhookID string
+		// Input is the input argument value.
+		Input model.WebhookSubscriptionInput
+	}{
+		Ctx:       ctx,
+		WebhookID: webhookID,
+		Input:     input,
+	}
+	mock.lockUpdateWebhookSubscription.Lock()
+	mock.calls.UpdateWebhookSubscription = append(mock.calls.UpdateWebhookSubscription, callInfo)
+	mock.lockUpdateWebhookSubscription.Unlock()
+	return mock.UpdateWebhookSubscriptionFunc(ctx, webhookID, input)
+}
+
+// UpdateWebhookSubscriptionCalls returns the calls made to UpdateWebhookSubscription.
+func (mock *WebhookServiceMock) UpdateWebhookSubscriptionCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// WebhookID is the webhookID argument value.
+	WebhookID string
+	// Input is the input argument value.
+	Input model.WebhookSubscriptionInput
+} {
+	mock.lockUpdateWebhookSubscription.RLock()
+	defer mock.lockUpdateWebhookSubscription.RUnlock()
+	return mock.calls.UpdateWebhookSubscription
+}
+
+// NewFilteredWebhookSubscription calls NewFilteredWebhookSubscriptionFunc.
+func (mock *WebhookServiceMock) NewFilteredWebhookSubscription(ctx context.Context, topic model.WebhookSubscriptionTopic, input shopify.WebhookSubscriptionFilteredInput) (output *model.WebhookSubscription, err error) {
+	if mock.NewFilteredWebhookSubscriptionFunc == nil {
+		panic("WebhookServiceMock.NewFilteredWebhookSubscriptionFunc: method is nil but WebhookService.NewFilteredWebhookSubscription was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Topic is the topic argument value.
+		Topic model.WebhookSubscriptionTopic
+		// Input is the input argument value.
+		Input shopify.WebhookSubscriptionFilteredInput
+	}{
+		Ctx:   ctx,
+		Topic: topic,
+		Input: input,
+	}
+	mock.lockNewFilteredWebhookSubscription.Lock()
+	mock.calls.NewFilteredWebhookSubscription = append(mock.calls.NewFilteredWebhookSubscription, callInfo)
+	mock.lockNewFilteredWebhookSubscription.Unlock()
+	return mock.NewFilteredWebhookSubscriptionFunc(ctx, topic, input)
+}
+
+// NewFilteredWebhookSubscriptionCalls returns the calls made to NewFilteredWebhookSubscription.
+func (mock *WebhookServiceMock) NewFilteredWebhookSubscriptionCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Topic is the topic argument value.
+	Topic model.WebhookSubscriptionTopic
+	// Input is the input argument value.
+	Input shopify.WebhookSubscriptionFilteredInput
+} {
+	mock.lockNewFilteredWebhookSubscription.RLock()
+	defer mock.lockNewFilteredWebhookSubscription.RUnlock()
+	return mock.calls.NewFilteredWebhookSubscription
+}
+
+// UpdateFilteredWebhookSubscription calls UpdateFilteredWebhookSubscriptionFunc.
+func (mock *WebhookServiceMock) UpdateFilteredWebhookSubscription(ctx context.Context, webhookID string, input shopify.WebhookSubscriptionFilteredInput) (output *model.WebhookSubscription, err error) {
+	if mock.UpdateFilteredWebhookSubscriptionFunc == nil {
+		panic("WebhookServiceMock.UpdateFilteredWebhookSubscriptionFunc: method is nil but WebhookService.UpdateFilteredWebhookSubscription was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// WebhookID is the webhookID argument value.
+		WebhookID string
+		// Input is the input argument value.
+		Input shopify.WebhookSubscriptionFilteredInput
+	}{
+		Ctx:       ctx,
+		WebhookID: webhookID,
+		Input:     input,
+	}
+	mock.lockUpdateFilteredWebhookSubscription.Lock()
+	mock.calls.UpdateFilteredWebhookSubscription = append(mock.calls.UpdateFilteredWebhookSubscription, callInfo)
+	mock.lockUpdateFilteredWebhookSubscription.Unlock()
+	return mock.UpdateFilteredWebhookSubscriptionFunc(ctx, webhookID, input)
+}
+
+// UpdateFilteredWebhookSubscriptionCalls returns the calls made to UpdateFilteredWebhookSubscription.
+func (mock *WebhookServiceMock) UpdateFilteredWebhookSubscriptionCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// WebhookID is the webhookID argument value.
+	WebhookID string
+	// Input is the input argument value.
+	Input shopify.WebhookSubscriptionFilteredInput
+} {
+	mock.lockUpdateFilteredWebhookSubscription.RLock()
+	defer mock.lockUpdateFilteredWebhookSubscription.RUnlock()
+	return mock.calls.UpdateFilteredWebhookSubscription
+}
+
+// UpdateEventBridgeWebhookSubscription calls UpdateEventBridgeWebhookSubscriptionFunc.
+func (mock *WebhookServiceMock) UpdateEventBridgeWebhookSubscription(ctx context.Context, webhookID string, input model.EventBridgeWebhookSubscriptionInput) (output *model.WebhookSubscription, err error) {
+	if mock.UpdateEventBridgeWebhookSubscriptionFunc == nil {
+		panic("WebhookServiceMock.UpdateEventBridgeWebhookSubscriptionFunc: method is nil but WebhookService.UpdateEventBridgeWebhookSubscription was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// WebhookID is the webhookID argument value.
+		WebhookID string
+		// Input is the input argument value.
+		Input model.EventBridgeWebhookSubscriptionInput
+	}{
+		Ctx:       ctx,
+		WebhookID: webhookID,
+		Input:     input,
+	}
+	mock.lockUpdateEventBridgeWebhookSubscription.Lock()
+	mock.calls.UpdateEventBridgeWebhookSubscription = append(mock.calls.UpdateEventBridgeWebhookSubscription, callInfo)
+	mock.lockUpdateEventBridgeWebhookSubscription.Unlock()
+	return mock.UpdateEventBridgeWebhookSubscriptionFunc(ctx, webhookID, input)
+}
+
+// UpdateEventBridgeWebhookSubscriptionCalls returns the calls made to UpdateEventBridgeWebhookSubscription.
+func (mock *WebhookServiceMock) UpdateEventBridgeWebhookSubscriptionCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// WebhookID is the webhookID argument value.
+	WebhookID string
+	// Input is the input argument value.
+	Input model.EventBridgeWebhookSubscriptionInput
+} {
+	mock.lockUpdateEventBridgeWebhookSubscription.RLock()
+	defer mock.lockUpdateEventBridgeWebhookSubscription.RUnlock()
+	return mock.calls.UpdateEventBridgeWebhookSubscription
+}
+
+// NewPubSubWebhookSubscription calls NewPubSubWebhookSubscriptionFunc.
+func (mock *WebhookServiceMock) NewPubSubWebhookSubscription(ctx context.Context, topic model.WebhookSubscriptionTopic, input model.PubSubWebhookSubscriptionInput) (output *model.WebhookSubscription, err error) {
+	if mock.NewPubSubWebhookSubscriptionFunc == nil {
+		panic("WebhookServiceMock.NewPubSubWebhookSubscriptionFunc: method is nil but WebhookService.NewPubSubWebhookSubscription was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Topic is the topic argument value.
+		Topic model.WebhookSubscriptionTopic
+		// Input is the input argument value.
+		Input model.PubSubWebhookSubscriptionInput
+	}{
+		Ctx:   ctx,
+		Topic: topic,
+		Input: input,
+	}
+	mock.lockNewPubSubWebhookSubscription.Lock()
+	mock.calls.NewPubSubWebhookSubscription = append(mock.calls.NewPubSubWebhookSubscription, callInfo)
+	mock.lockNewPubSubWebhookSubscription.Unlock()
+	return mock.NewPubSubWebhookSubscriptionFunc(ctx, topic, input)
+}
+
+// NewPubSubWebhookSubscriptionCalls returns the calls made to NewPubSubWebhookSubscription.
+func (mock *WebhookServiceMock) NewPubSubWebhookSubscriptionCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Topic is the topic argument value.
+	Topic model.WebhookSubscriptionTopic
+	// Input is the input argument value.
+	Input model.PubSubWebhookSubscriptionInput
+} {
+	mock.lockNewPubSubWebhookSubscription.RLock()
+	defer mock.lockNewPubSubWebhookSubscription.RUnlock()
+	return mock.calls.NewPubSubWebhookSubscription
+}
+
+// UpdatePubSubWebhookSubscription calls UpdatePubSubWebhookSubscriptionFunc.
+func (mock *WebhookServiceMock) UpdatePubSubWebhookSubscription(ctx context.Context, webhookID string, input model.PubSubWebhookSubscriptionInput) (output *model.WebhookSubscription, err error) {
+	if mock.UpdatePubSubWebhookSubscriptionFunc == nil {
+		panic("WebhookServiceMock.UpdatePubSubWebhookSubscriptionFunc: method is nil but WebhookService.UpdatePubSubWebhookSubscription was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// WebhookID is the webhookID argument value.
+		WebhookID string
+		// Input is the input argument value.
+		Input model.PubSubWebhookSubscriptionInput
+	}{
+		Ctx:       ctx,
+		WebhookID: webhookID,
+		Input:     input,
+	}
+	mock.lockUpdatePubSubWebhookSubscription.Lock()
+	mock.calls.UpdatePubSubWebhookSubscription = append(mock.calls.UpdatePubSubWebhookSubscription, callInfo)
+	mock.lockUpdatePubSubWebhookSubscription.Unlock()
+	return mock.UpdatePubSubWebhookSubscriptionFunc(ctx, webhookID, input)
+}
+
+// UpdatePubSubWebhookSubscriptionCalls returns the calls made to UpdatePubSubWebhookSubscription.
+func (mock *WebhookServiceMock) UpdatePubSubWebhookSubscriptionCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// WebhookID is the webhookID argument value.
+	WebhookID string
+	// Input is the input argument value.
+	Input model.PubSubWebhookSubscriptionInput
+} {
+	mock.lockUpdatePubSubWebhookSubscription.RLock()
+	defer mock.lockUpdatePubSubWebhookSubscription.RUnlock()
+	return mock.calls.UpdatePubSubWebhookSubscription
+}
+
+// ListWebhookSubscriptionsByEndpoint calls ListWebhookSubscriptionsByEndpointFunc.
+func (mock *WebhookServiceMock) ListWebhookSubscriptionsByEndpoint(ctx context.Context, topics []model.WebhookSubscriptionTopic, endpointType shopify.WebhookEndpointType) (output []*model.WebhookSubscription, err error) {
+	if mock.ListWebhookSubscriptionsByEndpointFunc == nil {
+		panic("WebhookServiceMock.ListWebhookSubscriptionsByEndpointFunc: method is nil but WebhookService.ListWebhookSubscriptionsByEndpoint was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Topics is the topics argument value.
+		Topics []model.WebhookSubscriptionTopic
+		// EndpointType is the endpointType argument value.
+		EndpointType shopify.WebhookEndpointType
+	}{
+		Ctx:          ctx,
+		Topics:       topics,
+		EndpointType: endpointType,
+	}
+	mock.lockListWebhookSubscriptionsByEndpoint.Lock()
+	mock.calls.ListWebhookSubscriptionsByEndpoint = append(mock.calls.ListWebhookSubscriptionsByEndpoint, callInfo)
+	mock.lockListWebhookSubscriptionsByEndpoint.Unlock()
+	return mock.ListWebhookSubscriptionsByEndpointFunc(ctx, topics, endpointType)
+}
+
+// ListWebhookSubscriptionsByEndpointCalls returns the calls made to ListWebhookSubscriptionsByEndpoint.
+func (mock *WebhookServiceMock) ListWebhookSubscriptionsByEndpointCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Topics is the topics argument value.
+	Topics []model.WebhookSubscriptionTopic
+	// EndpointType is the endpointType argument value.
+	EndpointType shopify.WebhookEndpointType
+} {
+	mock.lockListWebhookSubscriptionsByEndpoint.RLock()
+	defer mock.lockListWebhookSubscriptionsByEndpoint.RUnlock()
+	return mock.calls.ListWebhookSubscriptionsByEndpoint
+}
+
+// ListWebhookSubscriptionsWithOptions calls ListWebhookSubscriptionsWithOptionsFunc.
+func (mock *WebhookServiceMock) ListWebhookSubscriptionsWithOptions(ctx context.Context, opts shopify.WebhookSubscriptionListOptions) (output []*model.WebhookSubscription, err error) {
+	if mock.ListWebhookSubscriptionsWithOptionsFunc == nil {
+		panic("WebhookServiceMock.ListWebhookSubscriptionsWithOptionsFunc: method is nil but WebhookService.ListWebhookSubscriptionsWithOptions was just called")
+	}
+	callInfo := struct {
+		// Ctx is the ctx argument value.
+		Ctx context.Context
+		// Opts is the opts argument value.
+		Opts shopify.WebhookSubscriptionListOptions
+	}{
+		Ctx:  ctx,
+		Opts: opts,
+	}
+	mock.lockListWebhookSubscriptionsWithOptions.Lock()
+	mock.calls.ListWebhookSubscriptionsWithOptions = append(mock.calls.ListWebhookSubscriptionsWithOptions, callInfo)
+	mock.lockListWebhookSubscriptionsWithOptions.Unlock()
+	return mock.ListWebhookSubscriptionsWithOptionsFunc(ctx, opts)
+}
+
+// ListWebhookSubscriptionsWithOptionsCalls returns the calls made to ListWebhookSubscriptionsWithOptions.
+func (mock *WebhookServiceMock) ListWebhookSubscriptionsWithOptionsCalls() []struct {
+	// Ctx is the ctx argument value.
+	Ctx context.Context
+	// Opts is the opts argument value.
+	Opts shopify.WebhookSubscriptionListOptions
+} {
+	mock.lockListWebhookSubscriptionsWithOptions.RLock()
+	defer mock.lockListWebhookSubscriptionsWithOptions.RUnlock()
+	return mock.calls.ListWebhookSubscriptionsWithOptions
+}
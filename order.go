@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/gempages/go-helper/errors"
 	"github.com/gempages/go-shopify-graphql-model/graph/model"
 	"github.com/gempages/go-shopify-graphql/graphql"
 )
@@ -16,10 +18,37 @@ type OrderService interface {
 	ListAll(ctx context.Context) ([]*Order, error)
 
 	ListAfterCursor(ctx context.Context, opts ListOptions) ([]*OrderQueryResult, string, string, error)
+	Iterate(ctx context.Context, query string, sortKey model.OrderSortKeys) *OrderIterator
+	IterateFrom(ctx context.Context, query string, sortKey model.OrderSortKeys, after string) *OrderIterator
+
+	Count(ctx context.Context, query string) (*model.Count, error)
 
 	Update(ctx context.Context, input OrderInput) error
 
 	GetFulfillmentOrdersAtLocation(ctx context.Context, orderID graphql.ID, locationID graphql.ID) ([]FulfillmentOrder, error)
+
+	Refund(ctx context.Context, input model.RefundInput) (*model.Refund, error)
+	SuggestedRefund(ctx context.Context, orderID string, lineItems []model.RefundLineItemInput, refundShipping bool) (*model.SuggestedRefund, error)
+
+	Capture(ctx context.Context, input model.OrderCaptureInput) (*OrderTransaction, error)
+	MarkAsPaid(ctx context.Context, id graphql.ID) (*OrderBase, error)
+	Void(ctx context.Context, parentTransactionID graphql.ID) (*OrderTransaction, error)
+
+	ListTransactions(ctx context.Context, orderID graphql.ID) ([]OrderTransaction, error)
+
+	GetPaymentTerms(ctx context.Context, referenceID string) (*model.PaymentTerms, error)
+	CreatePaymentTerms(ctx context.Context, referenceID string, attrs model.PaymentTermsCreateInput) (*model.PaymentTerms, error)
+	UpdatePaymentTerms(ctx context.Context, input model.PaymentTermsUpdateInput) (*model.PaymentTerms, error)
+	DeletePaymentTerms(ctx context.Context, paymentTermsID string) error
+
+	SendInvoice(ctx context.Context, orderID string, email *model.EmailInput) error
+	SendDraftOrderInvoice(ctx context.Context, draftOrderID string, email *model.EmailInput) (*model.DraftOrder, error)
+
+	BeginEdit(ctx context.Context, orderID string) (*model.CalculatedOrder, error)
+	EditSetQuantity(ctx context.Context, calculatedOrderID, calculatedLineItemID string, quantity int, restock bool) (*model.CalculatedOrder, error)
+	EditAddVariant(ctx context.Context, calculatedOrderID, variantID string, quantity int, locationID *string) (*model.CalculatedOrder, error)
+	CommitEdit(ctx context.Context, calculatedOrderID string, notifyCustomer bool, staffNote *string) (*OrderEditCommitResult, error)
+	Exchange(ctx context.Context, input OrderExchangeInput) (*OrderExchangeResult, error)
 }
 
 type OrderServiceOp struct {
@@ -43,13 +72,43 @@ type OrderBase struct {
 	DisplayFinancialStatus   graphql.String     `json:"displayFinancialStatus,omitempty"`
 	DisplayFulfillmentStatus graphql.String     `json:"displayFulfillmentStatus,omitempty"`
 	Transactions             []OrderTransaction `json:"transactions,omitempty"`
+	CustomAttributes         []model.Attribute  `json:"customAttributes,omitempty"`
 }
 
 type Order struct {
 	OrderBase
 
-	LineItems         []LineItem         `json:"lineItems,omitempty"`
-	FulfillmentOrders []FulfillmentOrder `json:"fulfillmentOrders,omitempty"`
+	LineItems            []LineItem                 `json:"lineItems,omitempty"`
+	FulfillmentOrders    []FulfillmentOrder         `json:"fulfillmentOrders,omitempty"`
+	Metafields           []Metafield                `json:"metafields,omitempty"`
+	DiscountApplications []OrderDiscountApplication `json:"discountApplications,omitempty"`
+}
+
+// OrderDiscountApplication is a discount application of an order, of the type named by Typename:
+// AutomaticDiscountApplication, DiscountCodeApplication, ManualDiscountApplication or
+// ScriptDiscountApplication. Fields of the other types are empty.
+type OrderDiscountApplication struct {
+	Typename         graphql.String                            `json:"__typename,omitempty"`
+	Index            graphql.Int                               `json:"index"`
+	AllocationMethod model.DiscountApplicationAllocationMethod `json:"allocationMethod,omitempty"`
+	TargetSelection  model.DiscountApplicationTargetSelection  `json:"targetSelection,omitempty"`
+	TargetType       model.DiscountApplicationTargetType       `json:"targetType,omitempty"`
+	Value            OrderDiscountValue                        `json:"value,omitempty"`
+	// Code is set for DiscountCodeApplication.
+	Code graphql.String `json:"code,omitempty"`
+	// Title is set for all the types but DiscountCodeApplication.
+	Title graphql.String `json:"title,omitempty"`
+	// Description is set for ManualDiscountApplication.
+	Description graphql.String `json:"description,omitempty"`
+}
+
+// OrderDiscountValue is the value of a discount application, an amount when Typename is MoneyV2
+// or a percentage when it is PricingPercentageValue.
+type OrderDiscountValue struct {
+	Typename     graphql.String `json:"__typename,omitempty"`
+	Amount       Decimal        `json:"amount,omitempty"`
+	CurrencyCode graphql.String `json:"currencyCode,omitempty"`
+	Percentage   graphql.Float  `json:"percentage,omitempty"`
 }
 
 type OrderQueryResult struct {
@@ -93,20 +152,21 @@ type OrderLineItemNode struct {
 }
 
 type LineItem struct {
-	ID                     graphql.ID      `json:"id,omitempty"`
-	SKU                    graphql.String  `json:"sku,omitempty"`
-	Quantity               graphql.Int     `json:"quantity,omitempty"`
-	FulfillableQuantity    graphql.Int     `json:"fulfillableQuantity,omitempty"`
-	FulfillmentStatus      graphql.String  `json:"fulfillmentStatus,omitempty"`
-	Vendor                 graphql.String  `json:"vendor,omitempty"`
-	Title                  graphql.String  `json:"title,omitempty"`
-	VariantTitle           graphql.String  `json:"variantTitle,omitempty"`
-	Product                LineItemProduct `json:"product,omitempty"`
-	Variant                LineItemVariant `json:"variant,omitempty"`
-	OriginalTotalSet       MoneyBag        `json:"originalTotalSet,omitempty"`
-	OriginalUnitPriceSet   MoneyBag        `json:"originalUnitPriceSet,omitempty"`
-	DiscountedUnitPriceSet MoneyBag        `json:"discountedUnitPriceSet,omitempty"`
-	DiscountedTotalSet     MoneyBag        `json:"discountedTotalSet,omitempty"`
+	ID                     graphql.ID        `json:"id,omitempty"`
+	SKU                    graphql.String    `json:"sku,omitempty"`
+	Quantity               graphql.Int       `json:"quantity,omitempty"`
+	FulfillableQuantity    graphql.Int       `json:"fulfillableQuantity,omitempty"`
+	FulfillmentStatus      graphql.String    `json:"fulfillmentStatus,omitempty"`
+	Vendor                 graphql.String    `json:"vendor,omitempty"`
+	Title                  graphql.String    `json:"title,omitempty"`
+	VariantTitle           graphql.String    `json:"variantTitle,omitempty"`
+	Product                LineItemProduct   `json:"product,omitempty"`
+	Variant                LineItemVariant   `json:"variant,omitempty"`
+	OriginalTotalSet       MoneyBag          `json:"originalTotalSet,omitempty"`
+	OriginalUnitPriceSet   MoneyBag          `json:"originalUnitPriceSet,omitempty"`
+	DiscountedUnitPriceSet MoneyBag          `json:"discountedUnitPriceSet,omitempty"`
+	DiscountedTotalSet     MoneyBag          `json:"discountedTotalSet,omitempty"`
+	CustomAttributes       []model.Attribute `json:"customAttributes,omitempty"`
 }
 
 type LineItemProduct struct {
@@ -137,14 +197,75 @@ type FulfillmentOrderLineItem struct {
 
 type OrderTransactionStatus string
 
+const (
+	OrderTransactionStatusAwaitingResponse OrderTransactionStatus = "AWAITING_RESPONSE"
+	OrderTransactionStatusError            OrderTransactionStatus = "ERROR"
+	OrderTransactionStatusFailure          OrderTransactionStatus = "FAILURE"
+	OrderTransactionStatusPending          OrderTransactionStatus = "PENDING"
+	OrderTransactionStatusSuccess          OrderTransactionStatus = "SUCCESS"
+	OrderTransactionStatusUnknown          OrderTransactionStatus = "UNKNOWN"
+)
+
 type OrderTransactionKind string
 
+const (
+	OrderTransactionKindAuthorization    OrderTransactionKind = "AUTHORIZATION"
+	OrderTransactionKindCapture          OrderTransactionKind = "CAPTURE"
+	OrderTransactionKindChange           OrderTransactionKind = "CHANGE"
+	OrderTransactionKindEmvAuthorization OrderTransactionKind = "EMV_AUTHORIZATION"
+	OrderTransactionKindRefund           OrderTransactionKind = "REFUND"
+	OrderTransactionKindSale             OrderTransactionKind = "SALE"
+	OrderTransactionKindSuggestedRefund  OrderTransactionKind = "SUGGESTED_REFUND"
+	OrderTransactionKindVoid             OrderTransactionKind = "VOID"
+)
+
 type OrderTransaction struct {
-	ProcessedAt DateTime               `json:"processedAt,omitempty"`
-	Status      OrderTransactionStatus `json:"status,omitempty"`
-	Kind        OrderTransactionKind   `json:"kind,omitempty"`
-	Test        graphql.Boolean        `json:"test,omitempty"`
-	AmountSet   *MoneyBag              `json:"amountSet,omitempty"`
+	ID                graphql.ID             `json:"id,omitempty"`
+	ProcessedAt       DateTime               `json:"processedAt,omitempty"`
+	Status            OrderTransactionStatus `json:"status,omitempty"`
+	Kind              OrderTransactionKind   `json:"kind,omitempty"`
+	Gateway           graphql.String         `json:"gateway,omitempty"`
+	ErrorCode         graphql.String         `json:"errorCode,omitempty"`
+	Test              graphql.Boolean        `json:"test,omitempty"`
+	AmountSet         *MoneyBag              `json:"amountSet,omitempty"`
+	ParentTransaction *struct {
+		ID graphql.ID `json:"id,omitempty"`
+	} `json:"parentTransaction,omitempty"`
+
+	CreatedAt         DateTime                     `json:"createdAt,omitempty"`
+	AuthorizationCode graphql.String               `json:"authorizationCode,omitempty"`
+	FormattedGateway  graphql.String               `json:"formattedGateway,omitempty"`
+	PaymentID         graphql.String               `json:"paymentId,omitempty"`
+	ReceiptJSON       graphql.String               `json:"receiptJson,omitempty"`
+	TotalUnsettledSet *MoneyBag                    `json:"totalUnsettledSet,omitempty"`
+	PaymentDetails    *OrderTransactionCardDetails `json:"paymentDetails,omitempty"`
+	Fees              []OrderTransactionFee        `json:"fees,omitempty"`
+}
+
+// OrderTransactionCardDetails is the CardPaymentDetails of a transaction, other payment details types only have the typename.
+type OrderTransactionCardDetails struct {
+	Typename          graphql.String `json:"__typename,omitempty"`
+	AvsResultCode     graphql.String `json:"avsResultCode,omitempty"`
+	Bin               graphql.String `json:"bin,omitempty"`
+	Company           graphql.String `json:"company,omitempty"`
+	CvvResultCode     graphql.String `json:"cvvResultCode,omitempty"`
+	ExpirationMonth   graphql.Int    `json:"expirationMonth,omitempty"`
+	ExpirationYear    graphql.Int    `json:"expirationYear,omitempty"`
+	Name              graphql.String `json:"name,omitempty"`
+	Number            graphql.String `json:"number,omitempty"`
+	PaymentMethodName graphql.String `json:"paymentMethodName,omitempty"`
+	Wallet            graphql.String `json:"wallet,omitempty"`
+}
+
+type OrderTransactionFee struct {
+	ID          graphql.ID     `json:"id,omitempty"`
+	Amount      MoneyV2        `json:"amount,omitempty"`
+	FlatFee     MoneyV2        `json:"flatFee,omitempty"`
+	FlatFeeName graphql.String `json:"flatFeeName,omitempty"`
+	Rate        Decimal        `json:"rate,omitempty"`
+	RateName    graphql.String `json:"rateName,omitempty"`
+	TaxAmount   MoneyV2        `json:"taxAmount,omitempty"`
+	Type        graphql.String `json:"type,omitempty"`
 }
 
 type mutationOrderUpdate struct {
@@ -154,10 +275,222 @@ type mutationOrderUpdate struct {
 type OrderUpdateResult struct {
 	UserErrors []UserErrors `json:"userErrors"`
 }
+
+// OrderInput holds the fields orderUpdate can change on an existing order, empty fields are left untouched.
 type OrderInput struct {
-	ID   graphql.ID       `json:"id,omitempty"`
-	Tags []graphql.String `json:"tags,omitempty"`
-	Note graphql.String   `json:"note,omitempty"`
+	ID               graphql.ID                 `json:"id,omitempty"`
+	Email            graphql.String             `json:"email,omitempty"`
+	Tags             []graphql.String           `json:"tags,omitempty"`
+	Note             graphql.String             `json:"note,omitempty"`
+	ShippingAddress  *model.MailingAddressInput `json:"shippingAddress,omitempty"`
+	CustomAttributes []model.AttributeInput     `json:"customAttributes,omitempty"`
+	Metafields       []model.MetafieldInput     `json:"metafields,omitempty"`
+}
+
+type mutationRefundCreate struct {
+	RefundCreateResult struct {
+		Refund     *model.Refund `json:"refund,omitempty"`
+		UserErrors []UserErrors  `json:"userErrors,omitempty"`
+	} `json:"refundCreate"`
+}
+
+const moneyBagFields = `
+	presentmentMoney {
+		amount
+		currencyCode
+	}
+	shopMoney {
+		amount
+		currencyCode
+	}
+`
+
+var refundCreate = fmt.Sprintf(`
+mutation refundCreate($input: RefundInput!) {
+	refundCreate(input: $input) {
+		refund {
+			id
+			legacyResourceId
+			createdAt
+			note
+			totalRefundedSet {
+				%[1]s
+			}
+			refundLineItems(first: 250) {
+				edges {
+					node {
+						lineItem {
+							id
+							sku
+						}
+						quantity
+						restockType
+						restocked
+						subtotalSet {
+							%[1]s
+						}
+						totalTaxSet {
+							%[1]s
+						}
+					}
+				}
+			}
+			transactions(first: 50) {
+				edges {
+					node {
+						id
+						kind
+						status
+						gateway
+						amountSet {
+							%[1]s
+						}
+					}
+				}
+			}
+		}
+		userErrors {
+			field
+			message
+		}
+	}
+}
+`, moneyBagFields)
+
+var querySuggestedRefund = fmt.Sprintf(`
+query suggestedRefund($id: ID!, $refundLineItems: [RefundLineItemInput!], $refundShipping: Boolean, $suggestFullRefund: Boolean) {
+	order(id: $id) {
+		id
+		suggestedRefund(refundLineItems: $refundLineItems, refundShipping: $refundShipping, suggestFullRefund: $suggestFullRefund) {
+			amountSet {
+				%[1]s
+			}
+			maximumRefundableSet {
+				%[1]s
+			}
+			subtotalSet {
+				%[1]s
+			}
+			totalTaxSet {
+				%[1]s
+			}
+			totalCartDiscountAmountSet {
+				%[1]s
+			}
+			shipping {
+				amountSet {
+					%[1]s
+				}
+				maximumRefundableSet {
+					%[1]s
+				}
+				taxSet {
+					%[1]s
+				}
+			}
+			refundLineItems {
+				lineItem {
+					id
+					sku
+				}
+				quantity
+				restockType
+				location {
+					id
+				}
+				priceSet {
+					%[1]s
+				}
+				subtotalSet {
+					%[1]s
+				}
+				totalTaxSet {
+					%[1]s
+				}
+			}
+			suggestedTransactions {
+				gateway
+				kind
+				parentTransaction {
+					id
+				}
+				amountSet {
+					%[1]s
+				}
+				maximumRefundableSet {
+					%[1]s
+				}
+			}
+		}
+	}
+}
+`, moneyBagFields)
+
+var orderTransactionFields = fmt.Sprintf(`
+	id
+	processedAt
+	status
+	kind
+	gateway
+	errorCode
+	test
+	amountSet {
+		%s
+	}
+	parentTransaction {
+		id
+	}
+`, moneyBagFields)
+
+var orderTransactionDetailFields = fmt.Sprintf(`
+	%s
+	createdAt
+	authorizationCode
+	formattedGateway
+	paymentId
+	receiptJson
+	totalUnsettledSet {
+		%s
+	}
+	paymentDetails {
+		__typename
+		... on CardPaymentDetails {
+			avsResultCode
+			bin
+			company
+			cvvResultCode
+			expirationMonth
+			expirationYear
+			name
+			number
+			paymentMethodName
+			wallet
+		}
+	}
+	fees {
+		id
+		amount {
+			amount
+			currencyCode
+		}
+		flatFee {
+			amount
+			currencyCode
+		}
+		flatFeeName
+		rate
+		rateName
+		taxAmount {
+			amount
+			currencyCode
+		}
+		type
+	}
+`, orderTransactionFields, moneyBagFields)
+
+type orderTransactionMutationResult struct {
+	Transaction *OrderTransaction `json:"transaction,omitempty"`
+	UserErrors  []UserErrors      `json:"userErrors,omitempty"`
 }
 
 const orderBaseQuery = `
@@ -221,6 +554,10 @@ const orderBaseQuery = `
 	}
 	note
 	tags
+	customAttributes {
+		key
+		value
+	}
 	transactions {
 		processedAt
 		status
@@ -329,9 +666,93 @@ fragment lineItem on LineItem {
 			currencyCode
 		}
 	}
+	customAttributes{
+		key
+		value
+	}
 }
 `
 
+// orderBulkQuery selects an order with its children, as decoded into Order. The typename of
+// discount applications tells the bulk operation service their type, as they have no id.
+var orderBulkQuery = fmt.Sprintf(`
+	%s
+	lineItems{
+		edges{
+			node{
+				...lineItem
+			}
+		}
+	}
+	metafields{
+		edges{
+			node{
+				id
+				legacyResourceId
+				namespace
+				key
+				value
+				type
+				ownerType
+			}
+		}
+	}
+	discountApplications{
+		edges{
+			node{
+				__typename
+				index
+				allocationMethod
+				targetSelection
+				targetType
+				value{
+					__typename
+					... on MoneyV2{
+						amount
+						currencyCode
+					}
+					... on PricingPercentageValue{
+						percentage
+					}
+				}
+				... on DiscountCodeApplication{
+					code
+				}
+				... on AutomaticDiscountApplication{
+					title
+				}
+				... on ManualDiscountApplication{
+					title
+					description
+				}
+				... on ScriptDiscountApplication{
+					title
+				}
+			}
+		}
+	}
+	fulfillmentOrders{
+		edges{
+			node{
+				id
+				status
+				lineItems{
+					edges{
+						node{
+							id
+							remainingQuantity
+							totalQuantity
+							lineItem{
+								sku
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+`, orderBaseQuery)
+
 const lineItemFragmentLight = `
 fragment lineItem on LineItem {
 	id
@@ -407,20 +828,13 @@ func (s *OrderServiceOp) List(ctx context.Context, opts ListOptions) ([]*Order,
 				edges{
 					node{
 						%s
-						lineItems{
-							edges{
-								node{
-									...lineItem
-								}
-							}
-						}
 					}
 				}
 			}
 		}
 
 		%s
-	`, orderBaseQuery, lineItemFragment)
+	`, orderBulkQuery, lineItemFragment)
 
 	q = strings.ReplaceAll(q, "$query", opts.Query)
 
@@ -440,20 +854,13 @@ func (s *OrderServiceOp) ListAll(ctx context.Context) ([]*Order, error) {
 				edges{
 					node{
 						%s
-						lineItems{
-							edges{
-								node{
-									...lineItem
-								}
-							}
-						}
 					}
 				}
 			}
 		}
 
 		%s
-	`, orderBaseQuery, lineItemFragment)
+	`, orderBulkQuery, lineItemFragment)
 
 	res := []*Order{}
 	err := s.client.BulkOperation.BulkQuery(ctx, q, &res)
@@ -538,6 +945,29 @@ func (s *OrderServiceOp) ListAfterCursor(ctx context.Context, opts ListOptions)
 	return res, firstCursor, lastCursor, nil
 }
 
+// Iterate pages through the orders matching query without going through a bulk operation, which suits incremental syncs.
+// The query can be built with OrderSearchQuery.
+func (s *OrderServiceOp) Iterate(ctx context.Context, query string, sortKey model.OrderSortKeys) *OrderIterator {
+	return s.IterateFrom(ctx, query, sortKey, "")
+}
+
+// IterateFrom is Iterate starting after a cursor returned by OrderIterator.Cursor. The cursor is only
+// valid with the query and sort key of the iterator that returned it.
+func (s *OrderServiceOp) IterateFrom(ctx context.Context, query string, sortKey model.OrderSortKeys, after string) *OrderIterator {
+	return &OrderIterator{
+		ctx:     ctx,
+		client:  s.client,
+		query:   query,
+		sortKey: sortKey,
+		cursor:  after,
+		hasNext: true,
+	}
+}
+
+func (s *OrderServiceOp) Count(ctx context.Context, query string) (*model.Count, error) {
+	return queryCount(ctx, s.client.gql, "ordersCount", query)
+}
+
 func (s *OrderServiceOp) Update(ctx context.Context, input OrderInput) error {
 	m := mutationOrderUpdate{}
 
@@ -592,3 +1022,649 @@ func (s *OrderServiceOp) GetFulfillmentOrdersAtLocation(ctx context.Context, ord
 
 	return res, nil
 }
+
+// Refund creates a full or partial refund, use SuggestedRefund to calculate the amounts and transactions.
+func (s *OrderServiceOp) Refund(ctx context.Context, input model.RefundInput) (*model.Refund, error) {
+	m := mutationRefundCreate{}
+	vars := map[string]interface{}{
+		"input": input,
+	}
+	err := s.client.gql.MutateString(ctx, refundCreate, vars, &m)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(m.RefundCreateResult.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", m.RefundCreateResult.UserErrors)
+	}
+
+	return m.RefundCreateResult.Refund, nil
+}
+
+// SuggestedRefund calculates the refund of the line items including prorated taxes and discounts.
+// When no line items are given, a full refund of the order is suggested.
+func (s *OrderServiceOp) SuggestedRefund(ctx context.Context, orderID string, lineItems []model.RefundLineItemInput, refundShipping bool) (*model.SuggestedRefund, error) {
+	vars := map[string]interface{}{
+		"id":                orderID,
+		"refundShipping":    refundShipping,
+		"suggestFullRefund": len(lineItems) == 0,
+	}
+	if len(lineItems) > 0 {
+		vars["refundLineItems"] = lineItems
+	}
+
+	out := struct {
+		Order *struct {
+			ID              string                 `json:"id"`
+			SuggestedRefund *model.SuggestedRefund `json:"suggestedRefund"`
+		} `json:"order"`
+	}{}
+	err := s.client.gql.QueryString(ctx, querySuggestedRefund, vars, &out)
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Order == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "order not found", nil)
+	}
+
+	return out.Order.SuggestedRefund, nil
+}
+
+// Capture captures an authorized payment, partially when the amount is lower than the authorized amount.
+func (s *OrderServiceOp) Capture(ctx context.Context, input model.OrderCaptureInput) (*OrderTransaction, error) {
+	m := fmt.Sprintf(`
+	mutation orderCapture($input: OrderCaptureInput!) {
+		orderCapture(input: $input) {
+			transaction {
+				%s
+			}
+			userErrors {
+				field
+				message
+			}
+		}
+	}
+	`, orderTransactionFields)
+
+	out := struct {
+		OrderCapture orderTransactionMutationResult `json:"orderCapture"`
+	}{}
+	vars := map[string]interface{}{
+		"input": input,
+	}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.OrderCapture.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.OrderCapture.UserErrors)
+	}
+
+	return out.OrderCapture.Transaction, nil
+}
+
+// MarkAsPaid marks an order paid by a manual payment method, e.g. cash on delivery.
+func (s *OrderServiceOp) MarkAsPaid(ctx context.Context, id graphql.ID) (*OrderBase, error) {
+	m := fmt.Sprintf(`
+	mutation orderMarkAsPaid($input: OrderMarkAsPaidInput!) {
+		orderMarkAsPaid(input: $input) {
+			order {
+				id
+				legacyResourceId
+				name
+				displayFinancialStatus
+				totalReceivedSet {
+					%s
+				}
+			}
+			userErrors {
+				field
+				message
+			}
+		}
+	}
+	`, moneyBagFields)
+
+	out := struct {
+		OrderMarkAsPaid struct {
+			Order      *OrderBase   `json:"order,omitempty"`
+			UserErrors []UserErrors `json:"userErrors,omitempty"`
+		} `json:"orderMarkAsPaid"`
+	}{}
+	vars := map[string]interface{}{
+		"input": map[string]interface{}{
+			"id": id,
+		},
+	}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.OrderMarkAsPaid.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.OrderMarkAsPaid.UserErrors)
+	}
+
+	return out.OrderMarkAsPaid.Order, nil
+}
+
+// Void voids an uncaptured authorization transaction.
+func (s *OrderServiceOp) Void(ctx context.Context, parentTransactionID graphql.ID) (*OrderTransaction, error) {
+	m := fmt.Sprintf(`
+	mutation transactionVoid($parentTransactionId: ID!) {
+		transactionVoid(parentTransactionId: $parentTransactionId) {
+			transaction {
+				%s
+			}
+			userErrors {
+				field
+				message
+				code
+			}
+		}
+	}
+	`, orderTransactionFields)
+
+	out := struct {
+		TransactionVoid orderTransactionMutationResult `json:"transactionVoid"`
+	}{}
+	vars := map[string]interface{}{
+		"parentTransactionId": parentTransactionID,
+	}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.TransactionVoid.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.TransactionVoid.UserErrors)
+	}
+
+	return out.TransactionVoid.Transaction, nil
+}
+
+// maxOrderTransactions is the number of transactions ListTransactions returns at most.
+const maxOrderTransactions = 250
+
+// ListTransactions returns every transaction of the order with its payment details and fees, oldest first.
+// Order.transactions is a list, not a connection, so it can't be paged: an order with more than
+// maxOrderTransactions transactions is an error rather than a truncated list.
+func (s *OrderServiceOp) ListTransactions(ctx context.Context, orderID graphql.ID) ([]OrderTransaction, error) {
+	q := fmt.Sprintf(`
+		query orderTransactions($id: ID!, $first: Int) {
+			order(id: $id) {
+				id
+				transactions(first: $first) {
+					%s
+				}
+			}
+		}
+	`, orderTransactionDetailFields)
+
+	vars := map[string]interface{}{
+		"id":    orderID,
+		"first": maxOrderTransactions + 1,
+	}
+	out := struct {
+		Order *struct {
+			ID           graphql.ID         `json:"id"`
+			Transactions []OrderTransaction `json:"transactions"`
+		} `json:"order"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Order == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "order not found", nil)
+	}
+	if len(out.Order.Transactions) > maxOrderTransactions {
+		return nil, fmt.Errorf("order %s has more than %d transactions", orderID, maxOrderTransactions)
+	}
+
+	return out.Order.Transactions, nil
+}
+
+const orderIteratorPageSize = 50
+
+// OrderIterator walks the orders page by page:
+//
+//	it := client.Order.Iterate(ctx, query, model.OrderSortKeysUpdatedAt)
+//	for it.Next() {
+//		order := it.Order()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type OrderIterator struct {
+	ctx     context.Context
+	client  *Client
+	query   string
+	sortKey model.OrderSortKeys
+
+	page    []*OrderQueryResult
+	current *OrderQueryResult
+	cursor  string
+	hasNext bool
+	err     error
+}
+
+// Next advances to the next order, fetching the next page when needed. It returns false when there are no more orders or an error occurred.
+func (it *OrderIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if len(it.page) == 0 {
+		if !it.hasNext {
+			return false
+		}
+		it.err = it.fetch()
+		if it.err != nil || len(it.page) == 0 {
+			return false
+		}
+	}
+
+	it.current = it.page[0]
+	it.page = it.page[1:]
+	return true
+}
+
+func (it *OrderIterator) Order() *OrderQueryResult {
+	return it.current
+}
+
+// Cursor is the end cursor of the last fetched page. Once the orders of that page are handled, it can be
+// stored to resume a sync later with IterateFrom and the same query and sort key.
+func (it *OrderIterator) Cursor() string {
+	return it.cursor
+}
+
+func (it *OrderIterator) Err() error {
+	return it.err
+}
+
+func (it *OrderIterator) fetch() error {
+	q := fmt.Sprintf(`
+		query orders($query: String, $first: Int!, $after: String, $sortKey: OrderSortKeys) {
+			orders(query: $query, first: $first, after: $after, sortKey: $sortKey){
+				edges{
+					node{
+						%s
+
+						lineItems(first:25){
+							edges{
+								node{
+									...lineItem
+								}
+							}
+						}
+					}
+				}
+				pageInfo{
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+
+		%s
+	`, orderLightQuery, lineItemFragmentLight)
+
+	vars := map[string]interface{}{
+		"query": it.query,
+		"first": orderIteratorPageSize,
+	}
+	if it.sortKey != "" {
+		vars["sortKey"] = it.sortKey
+	}
+	if it.cursor != "" {
+		vars["after"] = it.cursor
+	}
+
+	out := struct {
+		Orders struct {
+			Edges []struct {
+				OrderQueryResult *OrderQueryResult `json:"node,omitempty"`
+			} `json:"edges,omitempty"`
+			PageInfo struct {
+				HasNextPage bool   `json:"hasNextPage,omitempty"`
+				EndCursor   string `json:"endCursor,omitempty"`
+			} `json:"pageInfo,omitempty"`
+		} `json:"orders,omitempty"`
+	}{}
+	err := it.client.gql.QueryString(it.ctx, q, vars, &out)
+	if err != nil {
+		return fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	for _, edge := range out.Orders.Edges {
+		it.page = append(it.page, edge.OrderQueryResult)
+	}
+	it.hasNext = out.Orders.PageInfo.HasNextPage
+	if out.Orders.PageInfo.EndCursor != "" {
+		it.cursor = out.Orders.PageInfo.EndCursor
+	}
+
+	return nil
+}
+
+// OrderSearchQuery builds the search syntax of the orders query argument. Zero values are left out.
+type OrderSearchQuery struct {
+	CreatedAtMin *time.Time
+	CreatedAtMax *time.Time
+	UpdatedAtMin *time.Time
+	UpdatedAtMax *time.Time
+	// FinancialStatus values are OR'ed, e.g. "paid", "partially_refunded"
+	FinancialStatus []string
+	// FulfillmentStatus values are OR'ed, e.g. "unshipped", "shipped"
+	FulfillmentStatus []string
+	// Tags are AND'ed
+	Tags []string
+	// Status is "open", "closed", "cancelled" or "any"
+	Status string
+	// Raw is appended as is
+	Raw string
+}
+
+func (q OrderSearchQuery) String() string {
+	terms := []string{}
+	addTime := func(field, op string, t *time.Time) {
+		if t != nil {
+			terms = append(terms, fmt.Sprintf(`%s:%s"%s"`, field, op, t.UTC().Format(time.RFC3339)))
+		}
+	}
+	addTime("created_at", ">=", q.CreatedAtMin)
+	addTime("created_at", "<=", q.CreatedAtMax)
+	addTime("updated_at", ">=", q.UpdatedAtMin)
+	addTime("updated_at", "<=", q.UpdatedAtMax)
+	terms = appendSearchTermGroup(terms, "financial_status", q.FinancialStatus)
+	terms = appendSearchTermGroup(terms, "fulfillment_status", q.FulfillmentStatus)
+	for _, tag := range q.Tags {
+		terms = append(terms, fmt.Sprintf("tag:%s", quoteSearchValue(tag)))
+	}
+	if q.Status != "" {
+		terms = append(terms, fmt.Sprintf("status:%s", q.Status))
+	}
+	if q.Raw != "" {
+		terms = append(terms, q.Raw)
+	}
+
+	return strings.Join(terms, " AND ")
+}
+
+func appendSearchTermGroup(terms []string, field string, values []string) []string {
+	switch len(values) {
+	case 0:
+		return terms
+	case 1:
+		return append(terms, fmt.Sprintf("%s:%s", field, quoteSearchValue(values[0])))
+	}
+
+	group := make([]string, 0, len(values))
+	for _, v := range values {
+		group = append(group, fmt.Sprintf("%s:%s", field, quoteSearchValue(v)))
+	}
+	return append(terms, "("+strings.Join(group, " OR ")+")")
+}
+
+func quoteSearchValue(v string) string {
+	if strings.ContainsAny(v, ` :()"'`) {
+		return `"` + strings.ReplaceAll(v, `"`, `\"`) + `"`
+	}
+	return v
+}
+
+const paymentTermsFields = `
+	id
+	paymentTermsName
+	paymentTermsType
+	dueInDays
+	overdue
+	translatedName
+	paymentSchedules(first: 50) {
+		edges {
+			node {
+				id
+				issuedAt
+				dueAt
+				completedAt
+			}
+		}
+	}
+`
+
+type paymentTermsMutationResult struct {
+	PaymentTerms *model.PaymentTerms `json:"paymentTerms,omitempty"`
+	UserErrors   []UserErrors        `json:"userErrors,omitempty"`
+}
+
+// GetPaymentTerms returns the payment terms of an order or a draft order, nil when it has none.
+func (s *OrderServiceOp) GetPaymentTerms(ctx context.Context, referenceID string) (*model.PaymentTerms, error) {
+	q := fmt.Sprintf(`
+		query paymentTerms($id: ID!) {
+			node(id: $id) {
+				id
+				... on Order {
+					paymentTerms {
+						%[1]s
+					}
+				}
+				... on DraftOrder {
+					paymentTerms {
+						%[1]s
+					}
+				}
+			}
+		}
+	`, paymentTermsFields)
+
+	vars := map[string]interface{}{
+		"id": referenceID,
+	}
+	out := struct {
+		Node *struct {
+			ID           string              `json:"id"`
+			PaymentTerms *model.PaymentTerms `json:"paymentTerms"`
+		} `json:"node"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Node == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "order not found", nil)
+	}
+
+	return out.Node.PaymentTerms, nil
+}
+
+// CreatePaymentTerms sets the payment terms of an order or a draft order.
+func (s *OrderServiceOp) CreatePaymentTerms(ctx context.Context, referenceID string, attrs model.PaymentTermsCreateInput) (*model.PaymentTerms, error) {
+	m := fmt.Sprintf(`
+		mutation paymentTermsCreate($referenceId: ID!, $paymentTermsAttributes: PaymentTermsCreateInput!) {
+			paymentTermsCreate(referenceId: $referenceId, paymentTermsAttributes: $paymentTermsAttributes) {
+				paymentTerms {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, paymentTermsFields)
+
+	vars := map[string]interface{}{
+		"referenceId":            referenceID,
+		"paymentTermsAttributes": attrs,
+	}
+	out := struct {
+		PaymentTermsCreate paymentTermsMutationResult `json:"paymentTermsCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.PaymentTermsCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.PaymentTermsCreate.UserErrors)
+	}
+
+	return out.PaymentTermsCreate.PaymentTerms, nil
+}
+
+func (s *OrderServiceOp) UpdatePaymentTerms(ctx context.Context, input model.PaymentTermsUpdateInput) (*model.PaymentTerms, error) {
+	m := fmt.Sprintf(`
+		mutation paymentTermsUpdate($input: PaymentTermsUpdateInput!) {
+			paymentTermsUpdate(input: $input) {
+				paymentTerms {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, paymentTermsFields)
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+	out := struct {
+		PaymentTermsUpdate paymentTermsMutationResult `json:"paymentTermsUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.PaymentTermsUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.PaymentTermsUpdate.UserErrors)
+	}
+
+	return out.PaymentTermsUpdate.PaymentTerms, nil
+}
+
+func (s *OrderServiceOp) DeletePaymentTerms(ctx context.Context, paymentTermsID string) error {
+	m := `
+		mutation paymentTermsDelete($input: PaymentTermsDeleteInput!) {
+			paymentTermsDelete(input: $input) {
+				deletedId
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"input": map[string]interface{}{
+			"paymentTermsId": paymentTermsID,
+		},
+	}
+	out := struct {
+		PaymentTermsDelete struct {
+			DeletedID  string       `json:"deletedId,omitempty"`
+			UserErrors []UserErrors `json:"userErrors,omitempty"`
+		} `json:"paymentTermsDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.PaymentTermsDelete.UserErrors) > 0 {
+		return fmt.Errorf("%+v", out.PaymentTermsDelete.UserErrors)
+	}
+
+	return nil
+}
+
+// SendInvoice emails the order invoice to the customer. A nil email uses the shop's default notification template,
+// otherwise its subject, custom message, recipients and bcc override it.
+func (s *OrderServiceOp) SendInvoice(ctx context.Context, orderID string, email *model.EmailInput) error {
+	m := `
+		mutation orderInvoiceSend($id: ID!, $email: EmailInput) {
+			orderInvoiceSend(id: $id, email: $email) {
+				order {
+					id
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"id": orderID,
+	}
+	if email != nil {
+		vars["email"] = email
+	}
+	out := struct {
+		OrderInvoiceSend struct {
+			UserErrors []UserErrors `json:"userErrors,omitempty"`
+		} `json:"orderInvoiceSend"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.OrderInvoiceSend.UserErrors) > 0 {
+		return fmt.Errorf("%+v", out.OrderInvoiceSend.UserErrors)
+	}
+
+	return nil
+}
+
+// SendDraftOrderInvoice emails the checkout link of a draft order to the customer, see SendInvoice for the email.
+func (s *OrderServiceOp) SendDraftOrderInvoice(ctx context.Context, draftOrderID string, email *model.EmailInput) (*model.DraftOrder, error) {
+	m := `
+		mutation draftOrderInvoiceSend($id: ID!, $email: EmailInput) {
+			draftOrderInvoiceSend(id: $id, email: $email) {
+				draftOrder {
+					id
+					name
+					status
+					invoiceUrl
+					invoiceSentAt
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"id": draftOrderID,
+	}
+	if email != nil {
+		vars["email"] = email
+	}
+	out := struct {
+		DraftOrderInvoiceSend struct {
+			DraftOrder *model.DraftOrder `json:"draftOrder,omitempty"`
+			UserErrors []UserErrors      `json:"userErrors,omitempty"`
+		} `json:"draftOrderInvoiceSend"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.DraftOrderInvoiceSend.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.DraftOrderInvoiceSend.UserErrors)
+	}
+
+	return out.DraftOrderInvoiceSend.DraftOrder, nil
+}
@@ -0,0 +1,58 @@
+package shopify
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+
+	"github.com/gempages/go-shopify-graphql/graphql"
+)
+
+func TestOrderIterateFromResumesAfterCursor(t *testing.T) {
+	var afters []interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		if body.Variables["sortKey"] != "UPDATED_AT" {
+			t.Errorf("got sort key %v", body.Variables["sortKey"])
+		}
+		afters = append(afters, body.Variables["after"])
+		if len(afters) == 1 {
+			_, _ = w.Write([]byte(`{"data":{"orders":{"edges":[{"node":{"id":"gid://shopify/Order/2"}}],"pageInfo":{"hasNextPage":true,"endCursor":"c2"}}}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"orders":{"edges":[{"node":{"id":"gid://shopify/Order/3"}}],"pageInfo":{"hasNextPage":false,"endCursor":"c3"}}}}`))
+	}))
+	defer srv.Close()
+	gql := graphql.NewClient(srv.URL, nil)
+	// keep the page size of the iterator instead of splitting the query by cost
+	gql.SetMaxQueryCost(0)
+	c := &Client{gql: gql}
+	c.initServices()
+
+	it := c.Order.IterateFrom(context.Background(), "updated_at:>2024-01-01", model.OrderSortKeysUpdatedAt, "c1")
+	var ids []string
+	for it.Next() {
+		ids = append(ids, it.Order().ID.(string))
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 2 || ids[0] != "gid://shopify/Order/2" || ids[1] != "gid://shopify/Order/3" {
+		t.Errorf("got orders %v", ids)
+	}
+	if len(afters) != 2 || afters[0] != "c1" || afters[1] != "c2" {
+		t.Errorf("got after cursors %v, want [c1 c2]", afters)
+	}
+	if it.Cursor() != "c3" {
+		t.Errorf("got cursor %s, want c3", it.Cursor())
+	}
+}
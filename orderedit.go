@@ -0,0 +1,291 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// OrderExchangeInput swaps returned line items for replacement variants through an order edit.
+// The price difference is left outstanding on the order, SendInvoice asks the customer to pay it.
+type OrderExchangeInput struct {
+	OrderID        string
+	Returned       []OrderExchangeReturnedItem
+	Replacements   []OrderExchangeReplacementItem
+	NotifyCustomer bool
+	StaffNote      *string
+	SendInvoice    bool
+}
+
+type OrderExchangeReturnedItem struct {
+	LineItemID string
+	Quantity   int
+	Restock    bool
+}
+
+type OrderExchangeReplacementItem struct {
+	VariantID  string
+	Quantity   int
+	LocationID *string
+}
+
+type OrderExchangeResult struct {
+	Order       *OrderEditCommitResult
+	InvoiceSent bool
+}
+
+type OrderEditCommitResult struct {
+	ID                     string   `json:"id,omitempty"`
+	Name                   string   `json:"name,omitempty"`
+	DisplayFinancialStatus string   `json:"displayFinancialStatus,omitempty"`
+	TotalOutstandingSet    MoneyBag `json:"totalOutstandingSet,omitempty"`
+}
+
+// HasOutstandingBalance reports whether the customer owes money after the edit.
+func (r *OrderEditCommitResult) HasOutstandingBalance() bool {
+	amount, err := strconv.ParseFloat(string(r.TotalOutstandingSet.ShopMoney.Amount), 64)
+	return err == nil && amount > 0
+}
+
+const calculatedOrderFields = `
+	id
+	lineItems(first: 250) {
+		edges {
+			node {
+				id
+				quantity
+				editableQuantity
+				restockable
+				variant {
+					id
+				}
+			}
+		}
+	}
+`
+
+type orderEditMutationResult struct {
+	CalculatedOrder *model.CalculatedOrder `json:"calculatedOrder,omitempty"`
+	UserErrors      []UserErrors           `json:"userErrors,omitempty"`
+}
+
+// BeginEdit starts an order edit, the returned calculated order is staged until CommitEdit.
+func (s *OrderServiceOp) BeginEdit(ctx context.Context, orderID string) (*model.CalculatedOrder, error) {
+	m := fmt.Sprintf(`
+		mutation orderEditBegin($id: ID!) {
+			orderEditBegin(id: $id) {
+				calculatedOrder {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, calculatedOrderFields)
+
+	vars := map[string]interface{}{
+		"id": orderID,
+	}
+	out := struct {
+		OrderEditBegin orderEditMutationResult `json:"orderEditBegin"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.OrderEditBegin.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.OrderEditBegin.UserErrors)
+	}
+
+	return out.OrderEditBegin.CalculatedOrder, nil
+}
+
+// EditSetQuantity changes the quantity of a calculated line item, restock puts removed units back in inventory.
+func (s *OrderServiceOp) EditSetQuantity(ctx context.Context, calculatedOrderID, calculatedLineItemID string, quantity int, restock bool) (*model.CalculatedOrder, error) {
+	m := fmt.Sprintf(`
+		mutation orderEditSetQuantity($id: ID!, $lineItemId: ID!, $quantity: Int!, $restock: Boolean) {
+			orderEditSetQuantity(id: $id, lineItemId: $lineItemId, quantity: $quantity, restock: $restock) {
+				calculatedOrder {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, calculatedOrderFields)
+
+	vars := map[string]interface{}{
+		"id":         calculatedOrderID,
+		"lineItemId": calculatedLineItemID,
+		"quantity":   quantity,
+		"restock":    restock,
+	}
+	out := struct {
+		OrderEditSetQuantity orderEditMutationResult `json:"orderEditSetQuantity"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.OrderEditSetQuantity.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.OrderEditSetQuantity.UserErrors)
+	}
+
+	return out.OrderEditSetQuantity.CalculatedOrder, nil
+}
+
+func (s *OrderServiceOp) EditAddVariant(ctx context.Context, calculatedOrderID, variantID string, quantity int, locationID *string) (*model.CalculatedOrder, error) {
+	m := fmt.Sprintf(`
+		mutation orderEditAddVariant($id: ID!, $variantId: ID!, $quantity: Int!, $locationId: ID) {
+			orderEditAddVariant(id: $id, variantId: $variantId, quantity: $quantity, locationId: $locationId, allowDuplicates: true) {
+				calculatedOrder {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, calculatedOrderFields)
+
+	vars := map[string]interface{}{
+		"id":        calculatedOrderID,
+		"variantId": variantID,
+		"quantity":  quantity,
+	}
+	if locationID != nil {
+		vars["locationId"] = *locationID
+	}
+	out := struct {
+		OrderEditAddVariant orderEditMutationResult `json:"orderEditAddVariant"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.OrderEditAddVariant.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.OrderEditAddVariant.UserErrors)
+	}
+
+	return out.OrderEditAddVariant.CalculatedOrder, nil
+}
+
+// CommitEdit applies the staged changes to the order.
+func (s *OrderServiceOp) CommitEdit(ctx context.Context, calculatedOrderID string, notifyCustomer bool, staffNote *string) (*OrderEditCommitResult, error) {
+	m := fmt.Sprintf(`
+		mutation orderEditCommit($id: ID!, $notifyCustomer: Boolean, $staffNote: String) {
+			orderEditCommit(id: $id, notifyCustomer: $notifyCustomer, staffNote: $staffNote) {
+				order {
+					id
+					name
+					displayFinancialStatus
+					totalOutstandingSet {
+						%s
+					}
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, moneyBagFields)
+
+	vars := map[string]interface{}{
+		"id":             calculatedOrderID,
+		"notifyCustomer": notifyCustomer,
+	}
+	if staffNote != nil {
+		vars["staffNote"] = *staffNote
+	}
+	out := struct {
+		OrderEditCommit struct {
+			Order      *OrderEditCommitResult `json:"order,omitempty"`
+			UserErrors []UserErrors           `json:"userErrors,omitempty"`
+		} `json:"orderEditCommit"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.OrderEditCommit.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.OrderEditCommit.UserErrors)
+	}
+
+	return out.OrderEditCommit.Order, nil
+}
+
+// Exchange runs an order edit that removes the returned quantities and adds the replacements.
+// For exchanges that go through a return, pass ExchangeLineItems to Return.Create instead.
+func (s *OrderServiceOp) Exchange(ctx context.Context, input OrderExchangeInput) (*OrderExchangeResult, error) {
+	calculated, err := s.BeginEdit(ctx, input.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("begin edit: %w", err)
+	}
+
+	quantities := map[string]int{}
+	if calculated.LineItems != nil {
+		for _, edge := range calculated.LineItems.Edges {
+			if edge.Node != nil {
+				quantities[edge.Node.ID] = edge.Node.Quantity
+			}
+		}
+	}
+
+	for _, item := range input.Returned {
+		calculatedLineItemID := calculatedLineItemGID(item.LineItemID)
+		current, ok := quantities[calculatedLineItemID]
+		if !ok {
+			return nil, fmt.Errorf("line item %s not found in order %s", item.LineItemID, input.OrderID)
+		}
+		if item.Quantity > current {
+			return nil, fmt.Errorf("cannot return %d of line item %s, only %d left", item.Quantity, item.LineItemID, current)
+		}
+
+		_, err = s.EditSetQuantity(ctx, calculated.ID, calculatedLineItemID, current-item.Quantity, item.Restock)
+		if err != nil {
+			return nil, fmt.Errorf("set quantity of %s: %w", item.LineItemID, err)
+		}
+		quantities[calculatedLineItemID] = current - item.Quantity
+	}
+
+	for _, item := range input.Replacements {
+		_, err = s.EditAddVariant(ctx, calculated.ID, item.VariantID, item.Quantity, item.LocationID)
+		if err != nil {
+			return nil, fmt.Errorf("add variant %s: %w", item.VariantID, err)
+		}
+	}
+
+	order, err := s.CommitEdit(ctx, calculated.ID, input.NotifyCustomer, input.StaffNote)
+	if err != nil {
+		return nil, fmt.Errorf("commit edit: %w", err)
+	}
+
+	res := &OrderExchangeResult{Order: order}
+	if input.SendInvoice && order != nil && order.HasOutstandingBalance() {
+		err = s.SendInvoice(ctx, order.ID, nil)
+		if err != nil {
+			return res, fmt.Errorf("send invoice: %w", err)
+		}
+		res.InvoiceSent = true
+	}
+
+	return res, nil
+}
+
+// calculatedLineItemGID converts a LineItem gid to the CalculatedLineItem gid used inside an order edit, both share the numeric id.
+func calculatedLineItemGID(lineItemID string) string {
+	return strings.Replace(lineItemID, "/LineItem/", "/CalculatedLineItem/", 1)
+}
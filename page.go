@@ -0,0 +1,296 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gempages/go-helper/errors"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// PageService manages the Online Store pages of a shop. The page APIs were added to GraphQL in
+// API version 2024-10 and are not part of the generated model, hence the local types.
+type PageService interface {
+	Get(ctx context.Context, id string) (*Page, error)
+	GetByHandle(ctx context.Context, handle string) (*Page, error)
+	List(ctx context.Context, opts ListOptions) ([]*Page, string, error)
+
+	Create(ctx context.Context, input PageCreateInput) (*Page, error)
+	Update(ctx context.Context, id string, input PageUpdateInput) (*Page, error)
+	Delete(ctx context.Context, id string) (string, error)
+}
+
+type PageServiceOp struct {
+	client *Client
+}
+
+var _ PageService = &PageServiceOp{}
+
+type Page struct {
+	ID             string     `json:"id"`
+	Title          string     `json:"title"`
+	Handle         string     `json:"handle"`
+	Body           string     `json:"body"`
+	BodySummary    string     `json:"bodySummary"`
+	IsPublished    bool       `json:"isPublished"`
+	PublishedAt    *time.Time `json:"publishedAt,omitempty"`
+	TemplateSuffix *string    `json:"templateSuffix,omitempty"`
+	CreatedAt      time.Time  `json:"createdAt"`
+	UpdatedAt      time.Time  `json:"updatedAt"`
+}
+
+type PageCreateInput struct {
+	Title string `json:"title"`
+	// Handle is generated from Title when empty.
+	Handle         *string                `json:"handle,omitempty"`
+	Body           *string                `json:"body,omitempty"`
+	IsPublished    *bool                  `json:"isPublished,omitempty"`
+	PublishDate    *time.Time             `json:"publishDate,omitempty"`
+	TemplateSuffix *string                `json:"templateSuffix,omitempty"`
+	Metafields     []model.MetafieldInput `json:"metafields,omitempty"`
+}
+
+// PageUpdateInput holds the page fields to change; nil fields are left unchanged.
+type PageUpdateInput struct {
+	Title  *string `json:"title,omitempty"`
+	Handle *string `json:"handle,omitempty"`
+	// RedirectNewHandle creates a redirect from the old handle to the new one.
+	RedirectNewHandle *bool                  `json:"redirectNewHandle,omitempty"`
+	Body              *string                `json:"body,omitempty"`
+	IsPublished       *bool                  `json:"isPublished,omitempty"`
+	PublishDate       *time.Time             `json:"publishDate,omitempty"`
+	TemplateSuffix    *string                `json:"templateSuffix,omitempty"`
+	Metafields        []model.MetafieldInput `json:"metafields,omitempty"`
+}
+
+// PageUserError is a user error of the page mutations, with codes such as TAKEN or BLANK.
+type PageUserError struct {
+	Code    *string  `json:"code"`
+	Field   []string `json:"field"`
+	Message string   `json:"message"`
+}
+
+const pageFields = `
+	id
+	title
+	handle
+	body
+	bodySummary
+	isPublished
+	publishedAt
+	templateSuffix
+	createdAt
+	updatedAt
+`
+
+func (s *PageServiceOp) Get(ctx context.Context, id string) (*Page, error) {
+	q := fmt.Sprintf(`
+		query page($id: ID!) {
+			page(id: $id) {
+				%s
+			}
+		}
+	`, pageFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		Page *Page `json:"page"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.Page == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "page not found", nil)
+	}
+
+	return out.Page, nil
+}
+
+func (s *PageServiceOp) GetByHandle(ctx context.Context, handle string) (*Page, error) {
+	pages, _, err := s.List(ctx, ListOptions{Query: "handle:" + quoteSearchValue(handle), First: 1})
+	if err != nil {
+		return nil, err
+	}
+	if len(pages) == 0 {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "page not found", nil)
+	}
+
+	return pages[0], nil
+}
+
+// List returns a page of Online Store pages matching opts.Query, which supports filters such as
+// "published_status:published" or "title:About*".
+func (s *PageServiceOp) List(ctx context.Context, opts ListOptions) ([]*Page, string, error) {
+	q := fmt.Sprintf(`
+		query pages($first: Int!, $after: String, $query: String, $reverse: Boolean) {
+			pages(first: $first, after: $after, query: $query, reverse: $reverse) {
+				edges {
+					node {
+						%s
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+				}
+			}
+		}
+	`, pageFields)
+
+	first := opts.First
+	if first <= 0 {
+		first = 50
+	}
+	vars := map[string]interface{}{
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+	if opts.Query != "" {
+		vars["query"] = opts.Query
+	}
+
+	out := struct {
+		Pages struct {
+			Edges []struct {
+				Node   *Page  `json:"node"`
+				Cursor string `json:"cursor"`
+			} `json:"edges"`
+			PageInfo struct {
+				HasNextPage bool `json:"hasNextPage"`
+			} `json:"pageInfo"`
+		} `json:"pages"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, "", fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	edges := out.Pages.Edges
+	res := make([]*Page, 0, len(edges))
+	for _, edge := range edges {
+		res = append(res, edge.Node)
+	}
+	nextCursor := ""
+	if out.Pages.PageInfo.HasNextPage && len(edges) > 0 {
+		nextCursor = edges[len(edges)-1].Cursor
+	}
+
+	return res, nextCursor, nil
+}
+
+func (s *PageServiceOp) Create(ctx context.Context, input PageCreateInput) (*Page, error) {
+	m := fmt.Sprintf(`
+		mutation pageCreate($page: PageCreateInput!) {
+			pageCreate(page: $page) {
+				page {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, pageFields)
+
+	vars := map[string]interface{}{
+		"page": input,
+	}
+	out := struct {
+		PageCreate struct {
+			Page       *Page           `json:"page"`
+			UserErrors []PageUserError `json:"userErrors"`
+		} `json:"pageCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.PageCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.PageCreate.UserErrors)
+	}
+
+	return out.PageCreate.Page, nil
+}
+
+func (s *PageServiceOp) Update(ctx context.Context, id string, input PageUpdateInput) (*Page, error) {
+	m := fmt.Sprintf(`
+		mutation pageUpdate($id: ID!, $page: PageUpdateInput!) {
+			pageUpdate(id: $id, page: $page) {
+				page {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, pageFields)
+
+	vars := map[string]interface{}{
+		"id":   id,
+		"page": input,
+	}
+	out := struct {
+		PageUpdate struct {
+			Page       *Page           `json:"page"`
+			UserErrors []PageUserError `json:"userErrors"`
+		} `json:"pageUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.PageUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.PageUpdate.UserErrors)
+	}
+
+	return out.PageUpdate.Page, nil
+}
+
+func (s *PageServiceOp) Delete(ctx context.Context, id string) (string, error) {
+	m := `
+		mutation pageDelete($id: ID!) {
+			pageDelete(id: $id) {
+				deletedPageId
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		PageDelete struct {
+			DeletedPageID *string         `json:"deletedPageId"`
+			UserErrors    []PageUserError `json:"userErrors"`
+		} `json:"pageDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return "", fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.PageDelete.UserErrors) > 0 {
+		return "", fmt.Errorf("%+v", out.PageDelete.UserErrors)
+	}
+	if out.PageDelete.DeletedPageID == nil {
+		return "", nil
+	}
+
+	return *out.PageDelete.DeletedPageID, nil
+}
@@ -0,0 +1,230 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// PaymentCustomizationService manages payment customizations, which hide, reorder or rename the
+// payment methods offered at checkout through a payment customization function. Up to 25
+// customizations can be active on a shop at once.
+type PaymentCustomizationService interface {
+	List(ctx context.Context, opts ListOptions) ([]*model.PaymentCustomization, string, error)
+	Create(ctx context.Context, input model.PaymentCustomizationInput) (*model.PaymentCustomization, error)
+	Update(ctx context.Context, id string, input model.PaymentCustomizationInput) (*model.PaymentCustomization, error)
+	Delete(ctx context.Context, id string) (string, error)
+	Activate(ctx context.Context, ids []string, enabled bool) ([]string, error)
+}
+
+type PaymentCustomizationServiceOp struct {
+	client *Client
+}
+
+var _ PaymentCustomizationService = &PaymentCustomizationServiceOp{}
+
+const paymentCustomizationFields = `
+	id
+	title
+	enabled
+	functionId
+`
+
+// List returns a page of the payment customizations of the shop. opts.Query supports filters
+// such as "enabled:true" or "function_id:<id>".
+func (s *PaymentCustomizationServiceOp) List(ctx context.Context, opts ListOptions) ([]*model.PaymentCustomization, string, error) {
+	q := fmt.Sprintf(`
+		query paymentCustomizations($first: Int!, $after: String, $query: String, $reverse: Boolean) {
+			paymentCustomizations(first: $first, after: $after, query: $query, reverse: $reverse) {
+				edges {
+					node {
+						%s
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+				}
+			}
+		}
+	`, paymentCustomizationFields)
+
+	first := opts.First
+	if first <= 0 {
+		first = 50
+	}
+	vars := map[string]interface{}{
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+	if opts.Query != "" {
+		vars["query"] = opts.Query
+	}
+
+	out := struct {
+		PaymentCustomizations struct {
+			Edges []struct {
+				Node   *model.PaymentCustomization `json:"node"`
+				Cursor string                      `json:"cursor"`
+			} `json:"edges"`
+			PageInfo struct {
+				HasNextPage bool `json:"hasNextPage"`
+			} `json:"pageInfo"`
+		} `json:"paymentCustomizations"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, "", fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	edges := out.PaymentCustomizations.Edges
+	res := make([]*model.PaymentCustomization, 0, len(edges))
+	for _, edge := range edges {
+		res = append(res, edge.Node)
+	}
+	nextCursor := ""
+	if out.PaymentCustomizations.PageInfo.HasNextPage && len(edges) > 0 {
+		nextCursor = edges[len(edges)-1].Cursor
+	}
+
+	return res, nextCursor, nil
+}
+
+func (s *PaymentCustomizationServiceOp) Create(ctx context.Context, input model.PaymentCustomizationInput) (*model.PaymentCustomization, error) {
+	m := fmt.Sprintf(`
+		mutation paymentCustomizationCreate($paymentCustomization: PaymentCustomizationInput!) {
+			paymentCustomizationCreate(paymentCustomization: $paymentCustomization) {
+				paymentCustomization {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, paymentCustomizationFields)
+
+	vars := map[string]interface{}{
+		"paymentCustomization": input,
+	}
+	out := struct {
+		PaymentCustomizationCreate model.PaymentCustomizationCreatePayload `json:"paymentCustomizationCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.PaymentCustomizationCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.PaymentCustomizationCreate.UserErrors)
+	}
+
+	return out.PaymentCustomizationCreate.PaymentCustomization, nil
+}
+
+// Update updates a payment customization; fields left nil in input are not changed.
+func (s *PaymentCustomizationServiceOp) Update(ctx context.Context, id string, input model.PaymentCustomizationInput) (*model.PaymentCustomization, error) {
+	m := fmt.Sprintf(`
+		mutation paymentCustomizationUpdate($id: ID!, $paymentCustomization: PaymentCustomizationInput!) {
+			paymentCustomizationUpdate(id: $id, paymentCustomization: $paymentCustomization) {
+				paymentCustomization {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, paymentCustomizationFields)
+
+	vars := map[string]interface{}{
+		"id":                   id,
+		"paymentCustomization": input,
+	}
+	out := struct {
+		PaymentCustomizationUpdate model.PaymentCustomizationUpdatePayload `json:"paymentCustomizationUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.PaymentCustomizationUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.PaymentCustomizationUpdate.UserErrors)
+	}
+
+	return out.PaymentCustomizationUpdate.PaymentCustomization, nil
+}
+
+func (s *PaymentCustomizationServiceOp) Delete(ctx context.Context, id string) (string, error) {
+	m := `
+		mutation paymentCustomizationDelete($id: ID!) {
+			paymentCustomizationDelete(id: $id) {
+				deletedId
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		PaymentCustomizationDelete model.PaymentCustomizationDeletePayload `json:"paymentCustomizationDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return "", fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.PaymentCustomizationDelete.UserErrors) > 0 {
+		return "", fmt.Errorf("%+v", out.PaymentCustomizationDelete.UserErrors)
+	}
+	if out.PaymentCustomizationDelete.DeletedID == nil {
+		return "", nil
+	}
+
+	return *out.PaymentCustomizationDelete.DeletedID, nil
+}
+
+// Activate enables or disables payment customizations and returns the IDs of the updated ones.
+func (s *PaymentCustomizationServiceOp) Activate(ctx context.Context, ids []string, enabled bool) ([]string, error) {
+	m := `
+		mutation paymentCustomizationActivation($ids: [ID!]!, $enabled: Boolean!) {
+			paymentCustomizationActivation(ids: $ids, enabled: $enabled) {
+				ids
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"ids":     ids,
+		"enabled": enabled,
+	}
+	out := struct {
+		PaymentCustomizationActivation model.PaymentCustomizationActivationPayload `json:"paymentCustomizationActivation"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.PaymentCustomizationActivation.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.PaymentCustomizationActivation.UserErrors)
+	}
+
+	return out.PaymentCustomizationActivation.Ids, nil
+}
@@ -15,6 +15,17 @@ type ProductService interface {
 	Get(ctx context.Context, id string) (*model.Product, error)
 	GetWithFields(ctx context.Context, id string, fields string) (*model.Product, error)
 	GetSingleProductCollection(ctx context.Context, id string, cursor string) (*model.Product, error)
+	GetContextualPricing(ctx context.Context, id string, countryCode model.CountryCode) (*model.ProductContextualPricing, error)
+	GetSellingPlans(ctx context.Context, id string) (*ProductSellingPlans, error)
+
+	Count(ctx context.Context, query string) (*model.Count, error)
+
+	GetCombinedListingChildren(ctx context.Context, id string) ([]CombinedListingChild, error)
+	CombinedListingUpdate(ctx context.Context, parentProductID string, input CombinedListingUpdateInput) (*model.Product, error)
+
+	GetBundleComponents(ctx context.Context, id string) ([]ProductBundleComponent, error)
+	BundleCreate(ctx context.Context, input ProductBundleCreateInput) (*ProductBundleOperation, error)
+	BundleUpdate(ctx context.Context, input ProductBundleUpdateInput) (*ProductBundleOperation, error)
 
 	Create(ctx context.Context, product model.ProductInput, media []model.CreateMediaInput) (output *model.Product, err error)
 	Update(ctx context.Context, product model.ProductInput) (output *model.Product, err error)
@@ -125,6 +136,50 @@ var singleProductQueryCollectionWithCursor = fmt.Sprintf(`
   }
 `)
 
+const contextualPricingFields = `
+	priceRange {
+		minVariantPrice {
+			amount
+			currencyCode
+		}
+		maxVariantPrice {
+			amount
+			currencyCode
+		}
+	}
+	minVariantPricing {
+		price {
+			amount
+			currencyCode
+		}
+		compareAtPrice {
+			amount
+			currencyCode
+		}
+	}
+	maxVariantPricing {
+		price {
+			amount
+			currencyCode
+		}
+		compareAtPrice {
+			amount
+			currencyCode
+		}
+	}
+`
+
+// ProductContextualPricingFields returns the product `contextualPricing` selection for a country.
+// The country is inlined so the result can be appended to the fields given to WithFields (bulk queries
+// don't accept variables) or GetWithFields.
+func ProductContextualPricingFields(countryCode model.CountryCode) string {
+	return fmt.Sprintf(`
+	contextualPricing(context: {country: %s}) {
+		%s
+	}
+`, countryCode, contextualPricingFields)
+}
+
 var productQuery = fmt.Sprintf(`
 	%s
 	variants(first: 250, after: $variantAfter) {
@@ -455,6 +510,47 @@ func (s *ProductServiceOp) GetSingleProductCollection(ctx context.Context, id st
 	return out.Product, nil
 }
 
+func (s *ProductServiceOp) GetContextualPricing(ctx context.Context, id string, countryCode model.CountryCode) (*model.ProductContextualPricing, error) {
+	q := fmt.Sprintf(`
+		query productContextualPricing($id: ID!, $context: ContextualPricingContext!) {
+			product(id: $id){
+				id
+				contextualPricing(context: $context) {
+					%s
+				}
+			}
+		}
+	`, contextualPricingFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+		"context": map[string]interface{}{
+			"country": countryCode,
+		},
+	}
+
+	out := struct {
+		Product *struct {
+			ID                string                          `json:"id"`
+			ContextualPricing *model.ProductContextualPricing `json:"contextualPricing"`
+		} `json:"product"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Product == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "product not found", nil)
+	}
+
+	return out.Product.ContextualPricing, nil
+}
+
+func (s *ProductServiceOp) Count(ctx context.Context, query string) (*model.Count, error) {
+	return queryCount(ctx, s.client.gql, "productsCount", query)
+}
+
 func (s *ProductServiceOp) Create(ctx context.Context, product model.ProductInput, media []model.CreateMediaInput) (output *model.Product, err error) {
 	m := mutationProductCreate{}
 
@@ -512,3 +608,387 @@ func (s *ProductServiceOp) Delete(ctx context.Context, product model.ProductDele
 
 	return m.ProductDeleteResult.DeletedProductID, nil
 }
+
+type CombinedListingChild struct {
+	Product       *model.Product        `json:"product,omitempty"`
+	ParentVariant *model.ProductVariant `json:"parentVariant,omitempty"`
+}
+
+type CombinedListingUpdateInput struct {
+	Title              *string                     `json:"title,omitempty"`
+	ProductsAdded      []ChildProductRelationInput `json:"productsAdded,omitempty"`
+	ProductsEdited     []ChildProductRelationInput `json:"productsEdited,omitempty"`
+	ProductsRemovedIDs []string                    `json:"productsRemovedIds,omitempty"`
+	OptionsAndValues   []OptionAndValueInput       `json:"optionsAndValues,omitempty"`
+}
+
+type ChildProductRelationInput struct {
+	ChildProductID             string                       `json:"childProductId"`
+	SelectedParentOptionValues []SelectedVariantOptionInput `json:"selectedParentOptionValues"`
+}
+
+type SelectedVariantOptionInput struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type OptionAndValueInput struct {
+	OptionID *string  `json:"optionId,omitempty"`
+	Name     string   `json:"name"`
+	Values   []string `json:"values"`
+}
+
+type ProductBundleComponent struct {
+	ComponentProduct *model.Product                          `json:"componentProduct,omitempty"`
+	Quantity         int                                     `json:"quantity,omitempty"`
+	OptionSelections []ProductBundleComponentOptionSelection `json:"optionSelections,omitempty"`
+}
+
+type ProductBundleComponentOptionSelection struct {
+	ComponentOption *model.ProductOption `json:"componentOption,omitempty"`
+	ParentOption    *model.ProductOption `json:"parentOption,omitempty"`
+	Values          []struct {
+		Value           string `json:"value"`
+		SelectionStatus string `json:"selectionStatus"`
+	} `json:"values,omitempty"`
+}
+
+type ProductBundleCreateInput struct {
+	Title               string                                 `json:"title"`
+	Components          []ProductBundleComponentInput          `json:"components"`
+	ConsolidatedOptions []ProductBundleConsolidatedOptionInput `json:"consolidatedOptions,omitempty"`
+}
+
+type ProductBundleUpdateInput struct {
+	ProductID           string                                 `json:"productId"`
+	Title               *string                                `json:"title,omitempty"`
+	Components          []ProductBundleComponentInput          `json:"components,omitempty"`
+	ConsolidatedOptions []ProductBundleConsolidatedOptionInput `json:"consolidatedOptions,omitempty"`
+}
+
+type ProductBundleComponentInput struct {
+	ProductID        string                                       `json:"productId"`
+	Quantity         *int                                         `json:"quantity,omitempty"`
+	OptionSelections []ProductBundleComponentOptionSelectionInput `json:"optionSelections"`
+}
+
+type ProductBundleComponentOptionSelectionInput struct {
+	ComponentOptionID string   `json:"componentOptionId"`
+	Name              string   `json:"name"`
+	Values            []string `json:"values"`
+}
+
+type ProductBundleConsolidatedOptionInput struct {
+	OptionName       string                                          `json:"optionName"`
+	OptionSelections []ProductBundleConsolidatedOptionSelectionInput `json:"optionSelections"`
+}
+
+type ProductBundleConsolidatedOptionSelectionInput struct {
+	OptionValue string                                          `json:"optionValue"`
+	Components  []ProductBundleConsolidatedOptionComponentInput `json:"components"`
+}
+
+type ProductBundleConsolidatedOptionComponentInput struct {
+	OptionID    string `json:"optionId"`
+	OptionValue string `json:"optionValue"`
+}
+
+// ProductBundleOperation is an asynchronous operation, the bundle product is available once status is COMPLETE.
+type ProductBundleOperation struct {
+	ID      string         `json:"id,omitempty"`
+	Status  string         `json:"status,omitempty"`
+	Product *model.Product `json:"product,omitempty"`
+}
+
+type productBundleMutationResult struct {
+	ProductBundleOperation *ProductBundleOperation `json:"productBundleOperation,omitempty"`
+	UserErrors             []UserErrors            `json:"userErrors,omitempty"`
+}
+
+const productBundleMutationSelects = `
+productBundleOperation {
+	id
+	status
+	product {
+		id
+		title
+	}
+}
+userErrors {
+	field
+	message
+}`
+
+func (s *ProductServiceOp) GetCombinedListingChildren(ctx context.Context, id string) ([]CombinedListingChild, error) {
+	q := `
+		query combinedListing($id: ID!, $after: String) {
+			product(id: $id){
+				id
+				combinedListing {
+					combinedListingChildren(first: 250, after: $after) {
+						edges {
+							node {
+								product {
+									id
+									title
+									handle
+								}
+								parentVariant {
+									id
+									title
+									selectedOptions {
+										name
+										value
+									}
+								}
+							}
+							cursor
+						}
+						pageInfo {
+							hasNextPage
+						}
+					}
+				}
+			}
+		}
+	`
+
+	var (
+		res    []CombinedListingChild
+		cursor string
+	)
+	for {
+		vars := map[string]interface{}{
+			"id": id,
+		}
+		if cursor != "" {
+			vars["after"] = cursor
+		}
+
+		out := struct {
+			Product *struct {
+				ID              string `json:"id"`
+				CombinedListing *struct {
+					CombinedListingChildren struct {
+						Edges []struct {
+							Node   CombinedListingChild `json:"node"`
+							Cursor string               `json:"cursor"`
+						} `json:"edges"`
+						PageInfo struct {
+							HasNextPage bool `json:"hasNextPage"`
+						} `json:"pageInfo"`
+					} `json:"combinedListingChildren"`
+				} `json:"combinedListing"`
+			} `json:"product"`
+		}{}
+		err := s.client.gql.QueryString(ctx, q, vars, &out)
+		if err != nil {
+			return nil, err
+		}
+
+		if out.Product == nil {
+			return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "product not found", nil)
+		}
+		if out.Product.CombinedListing == nil {
+			return res, nil
+		}
+
+		children := out.Product.CombinedListing.CombinedListingChildren
+		for _, e := range children.Edges {
+			res = append(res, e.Node)
+		}
+		if !children.PageInfo.HasNextPage || len(children.Edges) == 0 {
+			break
+		}
+		cursor = children.Edges[len(children.Edges)-1].Cursor
+	}
+
+	return res, nil
+}
+
+func (s *ProductServiceOp) CombinedListingUpdate(ctx context.Context, parentProductID string, input CombinedListingUpdateInput) (*model.Product, error) {
+	m := `
+	mutation combinedListingUpdate($parentProductId: ID!, $title: String, $productsAdded: [ChildProductRelationInput!], $productsEdited: [ChildProductRelationInput!], $productsRemovedIds: [ID!], $optionsAndValues: [OptionAndValueInput!]) {
+		combinedListingUpdate(parentProductId: $parentProductId, title: $title, productsAdded: $productsAdded, productsEdited: $productsEdited, productsRemovedIds: $productsRemovedIds, optionsAndValues: $optionsAndValues) {
+			product {
+				id
+				title
+			}
+			userErrors {
+				field
+				message
+			}
+		}
+	}
+	`
+
+	vars := map[string]interface{}{
+		"parentProductId": parentProductID,
+	}
+	if input.Title != nil {
+		vars["title"] = input.Title
+	}
+	if len(input.ProductsAdded) > 0 {
+		vars["productsAdded"] = input.ProductsAdded
+	}
+	if len(input.ProductsEdited) > 0 {
+		vars["productsEdited"] = input.ProductsEdited
+	}
+	if len(input.ProductsRemovedIDs) > 0 {
+		vars["productsRemovedIds"] = input.ProductsRemovedIDs
+	}
+	if len(input.OptionsAndValues) > 0 {
+		vars["optionsAndValues"] = input.OptionsAndValues
+	}
+
+	out := struct {
+		CombinedListingUpdate struct {
+			Product    *model.Product `json:"product"`
+			UserErrors []UserErrors   `json:"userErrors"`
+		} `json:"combinedListingUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.CombinedListingUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.CombinedListingUpdate.UserErrors)
+	}
+
+	return out.CombinedListingUpdate.Product, nil
+}
+
+func (s *ProductServiceOp) GetBundleComponents(ctx context.Context, id string) ([]ProductBundleComponent, error) {
+	q := `
+		query bundleComponents($id: ID!, $after: String) {
+			product(id: $id){
+				id
+				bundleComponents(first: 250, after: $after) {
+					edges {
+						node {
+							componentProduct {
+								id
+								title
+							}
+							quantity
+							optionSelections {
+								componentOption {
+									id
+									name
+								}
+								parentOption {
+									id
+									name
+								}
+								values {
+									value
+									selectionStatus
+								}
+							}
+						}
+						cursor
+					}
+					pageInfo {
+						hasNextPage
+					}
+				}
+			}
+		}
+	`
+
+	var (
+		res    []ProductBundleComponent
+		cursor string
+	)
+	for {
+		vars := map[string]interface{}{
+			"id": id,
+		}
+		if cursor != "" {
+			vars["after"] = cursor
+		}
+
+		out := struct {
+			Product *struct {
+				ID               string `json:"id"`
+				BundleComponents struct {
+					Edges []struct {
+						Node   ProductBundleComponent `json:"node"`
+						Cursor string                 `json:"cursor"`
+					} `json:"edges"`
+					PageInfo struct {
+						HasNextPage bool `json:"hasNextPage"`
+					} `json:"pageInfo"`
+				} `json:"bundleComponents"`
+			} `json:"product"`
+		}{}
+		err := s.client.gql.QueryString(ctx, q, vars, &out)
+		if err != nil {
+			return nil, err
+		}
+
+		if out.Product == nil {
+			return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "product not found", nil)
+		}
+
+		components := out.Product.BundleComponents
+		for _, e := range components.Edges {
+			res = append(res, e.Node)
+		}
+		if !components.PageInfo.HasNextPage || len(components.Edges) == 0 {
+			break
+		}
+		cursor = components.Edges[len(components.Edges)-1].Cursor
+	}
+
+	return res, nil
+}
+
+func (s *ProductServiceOp) BundleCreate(ctx context.Context, input ProductBundleCreateInput) (*ProductBundleOperation, error) {
+	m := fmt.Sprintf(`mutation productBundleCreate($input: ProductBundleCreateInput!) {
+	productBundleCreate(input: $input) {
+		%s
+	}}`, productBundleMutationSelects)
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+	out := struct {
+		ProductBundleCreate productBundleMutationResult `json:"productBundleCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.ProductBundleCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.ProductBundleCreate.UserErrors)
+	}
+
+	return out.ProductBundleCreate.ProductBundleOperation, nil
+}
+
+func (s *ProductServiceOp) BundleUpdate(ctx context.Context, input ProductBundleUpdateInput) (*ProductBundleOperation, error) {
+	m := fmt.Sprintf(`mutation productBundleUpdate($input: ProductBundleUpdateInput!) {
+	productBundleUpdate(input: $input) {
+		%s
+	}}`, productBundleMutationSelects)
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+	out := struct {
+		ProductBundleUpdate productBundleMutationResult `json:"productBundleUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.ProductBundleUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.ProductBundleUpdate.UserErrors)
+	}
+
+	return out.ProductBundleUpdate.ProductBundleOperation, nil
+}
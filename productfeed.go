@@ -0,0 +1,223 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gempages/go-helper/errors"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// ProductFeedService manages the product feeds of a sales channel app. Once a feed is created,
+// Shopify sends PRODUCT_FEEDS_INCREMENTAL_SYNC webhooks as products change, and
+// PRODUCT_FEEDS_FULL_SYNC webhooks for every product when FullSync is called.
+type ProductFeedService interface {
+	List(ctx context.Context) ([]*model.ProductFeed, error)
+	Get(ctx context.Context, id string) (*model.ProductFeed, error)
+	Create(ctx context.Context, country model.CountryCode, language model.LanguageCode) (*model.ProductFeed, error)
+	Delete(ctx context.Context, id string) (string, error)
+	FullSync(ctx context.Context, id string, opts ProductFullSyncOptions) error
+}
+
+type ProductFeedServiceOp struct {
+	client *Client
+}
+
+var _ ProductFeedService = &ProductFeedServiceOp{}
+
+// ProductFullSyncOptions restricts a full sync to the products updated in a time range.
+type ProductFullSyncOptions struct {
+	UpdatedAtSince  *time.Time
+	BeforeUpdatedAt *time.Time
+}
+
+const productFeedFields = `
+	id
+	country
+	language
+	status
+`
+
+func (s *ProductFeedServiceOp) List(ctx context.Context) ([]*model.ProductFeed, error) {
+	q := fmt.Sprintf(`
+		query productFeeds($after: String) {
+			productFeeds(first: 50, after: $after) {
+				edges {
+					node {
+						%s
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+				}
+			}
+		}
+	`, productFeedFields)
+
+	vars := map[string]interface{}{}
+	var res []*model.ProductFeed
+	for {
+		out := struct {
+			ProductFeeds struct {
+				Edges []struct {
+					Node   *model.ProductFeed `json:"node"`
+					Cursor string             `json:"cursor"`
+				} `json:"edges"`
+				PageInfo struct {
+					HasNextPage bool `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"productFeeds"`
+		}{}
+		err := s.client.gql.QueryString(ctx, q, vars, &out)
+		if err != nil {
+			return nil, fmt.Errorf("gql.QueryString: %w", err)
+		}
+
+		edges := out.ProductFeeds.Edges
+		for _, edge := range edges {
+			res = append(res, edge.Node)
+		}
+		if !out.ProductFeeds.PageInfo.HasNextPage || len(edges) == 0 {
+			return res, nil
+		}
+		vars["after"] = edges[len(edges)-1].Cursor
+	}
+}
+
+func (s *ProductFeedServiceOp) Get(ctx context.Context, id string) (*model.ProductFeed, error) {
+	q := fmt.Sprintf(`
+		query productFeed($id: ID!) {
+			productFeed(id: $id) {
+				%s
+			}
+		}
+	`, productFeedFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		ProductFeed *model.ProductFeed `json:"productFeed"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.ProductFeed == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "product feed not found", nil)
+	}
+
+	return out.ProductFeed, nil
+}
+
+// Create creates a feed of the products published to the app's channel, localized for country
+// and language.
+func (s *ProductFeedServiceOp) Create(ctx context.Context, country model.CountryCode, language model.LanguageCode) (*model.ProductFeed, error) {
+	m := fmt.Sprintf(`
+		mutation productFeedCreate($input: ProductFeedInput) {
+			productFeedCreate(input: $input) {
+				productFeed {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, productFeedFields)
+
+	vars := map[string]interface{}{
+		"input": model.ProductFeedInput{
+			Country:  country,
+			Language: language,
+		},
+	}
+	out := struct {
+		ProductFeedCreate model.ProductFeedCreatePayload `json:"productFeedCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.ProductFeedCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.ProductFeedCreate.UserErrors)
+	}
+
+	return out.ProductFeedCreate.ProductFeed, nil
+}
+
+func (s *ProductFeedServiceOp) Delete(ctx context.Context, id string) (string, error) {
+	m := `
+		mutation productFeedDelete($id: ID!) {
+			productFeedDelete(id: $id) {
+				deletedId
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		ProductFeedDelete model.ProductFeedDeletePayload `json:"productFeedDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return "", fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.ProductFeedDelete.UserErrors) > 0 {
+		return "", fmt.Errorf("%+v", out.ProductFeedDelete.UserErrors)
+	}
+	if out.ProductFeedDelete.DeletedID == nil {
+		return "", nil
+	}
+
+	return *out.ProductFeedDelete.DeletedID, nil
+}
+
+// FullSync asks Shopify to send a PRODUCT_FEEDS_FULL_SYNC webhook for every product of the feed.
+// The webhooks are delivered asynchronously.
+func (s *ProductFeedServiceOp) FullSync(ctx context.Context, id string, opts ProductFullSyncOptions) error {
+	m := `
+		mutation productFullSync($id: ID!, $updatedAtSince: DateTime, $beforeUpdatedAt: DateTime) {
+			productFullSync(id: $id, updatedAtSince: $updatedAtSince, beforeUpdatedAt: $beforeUpdatedAt) {
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	if opts.UpdatedAtSince != nil {
+		vars["updatedAtSince"] = opts.UpdatedAtSince.UTC().Format(time.RFC3339)
+	}
+	if opts.BeforeUpdatedAt != nil {
+		vars["beforeUpdatedAt"] = opts.BeforeUpdatedAt.UTC().Format(time.RFC3339)
+	}
+	out := struct {
+		ProductFullSync model.ProductFullSyncPayload `json:"productFullSync"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.ProductFullSync.UserErrors) > 0 {
+		return fmt.Errorf("%+v", out.ProductFullSync.UserErrors)
+	}
+
+	return nil
+}
@@ -0,0 +1,432 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gempages/go-helper/errors"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+type ReturnService interface {
+	Get(ctx context.Context, id string) (*model.Return, error)
+
+	Create(ctx context.Context, input model.ReturnInput) (*model.Return, error)
+	ApproveRequest(ctx context.Context, input model.ReturnApproveRequestInput) (*model.Return, error)
+	DeclineRequest(ctx context.Context, input model.ReturnDeclineRequestInput) (*model.Return, error)
+	Refund(ctx context.Context, input model.ReturnRefundInput) (*model.Refund, error)
+
+	GetReverseFulfillmentOrder(ctx context.Context, id string) (*model.ReverseFulfillmentOrder, error)
+	GetReverseDelivery(ctx context.Context, id string) (*model.ReverseDelivery, error)
+}
+
+type ReturnServiceOp struct {
+	client *Client
+}
+
+var _ ReturnService = &ReturnServiceOp{}
+
+type returnMutationResult struct {
+	Return     *returnNode  `json:"return,omitempty"`
+	UserErrors []UserErrors `json:"userErrors,omitempty"`
+}
+
+// returnNode decodes a Return. The deliverable of its reverse deliveries is an interface of the
+// model without a decoder, so they are read into reverseDeliveryNode instead.
+type returnNode struct {
+	model.Return
+	ReverseFulfillmentOrders *struct {
+		Edges []struct {
+			Node *reverseFulfillmentOrderNode `json:"node"`
+		} `json:"edges"`
+	} `json:"reverseFulfillmentOrders"`
+}
+
+func (n *returnNode) toModel() *model.Return {
+	if n == nil {
+		return nil
+	}
+	r := n.Return
+	if n.ReverseFulfillmentOrders != nil {
+		r.ReverseFulfillmentOrders = &model.ReverseFulfillmentOrderConnection{}
+		for _, edge := range n.ReverseFulfillmentOrders.Edges {
+			r.ReverseFulfillmentOrders.Edges = append(r.ReverseFulfillmentOrders.Edges, model.ReverseFulfillmentOrderEdge{
+				Node: edge.Node.toModel(),
+			})
+		}
+	}
+	return &r
+}
+
+// reverseFulfillmentOrderNode decodes a ReverseFulfillmentOrder, see returnNode.
+type reverseFulfillmentOrderNode struct {
+	model.ReverseFulfillmentOrder
+	ReverseDeliveries *struct {
+		Edges []struct {
+			Node *reverseDeliveryNode `json:"node"`
+		} `json:"edges"`
+	} `json:"reverseDeliveries"`
+}
+
+func (n *reverseFulfillmentOrderNode) toModel() *model.ReverseFulfillmentOrder {
+	if n == nil {
+		return nil
+	}
+	o := n.ReverseFulfillmentOrder
+	if n.ReverseDeliveries != nil {
+		o.ReverseDeliveries = &model.ReverseDeliveryConnection{}
+		for _, edge := range n.ReverseDeliveries.Edges {
+			o.ReverseDeliveries.Edges = append(o.ReverseDeliveries.Edges, model.ReverseDeliveryEdge{
+				Node: edge.Node.toModel(),
+			})
+		}
+	}
+	return &o
+}
+
+// reverseDeliveryNode decodes a ReverseDelivery, reading its deliverable by __typename.
+type reverseDeliveryNode struct {
+	model.ReverseDelivery
+	Deliverable *struct {
+		Typename string `json:"__typename"`
+		model.ReverseDeliveryShippingDeliverable
+	} `json:"deliverable"`
+}
+
+func (n *reverseDeliveryNode) toModel() *model.ReverseDelivery {
+	if n == nil {
+		return nil
+	}
+	d := n.ReverseDelivery
+	if n.Deliverable != nil && n.Deliverable.Typename == "ReverseDeliveryShippingDeliverable" {
+		deliverable := n.Deliverable.ReverseDeliveryShippingDeliverable
+		d.Deliverable = &deliverable
+	}
+	return &d
+}
+
+const reverseDeliveryFields = `
+	id
+	deliverable {
+		__typename
+		... on ReverseDeliveryShippingDeliverable {
+			tracking {
+				carrierName
+				number
+				url
+			}
+			label {
+				createdAt
+				publicFileUrl
+			}
+		}
+	}
+`
+
+var reverseFulfillmentOrderFields = fmt.Sprintf(`
+	id
+	status
+	order {
+		id
+	}
+	lineItems(first: 250) {
+		edges {
+			node {
+				id
+				totalQuantity
+				fulfillmentLineItem {
+					id
+					lineItem {
+						id
+						sku
+					}
+				}
+				dispositions {
+					id
+					quantity
+					type
+					location {
+						id
+					}
+				}
+			}
+		}
+	}
+	reverseDeliveries(first: 50) {
+		edges {
+			node {
+				%s
+			}
+		}
+	}
+`, reverseDeliveryFields)
+
+var returnFields = fmt.Sprintf(`
+	id
+	name
+	status
+	totalQuantity
+	order {
+		id
+	}
+	decline {
+		reason
+		note
+	}
+	returnLineItems(first: 250) {
+		edges {
+			node {
+				... on ReturnLineItem {
+					id
+					quantity
+					returnReason
+					returnReasonNote
+					customerNote
+					fulfillmentLineItem {
+						id
+						lineItem {
+							id
+							sku
+						}
+					}
+				}
+			}
+		}
+	}
+	reverseFulfillmentOrders(first: 50) {
+		edges {
+			node {
+				%s
+			}
+		}
+	}
+`, reverseFulfillmentOrderFields)
+
+func (s *ReturnServiceOp) Get(ctx context.Context, id string) (*model.Return, error) {
+	q := fmt.Sprintf(`
+		query return($id: ID!) {
+			return(id: $id) {
+				%s
+			}
+		}
+	`, returnFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		Return *returnNode `json:"return"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Return == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "return not found", nil)
+	}
+
+	return out.Return.toModel(), nil
+}
+
+// Create creates a return that is open right away, use ApproveRequest for returns requested by the customer.
+func (s *ReturnServiceOp) Create(ctx context.Context, input model.ReturnInput) (*model.Return, error) {
+	m := fmt.Sprintf(`
+		mutation returnCreate($returnInput: ReturnInput!) {
+			returnCreate(returnInput: $returnInput) {
+				return {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, returnFields)
+
+	vars := map[string]interface{}{
+		"returnInput": input,
+	}
+	out := struct {
+		ReturnCreate returnMutationResult `json:"returnCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.ReturnCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.ReturnCreate.UserErrors)
+	}
+
+	return out.ReturnCreate.Return.toModel(), nil
+}
+
+// ApproveRequest approves a customer's return request, which opens the return and creates its reverse fulfillment orders.
+func (s *ReturnServiceOp) ApproveRequest(ctx context.Context, input model.ReturnApproveRequestInput) (*model.Return, error) {
+	m := fmt.Sprintf(`
+		mutation returnApproveRequest($input: ReturnApproveRequestInput!) {
+			returnApproveRequest(input: $input) {
+				return {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, returnFields)
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+	out := struct {
+		ReturnApproveRequest returnMutationResult `json:"returnApproveRequest"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.ReturnApproveRequest.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.ReturnApproveRequest.UserErrors)
+	}
+
+	return out.ReturnApproveRequest.Return.toModel(), nil
+}
+
+func (s *ReturnServiceOp) DeclineRequest(ctx context.Context, input model.ReturnDeclineRequestInput) (*model.Return, error) {
+	m := fmt.Sprintf(`
+		mutation returnDeclineRequest($input: ReturnDeclineRequestInput!) {
+			returnDeclineRequest(input: $input) {
+				return {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, returnFields)
+
+	vars := map[string]interface{}{
+		"input": input,
+	}
+	out := struct {
+		ReturnDeclineRequest returnMutationResult `json:"returnDeclineRequest"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.ReturnDeclineRequest.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.ReturnDeclineRequest.UserErrors)
+	}
+
+	return out.ReturnDeclineRequest.Return.toModel(), nil
+}
+
+// Refund refunds the returned line items and, optionally, shipping and duties.
+func (s *ReturnServiceOp) Refund(ctx context.Context, input model.ReturnRefundInput) (*model.Refund, error) {
+	m := fmt.Sprintf(`
+		mutation returnRefund($returnRefundInput: ReturnRefundInput!) {
+			returnRefund(returnRefundInput: $returnRefundInput) {
+				refund {
+					id
+					legacyResourceId
+					createdAt
+					note
+					totalRefundedSet {
+						%s
+					}
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, moneyBagFields)
+
+	vars := map[string]interface{}{
+		"returnRefundInput": input,
+	}
+	out := struct {
+		ReturnRefund struct {
+			Refund     *model.Refund `json:"refund,omitempty"`
+			UserErrors []UserErrors  `json:"userErrors,omitempty"`
+		} `json:"returnRefund"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.ReturnRefund.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.ReturnRefund.UserErrors)
+	}
+
+	return out.ReturnRefund.Refund, nil
+}
+
+func (s *ReturnServiceOp) GetReverseFulfillmentOrder(ctx context.Context, id string) (*model.ReverseFulfillmentOrder, error) {
+	q := fmt.Sprintf(`
+		query reverseFulfillmentOrder($id: ID!) {
+			reverseFulfillmentOrder(id: $id) {
+				%s
+			}
+		}
+	`, reverseFulfillmentOrderFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		ReverseFulfillmentOrder *reverseFulfillmentOrderNode `json:"reverseFulfillmentOrder"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, err
+	}
+
+	if out.ReverseFulfillmentOrder == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "reverse fulfillment order not found", nil)
+	}
+
+	return out.ReverseFulfillmentOrder.toModel(), nil
+}
+
+func (s *ReturnServiceOp) GetReverseDelivery(ctx context.Context, id string) (*model.ReverseDelivery, error) {
+	q := fmt.Sprintf(`
+		query reverseDelivery($id: ID!) {
+			reverseDelivery(id: $id) {
+				%s
+				reverseFulfillmentOrder {
+					id
+					status
+				}
+			}
+		}
+	`, reverseDeliveryFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		ReverseDelivery *reverseDeliveryNode `json:"reverseDelivery"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, err
+	}
+
+	if out.ReverseDelivery == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "reverse delivery not found", nil)
+	}
+
+	return out.ReverseDelivery.toModel(), nil
+}
@@ -0,0 +1,105 @@
+package shopify
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+
+	"github.com/gempages/go-shopify-graphql/graphql"
+)
+
+const returnResponse = `{
+	"data": {
+		"return": {
+			"id": "gid://shopify/Return/945000954",
+			"name": "#1001-R1",
+			"status": "OPEN",
+			"totalQuantity": 1,
+			"order": {"id": "gid://shopify/Order/148977776"},
+			"decline": null,
+			"returnLineItems": {
+				"edges": [{
+					"node": {
+						"id": "gid://shopify/ReturnLineItem/677614677",
+						"quantity": 1,
+						"returnReason": "SIZE_TOO_SMALL",
+						"returnReasonNote": "",
+						"customerNote": null,
+						"fulfillmentLineItem": {
+							"id": "gid://shopify/FulfillmentLineItem/4451426",
+							"lineItem": {"id": "gid://shopify/LineItem/466157049", "sku": "IPOD2008GREEN"}
+						}
+					}
+				}]
+			},
+			"reverseFulfillmentOrders": {
+				"edges": [{
+					"node": {
+						"id": "gid://shopify/ReverseFulfillmentOrder/2",
+						"status": "OPEN",
+						"order": {"id": "gid://shopify/Order/148977776"},
+						"lineItems": {"edges": []},
+						"reverseDeliveries": {
+							"edges": [{
+								"node": {
+									"id": "gid://shopify/ReverseDelivery/3",
+									"deliverable": {
+										"__typename": "ReverseDeliveryShippingDeliverable",
+										"tracking": {"carrierName": "USPS", "number": "9400100000000000000000", "url": null},
+										"label": {"createdAt": "2024-05-01T10:00:00Z", "publicFileUrl": "https://cdn.shopify.com/label.pdf"}
+									}
+								}
+							}, {
+								"node": {
+									"id": "gid://shopify/ReverseDelivery/4",
+									"deliverable": null
+								}
+							}]
+						}
+					}
+				}]
+			}
+		}
+	}
+}`
+
+func TestReturnGetDecodesDeliverable(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(returnResponse))
+	}))
+	defer srv.Close()
+	c := &Client{gql: graphql.NewClient(srv.URL, nil)}
+	c.initServices()
+
+	ret, err := c.Return().Get(context.Background(), "gid://shopify/Return/945000954")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ret.Name != "#1001-R1" || ret.Status != model.ReturnStatusOpen {
+		t.Errorf("got return %s %s", ret.Name, ret.Status)
+	}
+	if n := len(ret.ReturnLineItems.Edges); n != 1 {
+		t.Fatalf("got %d return line items, want 1", n)
+	}
+	if n := len(ret.ReverseFulfillmentOrders.Edges); n != 1 {
+		t.Fatalf("got %d reverse fulfillment orders, want 1", n)
+	}
+
+	deliveries := ret.ReverseFulfillmentOrders.Edges[0].Node.ReverseDeliveries.Edges
+	if len(deliveries) != 2 {
+		t.Fatalf("got %d reverse deliveries, want 2", len(deliveries))
+	}
+	shipping, ok := deliveries[0].Node.Deliverable.(*model.ReverseDeliveryShippingDeliverable)
+	if !ok {
+		t.Fatalf("got deliverable %T, want *model.ReverseDeliveryShippingDeliverable", deliveries[0].Node.Deliverable)
+	}
+	if shipping.Tracking == nil || *shipping.Tracking.Number != "9400100000000000000000" {
+		t.Errorf("got tracking %+v", shipping.Tracking)
+	}
+	if deliveries[1].Node.Deliverable != nil {
+		t.Errorf("got deliverable %T for a delivery without one", deliveries[1].Node.Deliverable)
+	}
+}
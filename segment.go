@@ -0,0 +1,360 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gempages/go-helper/errors"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// SegmentService manages customer segments, which are defined by a ShopifyQL query such as
+// "email_subscription_status = 'SUBSCRIBED'", and reads their members.
+type SegmentService interface {
+	Get(ctx context.Context, id string) (*model.Segment, error)
+	List(ctx context.Context, opts ListOptions) ([]*model.Segment, string, error)
+
+	Create(ctx context.Context, name, query string) (*model.Segment, error)
+	Update(ctx context.Context, id string, name, query *string) (*model.Segment, error)
+	Delete(ctx context.Context, id string) (string, error)
+
+	ListMembers(ctx context.Context, segmentID string, opts ListOptions) ([]*model.CustomerSegmentMember, string, error)
+	IsMember(ctx context.Context, customerID string, segmentIDs []string) (map[string]bool, error)
+}
+
+type SegmentServiceOp struct {
+	client *Client
+}
+
+var _ SegmentService = &SegmentServiceOp{}
+
+const segmentFields = `
+	id
+	name
+	query
+	creationDate
+	lastEditDate
+`
+
+const customerSegmentMemberFields = `
+	id
+	displayName
+	firstName
+	lastName
+	note
+	numberOfOrders
+	lastOrderId
+	amountSpent {
+		amount
+		currencyCode
+	}
+	defaultEmailAddress {
+		emailAddress
+	}
+	defaultPhoneNumber {
+		phoneNumber
+	}
+`
+
+func (s *SegmentServiceOp) Get(ctx context.Context, id string) (*model.Segment, error) {
+	q := fmt.Sprintf(`
+		query segment($id: ID!) {
+			segment(id: $id) {
+				%s
+			}
+		}
+	`, segmentFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		Segment *model.Segment `json:"segment"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.Segment == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "segment not found", nil)
+	}
+
+	return out.Segment, nil
+}
+
+// List returns a page of segments matching opts.Query, oldest first unless opts.Reverse is set.
+func (s *SegmentServiceOp) List(ctx context.Context, opts ListOptions) ([]*model.Segment, string, error) {
+	q := fmt.Sprintf(`
+		query segments($first: Int!, $after: String, $query: String, $reverse: Boolean) {
+			segments(first: $first, after: $after, query: $query, reverse: $reverse) {
+				edges {
+					node {
+						%s
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+				}
+			}
+		}
+	`, segmentFields)
+
+	first := opts.First
+	if first <= 0 {
+		first = 50
+	}
+	vars := map[string]interface{}{
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+	if opts.Query != "" {
+		vars["query"] = opts.Query
+	}
+
+	out := struct {
+		Segments struct {
+			Edges []struct {
+				Node   *model.Segment `json:"node"`
+				Cursor string         `json:"cursor"`
+			} `json:"edges"`
+			PageInfo struct {
+				HasNextPage bool `json:"hasNextPage"`
+			} `json:"pageInfo"`
+		} `json:"segments"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, "", fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	edges := out.Segments.Edges
+	res := make([]*model.Segment, 0, len(edges))
+	for _, edge := range edges {
+		res = append(res, edge.Node)
+	}
+	nextCursor := ""
+	if out.Segments.PageInfo.HasNextPage && len(edges) > 0 {
+		nextCursor = edges[len(edges)-1].Cursor
+	}
+
+	return res, nextCursor, nil
+}
+
+// Create creates a segment. Segment names must be unique within a shop.
+func (s *SegmentServiceOp) Create(ctx context.Context, name, query string) (*model.Segment, error) {
+	m := fmt.Sprintf(`
+		mutation segmentCreate($name: String!, $query: String!) {
+			segmentCreate(name: $name, query: $query) {
+				segment {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, segmentFields)
+
+	vars := map[string]interface{}{
+		"name":  name,
+		"query": query,
+	}
+	out := struct {
+		SegmentCreate struct {
+			Segment    *model.Segment    `json:"segment"`
+			UserErrors []model.UserError `json:"userErrors"`
+		} `json:"segmentCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.SegmentCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.SegmentCreate.UserErrors)
+	}
+
+	return out.SegmentCreate.Segment, nil
+}
+
+// Update renames a segment and/or changes its query; nil arguments are left unchanged.
+func (s *SegmentServiceOp) Update(ctx context.Context, id string, name, query *string) (*model.Segment, error) {
+	m := fmt.Sprintf(`
+		mutation segmentUpdate($id: ID!, $name: String, $query: String) {
+			segmentUpdate(id: $id, name: $name, query: $query) {
+				segment {
+					%s
+				}
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`, segmentFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	if name != nil {
+		vars["name"] = *name
+	}
+	if query != nil {
+		vars["query"] = *query
+	}
+	out := struct {
+		SegmentUpdate struct {
+			Segment    *model.Segment    `json:"segment"`
+			UserErrors []model.UserError `json:"userErrors"`
+		} `json:"segmentUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.SegmentUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.SegmentUpdate.UserErrors)
+	}
+
+	return out.SegmentUpdate.Segment, nil
+}
+
+func (s *SegmentServiceOp) Delete(ctx context.Context, id string) (string, error) {
+	m := `
+		mutation segmentDelete($id: ID!) {
+			segmentDelete(id: $id) {
+				deletedSegmentId
+				userErrors {
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		SegmentDelete struct {
+			DeletedSegmentID *string           `json:"deletedSegmentId"`
+			UserErrors       []model.UserError `json:"userErrors"`
+		} `json:"segmentDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return "", fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.SegmentDelete.UserErrors) > 0 {
+		return "", fmt.Errorf("%+v", out.SegmentDelete.UserErrors)
+	}
+	if out.SegmentDelete.DeletedSegmentID == nil {
+		return "", nil
+	}
+
+	return *out.SegmentDelete.DeletedSegmentID, nil
+}
+
+// ListMembers returns a page of the customers in a segment. When segmentID is empty, opts.Query is
+// evaluated as an ad hoc ShopifyQL segment query instead, which previews an audience without saving it.
+func (s *SegmentServiceOp) ListMembers(ctx context.Context, segmentID string, opts ListOptions) ([]*model.CustomerSegmentMember, string, error) {
+	q := fmt.Sprintf(`
+		query customerSegmentMembers($segmentId: ID, $query: String, $first: Int!, $after: String, $reverse: Boolean) {
+			customerSegmentMembers(segmentId: $segmentId, query: $query, first: $first, after: $after, reverse: $reverse) {
+				edges {
+					node {
+						%s
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+				}
+			}
+		}
+	`, customerSegmentMemberFields)
+
+	first := opts.First
+	if first <= 0 {
+		first = 50
+	}
+	vars := map[string]interface{}{
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	switch {
+	case segmentID != "":
+		vars["segmentId"] = segmentID
+	case opts.Query != "":
+		vars["query"] = opts.Query
+	default:
+		return nil, "", fmt.Errorf("segment ID or query is required")
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+
+	out := struct {
+		CustomerSegmentMembers struct {
+			Edges []struct {
+				Node   *model.CustomerSegmentMember `json:"node"`
+				Cursor string                       `json:"cursor"`
+			} `json:"edges"`
+			PageInfo struct {
+				HasNextPage bool `json:"hasNextPage"`
+			} `json:"pageInfo"`
+		} `json:"customerSegmentMembers"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, "", fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	edges := out.CustomerSegmentMembers.Edges
+	res := make([]*model.CustomerSegmentMember, 0, len(edges))
+	for _, edge := range edges {
+		res = append(res, edge.Node)
+	}
+	nextCursor := ""
+	if out.CustomerSegmentMembers.PageInfo.HasNextPage && len(edges) > 0 {
+		nextCursor = edges[len(edges)-1].Cursor
+	}
+
+	return res, nextCursor, nil
+}
+
+// IsMember reports, for each of segmentIDs, whether the customer belongs to the segment.
+func (s *SegmentServiceOp) IsMember(ctx context.Context, customerID string, segmentIDs []string) (map[string]bool, error) {
+	q := `
+		query customerSegmentMembership($customerId: ID!, $segmentIds: [ID!]!) {
+			customerSegmentMembership(customerId: $customerId, segmentIds: $segmentIds) {
+				memberships {
+					segmentId
+					isMember
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"customerId": customerID,
+		"segmentIds": segmentIDs,
+	}
+	out := struct {
+		CustomerSegmentMembership model.SegmentMembershipResponse `json:"customerSegmentMembership"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	res := make(map[string]bool, len(segmentIDs))
+	for _, membership := range out.CustomerSegmentMembership.Memberships {
+		res[membership.SegmentID] = membership.IsMember
+	}
+	return res, nil
+}
@@ -0,0 +1,191 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gempages/go-helper/errors"
+	"github.com/gempages/go-shopify-graphql/graphql"
+)
+
+// SellingPlanGroup is a set of purchase options, such as "Subscribe and save", offered on a product.
+type SellingPlanGroup struct {
+	Name         graphql.String           `json:"name,omitempty"`
+	AppName      *graphql.String          `json:"appName,omitempty"`
+	Options      []SellingPlanGroupOption `json:"options,omitempty"`
+	SellingPlans []SellingPlan            `json:"-"`
+}
+
+type SellingPlanGroupOption struct {
+	Name   graphql.String   `json:"name,omitempty"`
+	Values []graphql.String `json:"values,omitempty"`
+}
+
+// ProductSellingPlans holds what a storefront needs to display subscription purchase options.
+// The ID of a selling plan is passed as SellingPlanId of a CartLineInput to buy a variant with it.
+type ProductSellingPlans struct {
+	// RequiresSellingPlan is true when the product can only be bought with a selling plan.
+	RequiresSellingPlan bool
+	Groups              []SellingPlanGroup
+	// VariantAllocations maps variant IDs to the selling plans available for them and the resulting prices.
+	VariantAllocations map[string][]SellingPlanAllocation
+}
+
+const sellingPlanFields = `
+	id
+	name
+	description
+	recurringDeliveries
+	options {
+		name
+		value
+	}
+	priceAdjustments {
+		orderCount
+		adjustmentValue {
+			__typename
+			... on SellingPlanPercentagePriceAdjustment {
+				adjustmentPercentage
+			}
+			... on SellingPlanFixedAmountPriceAdjustment {
+				adjustmentAmount {
+					amount
+					currencyCode
+				}
+			}
+			... on SellingPlanFixedPriceAdjustment {
+				price {
+					amount
+					currencyCode
+				}
+			}
+		}
+	}
+`
+
+// GetSellingPlans returns the selling plan groups of a product and the selling plan allocations of
+// its variants. It is only available on clients created with NewClientStoreFrontWithToken.
+func (s *ProductServiceOp) GetSellingPlans(ctx context.Context, id string) (*ProductSellingPlans, error) {
+	q := fmt.Sprintf(`
+		query productSellingPlans($id: ID!) {
+			product(id: $id) {
+				requiresSellingPlan
+				sellingPlanGroups(first: 25) {
+					edges {
+						node {
+							name
+							appName
+							options {
+								name
+								values
+							}
+							sellingPlans(first: 50) {
+								edges {
+									node {
+										%s
+									}
+								}
+							}
+						}
+					}
+				}
+				variants(first: 250) {
+					edges {
+						node {
+							id
+							sellingPlanAllocations(first: 50) {
+								edges {
+									node {
+										sellingPlan {
+											id
+											name
+										}
+										priceAdjustments {
+											price {
+												amount
+												currencyCode
+											}
+											compareAtPrice {
+												amount
+												currencyCode
+											}
+											perDeliveryPrice {
+												amount
+												currencyCode
+											}
+											unitPrice {
+												amount
+												currencyCode
+											}
+										}
+									}
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	`, sellingPlanFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		Product *struct {
+			RequiresSellingPlan bool `json:"requiresSellingPlan"`
+			SellingPlanGroups   struct {
+				Edges []struct {
+					Node struct {
+						SellingPlanGroup
+						SellingPlans struct {
+							Edges []struct {
+								Node SellingPlan `json:"node"`
+							} `json:"edges"`
+						} `json:"sellingPlans"`
+					} `json:"node"`
+				} `json:"edges"`
+			} `json:"sellingPlanGroups"`
+			Variants struct {
+				Edges []struct {
+					Node struct {
+						ID                     string `json:"id"`
+						SellingPlanAllocations struct {
+							Edges []struct {
+								Node SellingPlanAllocation `json:"node"`
+							} `json:"edges"`
+						} `json:"sellingPlanAllocations"`
+					} `json:"node"`
+				} `json:"edges"`
+			} `json:"variants"`
+		} `json:"product"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.Product == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "product not found", nil)
+	}
+
+	result := &ProductSellingPlans{
+		RequiresSellingPlan: out.Product.RequiresSellingPlan,
+		Groups:              make([]SellingPlanGroup, 0, len(out.Product.SellingPlanGroups.Edges)),
+		VariantAllocations:  make(map[string][]SellingPlanAllocation, len(out.Product.Variants.Edges)),
+	}
+	for _, edge := range out.Product.SellingPlanGroups.Edges {
+		group := edge.Node.SellingPlanGroup
+		for _, planEdge := range edge.Node.SellingPlans.Edges {
+			group.SellingPlans = append(group.SellingPlans, planEdge.Node)
+		}
+		result.Groups = append(result.Groups, group)
+	}
+	for _, edge := range out.Product.Variants.Edges {
+		allocations := make([]SellingPlanAllocation, 0, len(edge.Node.SellingPlanAllocations.Edges))
+		for _, allocationEdge := range edge.Node.SellingPlanAllocations.Edges {
+			allocations = append(allocations, allocationEdge.Node)
+		}
+		result.VariantAllocations[edge.Node.ID] = allocations
+	}
+	return result, nil
+}
@@ -0,0 +1,163 @@
+package shopifytest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// runBulkQuery runs query as a bulk operation, which completes right away, and returns the
+// bulkOperationRunQuery payload. s.mu must be held.
+func (s *Server) runBulkQuery(query string) map[string]interface{} {
+	lines, rootCount, err := s.bulkQueryLines(query)
+	if err != nil {
+		return map[string]interface{}{
+			"bulkOperation": nil,
+			"userErrors":    []interface{}{userError(err.Error(), "query")},
+		}
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, line := range lines {
+		if err = enc.Encode(line); err != nil {
+			panic(fmt.Sprintf("shopifytest: encode bulk operation result: %s", err))
+		}
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	id := s.newID("BulkOperation")
+	op := map[string]interface{}{
+		"__typename":      "BulkOperation",
+		"id":              id,
+		"type":            "QUERY",
+		"status":          "COMPLETED",
+		"query":           query,
+		"errorCode":       nil,
+		"createdAt":       now,
+		"completedAt":     now,
+		"objectCount":     strconv.Itoa(len(lines)),
+		"rootObjectCount": strconv.Itoa(rootCount),
+		"fileSize":        strconv.Itoa(buf.Len()),
+		"url":             nil,
+		"partialDataUrl":  nil,
+	}
+	if len(lines) > 0 {
+		path := fmt.Sprintf("/bulk/%d.jsonl", s.lastID)
+		s.results[path] = buf.Bytes()
+		op["url"] = s.files.URL + path
+	}
+	s.bulkOp = op
+	s.nodes[id] = op
+
+	return map[string]interface{}{"bulkOperation": op, "userErrors": []interface{}{}}
+}
+
+// bulkQueryLines returns the JSONL lines of the result of a bulk query: one line per node, where
+// the nodes of nested connections reference their parent with __parentId.
+func (s *Server) bulkQueryLines(query string) ([]interface{}, int, error) {
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid bulk query: %s", err.Error())
+	}
+	if len(doc.Operations) != 1 || doc.Operations[0].Operation != ast.Query {
+		return nil, 0, fmt.Errorf("invalid bulk query: a bulk query must contain a single query operation")
+	}
+
+	r := &request{s: s, doc: doc, vars: map[string]interface{}{}}
+	roots := r.fields(doc.Operations[0].SelectionSet, nil)
+	if len(roots) != 1 {
+		return nil, 0, fmt.Errorf("invalid bulk query: a bulk query must have a single top-level field")
+	}
+	root := roots[0]
+	v, err := r.resolveRoot(root)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// a single object root, such as shop or node, only serves to reach its connections, whose
+	// nodes are returned as top-level lines
+	connection, nodes := root, []interface{}(nil)
+	if isConnection(root) {
+		nodes, _ = v.([]interface{})
+	} else {
+		obj, _ := v.(map[string]interface{})
+		if obj == nil {
+			return nil, 0, nil
+		}
+		connection = nil
+		for _, f := range r.fields(root.SelectionSet, obj) {
+			if isConnection(f) {
+				connection = f
+				nodes, _ = r.resolveField(obj, f).([]interface{})
+				break
+			}
+		}
+		if connection == nil {
+			return nil, 0, fmt.Errorf("invalid bulk query: bulk queries must contain at least one connection")
+		}
+	}
+	args, err := r.args(connection)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	nodes = filterNodes(nodes, args)
+	var lines []interface{}
+	for _, n := range nodes {
+		lines = r.bulkLines(lines, n.(map[string]interface{}), nodeSelection(connection), "")
+	}
+	return lines, len(nodes), nil
+}
+
+// bulkLines appends the line of obj, then the lines of the nodes of its nested connections.
+func (r *request) bulkLines(lines []interface{}, obj map[string]interface{}, set ast.SelectionSet, parentID string) []interface{} {
+	line := r.project(obj, set, true).(map[string]interface{})
+	if parentID != "" {
+		line["__parentId"] = parentID
+	}
+	lines = append(lines, line)
+
+	id, _ := obj["id"].(string)
+	for _, f := range r.fields(set, obj) {
+		if !isConnection(f) {
+			continue
+		}
+		children, _ := r.resolveField(obj, f).([]interface{})
+		args, err := r.args(f)
+		if err != nil {
+			continue
+		}
+		for _, child := range filterNodes(children, args) {
+			lines = r.bulkLines(lines, child.(map[string]interface{}), nodeSelection(f), id)
+		}
+	}
+	return lines
+}
+
+// nodeSelection returns the selection set of the nodes of connection field f.
+func nodeSelection(f *ast.Field) ast.SelectionSet {
+	var set ast.SelectionSet
+	for _, sel := range f.SelectionSet {
+		sf, ok := sel.(*ast.Field)
+		if !ok {
+			continue
+		}
+		switch sf.Name {
+		case "nodes":
+			set = append(set, sf.SelectionSet...)
+		case "edges":
+			for _, esel := range sf.SelectionSet {
+				if ef, ok := esel.(*ast.Field); ok && ef.Name == "node" {
+					set = append(set, ef.SelectionSet...)
+				}
+			}
+		}
+	}
+	return set
+}
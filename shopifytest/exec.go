@@ -0,0 +1,304 @@
+package shopifytest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// pagingArguments are the connection arguments that don't filter nodes by field value.
+var pagingArguments = map[string]bool{
+	"first": true, "last": true, "after": true, "before": true, "reverse": true, "query": true,
+	"sortKey": true, "savedSearchId": true,
+}
+
+// abstractTypes are the interfaces matched by inline fragments on any object.
+var abstractTypes = map[string]bool{
+	"Node": true, "HasMetafields": true, "HasMetafieldDefinitions": true, "HasPublishedTranslations": true,
+	"Publishable": true, "OnlineStorePublishable": true, "LegacyInteroperability": true, "Navigable": true,
+	"HasEvents": true, "CommentEventSubject": true,
+}
+
+// request is the execution of one GraphQL document.
+type request struct {
+	s    *Server
+	doc  *ast.QueryDocument
+	vars map[string]interface{}
+}
+
+// execute runs the operation of query and returns its data. s.mu must be held.
+func (s *Server) execute(query, operationName string, vars map[string]interface{}) (map[string]interface{}, error) {
+	doc, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return nil, fmt.Errorf("parse query: %s", err.Error())
+	}
+	var op *ast.OperationDefinition
+	if operationName != "" {
+		op = doc.Operations.ForName(operationName)
+	} else if len(doc.Operations) == 1 {
+		op = doc.Operations[0]
+	}
+	if op == nil {
+		return nil, fmt.Errorf("operation %q not found", operationName)
+	}
+
+	if vars == nil {
+		vars = map[string]interface{}{}
+	}
+	for _, def := range op.VariableDefinitions {
+		if _, ok := vars[def.Variable]; !ok && def.DefaultValue != nil {
+			if vars[def.Variable], err = def.DefaultValue.Value(nil); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	r := &request{s: s, doc: doc, vars: vars}
+	data := map[string]interface{}{}
+	for _, f := range r.fields(op.SelectionSet, nil) {
+		var v interface{}
+		if op.Operation == ast.Mutation {
+			v, err = r.mutate(f)
+		} else {
+			v, err = r.resolveRoot(f)
+		}
+		if err != nil {
+			return nil, err
+		}
+		data[responseKey(f)] = r.complete(v, f, false)
+	}
+	return data, nil
+}
+
+// fields returns the fields of set that apply to obj, expanding fragments. A nil obj matches
+// every fragment.
+func (r *request) fields(set ast.SelectionSet, obj map[string]interface{}) []*ast.Field {
+	var res []*ast.Field
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *ast.Field:
+			res = append(res, sel)
+		case *ast.InlineFragment:
+			if matchesType(obj, sel.TypeCondition) {
+				res = append(res, r.fields(sel.SelectionSet, obj)...)
+			}
+		case *ast.FragmentSpread:
+			def := r.doc.Fragments.ForName(sel.Name)
+			if def != nil && matchesType(obj, def.TypeCondition) {
+				res = append(res, r.fields(def.SelectionSet, obj)...)
+			}
+		}
+	}
+	return res
+}
+
+func matchesType(obj map[string]interface{}, typeCondition string) bool {
+	if obj == nil || typeCondition == "" || abstractTypes[typeCondition] {
+		return true
+	}
+	return obj["__typename"] == typeCondition
+}
+
+// args returns the argument values of f.
+func (r *request) args(f *ast.Field) (map[string]interface{}, error) {
+	args := map[string]interface{}{}
+	for _, arg := range f.Arguments {
+		v, err := arg.Value.Value(r.vars)
+		if err != nil {
+			return nil, fmt.Errorf("argument %s of %s: %w", arg.Name, f.Name, err)
+		}
+		if v != nil {
+			args[arg.Name] = v
+		}
+	}
+	return args, nil
+}
+
+// complete projects the resolved value v on the selection set of f. With bulk set, the
+// connections nested in v are left out, as bulk operations return them on separate lines.
+func (r *request) complete(v interface{}, f *ast.Field, bulk bool) interface{} {
+	if len(f.SelectionSet) == 0 || v == nil {
+		return v
+	}
+	if list, ok := v.([]interface{}); ok && isConnection(f) {
+		args, err := r.args(f)
+		if err != nil {
+			return nil
+		}
+		return r.project(paginate(filterNodes(list, args), args), f.SelectionSet, false)
+	}
+	return r.project(v, f.SelectionSet, bulk)
+}
+
+// project returns the fields of set selected from v.
+func (r *request) project(v interface{}, set ast.SelectionSet, bulk bool) interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		res := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			res = append(res, r.project(item, set, bulk))
+		}
+		return res
+	case map[string]interface{}:
+		res := map[string]interface{}{}
+		for _, f := range r.fields(set, v) {
+			if bulk && isConnection(f) {
+				continue
+			}
+			res[responseKey(f)] = r.complete(r.resolveField(v, f), f, bulk)
+		}
+		return res
+	}
+	return v
+}
+
+// resolveField returns the value of field f of obj.
+func (r *request) resolveField(obj map[string]interface{}, f *ast.Field) interface{} {
+	if f.Name == "metafield" {
+		args, err := r.args(f)
+		if err != nil {
+			return nil
+		}
+		metafields, _ := obj["metafields"].([]interface{})
+		for _, m := range metafields {
+			m, ok := m.(map[string]interface{})
+			if ok && m["key"] == args["key"] && (args["namespace"] == nil || m["namespace"] == args["namespace"]) {
+				return m
+			}
+		}
+		return nil
+	}
+	return obj[f.Name]
+}
+
+func isConnection(f *ast.Field) bool {
+	for _, sel := range f.SelectionSet {
+		if sf, ok := sel.(*ast.Field); ok && (sf.Name == "edges" || sf.Name == "nodes" || sf.Name == "pageInfo") {
+			return true
+		}
+	}
+	return false
+}
+
+func responseKey(f *ast.Field) string {
+	if f.Alias != "" {
+		return f.Alias
+	}
+	return f.Name
+}
+
+// filterNodes returns the nodes matching the query argument and the other filtering arguments
+// of a connection field.
+func filterNodes(nodes []interface{}, args map[string]interface{}) []interface{} {
+	query, _ := args["query"].(string)
+	terms := parseSearchQuery(query)
+
+	res := make([]interface{}, 0, len(nodes))
+	for _, n := range nodes {
+		obj, ok := n.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if matchesArgs(obj, args) && terms.matches(obj) {
+			res = append(res, obj)
+		}
+	}
+	if reverse, _ := args["reverse"].(bool); reverse {
+		for i, j := 0, len(res)-1; i < j; i, j = i+1, j-1 {
+			res[i], res[j] = res[j], res[i]
+		}
+	}
+	return res
+}
+
+func matchesArgs(obj map[string]interface{}, args map[string]interface{}) bool {
+	for name, want := range args {
+		if pagingArguments[name] {
+			continue
+		}
+		got, ok := obj[name]
+		if !ok {
+			continue
+		}
+		if list, ok := want.([]interface{}); ok {
+			found := false
+			for _, w := range list {
+				found = found || fmt.Sprint(w) == fmt.Sprint(got)
+			}
+			if !found {
+				return false
+			}
+		} else if fmt.Sprint(want) != fmt.Sprint(got) {
+			return false
+		}
+	}
+	return true
+}
+
+// paginate returns the connection of the page of nodes selected by the first and after
+// arguments.
+func paginate(nodes []interface{}, args map[string]interface{}) map[string]interface{} {
+	start := 0
+	if after, ok := args["after"].(string); ok {
+		start = decodeCursor(after) + 1
+	}
+	start = min(start, len(nodes))
+	end := len(nodes)
+	if first, ok := toInt(args["first"]); ok {
+		end = min(start+first, end)
+	}
+
+	edges := make([]interface{}, 0, end-start)
+	for i := start; i < end; i++ {
+		edges = append(edges, map[string]interface{}{"cursor": encodeCursor(i), "node": nodes[i]})
+	}
+	pageInfo := map[string]interface{}{
+		"hasNextPage":     end < len(nodes),
+		"hasPreviousPage": start > 0,
+		"startCursor":     nil,
+		"endCursor":       nil,
+	}
+	if end > start {
+		pageInfo["startCursor"] = encodeCursor(start)
+		pageInfo["endCursor"] = encodeCursor(end - 1)
+	}
+	return map[string]interface{}{
+		"edges":    edges,
+		"nodes":    nodes[start:end],
+		"pageInfo": pageInfo,
+	}
+}
+
+func encodeCursor(i int) string {
+	return base64.StdEncoding.EncodeToString([]byte("cursor:" + strconv.Itoa(i)))
+}
+
+func decodeCursor(cursor string) int {
+	data, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return -1
+	}
+	i, err := strconv.Atoi(strings.TrimPrefix(string(data), "cursor:"))
+	if err != nil {
+		return -1
+	}
+	return i
+}
+
+func toInt(v interface{}) (int, bool) {
+	switch v := v.(type) {
+	case int64:
+		return int(v), true
+	case float64:
+		return int(v), true
+	case json.Number:
+		i, err := v.Int64()
+		return int(i), err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,194 @@
+package shopifytest
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// rootConnections maps the root connection fields to the type of their nodes.
+var rootConnections = map[string]string{
+	"products":    "Product",
+	"collections": "Collection",
+}
+
+// resolveRoot returns the value of the query root field f.
+func (r *request) resolveRoot(f *ast.Field) (interface{}, error) {
+	args, err := r.args(f)
+	if err != nil {
+		return nil, err
+	}
+
+	switch f.Name {
+	case "product", "collection", "node":
+		id, _ := args["id"].(string)
+		obj := r.s.nodes[id]
+		if obj == nil || (f.Name != "node" && typeOfID(id) != rootTypename(f.Name)) {
+			return nil, nil
+		}
+		return obj, nil
+	case "nodes":
+		ids, _ := args["ids"].([]interface{})
+		res := make([]interface{}, 0, len(ids))
+		for _, id := range ids {
+			if obj, ok := r.s.nodes[fmt.Sprint(id)]; ok {
+				res = append(res, obj)
+			} else {
+				res = append(res, nil)
+			}
+		}
+		return res, nil
+	case "productByHandle", "collectionByHandle":
+		typename := rootTypename(f.Name[:len(f.Name)-len("ByHandle")])
+		for _, obj := range r.s.roots[typename] {
+			if obj["handle"] == args["handle"] {
+				return obj, nil
+			}
+		}
+		return nil, nil
+	case "products", "collections":
+		return r.rootNodes(rootConnections[f.Name]), nil
+	case "shop":
+		return r.s.shop, nil
+	case "currentBulkOperation":
+		if r.s.bulkOp == nil {
+			return nil, nil
+		}
+		return r.s.bulkOp, nil
+	}
+	return nil, fmt.Errorf("shopifytest: query field %s is not supported", f.Name)
+}
+
+func rootTypename(field string) string {
+	switch field {
+	case "product":
+		return "Product"
+	case "collection":
+		return "Collection"
+	}
+	return ""
+}
+
+func (r *request) rootNodes(typename string) []interface{} {
+	objs := r.s.roots[typename]
+	res := make([]interface{}, 0, len(objs))
+	for _, obj := range objs {
+		res = append(res, obj)
+	}
+	return res
+}
+
+// mutate runs the mutation root field f and returns its payload.
+func (r *request) mutate(f *ast.Field) (interface{}, error) {
+	args, err := r.args(f)
+	if err != nil {
+		return nil, err
+	}
+
+	switch f.Name {
+	case "metafieldsSet":
+		return r.metafieldsSet(args), nil
+	case "metafieldsDelete":
+		return r.metafieldsDelete(args), nil
+	case "metafieldDelete":
+		return r.metafieldDelete(args), nil
+	case "bulkOperationRunQuery":
+		query, _ := args["query"].(string)
+		return r.s.runBulkQuery(query), nil
+	case "bulkOperationCancel":
+		return map[string]interface{}{"bulkOperation": r.s.bulkOp, "userErrors": []interface{}{}}, nil
+	}
+	return nil, fmt.Errorf("shopifytest: mutation field %s is not supported", f.Name)
+}
+
+func userError(message string, field ...interface{}) map[string]interface{} {
+	return map[string]interface{}{"field": field, "message": message, "code": "INVALID"}
+}
+
+func (r *request) metafieldsSet(args map[string]interface{}) map[string]interface{} {
+	inputs, _ := args["metafields"].([]interface{})
+	metafields := []interface{}{}
+	userErrors := []interface{}{}
+	now := time.Now().UTC().Format(time.RFC3339)
+	for i, in := range inputs {
+		in, _ := in.(map[string]interface{})
+		ownerID, _ := in["ownerId"].(string)
+		owner := r.s.nodes[ownerID]
+		if owner == nil {
+			userErrors = append(userErrors, userError("Owner does not exist.", "metafields", fmt.Sprint(i), "ownerId"))
+			continue
+		}
+
+		var metafield map[string]interface{}
+		list, _ := owner["metafields"].([]interface{})
+		for _, m := range list {
+			if m, ok := m.(map[string]interface{}); ok && m["namespace"] == in["namespace"] && m["key"] == in["key"] {
+				metafield = m
+			}
+		}
+		if metafield == nil {
+			id := r.s.newID("Metafield")
+			metafield = map[string]interface{}{
+				"__typename": "Metafield",
+				"id":         id,
+				"namespace":  in["namespace"],
+				"key":        in["key"],
+				"ownerType":  typeOfID(ownerID),
+				"createdAt":  now,
+			}
+			owner["metafields"] = append(list, metafield)
+			r.s.nodes[id] = metafield
+		}
+		metafield["value"] = in["value"]
+		if in["type"] != nil {
+			metafield["type"] = in["type"]
+		}
+		metafield["updatedAt"] = now
+		metafield["owner"] = map[string]interface{}{"__typename": owner["__typename"], "id": ownerID}
+		metafields = append(metafields, metafield)
+	}
+	return map[string]interface{}{"metafields": metafields, "userErrors": userErrors}
+}
+
+func (r *request) metafieldsDelete(args map[string]interface{}) map[string]interface{} {
+	inputs, _ := args["metafields"].([]interface{})
+	deleted := []interface{}{}
+	for _, in := range inputs {
+		in, _ := in.(map[string]interface{})
+		ownerID, _ := in["ownerId"].(string)
+		if r.deleteMetafield(ownerID, func(m map[string]interface{}) bool {
+			return m["namespace"] == in["namespace"] && m["key"] == in["key"]
+		}) {
+			deleted = append(deleted, map[string]interface{}{"ownerId": ownerID, "namespace": in["namespace"], "key": in["key"]})
+		} else {
+			deleted = append(deleted, nil)
+		}
+	}
+	return map[string]interface{}{"deletedMetafields": deleted, "userErrors": []interface{}{}}
+}
+
+func (r *request) metafieldDelete(args map[string]interface{}) map[string]interface{} {
+	in, _ := args["input"].(map[string]interface{})
+	id, _ := in["id"].(string)
+	for ownerID, owner := range r.s.nodes {
+		if _, ok := owner["metafields"]; ok && r.deleteMetafield(ownerID, func(m map[string]interface{}) bool { return m["id"] == id }) {
+			return map[string]interface{}{"deletedId": id, "userErrors": []interface{}{}}
+		}
+	}
+	return map[string]interface{}{"deletedId": nil, "userErrors": []interface{}{userError("Metafield does not exist", "input", "id")}}
+}
+
+// deleteMetafield removes the first metafield of the owner matching match.
+func (r *request) deleteMetafield(ownerID string, match func(map[string]interface{}) bool) bool {
+	owner := r.s.nodes[ownerID]
+	list, _ := owner["metafields"].([]interface{})
+	for i, m := range list {
+		if m, ok := m.(map[string]interface{}); ok && match(m) {
+			owner["metafields"] = append(list[:i:i], list[i+1:]...)
+			delete(r.s.nodes, fmt.Sprint(m["id"]))
+			return true
+		}
+	}
+	return false
+}
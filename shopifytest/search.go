@@ -0,0 +1,198 @@
+package shopifytest
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/gempages/go-shopify-graphql/gid"
+)
+
+// searchQuery is a parsed search query: every group must match, and a group matches when any of
+// its terms does.
+type searchQuery [][]searchTerm
+
+type searchTerm struct {
+	field  string // empty for a free text term
+	op     string // "", "<", "<=", ">" or ">="
+	value  string
+	negate bool
+}
+
+// parseSearchQuery parses the subset of the search syntax supported by the Server, e.g.
+// `status:active AND (id:1 OR id:2)`, `title:snow*` or `created_at:>'2024-01-01'`. A value
+// following OR without a field, as in `id:1 OR 2`, uses the field of the previous term.
+func parseSearchQuery(query string) searchQuery {
+	var (
+		res    searchQuery
+		or     bool
+		negate bool
+	)
+	for _, token := range tokenizeSearchQuery(query) {
+		switch token {
+		case "AND", "(", ")":
+			continue
+		case "OR":
+			or = true
+			continue
+		case "NOT":
+			negate = true
+			continue
+		}
+
+		t := searchTerm{negate: negate}
+		if strings.HasPrefix(token, "-") {
+			t.negate, token = true, token[1:]
+		}
+		if field, value, ok := strings.Cut(token, ":"); ok {
+			t.field, t.value = field, value
+			for _, op := range []string{"<=", ">=", "<", ">"} {
+				if strings.HasPrefix(t.value, op) {
+					t.op, t.value = op, t.value[len(op):]
+					break
+				}
+			}
+		} else {
+			t.value = token
+			if or && len(res) > 0 {
+				last := res[len(res)-1]
+				t.field = last[len(last)-1].field
+			}
+		}
+		t.value = strings.Trim(t.value, `"'`)
+
+		if or && len(res) > 0 {
+			res[len(res)-1] = append(res[len(res)-1], t)
+		} else {
+			res = append(res, []searchTerm{t})
+		}
+		or, negate = false, false
+	}
+	return res
+}
+
+// tokenizeSearchQuery splits query on spaces and parentheses, keeping quoted values whole.
+func tokenizeSearchQuery(query string) []string {
+	var (
+		tokens []string
+		cur    strings.Builder
+		quote  rune
+	)
+	flush := func() {
+		if cur.Len() > 0 {
+			tokens = append(tokens, cur.String())
+			cur.Reset()
+		}
+	}
+	for _, c := range query {
+		switch {
+		case quote != 0:
+			cur.WriteRune(c)
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+			cur.WriteRune(c)
+		case unicode.IsSpace(c):
+			flush()
+		case c == '(' || c == ')':
+			flush()
+			tokens = append(tokens, string(c))
+		default:
+			cur.WriteRune(c)
+		}
+	}
+	flush()
+	return tokens
+}
+
+func (q searchQuery) matches(obj map[string]interface{}) bool {
+	for _, group := range q {
+		matched := false
+		for _, t := range group {
+			matched = matched || t.matches(obj)
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func (t searchTerm) matches(obj map[string]interface{}) bool {
+	var values []interface{}
+	switch t.field {
+	case "":
+		values = []interface{}{obj["title"], obj["name"], obj["handle"]}
+		for _, v := range values {
+			if v != nil && strings.Contains(strings.ToLower(fmt.Sprint(v)), strings.ToLower(t.value)) {
+				return !t.negate
+			}
+		}
+		return t.negate
+	case "id":
+		id := fmt.Sprint(obj["id"])
+		values = []interface{}{id, gid.Legacy(id)}
+	case "tag":
+		values, _ = obj["tags"].([]interface{})
+	default:
+		v, ok := obj[camelCase(t.field)]
+		if !ok {
+			// filters on fields the fixture doesn't have are ignored
+			return true
+		}
+		values = []interface{}{v}
+	}
+
+	for _, v := range values {
+		if v != nil && t.compare(fmt.Sprint(v)) {
+			return !t.negate
+		}
+	}
+	return t.negate
+}
+
+func (t searchTerm) compare(v string) bool {
+	if t.op == "" {
+		if prefix, ok := strings.CutSuffix(t.value, "*"); ok {
+			return strings.HasPrefix(strings.ToLower(v), strings.ToLower(prefix))
+		}
+		return strings.EqualFold(v, t.value)
+	}
+
+	cmp := strings.Compare(v, t.value)
+	a, errA := strconv.ParseFloat(v, 64)
+	b, errB := strconv.ParseFloat(t.value, 64)
+	if errA == nil && errB == nil {
+		cmp = 0
+		if a < b {
+			cmp = -1
+		} else if a > b {
+			cmp = 1
+		}
+	}
+	switch t.op {
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	case ">":
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
+
+// camelCase converts the snake case name of a search field to the name of the object field,
+// e.g. product_type to productType.
+func camelCase(field string) string {
+	parts := strings.Split(field, "_")
+	for i := 1; i < len(parts); i++ {
+		if parts[i] != "" {
+			parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+		}
+	}
+	return strings.Join(parts, "")
+}
@@ -0,0 +1,277 @@
+// Package shopifytest provides an in-memory fake of the Shopify Admin GraphQL API, so that code
+// using a *shopify.Client can be tested without a shop or an access token.
+//
+// The fake serves a subset of the Admin schema from the fixtures added to the Server:
+//
+//   - the product, productByHandle, products, collection, collectionByHandle, collections,
+//     node, nodes, shop and currentBulkOperation queries;
+//   - the metafieldsSet, metafieldsDelete and metafieldDelete mutations;
+//   - bulk queries through bulkOperationRunQuery, which complete immediately.
+//
+// Fields are resolved by name from the fixtures, so any field of a fixture can be queried and
+// missing fields resolve to null. Lists of a fixture are served as connections, and
+// connection arguments other than first, after, reverse and query filter the nodes by field
+// value. The query search syntax supports field:value terms combined with AND and OR, the
+// comparison operators and trailing wildcards; filters on fields a fixture doesn't have are
+// ignored. Any other root field fails with a GraphQL error.
+package shopifytest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+
+	shopify "github.com/gempages/go-shopify-graphql"
+	"github.com/gempages/go-shopify-graphql/gid"
+	graphqlclient "github.com/gempages/go-shopify-graphql/graph"
+)
+
+// Server is a fake Shopify Admin GraphQL API. Create it with NewServer and Close it when done.
+type Server struct {
+	gql   *httptest.Server
+	files *httptest.Server
+
+	mu       sync.Mutex
+	nodes    map[string]map[string]interface{}
+	roots    map[string][]map[string]interface{}
+	shop     map[string]interface{}
+	bulkOp   map[string]interface{}
+	results  map[string][]byte
+	requests []Request
+	lastID   int
+}
+
+// Request is a GraphQL request received by the Server.
+type Request struct {
+	Query     string
+	Variables map[string]interface{}
+}
+
+// NewServer starts a Server with no fixtures.
+func NewServer() *Server {
+	s := &Server{
+		nodes:   map[string]map[string]interface{}{},
+		roots:   map[string][]map[string]interface{}{},
+		results: map[string][]byte{},
+		lastID:  1000,
+	}
+	s.gql = httptest.NewTLSServer(http.HandlerFunc(s.serveGraphQL))
+	// bulk operation results are downloaded with the default HTTP client, which doesn't trust
+	// the certificate of the TLS server
+	s.files = httptest.NewServer(http.HandlerFunc(s.serveFile))
+	s.shop = map[string]interface{}{
+		"__typename":      "Shop",
+		"id":              gid.Shop(1),
+		"name":            "shopifytest",
+		"myshopifyDomain": s.Domain(),
+		"currencyCode":    "USD",
+	}
+	return s
+}
+
+// Close shuts the Server down.
+func (s *Server) Close() {
+	s.gql.Close()
+	s.files.Close()
+}
+
+// Domain returns the host and port the Server listens on, to be used as the shop domain.
+func (s *Server) Domain() string {
+	return strings.TrimPrefix(s.gql.URL, "https://")
+}
+
+// Client returns an Admin API client sending its requests to the Server. opts are applied after
+// the options pointing the client at the Server.
+func (s *Server) Client(opts ...graphqlclient.Option) *shopify.Client {
+	opts = append([]graphqlclient.Option{
+		graphqlclient.WithToken("shpat_shopifytest"),
+		graphqlclient.WithTransport(s.gql.Client().Transport),
+	}, opts...)
+	return shopify.NewClientWithOpts(s.Domain(), opts...)
+}
+
+// AddProducts adds products, with their variants, media and metafields, to the fixtures.
+func (s *Server) AddProducts(products ...*model.Product) {
+	for _, p := range products {
+		s.AddNode(p)
+	}
+}
+
+// AddCollections adds collections, with their products and metafields, to the fixtures.
+func (s *Server) AddCollections(collections ...*model.Collection) {
+	for _, c := range collections {
+		s.AddNode(c)
+	}
+}
+
+// AddNode adds any object with a global ID to the fixtures, e.g. a model type or a map decoded
+// from a JSON fixture file. Connections of node are flattened into lists of nodes, and objects
+// whose __typename is missing get the type of their ID. Products and collections are listed by
+// the products and collections queries in the order they were added.
+func (s *Server) AddNode(node interface{}) {
+	data, err := json.Marshal(node)
+	if err != nil {
+		panic(fmt.Sprintf("shopifytest: marshal fixture: %s", err))
+	}
+	var v interface{}
+	if err = json.Unmarshal(data, &v); err != nil {
+		panic(fmt.Sprintf("shopifytest: unmarshal fixture: %s", err))
+	}
+	obj, ok := normalize(v).(map[string]interface{})
+	if !ok || typeOfID(obj["id"]) == "" {
+		panic(fmt.Sprintf("shopifytest: fixture %T has no global ID", node))
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.index(obj)
+	typename := obj["__typename"].(string)
+	s.roots[typename] = append(s.roots[typename], obj)
+}
+
+// Node returns the fixture with the given ID, including the changes made by mutations, or nil.
+// The returned map must not be modified.
+func (s *Server) Node(id string) map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.nodes[id]
+}
+
+// Requests returns the requests received so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// index registers obj and the objects nested in it under their IDs.
+func (s *Server) index(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if id, ok := v["id"].(string); ok && typeOfID(id) != "" {
+			if _, exists := s.nodes[id]; !exists {
+				s.nodes[id] = v
+			}
+		}
+		for _, child := range v {
+			s.index(child)
+		}
+	case []interface{}:
+		for _, child := range v {
+			s.index(child)
+		}
+	}
+}
+
+// newID returns a new global ID of the given type.
+func (s *Server) newID(typename string) string {
+	s.lastID++
+	return gid.Format(typename, s.lastID)
+}
+
+func (s *Server) serveGraphQL(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if r.Header.Get("X-Shopify-Access-Token") == "" {
+		http.Error(w, `{"errors":"[API] Invalid API key or access token (unrecognized login or wrong password)"}`, http.StatusUnauthorized)
+		return
+	}
+
+	var in struct {
+		Query         string                 `json:"query"`
+		Variables     map[string]interface{} `json:"variables"`
+		OperationName string                 `json:"operationName"`
+	}
+	dec := json.NewDecoder(r.Body)
+	dec.UseNumber()
+	if err := dec.Decode(&in); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err), http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{Query: in.Query, Variables: in.Variables})
+	data, err := s.execute(in.Query, in.OperationName, in.Variables)
+	s.mu.Unlock()
+
+	out := map[string]interface{}{}
+	if data != nil {
+		out["data"] = data
+	}
+	if err != nil {
+		out["errors"] = []map[string]interface{}{{"message": err.Error()}}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(out)
+}
+
+func (s *Server) serveFile(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	data, ok := s.results[r.URL.Path]
+	s.mu.Unlock()
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/jsonl")
+	_, _ = w.Write(data)
+}
+
+// normalize flattens the connections of a decoded fixture into lists of nodes and sets the
+// __typename of objects with a global ID.
+func normalize(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if nodes, ok := connectionNodes(v); ok {
+			return normalize(nodes)
+		}
+		for k, child := range v {
+			v[k] = normalize(child)
+		}
+		if _, ok := v["__typename"]; !ok {
+			if typename := typeOfID(v["id"]); typename != "" {
+				v["__typename"] = typename
+			}
+		}
+		return v
+	case []interface{}:
+		for i, child := range v {
+			v[i] = normalize(child)
+		}
+		return v
+	}
+	return v
+}
+
+// connectionNodes returns the nodes of obj if it is a connection.
+func connectionNodes(obj map[string]interface{}) ([]interface{}, bool) {
+	if edges, ok := obj["edges"].([]interface{}); ok {
+		nodes := make([]interface{}, 0, len(edges))
+		for _, edge := range edges {
+			if edge, ok := edge.(map[string]interface{}); ok {
+				nodes = append(nodes, edge["node"])
+			}
+		}
+		return nodes, true
+	}
+	if nodes, ok := obj["nodes"].([]interface{}); ok {
+		return nodes, true
+	}
+	if _, ok := obj["pageInfo"]; ok && len(obj) == 1 {
+		return []interface{}{}, true
+	}
+	return nil, false
+}
+
+// typeOfID returns the type of a global ID such as gid://shopify/Product/1, or "".
+func typeOfID(id interface{}) string {
+	s, _ := id.(string)
+	return gid.Resource(s)
+}
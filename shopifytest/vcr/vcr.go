@@ -0,0 +1,479 @@
+// Package vcr records the HTTP interactions of a client to a golden file and replays them, so
+// that tests written against a real shop run deterministically, and without credentials, once
+// recorded.
+//
+// A Recorder is installed on a client as a middleware:
+//
+//	rec, err := vcr.New("testdata/collection.json", vcr.WithMode(vcr.ModeFromEnv()))
+//	...
+//	client := shopify.NewClientWithOpts(domain,
+//		shopifyGraph.WithToken(token),
+//		shopifyGraph.WithMiddleware(rec.Middleware),
+//	)
+//	...
+//	err = rec.Stop() // writes the golden file when recording
+//
+// Bulk operation results are downloaded with the default HTTP client rather than the one of the
+// shopify.Client; wrap http.DefaultTransport with Middleware too to record them.
+//
+// Access tokens and cookies are scrubbed from recorded interactions, and so are the signatures
+// of URLs, e.g. of bulk operation results, and the values of JSON body fields named like
+// passwords, tokens or secrets. Use WithScrubber to remove any other sensitive data. Requests are
+// matched on their method, path, query and body, ignoring the host, so replaying doesn't need the
+// domain of the recorded shop; they are scrubbed the same way before being matched.
+package vcr
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ModeEnv is the environment variable read by ModeFromEnv.
+const ModeEnv = "SHOPIFY_VCR_MODE"
+
+// Redacted replaces the values of scrubbed headers.
+const Redacted = "[REDACTED]"
+
+// Mode selects whether a Recorder sends requests or replays them.
+type Mode int
+
+const (
+	// ModeAuto replays the golden file if it exists, and records it otherwise.
+	ModeAuto Mode = iota
+	// ModeReplay replays the golden file and fails the requests that weren't recorded.
+	ModeReplay
+	// ModeRecord sends the requests and overwrites the golden file.
+	ModeRecord
+)
+
+// ModeFromEnv returns the mode named by the SHOPIFY_VCR_MODE environment variable, "record",
+// "replay" or "auto". It defaults to ModeReplay, so that a plain go test never calls a shop.
+func ModeFromEnv() Mode {
+	switch strings.ToLower(os.Getenv(ModeEnv)) {
+	case "record":
+		return ModeRecord
+	case "auto":
+		return ModeAuto
+	}
+	return ModeReplay
+}
+
+// scrubbedHeaders are removed from, or redacted in, every recorded interaction.
+var scrubbedHeaders = []string{
+	"X-Shopify-Access-Token",
+	"X-Shopify-Storefront-Access-Token",
+	"Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// sensitiveWords are the words that make a URL query parameter or a JSON body field sensitive
+// when its lower-cased name contains one of them.
+var sensitiveWords = []string{"signature", "credential", "accessid", "token", "password", "secret"}
+
+// Interaction is a recorded request and its response.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a recorded request.
+type Request struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   Body        `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       Body        `json:"body,omitempty"`
+}
+
+// Body is a recorded request or response body. Compact JSON bodies, such as those of GraphQL
+// requests and responses, are stored as JSON so that golden files stay readable, any other body
+// as a string.
+type Body []byte
+
+func (b Body) MarshalJSON() ([]byte, error) {
+	if len(b) == 0 {
+		return []byte("null"), nil
+	}
+	// only compact JSON is stored as is, so that replayed bodies are byte for byte the recorded
+	// ones, trailing newlines of JSONL files included
+	var buf bytes.Buffer
+	if json.Compact(&buf, b) == nil && bytes.Equal(buf.Bytes(), b) {
+		return b, nil
+	}
+	return json.Marshal(string(b))
+}
+
+func (b *Body) UnmarshalJSON(data []byte) error {
+	var s string
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		*b = Body(s)
+		return nil
+	}
+	if string(data) == "null" {
+		*b = nil
+		return nil
+	}
+	// golden files are indented, while the recorded bodies were compact
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, data); err != nil {
+		return err
+	}
+	*b = buf.Bytes()
+	return nil
+}
+
+// cassette is the content of a golden file.
+type cassette struct {
+	Interactions []*Interaction `json:"interactions"`
+}
+
+// Option configures a Recorder.
+type Option func(r *Recorder)
+
+// WithMode sets the mode of the Recorder, ModeAuto by default.
+func WithMode(mode Mode) Option {
+	return func(r *Recorder) {
+		r.mode = mode
+	}
+}
+
+// WithScrubber adds a function called on every interaction before it is recorded, e.g. to
+// redact customer data from response bodies.
+func WithScrubber(scrub func(*Interaction)) Option {
+	return func(r *Recorder) {
+		r.scrubbers = append(r.scrubbers, scrub)
+	}
+}
+
+// Recorder records or replays the interactions of a golden file.
+type Recorder struct {
+	path      string
+	mode      Mode
+	scrubbers []func(*Interaction)
+
+	mu           sync.Mutex
+	interactions []*Interaction
+	used         []bool
+}
+
+// New returns a Recorder of the golden file at path. In replay mode, the file is loaded and
+// must exist.
+func New(path string, opts ...Option) (*Recorder, error) {
+	r := &Recorder{path: path}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case r.mode == ModeRecord:
+		return r, nil
+	case r.mode == ModeAuto && errors.Is(err, os.ErrNotExist):
+		r.mode = ModeRecord
+		return r, nil
+	case err != nil:
+		return nil, fmt.Errorf("read golden file: %w", err)
+	}
+
+	var c cassette
+	if err = json.Unmarshal(data, &c); err != nil {
+		return nil, fmt.Errorf("decode golden file %s: %w", path, err)
+	}
+	r.mode = ModeReplay
+	r.interactions = c.Interactions
+	r.used = make([]bool, len(c.Interactions))
+	return r, nil
+}
+
+// Mode returns the mode of the Recorder, ModeRecord or ModeReplay once ModeAuto is resolved.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Middleware returns next wrapped with the Recorder. It matches graphqlclient.Middleware.
+func (r *Recorder) Middleware(next http.RoundTripper) http.RoundTripper {
+	return roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if r.mode == ModeReplay {
+			return r.replay(req)
+		}
+		return r.record(req, next)
+	})
+}
+
+// Stop writes the recorded interactions to the golden file. It does nothing in replay mode.
+func (r *Recorder) Stop() error {
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.mu.Lock()
+	data, err := json.MarshalIndent(cassette{Interactions: r.interactions}, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("encode golden file: %w", err)
+	}
+	if err = os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return fmt.Errorf("create golden file directory: %w", err)
+	}
+	if err = os.WriteFile(r.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("write golden file: %w", err)
+	}
+	return nil
+}
+
+func (r *Recorder) record(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+	reqBody, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("vcr: read request body: %w", err)
+	}
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := readBody(&resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("vcr: read response body: %w", err)
+	}
+
+	in := &Interaction{
+		Request: Request{
+			Method: req.Method,
+			URL:    req.URL.String(),
+			Header: req.Header.Clone(),
+			Body:   reqBody,
+		},
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     resp.Header.Clone(),
+			Body:       append(Body(nil), respBody...),
+		},
+	}
+	scrub(in)
+	for _, s := range r.scrubbers {
+		s(in)
+	}
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, in)
+	r.mu.Unlock()
+	return resp, nil
+}
+
+// replay returns the response of the first unused interaction matching req. Once all the
+// matching interactions are used, the last one is replayed again, e.g. for repeated polling.
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	body, err := readBody(&req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("vcr: read request body: %w", err)
+	}
+	// the recorded requests were scrubbed
+	body = scrubBody(body)
+	u, err := url.Parse(scrubURL(req.URL.String()))
+	if err != nil {
+		return nil, fmt.Errorf("vcr: parse request URL: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	match := -1
+	for i, in := range r.interactions {
+		if !matches(in, req.Method, u, body) {
+			continue
+		}
+		match = i
+		if !r.used[i] {
+			break
+		}
+	}
+	if match < 0 {
+		return nil, fmt.Errorf("vcr: no interaction recorded in %s for %s %s", r.path, req.Method, req.URL.Path)
+	}
+	r.used[match] = true
+
+	in := r.interactions[match]
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", in.Response.StatusCode, http.StatusText(in.Response.StatusCode)),
+		StatusCode:    in.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        in.Response.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(in.Response.Body)),
+		ContentLength: int64(len(in.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+// matches reports whether the recorded interaction in is for the request, comparing JSON bodies
+// by value.
+func matches(in *Interaction, method string, reqURL *url.URL, body []byte) bool {
+	if in.Request.Method != method {
+		return false
+	}
+	u, err := url.Parse(in.Request.URL)
+	if err != nil || u.Path != reqURL.Path || u.RawQuery != reqURL.RawQuery {
+		return false
+	}
+	return equalBodies(in.Request.Body, body)
+}
+
+func equalBodies(a, b []byte) bool {
+	var va, vb interface{}
+	if json.Unmarshal(a, &va) != nil || json.Unmarshal(b, &vb) != nil {
+		return bytes.Equal(a, b)
+	}
+	ja, _ := json.Marshal(normalizeQuery(va))
+	jb, _ := json.Marshal(normalizeQuery(vb))
+	return bytes.Equal(ja, jb)
+}
+
+// normalizeQuery collapses the whitespace of the query of a GraphQL request body, so that
+// reindenting a query doesn't invalidate its recordings.
+func normalizeQuery(v interface{}) interface{} {
+	if m, ok := v.(map[string]interface{}); ok {
+		if q, ok := m["query"].(string); ok {
+			m["query"] = strings.Join(strings.Fields(q), " ")
+		}
+	}
+	return v
+}
+
+// scrub redacts the credentials of in.
+func scrub(in *Interaction) {
+	for _, h := range scrubbedHeaders {
+		if in.Request.Header.Get(h) != "" {
+			in.Request.Header.Set(h, Redacted)
+		}
+		in.Response.Header.Del(h)
+	}
+	in.Request.URL = scrubURL(in.Request.URL)
+	in.Request.Body = scrubBody(in.Request.Body)
+	if location := in.Response.Header.Get("Location"); location != "" {
+		in.Response.Header.Set("Location", scrubURL(location))
+	}
+	in.Response.Body = scrubBody(in.Response.Body)
+}
+
+func isSensitive(name string) bool {
+	name = strings.ToLower(name)
+	for _, word := range sensitiveWords {
+		if strings.Contains(name, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// scrubURL redacts the values of the sensitive query parameters of rawURL, such as the signature
+// of a signed storage URL. URLs without any are returned as is.
+func scrubURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.RawQuery == "" {
+		return rawURL
+	}
+	query := u.Query()
+	scrubbed := false
+	for name := range query {
+		if isSensitive(name) {
+			query.Set(name, Redacted)
+			scrubbed = true
+		}
+	}
+	if !scrubbed {
+		return rawURL
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// scrubBody redacts the sensitive fields and URLs of a JSON body. Other bodies, and JSON bodies
+// without anything to redact, are returned as is.
+func scrubBody(body Body) Body {
+	if len(body) == 0 {
+		return body
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var v interface{}
+	if decoder.Decode(&v) != nil || decoder.More() {
+		return body
+	}
+	v, scrubbed := scrubValue(v)
+	if !scrubbed {
+		return body
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if encoder.Encode(v) != nil {
+		return body
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+}
+
+func scrubValue(v interface{}) (interface{}, bool) {
+	scrubbed := false
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, ok := value.(string); ok && isSensitive(key) {
+				v[key] = Redacted
+				scrubbed = true
+				continue
+			}
+			var changed bool
+			v[key], changed = scrubValue(value)
+			scrubbed = scrubbed || changed
+		}
+	case []interface{}:
+		for i, value := range v {
+			var changed bool
+			v[i], changed = scrubValue(value)
+			scrubbed = scrubbed || changed
+		}
+	case string:
+		if strings.HasPrefix(v, "https://") || strings.HasPrefix(v, "http://") {
+			if u := scrubURL(v); u != v {
+				return u, true
+			}
+		}
+	}
+	return v, scrubbed
+}
+
+// readBody reads *body and replaces it with a reader of the same content.
+func readBody(body *io.ReadCloser) ([]byte, error) {
+	if *body == nil || *body == http.NoBody {
+		return nil, nil
+	}
+	data, err := io.ReadAll(*body)
+	_ = (*body).Close()
+	*body = io.NopCloser(bytes.NewReader(data))
+	return data, err
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
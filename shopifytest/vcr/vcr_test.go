@@ -0,0 +1,167 @@
+package vcr
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func do(t *testing.T, rt http.RoundTripper, url, body string) string {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("X-Shopify-Access-Token", "shpat_secret")
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("round trip: %s", err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Set-Cookie", "session=1")
+		b, _ := io.ReadAll(r.Body)
+		_, _ = w.Write([]byte(`{"data":{"echo":` + string(b) + `,"call":` + strconv.Itoa(calls) + `}}`))
+	}))
+	path := filepath.Join(t.TempDir(), "golden", "cassette.json")
+
+	rec, err := New(path, WithMode(ModeAuto))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Mode() != ModeRecord {
+		t.Fatalf("got mode %d without golden file, want ModeRecord", rec.Mode())
+	}
+	rt := rec.Middleware(http.DefaultTransport)
+	first := do(t, rt, srv.URL+"/admin/api/graphql.json", `{"query":"{ shop { name } }"}`)
+	second := do(t, rt, srv.URL+"/admin/api/graphql.json", `{"query":"{ shop { name } }"}`)
+	if err = rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "shpat_secret") || strings.Contains(string(data), "session=1") {
+		t.Errorf("golden file contains credentials:\n%s", data)
+	}
+
+	rec, err = New(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rec.Mode() != ModeReplay {
+		t.Fatalf("got mode %d with golden file, want ModeReplay", rec.Mode())
+	}
+	rt = rec.Middleware(nil)
+	// the host and the indentation of the query don't matter
+	if got := do(t, rt, "https://other.myshopify.com/admin/api/graphql.json", `{"query":"{\n  shop {\n    name\n  }\n}"}`); got != first {
+		t.Errorf("first replay got %s, want %s", got, first)
+	}
+	if got := do(t, rt, "https://other.myshopify.com/admin/api/graphql.json", `{"query":"{ shop { name } }"}`); got != second {
+		t.Errorf("second replay got %s, want %s", got, second)
+	}
+	if got := do(t, rt, "https://other.myshopify.com/admin/api/graphql.json", `{"query":"{ shop { name } }"}`); got != second {
+		t.Errorf("replay after the last recording got %s, want %s", got, second)
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, "https://other.myshopify.com/admin/api/graphql.json", strings.NewReader(`{"query":"{ shop { id } }"}`))
+	if _, err = rt.RoundTrip(req); err == nil {
+		t.Error("replaying an unrecorded request succeeded")
+	}
+}
+
+func TestReplayWithoutGoldenFile(t *testing.T) {
+	if _, err := New(filepath.Join(t.TempDir(), "missing.json"), WithMode(ModeReplay)); err == nil {
+		t.Error("New in replay mode succeeded without a golden file")
+	}
+}
+
+func TestWithScrubber(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"customer":{"email":"jane@example.com"}}}`))
+	}))
+	defer srv.Close()
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	rec, err := New(path, WithMode(ModeRecord), WithScrubber(func(in *Interaction) {
+		in.Response.Body = Body(strings.ReplaceAll(string(in.Response.Body), "jane@example.com", "customer@example.com"))
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := do(t, rec.Middleware(http.DefaultTransport), srv.URL, `{}`); !strings.Contains(got, "jane@example.com") {
+		t.Errorf("scrubber changed the live response: %s", got)
+	}
+	if err = rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(path)
+	if strings.Contains(string(data), "jane@example.com") {
+		t.Errorf("golden file wasn't scrubbed:\n%s", data)
+	}
+}
+
+func TestDefaultScrubbing(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":{"currentBulkOperation":{"url":"https://storage.googleapis.com/bulk/1.jsonl?GoogleAccessId=svc&Expires=1&Signature=abc%2B"},"customerAccessTokenCreate":{"customerAccessToken":{"accessToken":"tok_live"}}}}`))
+	}))
+	defer srv.Close()
+	path := filepath.Join(t.TempDir(), "cassette.json")
+
+	rec, err := New(path, WithMode(ModeRecord))
+	if err != nil {
+		t.Fatal(err)
+	}
+	live := do(t, rec.Middleware(http.DefaultTransport), srv.URL+"/bulk/1.jsonl?X-Goog-Signature=sig", `{"variables":{"password":"hunter2"}}`)
+	if !strings.Contains(live, "tok_live") {
+		t.Errorf("scrubbing changed the live response: %s", live)
+	}
+	if err = rec.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := os.ReadFile(path)
+	for _, secret := range []string{"abc%2B", "GoogleAccessId=svc", "tok_live", "X-Goog-Signature=sig", "hunter2"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("golden file contains %s:\n%s", secret, data)
+		}
+	}
+
+	rec, err = New(path, WithMode(ModeReplay))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// requests with the secrets of the recording still match it
+	got := do(t, rec.Middleware(nil), "https://other.myshopify.com/bulk/1.jsonl?X-Goog-Signature=other", `{"variables":{"password":"other"}}`)
+	if !strings.Contains(got, `"accessToken":"[REDACTED]"`) || !strings.Contains(got, "Expires=1") {
+		t.Errorf("got replayed response %s", got)
+	}
+}
+
+func TestModeFromEnv(t *testing.T) {
+	t.Setenv(ModeEnv, "")
+	if got := ModeFromEnv(); got != ModeReplay {
+		t.Errorf("got mode %d without %s, want ModeReplay", got, ModeEnv)
+	}
+	t.Setenv(ModeEnv, "auto")
+	if got := ModeFromEnv(); got != ModeAuto {
+		t.Errorf("got mode %d, want ModeAuto", got)
+	}
+}
@@ -0,0 +1,125 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gempages/go-helper/errors"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// StaffMemberService reads the staff accounts of the shop. It requires the read_users scope,
+// which is only granted to apps on Shopify Plus or Advanced plans.
+type StaffMemberService interface {
+	List(ctx context.Context) ([]*model.StaffMember, error)
+	Get(ctx context.Context, id string) (*model.StaffMember, error)
+}
+
+type StaffMemberServiceOp struct {
+	client *Client
+}
+
+var _ StaffMemberService = &StaffMemberServiceOp{}
+
+const staffMemberFields = `
+	id
+	name
+	firstName
+	lastName
+	initials
+	email
+	phone
+	locale
+	active
+	exists
+	isShopOwner
+	avatar {
+		url
+	}
+	privateData {
+		accountSettingsUrl
+		createdAt
+		permissions
+	}
+`
+
+// List returns all staff members of the shop, including deactivated ones.
+func (s *StaffMemberServiceOp) List(ctx context.Context) ([]*model.StaffMember, error) {
+	q := fmt.Sprintf(`
+		query staffMembers($after: String) {
+			shop {
+				staffMembers(first: 50, after: $after) {
+					edges {
+						node {
+							%s
+						}
+						cursor
+					}
+					pageInfo {
+						hasNextPage
+					}
+				}
+			}
+		}
+	`, staffMemberFields)
+
+	vars := map[string]interface{}{}
+	var res []*model.StaffMember
+	for {
+		out := struct {
+			Shop struct {
+				StaffMembers struct {
+					Edges []struct {
+						Node   *model.StaffMember `json:"node"`
+						Cursor string             `json:"cursor"`
+					} `json:"edges"`
+					PageInfo struct {
+						HasNextPage bool `json:"hasNextPage"`
+					} `json:"pageInfo"`
+				} `json:"staffMembers"`
+			} `json:"shop"`
+		}{}
+		err := s.client.gql.QueryString(ctx, q, vars, &out)
+		if err != nil {
+			return nil, fmt.Errorf("gql.QueryString: %w", err)
+		}
+
+		edges := out.Shop.StaffMembers.Edges
+		for _, edge := range edges {
+			res = append(res, edge.Node)
+		}
+		if !out.Shop.StaffMembers.PageInfo.HasNextPage || len(edges) == 0 {
+			return res, nil
+		}
+		vars["after"] = edges[len(edges)-1].Cursor
+	}
+}
+
+// Get returns the staff member with the given ID. With an empty id it returns the staff member
+// the request is made on behalf of, which requires an online access token.
+func (s *StaffMemberServiceOp) Get(ctx context.Context, id string) (*model.StaffMember, error) {
+	q := fmt.Sprintf(`
+		query staffMember($id: ID) {
+			staffMember(id: $id) {
+				%s
+			}
+		}
+	`, staffMemberFields)
+
+	vars := map[string]interface{}{}
+	if id != "" {
+		vars["id"] = id
+	}
+	out := struct {
+		StaffMember *model.StaffMember `json:"staffMember"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.StaffMember == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "staff member not found", nil)
+	}
+
+	return out.StaffMember, nil
+}
@@ -0,0 +1,305 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gempages/go-helper/errors"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// StoreCreditService issues and spends store credit. A customer has at most one store credit
+// account per currency; crediting a customer ID creates the account for the currency if needed.
+type StoreCreditService interface {
+	Credit(ctx context.Context, id string, amount model.MoneyInput, expiresAt *time.Time) (*StoreCreditTransaction, error)
+	Debit(ctx context.Context, id string, amount model.MoneyInput) (*StoreCreditTransaction, error)
+
+	ListAccounts(ctx context.Context, customerID string) ([]*StoreCreditAccount, error)
+	GetAccount(ctx context.Context, id string) (*StoreCreditAccount, error)
+	ListTransactions(ctx context.Context, accountID string, opts ListOptions) ([]*StoreCreditTransaction, string, error)
+}
+
+type StoreCreditServiceOp struct {
+	client *Client
+}
+
+var _ StoreCreditService = &StoreCreditServiceOp{}
+
+type StoreCreditAccount struct {
+	ID      string  `json:"id"`
+	Balance MoneyV2 `json:"balance"`
+}
+
+// StoreCreditTransaction is a credit, debit, expiration or debit revert on a store credit account.
+// Typename tells them apart; Amount is negative for debits and expirations.
+type StoreCreditTransaction struct {
+	Typename                string              `json:"__typename"`
+	Amount                  MoneyV2             `json:"amount"`
+	BalanceAfterTransaction MoneyV2             `json:"balanceAfterTransaction"`
+	CreatedAt               time.Time           `json:"createdAt"`
+	ExpiresAt               *time.Time          `json:"expiresAt,omitempty"`
+	Account                 *StoreCreditAccount `json:"account,omitempty"`
+}
+
+// StoreCreditUserError is a user error of the store credit mutations, with codes such as
+// INSUFFICIENT_FUNDS or MISMATCHING_CURRENCY.
+type StoreCreditUserError struct {
+	Code    *string  `json:"code"`
+	Field   []string `json:"field"`
+	Message string   `json:"message"`
+}
+
+const storeCreditTransactionFields = `
+	__typename
+	amount {
+		amount
+		currencyCode
+	}
+	balanceAfterTransaction {
+		amount
+		currencyCode
+	}
+	createdAt
+	... on StoreCreditAccountCreditTransaction {
+		expiresAt
+	}
+	account {
+		id
+		balance {
+			amount
+			currencyCode
+		}
+	}
+`
+
+// Credit adds amount to the store credit account with the given ID, or to the account of the
+// customer with the given ID in the currency of amount. expiresAt is optional.
+func (s *StoreCreditServiceOp) Credit(ctx context.Context, id string, amount model.MoneyInput, expiresAt *time.Time) (*StoreCreditTransaction, error) {
+	m := fmt.Sprintf(`
+		mutation storeCreditAccountCredit($id: ID!, $creditInput: StoreCreditAccountCreditInput!) {
+			storeCreditAccountCredit(id: $id, creditInput: $creditInput) {
+				storeCreditAccountTransaction {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, storeCreditTransactionFields)
+
+	creditInput := map[string]interface{}{
+		"creditAmount": amount,
+	}
+	if expiresAt != nil {
+		creditInput["expiresAt"] = expiresAt
+	}
+	vars := map[string]interface{}{
+		"id":          id,
+		"creditInput": creditInput,
+	}
+	out := struct {
+		StoreCreditAccountCredit struct {
+			Transaction *StoreCreditTransaction `json:"storeCreditAccountTransaction"`
+			UserErrors  []StoreCreditUserError  `json:"userErrors"`
+		} `json:"storeCreditAccountCredit"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.StoreCreditAccountCredit.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.StoreCreditAccountCredit.UserErrors)
+	}
+
+	return out.StoreCreditAccountCredit.Transaction, nil
+}
+
+// Debit spends amount from the store credit account with the given ID, or from the account of the
+// customer with the given ID in the currency of amount. The balance can't go below zero.
+func (s *StoreCreditServiceOp) Debit(ctx context.Context, id string, amount model.MoneyInput) (*StoreCreditTransaction, error) {
+	m := fmt.Sprintf(`
+		mutation storeCreditAccountDebit($id: ID!, $debitInput: StoreCreditAccountDebitInput!) {
+			storeCreditAccountDebit(id: $id, debitInput: $debitInput) {
+				storeCreditAccountTransaction {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, storeCreditTransactionFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+		"debitInput": map[string]interface{}{
+			"debitAmount": amount,
+		},
+	}
+	out := struct {
+		StoreCreditAccountDebit struct {
+			Transaction *StoreCreditTransaction `json:"storeCreditAccountTransaction"`
+			UserErrors  []StoreCreditUserError  `json:"userErrors"`
+		} `json:"storeCreditAccountDebit"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.StoreCreditAccountDebit.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.StoreCreditAccountDebit.UserErrors)
+	}
+
+	return out.StoreCreditAccountDebit.Transaction, nil
+}
+
+// ListAccounts returns the store credit accounts of a customer, one per currency, with their balance.
+func (s *StoreCreditServiceOp) ListAccounts(ctx context.Context, customerID string) ([]*StoreCreditAccount, error) {
+	q := `
+		query customerStoreCreditAccounts($id: ID!) {
+			customer(id: $id) {
+				storeCreditAccounts(first: 50) {
+					edges {
+						node {
+							id
+							balance {
+								amount
+								currencyCode
+							}
+						}
+					}
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"id": customerID,
+	}
+	out := struct {
+		Customer *struct {
+			StoreCreditAccounts struct {
+				Edges []struct {
+					Node *StoreCreditAccount `json:"node"`
+				} `json:"edges"`
+			} `json:"storeCreditAccounts"`
+		} `json:"customer"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.Customer == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "customer not found", nil)
+	}
+
+	accounts := make([]*StoreCreditAccount, 0, len(out.Customer.StoreCreditAccounts.Edges))
+	for _, edge := range out.Customer.StoreCreditAccounts.Edges {
+		accounts = append(accounts, edge.Node)
+	}
+	return accounts, nil
+}
+
+func (s *StoreCreditServiceOp) GetAccount(ctx context.Context, id string) (*StoreCreditAccount, error) {
+	q := `
+		query storeCreditAccount($id: ID!) {
+			storeCreditAccount(id: $id) {
+				id
+				balance {
+					amount
+					currencyCode
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		StoreCreditAccount *StoreCreditAccount `json:"storeCreditAccount"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.StoreCreditAccount == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "store credit account not found", nil)
+	}
+
+	return out.StoreCreditAccount, nil
+}
+
+// ListTransactions returns a page of the transactions of a store credit account, oldest first
+// unless opts.Reverse is set.
+func (s *StoreCreditServiceOp) ListTransactions(ctx context.Context, accountID string, opts ListOptions) ([]*StoreCreditTransaction, string, error) {
+	q := fmt.Sprintf(`
+		query storeCreditAccountTransactions($id: ID!, $first: Int!, $after: String, $reverse: Boolean) {
+			storeCreditAccount(id: $id) {
+				transactions(first: $first, after: $after, reverse: $reverse) {
+					edges {
+						node {
+							%s
+						}
+						cursor
+					}
+					pageInfo {
+						hasNextPage
+					}
+				}
+			}
+		}
+	`, storeCreditTransactionFields)
+
+	first := opts.First
+	if first <= 0 {
+		first = 50
+	}
+	vars := map[string]interface{}{
+		"id":      accountID,
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+
+	out := struct {
+		StoreCreditAccount *struct {
+			Transactions struct {
+				Edges []struct {
+					Node   *StoreCreditTransaction `json:"node"`
+					Cursor string                  `json:"cursor"`
+				} `json:"edges"`
+				PageInfo struct {
+					HasNextPage bool `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"transactions"`
+		} `json:"storeCreditAccount"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, "", fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.StoreCreditAccount == nil {
+		return nil, "", errors.NewNotExistsError(errors.ErrorResourceNotFound, "store credit account not found", nil)
+	}
+
+	edges := out.StoreCreditAccount.Transactions.Edges
+	res := make([]*StoreCreditTransaction, 0, len(edges))
+	for _, edge := range edges {
+		res = append(res, edge.Node)
+	}
+	nextCursor := ""
+	if out.StoreCreditAccount.Transactions.PageInfo.HasNextPage && len(edges) > 0 {
+		nextCursor = edges[len(edges)-1].Cursor
+	}
+
+	return res, nextCursor, nil
+}
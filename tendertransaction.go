@@ -0,0 +1,210 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+	"github.com/shopspring/decimal"
+)
+
+// TenderTransactionService reads tender transactions, i.e. the money that changed hands for
+// orders, per payment method. Unlike order transactions they include payments collected outside
+// Shopify, which makes them the basis for reconciling a shop's takings with its bank deposits.
+// It requires the read_orders scope.
+type TenderTransactionService interface {
+	List(ctx context.Context, opts TenderTransactionListOptions) ([]*model.TenderTransaction, string, error)
+	ListAll(ctx context.Context, opts TenderTransactionListOptions) ([]*model.TenderTransaction, error)
+}
+
+type TenderTransactionServiceOp struct {
+	client *Client
+}
+
+var _ TenderTransactionService = &TenderTransactionServiceOp{}
+
+// TenderTransactionListOptions filters tender transactions. Zero values are ignored; First and
+// After only apply to List.
+type TenderTransactionListOptions struct {
+	ProcessedAtMin *time.Time
+	ProcessedAtMax *time.Time
+	// Test keeps only test transactions when true, and excludes them when false.
+	Test *bool
+	// Query is appended to the other filters, e.g. "point_of_sale_device_id:123".
+	Query   string
+	First   int
+	After   string
+	Reverse bool
+}
+
+func (o TenderTransactionListOptions) searchQuery() string {
+	var terms []string
+	if o.ProcessedAtMin != nil {
+		terms = append(terms, fmt.Sprintf("processed_at:>='%s'", o.ProcessedAtMin.UTC().Format(time.RFC3339)))
+	}
+	if o.ProcessedAtMax != nil {
+		terms = append(terms, fmt.Sprintf("processed_at:<'%s'", o.ProcessedAtMax.UTC().Format(time.RFC3339)))
+	}
+	if o.Test != nil {
+		terms = append(terms, fmt.Sprintf("test:%t", *o.Test))
+	}
+	if o.Query != "" {
+		terms = append(terms, o.Query)
+	}
+	return strings.Join(terms, " AND ")
+}
+
+const tenderTransactionFields = `
+	id
+	processedAt
+	paymentMethod
+	remoteReference
+	test
+	amount {
+		amount
+		currencyCode
+	}
+	user {
+		id
+		name
+	}
+`
+
+// List returns a page of tender transactions. ProcessedAtMax is exclusive, so consecutive days
+// can be requested without overlap.
+func (s *TenderTransactionServiceOp) List(ctx context.Context, opts TenderTransactionListOptions) ([]*model.TenderTransaction, string, error) {
+	q := fmt.Sprintf(`
+		query tenderTransactions($first: Int!, $after: String, $query: String, $reverse: Boolean) {
+			tenderTransactions(first: $first, after: $after, query: $query, reverse: $reverse) {
+				edges {
+					node {
+						%s
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+				}
+			}
+		}
+	`, tenderTransactionFields)
+
+	first := opts.First
+	if first <= 0 {
+		first = 50
+	}
+	vars := map[string]interface{}{
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+	if query := opts.searchQuery(); query != "" {
+		vars["query"] = query
+	}
+
+	out := struct {
+		TenderTransactions struct {
+			Edges []struct {
+				Node   *model.TenderTransaction `json:"node"`
+				Cursor string                   `json:"cursor"`
+			} `json:"edges"`
+			PageInfo struct {
+				HasNextPage bool `json:"hasNextPage"`
+			} `json:"pageInfo"`
+		} `json:"tenderTransactions"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, "", fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	edges := out.TenderTransactions.Edges
+	res := make([]*model.TenderTransaction, 0, len(edges))
+	for _, edge := range edges {
+		res = append(res, edge.Node)
+	}
+	nextCursor := ""
+	if out.TenderTransactions.PageInfo.HasNextPage && len(edges) > 0 {
+		nextCursor = edges[len(edges)-1].Cursor
+	}
+
+	return res, nextCursor, nil
+}
+
+// ListAll exports all tender transactions matching opts through a bulk operation, for reports
+// spanning long periods.
+func (s *TenderTransactionServiceOp) ListAll(ctx context.Context, opts TenderTransactionListOptions) ([]*model.TenderTransaction, error) {
+	q := fmt.Sprintf(`
+		{
+			tenderTransactions(query: "$query", reverse: %t) {
+				edges {
+					node {
+						%s
+					}
+				}
+			}
+		}
+	`, opts.Reverse, tenderTransactionFields)
+
+	q = strings.ReplaceAll(q, "$query", opts.searchQuery())
+
+	res := []*model.TenderTransaction{}
+	err := s.client.BulkOperation.BulkQuery(ctx, q, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// TenderTotal is the sum of the tender transactions of one payment method in one currency.
+type TenderTotal struct {
+	PaymentMethod string
+	CurrencyCode  model.CurrencyCode
+	Amount        decimal.Decimal
+	Count         int
+}
+
+// SumTenderTransactions totals transactions by payment method and currency, sorted by payment
+// method then currency. Refunds have negative amounts and are netted against payments.
+func SumTenderTransactions(transactions []*model.TenderTransaction) []TenderTotal {
+	type key struct {
+		paymentMethod string
+		currencyCode  model.CurrencyCode
+	}
+	totals := map[key]*TenderTotal{}
+	for _, t := range transactions {
+		if t == nil || t.Amount == nil {
+			continue
+		}
+		k := key{currencyCode: t.Amount.CurrencyCode}
+		if t.PaymentMethod != nil {
+			k.paymentMethod = *t.PaymentMethod
+		}
+		total, ok := totals[k]
+		if !ok {
+			total = &TenderTotal{PaymentMethod: k.paymentMethod, CurrencyCode: k.currencyCode}
+			totals[k] = total
+		}
+		total.Amount = total.Amount.Add(t.Amount.Amount)
+		total.Count++
+	}
+
+	res := make([]TenderTotal, 0, len(totals))
+	for _, total := range totals {
+		res = append(res, *total)
+	}
+	slices.SortFunc(res, func(a, b TenderTotal) int {
+		if c := strings.Compare(a.PaymentMethod, b.PaymentMethod); c != 0 {
+			return c
+		}
+		return strings.Compare(string(a.CurrencyCode), string(b.CurrencyCode))
+	})
+
+	return res
+}
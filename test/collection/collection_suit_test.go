@@ -1,13 +1,40 @@
 package collection_test
 
 import (
+	"net/http"
 	"testing"
 
+	"github.com/gempages/go-shopify-graphql/shopifytest/vcr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
 
+var (
+	recorder  *vcr.Recorder
+	transport http.RoundTripper
+)
+
 func TestCollection(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "CollectionService Suite")
 }
+
+// The suite replays testdata/collection.json, so it runs without a shop. That golden file was
+// recorded against a stand-in shop serving the data the specs expect; run the suite with
+// SHOPIFY_VCR_MODE=record and the test shop credentials to record it again.
+var _ = BeforeSuite(func() {
+	var err error
+	recorder, err = vcr.New("testdata/collection.json", vcr.WithMode(vcr.ModeFromEnv()))
+	Expect(err).NotTo(HaveOccurred())
+
+	// bulk operation results are downloaded with the default client
+	transport = http.DefaultTransport
+	http.DefaultTransport = recorder.Middleware(transport)
+	DeferCleanup(func() {
+		http.DefaultTransport = transport
+	})
+})
+
+var _ = AfterSuite(func() {
+	Expect(recorder.Stop()).To(Succeed())
+})
@@ -8,6 +8,7 @@ import (
 
 	"github.com/gempages/go-helper/errors"
 	"github.com/gempages/go-shopify-graphql"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
 	shopifyGraph "github.com/gempages/go-shopify-graphql/graph"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -33,6 +34,8 @@ var _ = Describe("CollectionService", func() {
 		token = os.Getenv("SHOPIFY_API_TOKEN")
 		opts := []shopifyGraph.Option{
 			shopifyGraph.WithToken(token),
+			shopifyGraph.WithTransport(transport),
+			shopifyGraph.WithMiddleware(recorder.Middleware),
 		}
 		shopifyClient = shopify.NewClientWithOpts(domain, opts...)
 	})
@@ -122,4 +125,87 @@ var _ = Describe("CollectionService", func() {
 			})
 		})
 	})
+
+	Describe("GetAllProducts", func() {
+		When("ID does not exist", func() {
+			It("returns not found error", func() {
+				var notExistErr *errors.NotExistsError
+				products, err := shopifyClient.Collection.GetAllProducts(ctx, "gid://shopify/Collection/0000")
+				Expect(err).To(BeAssignableToTypeOf(notExistErr))
+				Expect(products).To(BeNil())
+			})
+		})
+
+		When("ID exists", func() {
+			It("returns every product of the collection", func() {
+				products, err := shopifyClient.Collection.GetAllProducts(ctx, TestSingleQueryCollectionID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(products)).To(Equal(TestCollectionProductCount))
+				for i := range products {
+					Expect(products[i].ID).NotTo(BeEmpty())
+					Expect(products[i].Title).NotTo(BeEmpty())
+					Expect(products[i].Handle).NotTo(BeEmpty())
+				}
+			})
+		})
+	})
+
+	Describe("CollectionRuleSetQuery", func() {
+		When("rules are applied conjunctively", func() {
+			It("joins the terms with AND", func() {
+				query, err := shopify.CollectionRuleSetQuery(model.CollectionRuleSetInput{
+					AppliedDisjunctively: false,
+					Rules: []model.CollectionRuleInput{
+						{Column: model.CollectionRuleColumnTag, Relation: model.CollectionRuleRelationEquals, Condition: "summer"},
+						{Column: model.CollectionRuleColumnTitle, Relation: model.CollectionRuleRelationStartsWith, Condition: "summer sale"},
+						{Column: model.CollectionRuleColumnVariantInventory, Relation: model.CollectionRuleRelationGreaterThan, Condition: "0"},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(query).To(Equal(`tag:"summer" AND title:"summer sale"* AND inventory_total:>0`))
+			})
+		})
+
+		When("rules are applied disjunctively", func() {
+			It("joins the terms with OR", func() {
+				query, err := shopify.CollectionRuleSetQuery(model.CollectionRuleSetInput{
+					AppliedDisjunctively: true,
+					Rules: []model.CollectionRuleInput{
+						{Column: model.CollectionRuleColumnVendor, Relation: model.CollectionRuleRelationNotEquals, Condition: "Acme"},
+						{Column: model.CollectionRuleColumnType, Relation: model.CollectionRuleRelationStartsWith, Condition: "Sho"},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(query).To(Equal(`-vendor:"Acme" OR product_type:Sho*`))
+			})
+		})
+
+		When("a rule matches inside or at the end of a value", func() {
+			It("returns an error", func() {
+				for _, relation := range []model.CollectionRuleRelation{
+					model.CollectionRuleRelationContains,
+					model.CollectionRuleRelationNotContains,
+					model.CollectionRuleRelationEndsWith,
+				} {
+					_, err := shopify.CollectionRuleSetQuery(model.CollectionRuleSetInput{
+						Rules: []model.CollectionRuleInput{
+							{Column: model.CollectionRuleColumnTitle, Relation: relation, Condition: "shirt"},
+						},
+					})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+
+		When("a rule column has no search field", func() {
+			It("returns an error", func() {
+				_, err := shopify.CollectionRuleSetQuery(model.CollectionRuleSetInput{
+					Rules: []model.CollectionRuleInput{
+						{Column: model.CollectionRuleColumnIsPriceReduced, Relation: model.CollectionRuleRelationIsSet, Condition: ""},
+					},
+				})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
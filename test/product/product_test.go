@@ -8,6 +8,7 @@ import (
 
 	"github.com/gempages/go-helper/errors"
 	"github.com/gempages/go-shopify-graphql"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
 	shopifyGraph "github.com/gempages/go-shopify-graphql/graph"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -190,6 +191,27 @@ var _ = Describe("ProductService", func() {
 			})
 		})
 	})
+
+	Describe("Count", func() {
+		When("no query is provided", func() {
+			It("returns the total number of products", func() {
+				count, err := shopifyClient.Product.Count(ctx, "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count).NotTo(BeNil())
+				Expect(count.Count).To(Equal(TotalProductCount))
+				Expect(count.Precision).To(Equal(model.CountPrecisionExact))
+			})
+		})
+
+		When("ID query is provided", func() {
+			It("returns the number of matching products", func() {
+				count, err := shopifyClient.Product.Count(ctx, "id:8427241144634")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(count).NotTo(BeNil())
+				Expect(count.Count).To(Equal(1))
+			})
+		})
+	})
 })
 
 var mediaQuery = `media(first: 10) {
@@ -0,0 +1,13 @@
+package shopifytest_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestShopifytest(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "shopifytest Server Suite")
+}
@@ -0,0 +1,95 @@
+package shopifytest_test
+
+import (
+	"context"
+
+	"github.com/gempages/go-shopify-graphql"
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+	"github.com/gempages/go-shopify-graphql/shopifytest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	TestProductID    = "gid://shopify/Product/1"
+	TestCollectionID = "gid://shopify/Collection/1"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		ctx           context.Context
+		server        *shopifytest.Server
+		shopifyClient *shopify.Client
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		server = shopifytest.NewServer()
+		DeferCleanup(server.Close)
+
+		server.AddProducts(
+			&model.Product{ID: TestProductID, Title: "Snowboard", Handle: "snowboard", Status: model.ProductStatusActive},
+			&model.Product{ID: "gid://shopify/Product/2", Title: "Ski wax", Handle: "ski-wax", Status: model.ProductStatusDraft},
+		)
+		server.AddCollections(&model.Collection{ID: TestCollectionID, Title: "Winter", Handle: "winter"})
+		shopifyClient = server.Client()
+	})
+
+	Describe("Product", func() {
+		It("gets a product by ID", func() {
+			product, err := shopifyClient.Product.Get(ctx, TestProductID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(product.ID).To(Equal(TestProductID))
+			Expect(product.Title).To(Equal("Snowboard"))
+			Expect(product.Handle).To(Equal("snowboard"))
+		})
+
+		It("lists all products with a bulk query", func() {
+			products, err := shopifyClient.Product.List(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(products).To(HaveLen(2))
+		})
+
+		It("filters products by search query", func() {
+			products, err := shopifyClient.Product.List(ctx, shopify.WithQuery("status:active"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(products).To(HaveLen(1))
+			Expect(products[0].ID).To(Equal(TestProductID))
+		})
+	})
+
+	Describe("Collection", func() {
+		It("gets a collection by ID", func() {
+			collection, err := shopifyClient.Collection.Get(ctx, TestCollectionID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(collection.Title).To(Equal("Winter"))
+		})
+	})
+
+	Describe("Metafield", func() {
+		It("sets, lists and deletes metafields", func() {
+			namespace, typ := "custom", "single_line_text_field"
+			metafields, err := shopifyClient.Metafield.CreateBulk(ctx, []model.MetafieldsSetInput{
+				{OwnerID: TestProductID, Namespace: &namespace, Key: "color", Value: "blue", Type: &typ},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metafields).To(HaveLen(1))
+			Expect(metafields[0].Value).To(Equal("blue"))
+
+			results, err := shopifyClient.Metafield.ListAllByOwner(ctx, TestProductID, namespace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(results).To(HaveLen(1))
+
+			err = shopifyClient.Metafield.Delete(ctx, model.MetafieldDeleteInput{ID: metafields[0].ID})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.Node(metafields[0].ID)).To(BeNil())
+		})
+	})
+
+	It("records the requests it receives", func() {
+		_, err := shopifyClient.Product.Get(ctx, TestProductID)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(server.Requests()).NotTo(BeEmpty())
+		Expect(server.Requests()[0].Variables).To(HaveKeyWithValue("id", TestProductID))
+	})
+})
@@ -0,0 +1,546 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gempages/go-helper/errors"
+)
+
+// themeFilesBatchSize is the maximum number of files accepted by a single themeFilesUpsert.
+const themeFilesBatchSize = 50
+
+// ThemeService manages Online Store themes and their files through the GraphQL theme APIs
+// (2024-10+), which replace the REST Asset API. Writing theme files requires the
+// write_themes scope and an exemption from Shopify for apps in the App Store.
+type ThemeService interface {
+	List(ctx context.Context, roles ...ThemeRole) ([]*Theme, error)
+	Get(ctx context.Context, id string) (*Theme, error)
+	Create(ctx context.Context, sourceURL, name string, role ThemeRole) (*Theme, error)
+	Publish(ctx context.Context, id string) (*Theme, error)
+	Delete(ctx context.Context, id string) (string, error)
+
+	ListFiles(ctx context.Context, themeID string, filenames []string, opts ListOptions) ([]*ThemeFile, string, error)
+	UpsertFiles(ctx context.Context, themeID string, files []ThemeFileInput) ([]string, error)
+	CopyFiles(ctx context.Context, themeID string, files []ThemeFileCopyInput) ([]string, error)
+	DeleteFiles(ctx context.Context, themeID string, filenames []string) ([]string, error)
+}
+
+type ThemeServiceOp struct {
+	client *Client
+}
+
+var _ ThemeService = &ThemeServiceOp{}
+
+type ThemeRole string
+
+const (
+	ThemeRoleMain        ThemeRole = "MAIN"
+	ThemeRoleUnpublished ThemeRole = "UNPUBLISHED"
+	ThemeRoleDemo        ThemeRole = "DEMO"
+	ThemeRoleDevelopment ThemeRole = "DEVELOPMENT"
+	ThemeRoleArchived    ThemeRole = "ARCHIVED"
+	ThemeRoleLocked      ThemeRole = "LOCKED"
+)
+
+type Theme struct {
+	ID   string    `json:"id"`
+	Name string    `json:"name"`
+	Role ThemeRole `json:"role"`
+	// Processing is true while a theme created from a ZIP file is being extracted; its files can't
+	// be read or written until it is done.
+	Processing       bool      `json:"processing"`
+	ProcessingFailed bool      `json:"processingFailed"`
+	ThemeStoreID     *int      `json:"themeStoreId,omitempty"`
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+}
+
+type ThemeFile struct {
+	Filename    string        `json:"filename"`
+	Size        int64         `json:"size,string"`
+	ChecksumMd5 *string       `json:"checksumMd5,omitempty"`
+	ContentType string        `json:"contentType"`
+	Body        ThemeFileBody `json:"body"`
+	CreatedAt   time.Time     `json:"createdAt"`
+	UpdatedAt   time.Time     `json:"updatedAt"`
+}
+
+// ThemeFileBody is the content of a theme file: Content for text files, ContentBase64 for binary
+// files, or URL when the file is too large to be returned inline.
+type ThemeFileBody struct {
+	Typename      string `json:"__typename"`
+	Content       string `json:"content,omitempty"`
+	ContentBase64 string `json:"contentBase64,omitempty"`
+	URL           string `json:"url,omitempty"`
+}
+
+type ThemeFileBodyType string
+
+const (
+	ThemeFileBodyTypeText   ThemeFileBodyType = "TEXT"
+	ThemeFileBodyTypeBase64 ThemeFileBodyType = "BASE64"
+	ThemeFileBodyTypeURL    ThemeFileBodyType = "URL"
+)
+
+type ThemeFileInput struct {
+	Filename string             `json:"filename"`
+	Body     ThemeFileBodyInput `json:"body"`
+}
+
+type ThemeFileBodyInput struct {
+	Type  ThemeFileBodyType `json:"type"`
+	Value string            `json:"value"`
+}
+
+// TextThemeFile returns the input to write a text file, such as a Liquid template or JSON section.
+func TextThemeFile(filename, content string) ThemeFileInput {
+	return ThemeFileInput{
+		Filename: filename,
+		Body:     ThemeFileBodyInput{Type: ThemeFileBodyTypeText, Value: content},
+	}
+}
+
+type ThemeFileCopyInput struct {
+	SrcFilename string `json:"srcFilename"`
+	DstFilename string `json:"dstFilename"`
+}
+
+// ThemeUserError is a user error of the theme mutations. Filename is set for errors about a
+// single file of a batch.
+type ThemeUserError struct {
+	Code     *string  `json:"code"`
+	Field    []string `json:"field"`
+	Filename *string  `json:"filename,omitempty"`
+	Message  string   `json:"message"`
+}
+
+const themeFields = `
+	id
+	name
+	role
+	processing
+	processingFailed
+	themeStoreId
+	createdAt
+	updatedAt
+`
+
+const themeFileFields = `
+	filename
+	size
+	checksumMd5
+	contentType
+	createdAt
+	updatedAt
+	body {
+		__typename
+		... on OnlineStoreThemeFileBodyText {
+			content
+		}
+		... on OnlineStoreThemeFileBodyBase64 {
+			contentBase64
+		}
+		... on OnlineStoreThemeFileBodyUrl {
+			url
+		}
+	}
+`
+
+// List returns the themes of the shop, optionally only those with one of roles. A shop has at
+// most 20 themes, so they are returned in a single page.
+func (s *ThemeServiceOp) List(ctx context.Context, roles ...ThemeRole) ([]*Theme, error) {
+	q := fmt.Sprintf(`
+		query themes($roles: [ThemeRole!]) {
+			themes(first: 50, roles: $roles) {
+				nodes {
+					%s
+				}
+			}
+		}
+	`, themeFields)
+
+	vars := map[string]interface{}{}
+	if len(roles) > 0 {
+		vars["roles"] = roles
+	}
+	out := struct {
+		Themes struct {
+			Nodes []*Theme `json:"nodes"`
+		} `json:"themes"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	return out.Themes.Nodes, nil
+}
+
+func (s *ThemeServiceOp) Get(ctx context.Context, id string) (*Theme, error) {
+	q := fmt.Sprintf(`
+		query theme($id: ID!) {
+			theme(id: $id) {
+				%s
+			}
+		}
+	`, themeFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		Theme *Theme `json:"theme"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.Theme == nil {
+		return nil, errors.NewNotExistsError(errors.ErrorResourceNotFound, "theme not found", nil)
+	}
+
+	return out.Theme, nil
+}
+
+// Create creates a theme from the ZIP file at sourceURL, typically a staged upload. The theme is
+// processed in the background; poll Get until Processing is false before using its files.
+func (s *ThemeServiceOp) Create(ctx context.Context, sourceURL, name string, role ThemeRole) (*Theme, error) {
+	m := fmt.Sprintf(`
+		mutation themeCreate($source: URL!, $name: String, $role: ThemeRole) {
+			themeCreate(source: $source, name: $name, role: $role) {
+				theme {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, themeFields)
+
+	vars := map[string]interface{}{
+		"source": sourceURL,
+	}
+	if name != "" {
+		vars["name"] = name
+	}
+	if role != "" {
+		vars["role"] = role
+	}
+	out := struct {
+		ThemeCreate struct {
+			Theme      *Theme           `json:"theme"`
+			UserErrors []ThemeUserError `json:"userErrors"`
+		} `json:"themeCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.ThemeCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.ThemeCreate.UserErrors)
+	}
+
+	return out.ThemeCreate.Theme, nil
+}
+
+// Publish makes the theme the live theme of the shop.
+func (s *ThemeServiceOp) Publish(ctx context.Context, id string) (*Theme, error) {
+	m := fmt.Sprintf(`
+		mutation themePublish($id: ID!) {
+			themePublish(id: $id) {
+				theme {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, themeFields)
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		ThemePublish struct {
+			Theme      *Theme           `json:"theme"`
+			UserErrors []ThemeUserError `json:"userErrors"`
+		} `json:"themePublish"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.ThemePublish.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.ThemePublish.UserErrors)
+	}
+
+	return out.ThemePublish.Theme, nil
+}
+
+func (s *ThemeServiceOp) Delete(ctx context.Context, id string) (string, error) {
+	m := `
+		mutation themeDelete($id: ID!) {
+			themeDelete(id: $id) {
+				deletedThemeId
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		ThemeDelete struct {
+			DeletedThemeID *string          `json:"deletedThemeId"`
+			UserErrors     []ThemeUserError `json:"userErrors"`
+		} `json:"themeDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return "", fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.ThemeDelete.UserErrors) > 0 {
+		return "", fmt.Errorf("%+v", out.ThemeDelete.UserErrors)
+	}
+	if out.ThemeDelete.DeletedThemeID == nil {
+		return "", nil
+	}
+
+	return *out.ThemeDelete.DeletedThemeID, nil
+}
+
+// ListFiles returns a page of the files of a theme with their content. filenames restricts the
+// result to the given names and accepts wildcards such as "sections/*.liquid"; all files are
+// returned when it is empty.
+func (s *ThemeServiceOp) ListFiles(ctx context.Context, themeID string, filenames []string, opts ListOptions) ([]*ThemeFile, string, error) {
+	q := fmt.Sprintf(`
+		query themeFiles($id: ID!, $filenames: [String!], $first: Int!, $after: String) {
+			theme(id: $id) {
+				files(filenames: $filenames, first: $first, after: $after) {
+					edges {
+						node {
+							%s
+						}
+						cursor
+					}
+					pageInfo {
+						hasNextPage
+					}
+				}
+			}
+		}
+	`, themeFileFields)
+
+	first := opts.First
+	if first <= 0 {
+		first = 50
+	}
+	vars := map[string]interface{}{
+		"id":    themeID,
+		"first": first,
+	}
+	if len(filenames) > 0 {
+		vars["filenames"] = filenames
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+
+	out := struct {
+		Theme *struct {
+			Files struct {
+				Edges []struct {
+					Node   *ThemeFile `json:"node"`
+					Cursor string     `json:"cursor"`
+				} `json:"edges"`
+				PageInfo struct {
+					HasNextPage bool `json:"hasNextPage"`
+				} `json:"pageInfo"`
+			} `json:"files"`
+		} `json:"theme"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, "", fmt.Errorf("gql.QueryString: %w", err)
+	}
+	if out.Theme == nil {
+		return nil, "", errors.NewNotExistsError(errors.ErrorResourceNotFound, "theme not found", nil)
+	}
+
+	edges := out.Theme.Files.Edges
+	res := make([]*ThemeFile, 0, len(edges))
+	for _, edge := range edges {
+		res = append(res, edge.Node)
+	}
+	nextCursor := ""
+	if out.Theme.Files.PageInfo.HasNextPage && len(edges) > 0 {
+		nextCursor = edges[len(edges)-1].Cursor
+	}
+
+	return res, nextCursor, nil
+}
+
+// UpsertFiles creates or overwrites files of a theme, sending them in batches of 50. It returns the
+// names of the written files. On error, the names written by the previous batches are returned
+// along with it.
+func (s *ThemeServiceOp) UpsertFiles(ctx context.Context, themeID string, files []ThemeFileInput) ([]string, error) {
+	written := make([]string, 0, len(files))
+	for start := 0; start < len(files); start += themeFilesBatchSize {
+		end := min(start+themeFilesBatchSize, len(files))
+		batch, err := s.upsertFiles(ctx, themeID, files[start:end])
+		if err != nil {
+			return written, err
+		}
+		written = append(written, batch...)
+	}
+	return written, nil
+}
+
+func (s *ThemeServiceOp) upsertFiles(ctx context.Context, themeID string, files []ThemeFileInput) ([]string, error) {
+	m := `
+		mutation themeFilesUpsert($themeId: ID!, $files: [OnlineStoreThemeFilesUpsertFileInput!]!) {
+			themeFilesUpsert(themeId: $themeId, files: $files) {
+				upsertedThemeFiles {
+					filename
+				}
+				userErrors {
+					code
+					field
+					filename
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"themeId": themeID,
+		"files":   files,
+	}
+	out := struct {
+		ThemeFilesUpsert struct {
+			UpsertedThemeFiles []struct {
+				Filename string `json:"filename"`
+			} `json:"upsertedThemeFiles"`
+			UserErrors []ThemeUserError `json:"userErrors"`
+		} `json:"themeFilesUpsert"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.ThemeFilesUpsert.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.ThemeFilesUpsert.UserErrors)
+	}
+
+	filenames := make([]string, 0, len(out.ThemeFilesUpsert.UpsertedThemeFiles))
+	for _, file := range out.ThemeFilesUpsert.UpsertedThemeFiles {
+		filenames = append(filenames, file.Filename)
+	}
+	return filenames, nil
+}
+
+// CopyFiles copies files within a theme, overwriting the destination files. It returns the names of
+// the destination files.
+func (s *ThemeServiceOp) CopyFiles(ctx context.Context, themeID string, files []ThemeFileCopyInput) ([]string, error) {
+	m := `
+		mutation themeFilesCopy($themeId: ID!, $files: [ThemeFilesCopyFileInput!]!) {
+			themeFilesCopy(themeId: $themeId, files: $files) {
+				copiedThemeFiles {
+					filename
+				}
+				userErrors {
+					code
+					field
+					filename
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"themeId": themeID,
+		"files":   files,
+	}
+	out := struct {
+		ThemeFilesCopy struct {
+			CopiedThemeFiles []struct {
+				Filename string `json:"filename"`
+			} `json:"copiedThemeFiles"`
+			UserErrors []ThemeUserError `json:"userErrors"`
+		} `json:"themeFilesCopy"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.ThemeFilesCopy.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.ThemeFilesCopy.UserErrors)
+	}
+
+	filenames := make([]string, 0, len(out.ThemeFilesCopy.CopiedThemeFiles))
+	for _, file := range out.ThemeFilesCopy.CopiedThemeFiles {
+		filenames = append(filenames, file.Filename)
+	}
+	return filenames, nil
+}
+
+// DeleteFiles deletes files of a theme and returns the names of the deleted files. Files required
+// by the theme, such as layout/theme.liquid, can't be deleted.
+func (s *ThemeServiceOp) DeleteFiles(ctx context.Context, themeID string, filenames []string) ([]string, error) {
+	m := `
+		mutation themeFilesDelete($themeId: ID!, $files: [String!]!) {
+			themeFilesDelete(themeId: $themeId, files: $files) {
+				deletedThemeFiles {
+					filename
+				}
+				userErrors {
+					code
+					field
+					filename
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"themeId": themeID,
+		"files":   filenames,
+	}
+	out := struct {
+		ThemeFilesDelete struct {
+			DeletedThemeFiles []struct {
+				Filename string `json:"filename"`
+			} `json:"deletedThemeFiles"`
+			UserErrors []ThemeUserError `json:"userErrors"`
+		} `json:"themeFilesDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.ThemeFilesDelete.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.ThemeFilesDelete.UserErrors)
+	}
+
+	deleted := make([]string, 0, len(out.ThemeFilesDelete.DeletedThemeFiles))
+	for _, file := range out.ThemeFilesDelete.DeletedThemeFiles {
+		deleted = append(deleted, file.Filename)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,208 @@
+package shopify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+// ValidationService manages checkout validations, which run a cart and checkout validation
+// function to block checkout with an error message, e.g. when a quantity limit is exceeded.
+type ValidationService interface {
+	List(ctx context.Context, opts ListOptions) ([]*model.Validation, string, error)
+	Create(ctx context.Context, input model.ValidationCreateInput) (*model.Validation, error)
+	Update(ctx context.Context, id string, input model.ValidationUpdateInput) (*model.Validation, error)
+	SetBlockOnFailure(ctx context.Context, id string, blockOnFailure bool) (*model.Validation, error)
+	Delete(ctx context.Context, id string) (string, error)
+}
+
+type ValidationServiceOp struct {
+	client *Client
+}
+
+var _ ValidationService = &ValidationServiceOp{}
+
+const validationFields = `
+	id
+	title
+	enabled
+	blockOnFailure
+	shopifyFunction {
+		id
+		title
+		apiType
+	}
+`
+
+// List returns a page of the validations of the shop. opts.Query is not supported by the API.
+func (s *ValidationServiceOp) List(ctx context.Context, opts ListOptions) ([]*model.Validation, string, error) {
+	q := fmt.Sprintf(`
+		query validations($first: Int!, $after: String, $reverse: Boolean) {
+			validations(first: $first, after: $after, reverse: $reverse) {
+				edges {
+					node {
+						%s
+					}
+					cursor
+				}
+				pageInfo {
+					hasNextPage
+				}
+			}
+		}
+	`, validationFields)
+
+	first := opts.First
+	if first <= 0 {
+		first = 50
+	}
+	vars := map[string]interface{}{
+		"first":   first,
+		"reverse": opts.Reverse,
+	}
+	if opts.After != "" {
+		vars["after"] = opts.After
+	}
+
+	out := struct {
+		Validations struct {
+			Edges []struct {
+				Node   *model.Validation `json:"node"`
+				Cursor string            `json:"cursor"`
+			} `json:"edges"`
+			PageInfo struct {
+				HasNextPage bool `json:"hasNextPage"`
+			} `json:"pageInfo"`
+		} `json:"validations"`
+	}{}
+	err := s.client.gql.QueryString(ctx, q, vars, &out)
+	if err != nil {
+		return nil, "", fmt.Errorf("gql.QueryString: %w", err)
+	}
+
+	edges := out.Validations.Edges
+	res := make([]*model.Validation, 0, len(edges))
+	for _, edge := range edges {
+		res = append(res, edge.Node)
+	}
+	nextCursor := ""
+	if out.Validations.PageInfo.HasNextPage && len(edges) > 0 {
+		nextCursor = edges[len(edges)-1].Cursor
+	}
+
+	return res, nextCursor, nil
+}
+
+// Create creates a validation for the function input.FunctionID. Set input.Enable to run it at
+// checkout right away.
+func (s *ValidationServiceOp) Create(ctx context.Context, input model.ValidationCreateInput) (*model.Validation, error) {
+	m := fmt.Sprintf(`
+		mutation validationCreate($validation: ValidationCreateInput!) {
+			validationCreate(validation: $validation) {
+				validation {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, validationFields)
+
+	vars := map[string]interface{}{
+		"validation": input,
+	}
+	out := struct {
+		ValidationCreate model.ValidationCreatePayload `json:"validationCreate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.ValidationCreate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.ValidationCreate.UserErrors)
+	}
+
+	return out.ValidationCreate.Validation, nil
+}
+
+// Update updates a validation; fields left nil in input are not changed.
+func (s *ValidationServiceOp) Update(ctx context.Context, id string, input model.ValidationUpdateInput) (*model.Validation, error) {
+	m := fmt.Sprintf(`
+		mutation validationUpdate($id: ID!, $validation: ValidationUpdateInput!) {
+			validationUpdate(id: $id, validation: $validation) {
+				validation {
+					%s
+				}
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`, validationFields)
+
+	vars := map[string]interface{}{
+		"id":         id,
+		"validation": input,
+	}
+	out := struct {
+		ValidationUpdate struct {
+			Validation *model.Validation           `json:"validation"`
+			UserErrors []model.ValidationUserError `json:"userErrors"`
+		} `json:"validationUpdate"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.ValidationUpdate.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.ValidationUpdate.UserErrors)
+	}
+
+	return out.ValidationUpdate.Validation, nil
+}
+
+// SetBlockOnFailure sets the failure mode of a validation. When blockOnFailure is true, checkout
+// is blocked if the function errors or times out; otherwise the validation is skipped.
+func (s *ValidationServiceOp) SetBlockOnFailure(ctx context.Context, id string, blockOnFailure bool) (*model.Validation, error) {
+	return s.Update(ctx, id, model.ValidationUpdateInput{BlockOnFailure: &blockOnFailure})
+}
+
+func (s *ValidationServiceOp) Delete(ctx context.Context, id string) (string, error) {
+	m := `
+		mutation validationDelete($id: ID!) {
+			validationDelete(id: $id) {
+				deletedId
+				userErrors {
+					code
+					field
+					message
+				}
+			}
+		}
+	`
+
+	vars := map[string]interface{}{
+		"id": id,
+	}
+	out := struct {
+		ValidationDelete model.ValidationDeletePayload `json:"validationDelete"`
+	}{}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return "", fmt.Errorf("gql.MutateString: %w", err)
+	}
+	if len(out.ValidationDelete.UserErrors) > 0 {
+		return "", fmt.Errorf("%+v", out.ValidationDelete.UserErrors)
+	}
+	if out.ValidationDelete.DeletedID == nil {
+		return "", nil
+	}
+
+	return *out.ValidationDelete.DeletedID, nil
+}
@@ -1,14 +1,27 @@
 package shopify
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
 
 	"github.com/gempages/go-shopify-graphql-model/graph/model"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/gempages/go-shopify-graphql/rand"
+	"github.com/gempages/go-shopify-graphql/utils"
 )
 
 type VariantService interface {
 	Update(ctx context.Context, variant model.ProductVariantInput) error
+	AppendMedia(ctx context.Context, productID string, variantMedia []model.ProductVariantAppendMediaInput) ([]*model.ProductVariant, error)
+	DetachMedia(ctx context.Context, productID string, variantMedia []model.ProductVariantDetachMediaInput) ([]*model.ProductVariant, error)
+	BulkUpdatePrices(ctx context.Context, shopID string, updates []VariantPriceUpdate) ([]VariantPriceUpdateResult, error)
 }
 
 type VariantServiceOp struct {
@@ -25,6 +38,85 @@ type productVariantUpdateResult struct {
 	UserErrors []UserErrors
 }
 
+type productVariantMediaResult struct {
+	ProductVariants []*model.ProductVariant `json:"productVariants,omitempty"`
+	UserErrors      []UserErrors            `json:"userErrors,omitempty"`
+}
+
+type mutationProductVariantAppendMedia struct {
+	ProductVariantAppendMediaResult productVariantMediaResult `json:"productVariantAppendMedia"`
+}
+
+type mutationProductVariantDetachMedia struct {
+	ProductVariantDetachMediaResult productVariantMediaResult `json:"productVariantDetachMedia"`
+}
+
+// variantPriceBulkMutationThreshold is the number of products above which BulkUpdatePrices
+// switches from one productVariantsBulkUpdate call per product to a bulk mutation.
+const variantPriceBulkMutationThreshold = 100
+
+// errVariantPriceNoResult is the error of updates whose product has no line in the bulk mutation result.
+var errVariantPriceNoResult = fmt.Errorf("no result for the product in the bulk mutation result")
+
+type VariantPriceUpdate struct {
+	ProductID      string
+	VariantID      string
+	Price          string
+	CompareAtPrice *string
+}
+
+// VariantPriceUpdateResult reports the outcome of a single VariantPriceUpdate, Err is nil on success.
+type VariantPriceUpdateResult struct {
+	VariantID string
+	Err       error
+}
+
+type productVariantPriceInput struct {
+	ID             string  `json:"id"`
+	Price          string  `json:"price,omitempty"`
+	CompareAtPrice *string `json:"compareAtPrice,omitempty"`
+}
+
+type productVariantsBulkUpdateResult struct {
+	ProductVariants []*model.ProductVariant `json:"productVariants,omitempty"`
+	UserErrors      []UserErrors            `json:"userErrors,omitempty"`
+}
+
+const productVariantsBulkUpdatePrices = `
+mutation productVariantsBulkUpdate($productId: ID!, $variants: [ProductVariantsBulkInput!]!) {
+	productVariantsBulkUpdate(productId: $productId, variants: $variants) {
+		productVariants {
+			id
+			price
+			compareAtPrice
+		}
+		userErrors {
+			field
+			message
+		}
+	}
+}
+`
+
+const productVariantMediaSelects = `
+productVariants {
+	id
+	media(first: 10) {
+		edges {
+			node {
+				id
+				mediaContentType
+				status
+			}
+		}
+	}
+}
+userErrors {
+	field
+	message
+	code
+}`
+
 func (s *VariantServiceOp) Update(ctx context.Context, variant model.ProductVariantInput) error {
 	m := mutationProductVariantUpdate{}
 
@@ -42,3 +134,207 @@ func (s *VariantServiceOp) Update(ctx context.Context, variant model.ProductVari
 
 	return nil
 }
+
+// AppendMedia attaches existing product media to the given variants.
+func (s *VariantServiceOp) AppendMedia(ctx context.Context, productID string, variantMedia []model.ProductVariantAppendMediaInput) ([]*model.ProductVariant, error) {
+	m := fmt.Sprintf(`mutation productVariantAppendMedia($productId: ID!, $variantMedia: [ProductVariantAppendMediaInput!]!) {
+	productVariantAppendMedia(productId: $productId, variantMedia: $variantMedia) {
+		%s
+	}}`, productVariantMediaSelects)
+
+	out := mutationProductVariantAppendMedia{}
+	vars := map[string]interface{}{
+		"productId":    productID,
+		"variantMedia": variantMedia,
+	}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.ProductVariantAppendMediaResult.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.ProductVariantAppendMediaResult.UserErrors)
+	}
+
+	return out.ProductVariantAppendMediaResult.ProductVariants, nil
+}
+
+// DetachMedia removes media from the given variants, the media stays on the product.
+func (s *VariantServiceOp) DetachMedia(ctx context.Context, productID string, variantMedia []model.ProductVariantDetachMediaInput) ([]*model.ProductVariant, error) {
+	m := fmt.Sprintf(`mutation productVariantDetachMedia($productId: ID!, $variantMedia: [ProductVariantDetachMediaInput!]!) {
+	productVariantDetachMedia(productId: $productId, variantMedia: $variantMedia) {
+		%s
+	}}`, productVariantMediaSelects)
+
+	out := mutationProductVariantDetachMedia{}
+	vars := map[string]interface{}{
+		"productId":    productID,
+		"variantMedia": variantMedia,
+	}
+	err := s.client.gql.MutateString(ctx, m, vars, &out)
+	if err != nil {
+		return nil, fmt.Errorf("gql.MutateString: %w", err)
+	}
+
+	if len(out.ProductVariantDetachMediaResult.UserErrors) > 0 {
+		return nil, fmt.Errorf("%+v", out.ProductVariantDetachMediaResult.UserErrors)
+	}
+
+	return out.ProductVariantDetachMediaResult.ProductVariants, nil
+}
+
+// BulkUpdatePrices updates variant prices grouped by product through productVariantsBulkUpdate.
+// Large catalogs are sent as a single bulk mutation instead. The results are in the same order as updates.
+func (s *VariantServiceOp) BulkUpdatePrices(ctx context.Context, shopID string, updates []VariantPriceUpdate) ([]VariantPriceUpdateResult, error) {
+	var (
+		productIDs []string
+		indexes    = make(map[string][]int)
+		results    = make([]VariantPriceUpdateResult, len(updates))
+	)
+	for i, u := range updates {
+		results[i].VariantID = u.VariantID
+		if _, ok := indexes[u.ProductID]; !ok {
+			productIDs = append(productIDs, u.ProductID)
+		}
+		indexes[u.ProductID] = append(indexes[u.ProductID], i)
+	}
+
+	if len(productIDs) > variantPriceBulkMutationThreshold {
+		log.Debugf("Shop %s: updating prices of %d products with a bulk mutation", shopID, len(productIDs))
+		err := s.bulkUpdatePricesWithBulkMutation(ctx, updates, productIDs, indexes, results)
+		if err != nil {
+			return nil, fmt.Errorf("bulk update prices with bulk mutation: %w", err)
+		}
+		return results, nil
+	}
+
+	for _, productID := range productIDs {
+		out := struct {
+			ProductVariantsBulkUpdate productVariantsBulkUpdateResult `json:"productVariantsBulkUpdate"`
+		}{}
+		vars := map[string]interface{}{
+			"productId": productID,
+			"variants":  variantPriceInputs(updates, indexes[productID]),
+		}
+		err := s.client.gql.MutateString(ctx, productVariantsBulkUpdatePrices, vars, &out)
+		if err != nil {
+			log.Warnf("Shop %s: couldn't update variant prices of product %s: %s", shopID, productID, err)
+			for _, i := range indexes[productID] {
+				results[i].Err = err
+			}
+			continue
+		}
+		mapVariantPriceUserErrors(out.ProductVariantsBulkUpdate.UserErrors, indexes[productID], results)
+	}
+
+	return results, nil
+}
+
+func (s *VariantServiceOp) bulkUpdatePricesWithBulkMutation(
+	ctx context.Context, updates []VariantPriceUpdate, productIDs []string, indexes map[string][]int, results []VariantPriceUpdateResult,
+) error {
+	variables := &bytes.Buffer{}
+	encoder := json.NewEncoder(variables)
+	for _, productID := range productIDs {
+		err := encoder.Encode(map[string]interface{}{
+			"productId": productID,
+			"variants":  variantPriceInputs(updates, indexes[productID]),
+		})
+		if err != nil {
+			return fmt.Errorf("encode variables: %w", err)
+		}
+	}
+
+	op, err := s.client.BulkOperation.BulkMutation(ctx, productVariantsBulkUpdatePrices, variables)
+	if err != nil {
+		return fmt.Errorf("bulk mutation: %w", err)
+	}
+	if op.ErrorCode != nil && op.ErrorCode.String() != "" {
+		return fmt.Errorf("bulk operation error: %s", op.ErrorCode)
+	}
+	if op.URL == nil || *op.URL == "" {
+		return fmt.Errorf("empty URL result")
+	}
+
+	// an update only succeeded once the result line of its product says so
+	for _, productID := range productIDs {
+		for _, i := range indexes[productID] {
+			results[i].Err = errVariantPriceNoResult
+		}
+	}
+
+	resultFile := filepath.Join(os.TempDir(), fmt.Sprintf("%s%s", rand.String(10), ".jsonl"))
+	// Clean up to avoid storage build up
+	defer os.Remove(resultFile)
+	err = utils.DownloadFile(ctx, resultFile, *op.URL)
+	if err != nil {
+		return fmt.Errorf("download file: %w", err)
+	}
+
+	f, err := os.Open(resultFile)
+	if err != nil {
+		return fmt.Errorf("open file: %w", err)
+	}
+	defer utils.CloseFile(f)
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		var line struct {
+			Data struct {
+				ProductVariantsBulkUpdate productVariantsBulkUpdateResult `json:"productVariantsBulkUpdate"`
+			} `json:"data"`
+			Errors     []UserErrors `json:"errors"`
+			LineNumber int          `json:"__lineNumber"`
+		}
+		err = json.Unmarshal(scanner.Bytes(), &line)
+		if err != nil {
+			return fmt.Errorf("unmarshalling: %w", err)
+		}
+		if line.LineNumber < 0 || line.LineNumber >= len(productIDs) {
+			continue
+		}
+
+		productIndexes := indexes[productIDs[line.LineNumber]]
+		for _, i := range productIndexes {
+			results[i].Err = nil
+		}
+		if len(line.Errors) > 0 {
+			for _, i := range productIndexes {
+				results[i].Err = fmt.Errorf("%+v", line.Errors)
+			}
+			continue
+		}
+		mapVariantPriceUserErrors(line.Data.ProductVariantsBulkUpdate.UserErrors, productIndexes, results)
+	}
+
+	return scanner.Err()
+}
+
+func variantPriceInputs(updates []VariantPriceUpdate, indexes []int) []productVariantPriceInput {
+	inputs := make([]productVariantPriceInput, 0, len(indexes))
+	for _, i := range indexes {
+		inputs = append(inputs, productVariantPriceInput{
+			ID:             updates[i].VariantID,
+			Price:          updates[i].Price,
+			CompareAtPrice: updates[i].CompareAtPrice,
+		})
+	}
+	return inputs
+}
+
+// mapVariantPriceUserErrors assigns user errors to the updates they belong to.
+// The error field looks like ["variants", "<index>", "price"], errors without an index apply to every update of the product.
+func mapVariantPriceUserErrors(userErrors []UserErrors, productIndexes []int, results []VariantPriceUpdateResult) {
+	for _, userErr := range userErrors {
+		if len(userErr.Field) >= 2 {
+			if i, err := strconv.Atoi(string(userErr.Field[1])); err == nil && i >= 0 && i < len(productIndexes) {
+				results[productIndexes[i]].Err = fmt.Errorf("%+v", userErr)
+				continue
+			}
+		}
+		for _, i := range productIndexes {
+			results[i].Err = fmt.Errorf("%+v", userErr)
+		}
+	}
+}
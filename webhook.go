@@ -3,6 +3,8 @@ package shopify
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/gempages/go-shopify-graphql-model/graph/model"
 )
@@ -13,6 +15,13 @@ type WebhookService interface {
 	ListWebhookSubscriptions(ctx context.Context, topics []model.WebhookSubscriptionTopic) (output []*model.WebhookSubscription, err error)
 	DeleteWebhook(ctx context.Context, webhookID string) (deletedID *string, err error)
 	UpdateWebhookSubscription(ctx context.Context, webhookID string, input model.WebhookSubscriptionInput) (output *model.WebhookSubscription, err error)
+	NewFilteredWebhookSubscription(ctx context.Context, topic model.WebhookSubscriptionTopic, input WebhookSubscriptionFilteredInput) (output *model.WebhookSubscription, err error)
+	UpdateFilteredWebhookSubscription(ctx context.Context, webhookID string, input WebhookSubscriptionFilteredInput) (output *model.WebhookSubscription, err error)
+	UpdateEventBridgeWebhookSubscription(ctx context.Context, webhookID string, input model.EventBridgeWebhookSubscriptionInput) (output *model.WebhookSubscription, err error)
+	NewPubSubWebhookSubscription(ctx context.Context, topic model.WebhookSubscriptionTopic, input model.PubSubWebhookSubscriptionInput) (output *model.WebhookSubscription, err error)
+	UpdatePubSubWebhookSubscription(ctx context.Context, webhookID string, input model.PubSubWebhookSubscriptionInput) (output *model.WebhookSubscription, err error)
+	ListWebhookSubscriptionsByEndpoint(ctx context.Context, topics []model.WebhookSubscriptionTopic, endpointType WebhookEndpointType) (output []*model.WebhookSubscription, err error)
+	ListWebhookSubscriptionsWithOptions(ctx context.Context, opts WebhookSubscriptionListOptions) (output []*model.WebhookSubscription, err error)
 }
 
 type WebhookServiceOp struct {
@@ -37,6 +46,27 @@ type mutationEventBridgeWebhookCreate struct {
 	EventBridgeWebhookCreateResult *model.EventBridgeWebhookSubscriptionCreatePayload `graphql:"eventBridgeWebhookSubscriptionCreate(topic: $topic, webhookSubscription: $webhookSubscription)" json:"eventBridgeWebhookSubscriptionCreate"`
 }
 
+type mutationEventBridgeWebhookUpdate struct {
+	EventBridgeWebhookUpdateResult *model.EventBridgeWebhookSubscriptionUpdatePayload `graphql:"eventBridgeWebhookSubscriptionUpdate(id: $id, webhookSubscription: $webhookSubscription)" json:"eventBridgeWebhookSubscriptionUpdate"`
+}
+
+type mutationPubSubWebhookCreate struct {
+	PubSubWebhookCreateResult *model.PubSubWebhookSubscriptionCreatePayload `graphql:"pubSubWebhookSubscriptionCreate(topic: $topic, webhookSubscription: $webhookSubscription)" json:"pubSubWebhookSubscriptionCreate"`
+}
+
+type mutationPubSubWebhookUpdate struct {
+	PubSubWebhookUpdateResult *model.PubSubWebhookSubscriptionUpdatePayload `graphql:"pubSubWebhookSubscriptionUpdate(id: $id, webhookSubscription: $webhookSubscription)" json:"pubSubWebhookSubscriptionUpdate"`
+}
+
+// WebhookEndpointType is the __typename of a webhook subscription endpoint.
+type WebhookEndpointType string
+
+const (
+	WebhookEndpointTypeHTTP        WebhookEndpointType = "WebhookHttpEndpoint"
+	WebhookEndpointTypeEventBridge WebhookEndpointType = "WebhookEventBridgeEndpoint"
+	WebhookEndpointTypePubSub      WebhookEndpointType = "WebhookPubSubEndpoint"
+)
+
 // NOTE: Have to use this because writeQuery function will not write structs that implements UnmarshalJSON function
 const webhookSubscriptionMutationSelects = `
 userErrors {
@@ -67,10 +97,26 @@ webhookSubscription {
 		...on WebhookHttpEndpoint {
 			callbackUrl
 		}
+		...on WebhookPubSubEndpoint {
+			pubSubProject
+			pubSubTopic
+		}
 	}
 }`
 
 func (w WebhookServiceOp) NewWebhookSubscription(ctx context.Context, topic model.WebhookSubscriptionTopic, input model.WebhookSubscriptionInput) (output *model.WebhookSubscription, err error) {
+	return w.createWebhookSubscription(ctx, topic, input)
+}
+
+// NewFilteredWebhookSubscription creates an HTTP webhook subscription that can be filtered server side.
+func (w WebhookServiceOp) NewFilteredWebhookSubscription(ctx context.Context, topic model.WebhookSubscriptionTopic, input WebhookSubscriptionFilteredInput) (output *model.WebhookSubscription, err error) {
+	return w.createWebhookSubscription(ctx, topic, input)
+}
+
+func (w WebhookServiceOp) createWebhookSubscription(ctx context.Context, topic model.WebhookSubscriptionTopic, input any) (output *model.WebhookSubscription, err error) {
+	if err = w.client.validateWebhookTopic(topic); err != nil {
+		return nil, err
+	}
 	m := fmt.Sprintf(`mutation($topic: WebhookSubscriptionTopic!, $webhookSubscription: WebhookSubscriptionInput!) {
 	webhookSubscriptionCreate(topic: $topic, webhookSubscription: $webhookSubscription) {
 		%s
@@ -94,6 +140,9 @@ func (w WebhookServiceOp) NewWebhookSubscription(ctx context.Context, topic mode
 }
 
 func (w WebhookServiceOp) NewEventBridgeWebhookSubscription(ctx context.Context, topic model.WebhookSubscriptionTopic, input model.EventBridgeWebhookSubscriptionInput) (output *model.WebhookSubscription, err error) {
+	if err = w.client.validateWebhookTopic(topic); err != nil {
+		return nil, err
+	}
 	m := fmt.Sprintf(`mutation($topic: WebhookSubscriptionTopic!, $webhookSubscription: EventBridgeWebhookSubscriptionInput!) {
 	eventBridgeWebhookSubscriptionCreate(topic: $topic, webhookSubscription: $webhookSubscription) {
 		%s
@@ -117,6 +166,82 @@ func (w WebhookServiceOp) NewEventBridgeWebhookSubscription(ctx context.Context,
 	return v.EventBridgeWebhookCreateResult.WebhookSubscription, nil
 }
 
+func (w WebhookServiceOp) UpdateEventBridgeWebhookSubscription(ctx context.Context, webhookID string, input model.EventBridgeWebhookSubscriptionInput) (output *model.WebhookSubscription, err error) {
+	m := fmt.Sprintf(`mutation($id: ID!, $webhookSubscription: EventBridgeWebhookSubscriptionInput!) {
+	eventBridgeWebhookSubscriptionUpdate(id: $id, webhookSubscription: $webhookSubscription) {
+		%s
+	}}`, webhookSubscriptionMutationSelects)
+	v := mutationEventBridgeWebhookUpdate{}
+	vars := map[string]interface{}{
+		"id":                  webhookID,
+		"webhookSubscription": input,
+	}
+
+	err = w.client.gql.MutateString(ctx, m, vars, &v)
+	if err != nil {
+		return
+	}
+
+	if len(v.EventBridgeWebhookUpdateResult.UserErrors) > 0 {
+		err = fmt.Errorf("%+v", v.EventBridgeWebhookUpdateResult.UserErrors)
+		return
+	}
+
+	return v.EventBridgeWebhookUpdateResult.WebhookSubscription, nil
+}
+
+func (w WebhookServiceOp) NewPubSubWebhookSubscription(ctx context.Context, topic model.WebhookSubscriptionTopic, input model.PubSubWebhookSubscriptionInput) (output *model.WebhookSubscription, err error) {
+	if err = w.client.validateWebhookTopic(topic); err != nil {
+		return nil, err
+	}
+	m := fmt.Sprintf(`mutation($topic: WebhookSubscriptionTopic!, $webhookSubscription: PubSubWebhookSubscriptionInput!) {
+	pubSubWebhookSubscriptionCreate(topic: $topic, webhookSubscription: $webhookSubscription) {
+		%s
+	}}`, webhookSubscriptionMutationSelects)
+	v := mutationPubSubWebhookCreate{}
+	vars := map[string]interface{}{
+		"topic":               topic,
+		"webhookSubscription": input,
+	}
+
+	err = w.client.gql.MutateString(ctx, m, vars, &v)
+	if err != nil {
+		return
+	}
+
+	if len(v.PubSubWebhookCreateResult.UserErrors) > 0 {
+		err = fmt.Errorf("%+v", v.PubSubWebhookCreateResult.UserErrors)
+		return
+	}
+
+	return v.PubSubWebhookCreateResult.WebhookSubscription, nil
+}
+
+func (w WebhookServiceOp) UpdatePubSubWebhookSubscription(ctx context.Context, webhookID string, input model.PubSubWebhookSubscriptionInput) (output *model.WebhookSubscription, err error) {
+	m := fmt.Sprintf(`mutation($id: ID!, $webhookSubscription: PubSubWebhookSubscriptionInput!) {
+	pubSubWebhookSubscriptionUpdate(id: $id, webhookSubscription: $webhookSubscription) {
+		%s
+	}}`, webhookSubscriptionMutationSelects)
+	v := mutationPubSubWebhookUpdate{}
+	vars := map[string]interface{}{
+		"id":                  webhookID,
+		"webhookSubscription": input,
+	}
+
+	err = w.client.gql.MutateString(ctx, m, vars, &v)
+	if err != nil {
+		return
+	}
+
+	if len(v.PubSubWebhookUpdateResult.UserErrors) > 0 {
+		err = fmt.Errorf("%+v", v.PubSubWebhookUpdateResult.UserErrors)
+		return
+	}
+
+	return v.PubSubWebhookUpdateResult.WebhookSubscription, nil
+}
+
+// DeleteWebhook deletes a webhook subscription regardless of its endpoint type (HTTP, EventBridge or Pub/Sub).
 func (w WebhookServiceOp) DeleteWebhook(ctx context.Context, webhookID string) (deletedID *string, err error) {
 	m := mutationWebhookDelete{}
 	vars := map[string]interface{}{
@@ -135,12 +260,58 @@ func (w WebhookServiceOp) DeleteWebhook(ctx context.Context, webhookID string) (
 }
 
 func (w WebhookServiceOp) ListWebhookSubscriptions(ctx context.Context, topics []model.WebhookSubscriptionTopic) (output []*model.WebhookSubscription, err error) {
-	queryFormat := `query webhookSubscriptions($first: Int!, $topics: [WebhookSubscriptionTopic!]%s) {
-		webhookSubscriptions(first: $first, topics: $topics%s) {
+	return w.ListWebhookSubscriptionsWithOptions(ctx, WebhookSubscriptionListOptions{Topics: topics})
+}
+
+// WebhookSubscriptionListOptions narrows ListWebhookSubscriptionsWithOptions. Zero values are ignored.
+type WebhookSubscriptionListOptions struct {
+	Topics []model.WebhookSubscriptionTopic
+	// CallbackURLContains keeps subscriptions whose callback URL contains this substring.
+	// Shopify only supports exact matches, so it is applied client side.
+	CallbackURLContains string
+	Format              *model.WebhookSubscriptionFormat
+	CreatedAfter        *time.Time
+	CreatedBefore       *time.Time
+	EndpointType        WebhookEndpointType
+}
+
+func (o WebhookSubscriptionListOptions) searchQuery() string {
+	var terms []string
+	if o.CreatedAfter != nil {
+		terms = append(terms, fmt.Sprintf("created_at:>='%s'", o.CreatedAfter.UTC().Format(time.RFC3339)))
+	}
+	if o.CreatedBefore != nil {
+		terms = append(terms, fmt.Sprintf("created_at:<='%s'", o.CreatedBefore.UTC().Format(time.RFC3339)))
+	}
+	return strings.Join(terms, " AND ")
+}
+
+func (o WebhookSubscriptionListOptions) matches(sub *model.WebhookSubscription) bool {
+	if o.CallbackURLContains != "" {
+		callbackURL := sub.CallbackURL
+		if endpoint, ok := sub.Endpoint.(*model.WebhookHTTPEndpoint); ok && endpoint != nil {
+			callbackURL = endpoint.CallbackURL
+		}
+		if !strings.Contains(callbackURL, o.CallbackURLContains) {
+			return false
+		}
+	}
+	if o.EndpointType != "" && webhookEndpointTypeOf(sub.Endpoint) != o.EndpointType {
+		return false
+	}
+	return true
+}
+
+// ListWebhookSubscriptionsWithOptions lists webhook subscriptions with their full endpoint details.
+// Topics, format and the createdAt range are filtered by Shopify; the rest client side.
+func (w WebhookServiceOp) ListWebhookSubscriptionsWithOptions(ctx context.Context, opts WebhookSubscriptionListOptions) (output []*model.WebhookSubscription, err error) {
+	query := `query webhookSubscriptions($first: Int!, $after: String, $topics: [WebhookSubscriptionTopic!], $format: WebhookSubscriptionFormat, $query: String) {
+		webhookSubscriptions(first: $first, after: $after, topics: $topics, format: $format, query: $query) {
 			edges {
 				cursor
 				node {
 					id
+					legacyResourceId
 					topic
 					apiVersion {
 						displayName
@@ -155,11 +326,15 @@ func (w WebhookServiceOp) ListWebhookSubscriptions(ctx context.Context, topics [
 						... on WebhookEventBridgeEndpoint{
 							arn
 						}
+						... on WebhookPubSubEndpoint {
+							pubSubProject
+							pubSubTopic
+						}
 					}
 					callbackUrl
 					format
-					topic
 					includeFields
+					metafieldNamespaces
 					createdAt
 					updatedAt
 				}
@@ -170,41 +345,64 @@ func (w WebhookServiceOp) ListWebhookSubscriptions(ctx context.Context, topics [
 		}
 	}`
 
-	var (
-		cursor string
-		vars   = map[string]interface{}{
-			"first":  200,
-			"topics": topics,
-		}
-	)
+	vars := map[string]interface{}{
+		"first": 200,
+	}
+	if len(opts.Topics) > 0 {
+		vars["topics"] = opts.Topics
+	}
+	if opts.Format != nil {
+		vars["format"] = *opts.Format
+	}
+	if q := opts.searchQuery(); q != "" {
+		vars["query"] = q
+	}
 	for {
-		var (
-			query string
-			out   model.QueryRoot
-		)
-		if cursor != "" {
-			vars["after"] = cursor
-			query = fmt.Sprintf(queryFormat, ", $after: String", ", after: $after")
-		} else {
-			query = fmt.Sprintf(queryFormat, "", "")
-		}
+		var out model.QueryRoot
 		err = w.client.gql.QueryString(ctx, query, vars, &out)
 		if err != nil {
 			return
 		}
 		for _, wh := range out.WebhookSubscriptions.Edges {
-			output = append(output, wh.Node)
+			if opts.matches(wh.Node) {
+				output = append(output, wh.Node)
+			}
 		}
-		if out.WebhookSubscriptions.PageInfo.HasNextPage {
-			cursor = out.WebhookSubscriptions.Edges[len(out.WebhookSubscriptions.Edges)-1].Cursor
-		} else {
+		if !out.WebhookSubscriptions.PageInfo.HasNextPage || len(out.WebhookSubscriptions.Edges) == 0 {
 			break
 		}
+		vars["after"] = out.WebhookSubscriptions.Edges[len(out.WebhookSubscriptions.Edges)-1].Cursor
 	}
 	return
 }
 
+// ListWebhookSubscriptionsByEndpoint lists subscriptions for topics whose endpoint is of endpointType.
+func (w WebhookServiceOp) ListWebhookSubscriptionsByEndpoint(ctx context.Context, topics []model.WebhookSubscriptionTopic, endpointType WebhookEndpointType) (output []*model.WebhookSubscription, err error) {
+	return w.ListWebhookSubscriptionsWithOptions(ctx, WebhookSubscriptionListOptions{Topics: topics, EndpointType: endpointType})
+}
+
+func webhookEndpointTypeOf(endpoint model.WebhookSubscriptionEndpoint) WebhookEndpointType {
+	switch endpoint.(type) {
+	case *model.WebhookHTTPEndpoint, model.WebhookHTTPEndpoint:
+		return WebhookEndpointTypeHTTP
+	case *model.WebhookEventBridgeEndpoint, model.WebhookEventBridgeEndpoint:
+		return WebhookEndpointTypeEventBridge
+	case *model.WebhookPubSubEndpoint, model.WebhookPubSubEndpoint:
+		return WebhookEndpointTypePubSub
+	}
+	return ""
+}
+
 func (w WebhookServiceOp) UpdateWebhookSubscription(ctx context.Context, webhookID string, input model.WebhookSubscriptionInput) (output *model.WebhookSubscription, err error) {
+	return w.updateWebhookSubscription(ctx, webhookID, input)
+}
+
+// UpdateFilteredWebhookSubscription updates an HTTP webhook subscription, including its filter.
+func (w WebhookServiceOp) UpdateFilteredWebhookSubscription(ctx context.Context, webhookID string, input WebhookSubscriptionFilteredInput) (output *model.WebhookSubscription, err error) {
+	return w.updateWebhookSubscription(ctx, webhookID, input)
+}
+
+func (w WebhookServiceOp) updateWebhookSubscription(ctx context.Context, webhookID string, input any) (output *model.WebhookSubscription, err error) {
 	m := fmt.Sprintf(`mutation webhookSubscriptionUpdate($id: ID!, $webhookSubscription: WebhookSubscriptionInput!) {
 	webhookSubscriptionUpdate(id: $id, webhookSubscription: $webhookSubscription) {
 		%s
@@ -226,3 +424,125 @@ func (w WebhookServiceOp) UpdateWebhookSubscription(ctx context.Context, webhook
 
 	return v.WebhookUpdateResult.WebhookSubscription, nil
 }
+
+// WebhookSubscriptionFilteredInput is model.WebhookSubscriptionInput plus the filter argument,
+// which the generated model predates. Build it with NewWebhookSubscriptionInput.
+type WebhookSubscriptionFilteredInput struct {
+	model.WebhookSubscriptionInput
+	// Filter uses Shopify search syntax; only events matching it are delivered. A nil Filter
+	// leaves the filter of a subscription unchanged on update, an empty one clears it.
+	Filter *string `json:"filter,omitempty"`
+}
+
+// WebhookSubscriptionInputBuilder builds a WebhookSubscriptionFilteredInput.
+type WebhookSubscriptionInputBuilder struct {
+	input WebhookSubscriptionFilteredInput
+}
+
+// NewWebhookSubscriptionInput starts a subscription input delivering to callbackURL.
+func NewWebhookSubscriptionInput(callbackURL string) *WebhookSubscriptionInputBuilder {
+	b := &WebhookSubscriptionInputBuilder{}
+	b.input.CallbackURL = &callbackURL
+	return b
+}
+
+func (b *WebhookSubscriptionInputBuilder) Format(format model.WebhookSubscriptionFormat) *WebhookSubscriptionInputBuilder {
+	b.input.Format = &format
+	return b
+}
+
+// IncludeFields limits the payload to the given top-level resource fields.
+func (b *WebhookSubscriptionInputBuilder) IncludeFields(fields ...string) *WebhookSubscriptionInputBuilder {
+	b.input.IncludeFields = append(b.input.IncludeFields, fields...)
+	return b
+}
+
+// MetafieldNamespaces adds metafields in the given namespaces to the payload.
+func (b *WebhookSubscriptionInputBuilder) MetafieldNamespaces(namespaces ...string) *WebhookSubscriptionInputBuilder {
+	b.input.MetafieldNamespaces = append(b.input.MetafieldNamespaces, namespaces...)
+	return b
+}
+
+// Filter sets the server-side filter. An empty or nil filter is sent as an empty string, which
+// clears the filter of the subscription on update.
+func (b *WebhookSubscriptionInputBuilder) Filter(filter *WebhookFilter) *WebhookSubscriptionInputBuilder {
+	q := filter.String()
+	b.input.Filter = &q
+	return b
+}
+
+func (b *WebhookSubscriptionInputBuilder) Build() WebhookSubscriptionFilteredInput {
+	return b.input
+}
+
+// WebhookFilter builds a webhook filter expression. Clauses are joined with AND,
+// e.g. NewWebhookFilter().Eq("vendor", "Acme").Gte("variants.price", "10.00").
+type WebhookFilter struct {
+	clauses []string
+}
+
+func NewWebhookFilter() *WebhookFilter {
+	return &WebhookFilter{}
+}
+
+func (f *WebhookFilter) Eq(field, value string) *WebhookFilter {
+	return f.compare(field, "", value)
+}
+
+func (f *WebhookFilter) Gt(field, value string) *WebhookFilter {
+	return f.compare(field, ">", value)
+}
+
+func (f *WebhookFilter) Gte(field, value string) *WebhookFilter {
+	return f.compare(field, ">=", value)
+}
+
+func (f *WebhookFilter) Lt(field, value string) *WebhookFilter {
+	return f.compare(field, "<", value)
+}
+
+func (f *WebhookFilter) Lte(field, value string) *WebhookFilter {
+	return f.compare(field, "<=", value)
+}
+
+// Not excludes events where field equals value.
+func (f *WebhookFilter) Not(field, value string) *WebhookFilter {
+	f.clauses = append(f.clauses, fmt.Sprintf("NOT %s:%s", field, quoteSearchValue(value)))
+	return f
+}
+
+// In matches events where field equals any of values.
+func (f *WebhookFilter) In(field string, values ...string) *WebhookFilter {
+	f.clauses = appendSearchTermGroup(f.clauses, field, values)
+	return f
+}
+
+// Any matches events satisfying at least one of filters.
+func (f *WebhookFilter) Any(filters ...*WebhookFilter) *WebhookFilter {
+	group := make([]string, 0, len(filters))
+	for _, sub := range filters {
+		if q := sub.String(); q != "" {
+			group = append(group, "("+q+")")
+		}
+	}
+	switch len(group) {
+	case 0:
+	case 1:
+		f.clauses = append(f.clauses, group[0])
+	default:
+		f.clauses = append(f.clauses, "("+strings.Join(group, " OR ")+")")
+	}
+	return f
+}
+
+func (f *WebhookFilter) compare(field, op, value string) *WebhookFilter {
+	f.clauses = append(f.clauses, fmt.Sprintf("%s:%s%s", field, op, quoteSearchValue(value)))
+	return f
+}
+
+func (f *WebhookFilter) String() string {
+	if f == nil {
+		return ""
+	}
+	return strings.Join(f.clauses, " AND ")
+}
@@ -0,0 +1,56 @@
+package shopify
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gempages/go-shopify-graphql-model/graph/model"
+)
+
+//go:generate go run ./internal/cmd/gentopics -out webhooktopic_gen.go 2024-07=internal/cmd/gentopics/schema/2024-07.graphql
+
+// WebhookTopicsForVersion returns the webhook topics supported by apiVersion.
+// Versions without a generated list, such as "unstable" or releases newer than
+// the model package, fall back to the latest known list.
+func WebhookTopicsForVersion(apiVersion string) []model.WebhookSubscriptionTopic {
+	if topics, ok := webhookTopicsByVersion[apiVersion]; ok {
+		return topics
+	}
+	return webhookTopicsByVersion[latestWebhookTopicVersion]
+}
+
+// IsValidTopic reports whether topic can be subscribed to on apiVersion.
+func IsValidTopic(apiVersion string, topic model.WebhookSubscriptionTopic) bool {
+	for _, t := range WebhookTopicsForVersion(apiVersion) {
+		if t == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTopic is IsValidTopic returning a descriptive error.
+func ValidateTopic(apiVersion string, topic model.WebhookSubscriptionTopic) error {
+	if IsValidTopic(apiVersion, topic) {
+		return nil
+	}
+	if apiVersion == "" {
+		apiVersion = latestWebhookTopicVersion
+	}
+	return fmt.Errorf("webhook topic %q is not supported by API version %s", topic, apiVersion)
+}
+
+// validateWebhookTopic validates topic against the API version set with
+// Client.SetWebhookTopicValidation, if any and if it has a generated list.
+func (c *Client) validateWebhookTopic(topic model.WebhookSubscriptionTopic) error {
+	if _, ok := webhookTopicsByVersion[c.webhookTopicVersion]; !ok {
+		return nil
+	}
+	return ValidateTopic(c.webhookTopicVersion, topic)
+}
+
+// WebhookTopicFromHeader converts a REST style topic, as sent in the X-Shopify-Topic header
+// (e.g. "products/update"), to its GraphQL enum value.
+func WebhookTopicFromHeader(topic string) model.WebhookSubscriptionTopic {
+	return model.WebhookSubscriptionTopic(strings.ToUpper(strings.ReplaceAll(topic, "/", "_")))
+}
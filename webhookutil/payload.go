@@ -0,0 +1,216 @@
+package webhookutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Topics, as sent in the X-Shopify-Topic header, that have a built-in payload type.
+const (
+	TopicProductsUpdate = "products/update"
+	TopicOrdersCreate   = "orders/create"
+	TopicAppUninstalled = "app/uninstalled"
+
+	TopicAppSubscriptionsUpdate                  = "app_subscriptions/update"
+	TopicAppPurchasesOneTimeUpdate               = "app_purchases_one_time/update"
+	TopicAppSubscriptionsApproachingCappedAmount = "app_subscriptions/approaching_capped_amount"
+)
+
+// ErrUnknownTopic is returned by ParsePayload when no payload type is registered for a topic.
+var ErrUnknownTopic = fmt.Errorf("webhookutil: unknown topic")
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]func() any{
+		TopicProductsUpdate: func() any { return &ProductPayload{} },
+		TopicOrdersCreate:   func() any { return &OrderPayload{} },
+		TopicAppUninstalled: func() any { return &ShopPayload{} },
+
+		TopicAppSubscriptionsUpdate:                  func() any { return &AppSubscriptionUpdatePayload{} },
+		TopicAppPurchasesOneTimeUpdate:               func() any { return &AppPurchaseOneTimeUpdatePayload{} },
+		TopicAppSubscriptionsApproachingCappedAmount: func() any { return &AppSubscriptionCappedAmountPayload{} },
+	}
+)
+
+// RegisterPayload associates topic with a constructor returning a pointer to the struct
+// its payload should be decoded into. Registering an existing topic replaces it.
+func RegisterPayload(topic string, newPayload func() any) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	registry[topic] = newPayload
+}
+
+// ParsePayload decodes body into the payload type registered for topic.
+func ParsePayload(topic string, body []byte) (any, error) {
+	registryMu.RLock()
+	newPayload, ok := registry[topic]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTopic, topic)
+	}
+
+	payload := newPayload()
+	if err := json.Unmarshal(body, payload); err != nil {
+		return nil, fmt.Errorf("json.Unmarshal %s: %w", topic, err)
+	}
+	return payload, nil
+}
+
+// ParseRequest decodes the body of r using the topic in its X-Shopify-Topic header.
+// It does not verify the HMAC; wrap the handler with Middleware for that.
+func ParseRequest(r *http.Request) (any, error) {
+	body, err := readBody(r)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePayload(r.Header.Get(HeaderTopic), body)
+}
+
+// ProductPayload is the body of products/create and products/update webhooks.
+type ProductPayload struct {
+	ID                int64                   `json:"id"`
+	AdminGraphqlAPIID string                  `json:"admin_graphql_api_id"`
+	Title             string                  `json:"title"`
+	BodyHTML          string                  `json:"body_html"`
+	Vendor            string                  `json:"vendor"`
+	ProductType       string                  `json:"product_type"`
+	Handle            string                  `json:"handle"`
+	Status            string                  `json:"status"`
+	Tags              string                  `json:"tags"`
+	CreatedAt         time.Time               `json:"created_at"`
+	UpdatedAt         time.Time               `json:"updated_at"`
+	PublishedAt       *time.Time              `json:"published_at"`
+	Variants          []ProductVariantPayload `json:"variants"`
+}
+
+type ProductVariantPayload struct {
+	ID                int64   `json:"id"`
+	AdminGraphqlAPIID string  `json:"admin_graphql_api_id"`
+	ProductID         int64   `json:"product_id"`
+	Title             string  `json:"title"`
+	Price             string  `json:"price"`
+	CompareAtPrice    *string `json:"compare_at_price"`
+	SKU               string  `json:"sku"`
+	Position          int     `json:"position"`
+	InventoryItemID   int64   `json:"inventory_item_id"`
+	InventoryQuantity int     `json:"inventory_quantity"`
+}
+
+// OrderPayload is the body of orders/create and orders/updated webhooks.
+type OrderPayload struct {
+	ID                int64              `json:"id"`
+	AdminGraphqlAPIID string             `json:"admin_graphql_api_id"`
+	Name              string             `json:"name"`
+	Email             string             `json:"email"`
+	Currency          string             `json:"currency"`
+	TotalPrice        string             `json:"total_price"`
+	SubtotalPrice     string             `json:"subtotal_price"`
+	TotalTax          string             `json:"total_tax"`
+	FinancialStatus   string             `json:"financial_status"`
+	FulfillmentStatus *string            `json:"fulfillment_status"`
+	Test              bool               `json:"test"`
+	CreatedAt         time.Time          `json:"created_at"`
+	UpdatedAt         time.Time          `json:"updated_at"`
+	LineItems         []LineItemPayload  `json:"line_items"`
+	Customer          *CustomerPayload   `json:"customer"`
+	NoteAttributes    []NoteAttribute    `json:"note_attributes"`
+	DiscountCodes     []DiscountCodeItem `json:"discount_codes"`
+}
+
+type LineItemPayload struct {
+	ID                int64  `json:"id"`
+	AdminGraphqlAPIID string `json:"admin_graphql_api_id"`
+	ProductID         *int64 `json:"product_id"`
+	VariantID         *int64 `json:"variant_id"`
+	Title             string `json:"title"`
+	Quantity          int    `json:"quantity"`
+	Price             string `json:"price"`
+	SKU               string `json:"sku"`
+}
+
+type CustomerPayload struct {
+	ID                int64  `json:"id"`
+	AdminGraphqlAPIID string `json:"admin_graphql_api_id"`
+	Email             string `json:"email"`
+	FirstName         string `json:"first_name"`
+	LastName          string `json:"last_name"`
+}
+
+type NoteAttribute struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type DiscountCodeItem struct {
+	Code   string `json:"code"`
+	Amount string `json:"amount"`
+	Type   string `json:"type"`
+}
+
+// ShopPayload is the body of app/uninstalled and shop/update webhooks.
+type ShopPayload struct {
+	ID              int64  `json:"id"`
+	Name            string `json:"name"`
+	Email           string `json:"email"`
+	Domain          string `json:"domain"`
+	MyshopifyDomain string `json:"myshopify_domain"`
+	PlanName        string `json:"plan_name"`
+	PlanDisplayName string `json:"plan_display_name"`
+	Currency        string `json:"currency"`
+	IanaTimezone    string `json:"iana_timezone"`
+}
+
+// AppSubscriptionUpdatePayload is the body of app_subscriptions/update webhooks.
+type AppSubscriptionUpdatePayload struct {
+	AppSubscription AppSubscriptionPayload `json:"app_subscription"`
+}
+
+// AppSubscriptionPayload is sent when a subscription changes status or capped amount.
+// Status holds AppSubscriptionStatus values such as ACTIVE, CANCELLED or FROZEN.
+type AppSubscriptionPayload struct {
+	AdminGraphqlAPIID     string      `json:"admin_graphql_api_id"`
+	AdminGraphqlAPIShopID string      `json:"admin_graphql_api_shop_id"`
+	Name                  string      `json:"name"`
+	Status                string      `json:"status"`
+	Currency              string      `json:"currency"`
+	Price                 json.Number `json:"price"`
+	CappedAmount          json.Number `json:"capped_amount"`
+	Interval              string      `json:"interval"`
+	PlanHandle            string      `json:"plan_handle"`
+	CreatedAt             time.Time   `json:"created_at"`
+	UpdatedAt             time.Time   `json:"updated_at"`
+}
+
+// AppPurchaseOneTimeUpdatePayload is the body of app_purchases_one_time/update webhooks.
+type AppPurchaseOneTimeUpdatePayload struct {
+	AppPurchaseOneTime AppPurchaseOneTimePayload `json:"app_purchase_one_time"`
+}
+
+type AppPurchaseOneTimePayload struct {
+	AdminGraphqlAPIID     string    `json:"admin_graphql_api_id"`
+	AdminGraphqlAPIShopID string    `json:"admin_graphql_api_shop_id"`
+	Name                  string    `json:"name"`
+	Status                string    `json:"status"`
+	CreatedAt             time.Time `json:"created_at"`
+	UpdatedAt             time.Time `json:"updated_at"`
+}
+
+// AppSubscriptionCappedAmountPayload is the body of app_subscriptions/approaching_capped_amount
+// webhooks, sent once usage charges reach 90% of the capped amount.
+type AppSubscriptionCappedAmountPayload struct {
+	AppSubscription AppSubscriptionUsagePayload `json:"app_subscription"`
+}
+
+type AppSubscriptionUsagePayload struct {
+	AdminGraphqlAPIID     string      `json:"admin_graphql_api_id"`
+	AdminGraphqlAPIShopID string      `json:"admin_graphql_api_shop_id"`
+	Name                  string      `json:"name"`
+	BalanceUsed           json.Number `json:"balance_used"`
+	CappedAmount          json.Number `json:"capped_amount"`
+	CurrencyCode          string      `json:"currency_code"`
+	CreatedAt             time.Time   `json:"created_at"`
+	UpdatedAt             time.Time   `json:"updated_at"`
+}
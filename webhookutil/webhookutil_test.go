@@ -0,0 +1,98 @@
+package webhookutil
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func sign(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestVerifyHMAC(t *testing.T) {
+	body := `{"id":1}`
+	tests := []struct {
+		name   string
+		secret string
+		header string
+		want   bool
+	}{
+		{"valid", "secret", sign("secret", body), true},
+		{"wrong secret", "other", sign("secret", body), false},
+		{"empty header", "secret", "", false},
+		{"empty secret", "", sign("", body), false},
+		{"not base64", "secret", "%%%", false},
+	}
+	for _, tc := range tests {
+		if got := VerifyHMAC(tc.secret, tc.header, []byte(body)); got != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	body := `{"id":1,"myshopify_domain":"x.myshopify.com"}`
+	var gotBody string
+	handler := Middleware("secret", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		gotBody = string(b)
+	}))
+
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(body))
+	req.Header.Set(HeaderHmacSHA256, sign("secret", body))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("got status %d, want %d", rec.Code, http.StatusOK)
+	}
+	if gotBody != body {
+		t.Errorf("next handler got body %q, want %q", gotBody, body)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(body))
+	req.Header.Set(HeaderHmacSHA256, sign("other", body))
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("got status %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestParsePayload(t *testing.T) {
+	got, err := ParsePayload(TopicOrdersCreate, []byte(`{"id":42,"name":"#1001","line_items":[{"id":7,"quantity":2}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	order, ok := got.(*OrderPayload)
+	if !ok {
+		t.Fatalf("got %T, want *OrderPayload", got)
+	}
+	if order.ID != 42 || order.Name != "#1001" || len(order.LineItems) != 1 || order.LineItems[0].Quantity != 2 {
+		t.Errorf("unexpected payload %+v", order)
+	}
+
+	got, err = ParsePayload(TopicAppSubscriptionsApproachingCappedAmount, []byte(`{"app_subscription":{"admin_graphql_api_id":"gid://shopify/AppSubscription/1","balance_used":18.5,"capped_amount":"20.0","currency_code":"USD"}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	usage, ok := got.(*AppSubscriptionCappedAmountPayload)
+	if !ok {
+		t.Fatalf("got %T, want *AppSubscriptionCappedAmountPayload", got)
+	}
+	if usage.AppSubscription.BalanceUsed != "18.5" || usage.AppSubscription.CappedAmount != "20.0" {
+		t.Errorf("unexpected payload %+v", usage.AppSubscription)
+	}
+
+	if _, err = ParsePayload("unknown/topic", []byte(`{}`)); !errors.Is(err, ErrUnknownTopic) {
+		t.Errorf("got error %v, want ErrUnknownTopic", err)
+	}
+}